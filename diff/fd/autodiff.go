@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+)
+
+// DualGradient computes the gradient of the multivariate function f at the
+// location x by forward mode automatic differentiation. If dst is not nil,
+// the result will be stored in-place into dst and returned, otherwise a new
+// slice will be allocated first.
+//
+// The function f must be written in terms of the dual numbers of package
+// num/dual. It is evaluated len(x) times, each time with the dual part of
+// one element of x set to one, and the gradient is exact up to the rounding
+// error in the evaluation of f. DualGradient is a more accurate replacement
+// for Gradient.
+//
+// DualGradient panics if the length of dst and x is not equal.
+func DualGradient(dst []float64, f func(x []dual.Number) dual.Number, x []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("fd: slice length mismatch")
+	}
+	xd := make([]dual.Number, len(x))
+	for i := range x {
+		setDual(xd, x, i)
+		dst[i] = f(xd).Emag
+	}
+	return dst
+}
+
+// DualJacobian computes the Jacobian matrix of the vector-valued function f at
+// the location x by forward mode automatic differentiation and stores the
+// result in-place into dst.
+//
+// The function f must be written in terms of the dual numbers of package
+// num/dual and store its value at x into y. It is evaluated len(x) times,
+// each time giving one column of the Jacobian, which is exact up to the
+// rounding error in the evaluation of f. DualJacobian is a more accurate
+// replacement for Jacobian.
+//
+// dst must be non-nil and the number of its columns must equal the length
+// of x, otherwise DualJacobian will panic.
+func DualJacobian(dst *mat.Dense, f func(y, x []dual.Number), x []float64) {
+	n := len(x)
+	if n == 0 {
+		panic("jacobian: x has zero length")
+	}
+	m, c := dst.Dims()
+	if c != n {
+		panic("jacobian: mismatched matrix size")
+	}
+	xd := make([]dual.Number, n)
+	y := make([]dual.Number, m)
+	for j := 0; j < n; j++ {
+		setDual(xd, x, j)
+		for i := range y {
+			y[i] = dual.Number{}
+		}
+		f(y, xd)
+		for i, v := range y {
+			dst.Set(i, j, v.Emag)
+		}
+	}
+}
+
+// setDual sets xd to x with the dual part of the j-th element set to one.
+// All elements are set since f may modify its argument.
+func setDual(xd []dual.Number, x []float64, j int) {
+	for i, v := range x {
+		xd[i] = dual.Number{Real: v}
+	}
+	xd[j].Emag = 1
+}
+
+// HyperDualHessian computes the Hessian matrix of the multivariate function f
+// at the location x by forward mode automatic differentiation and stores the
+// result in dst.
+//
+// The function f must be written in terms of the hyperdual numbers of
+// package num/hyperdual. It is evaluated n(n+1)/2 times, where n is the
+// length of x, each time with the ε₁ part of x_i and the ε₂ part of x_j set
+// to one, which gives the element H_{i,j} = ∂^2 f(x)/∂x_i ∂x_j exactly up to
+// the rounding error in the evaluation of f. HyperDualHessian is a more
+// accurate replacement for Hessian.
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise the dimensions of dst must match the length of x or
+// HyperDualHessian will panic.
+func HyperDualHessian(dst *mat.SymDense, f func(x []hyperdual.Number) hyperdual.Number, x []float64) {
+	n := len(x)
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(n).(*mat.SymDense))
+	} else if dst.SymmetricDim() != n {
+		panic("hessian: dst size mismatch")
+	}
+	xh := make([]hyperdual.Number, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			for k, v := range x {
+				xh[k] = hyperdual.Number{Real: v}
+			}
+			xh[i].E1mag = 1
+			xh[j].E2mag = 1
+			dst.SetSym(i, j, f(xh).E1E2mag)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+)
+
+// adFunc is f(x) = x_0^2 x_1 + exp(x_1) sin(x_2) + x_0 x_2.
+func adFuncDual(x []dual.Number) dual.Number {
+	return dual.Add(
+		dual.Add(
+			dual.Mul(dual.Mul(x[0], x[0]), x[1]),
+			dual.Mul(dual.Exp(x[1]), dual.Sin(x[2])),
+		),
+		dual.Mul(x[0], x[2]),
+	)
+}
+
+func adFuncHyperDual(x []hyperdual.Number) hyperdual.Number {
+	return hyperdual.Add(
+		hyperdual.Add(
+			hyperdual.Mul(hyperdual.Mul(x[0], x[0]), x[1]),
+			hyperdual.Mul(hyperdual.Exp(x[1]), hyperdual.Sin(x[2])),
+		),
+		hyperdual.Mul(x[0], x[2]),
+	)
+}
+
+func adFuncGrad(grad, x []float64) {
+	grad[0] = 2*x[0]*x[1] + x[2]
+	grad[1] = x[0]*x[0] + math.Exp(x[1])*math.Sin(x[2])
+	grad[2] = math.Exp(x[1])*math.Cos(x[2]) + x[0]
+}
+
+func adFuncHess(hess *mat.SymDense, x []float64) {
+	hess.SetSym(0, 0, 2*x[1])
+	hess.SetSym(0, 1, 2*x[0])
+	hess.SetSym(0, 2, 1)
+	hess.SetSym(1, 1, math.Exp(x[1])*math.Sin(x[2]))
+	hess.SetSym(1, 2, math.Exp(x[1])*math.Cos(x[2]))
+	hess.SetSym(2, 2, -math.Exp(x[1])*math.Sin(x[2]))
+}
+
+// adVecFunc is f(x) = [x_0^2 x_1, 5 x_0 + x_2 sin(x_1)].
+func adVecFuncDual(y, x []dual.Number) {
+	y[0] = dual.Mul(dual.Mul(x[0], x[0]), x[1])
+	y[1] = dual.Add(dual.Scale(5, x[0]), dual.Mul(x[2], dual.Sin(x[1])))
+}
+
+func adVecFuncJac(jac *mat.Dense, x []float64) {
+	jac.Set(0, 0, 2*x[0]*x[1])
+	jac.Set(0, 1, x[0]*x[0])
+	jac.Set(0, 2, 0)
+	jac.Set(1, 0, 5)
+	jac.Set(1, 1, x[2]*math.Cos(x[1]))
+	jac.Set(1, 2, math.Sin(x[1]))
+}
+
+func TestDualGradient(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 10; i++ {
+		x := randomSlice(rnd, 3, 2)
+		want := make([]float64, 3)
+		adFuncGrad(want, x)
+		got := DualGradient(nil, adFuncDual, x)
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("unexpected gradient: got %v, want %v", got, want)
+		}
+		dst := make([]float64, 3)
+		DualGradient(dst, adFuncDual, x)
+		if !floats.Equal(dst, got) {
+			t.Errorf("gradient mismatch for non-nil dst: got %v, want %v", dst, got)
+		}
+	}
+}
+
+func TestDualJacobian(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10; i++ {
+		x := randomSlice(rnd, 3, 2)
+		want := mat.NewDense(2, 3, nil)
+		adVecFuncJac(want, x)
+		got := mat.NewDense(2, 3, nil)
+		DualJacobian(got, adVecFuncDual, x)
+		if !mat.EqualApprox(got, want, tol) {
+			t.Errorf("unexpected Jacobian:\ngot  %v\nwant %v", mat.Formatted(got), mat.Formatted(want))
+		}
+	}
+}
+
+func TestHyperDualHessian(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for i := 0; i < 10; i++ {
+		x := randomSlice(rnd, 3, 2)
+		want := mat.NewSymDense(3, nil)
+		adFuncHess(want, x)
+		var got mat.SymDense
+		HyperDualHessian(&got, adFuncHyperDual, x)
+		if !mat.EqualApprox(&got, want, tol) {
+			t.Errorf("unexpected Hessian:\ngot  %v\nwant %v", mat.Formatted(&got), mat.Formatted(want))
+		}
+	}
+}
@@ -3,4 +3,8 @@
 // license that can be found in the LICENSE file.
 
 // Package fd provides functions to approximate derivatives using finite differences.
+//
+// The package also provides functions computing derivatives exactly by forward
+// mode automatic differentiation of functions written in terms of the dual and
+// hyperdual numbers of packages num/dual and num/hyperdual.
 package fd // import "gonum.org/v1/gonum/diff/fd"
@@ -0,0 +1,313 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"container/heap"
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+const (
+	defaultRelTol         = 1e-6
+	defaultMaxEvaluations = 1000000
+)
+
+// Status is the termination status of an adaptive integration.
+type Status int
+
+const (
+	// Success indicates that the requested accuracy has been achieved.
+	Success Status = iota
+	// EvaluationLimit indicates that the maximum number of evaluations
+	// has been reached before achieving the requested accuracy.
+	EvaluationLimit
+)
+
+func (s Status) String() string {
+	switch s {
+	case Success:
+		return "Success"
+	case EvaluationLimit:
+		return "EvaluationLimit"
+	}
+	return "Status(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Settings holds the parameters of Adaptive. A nil *Settings is equivalent
+// to the zero value.
+type Settings struct {
+	// AbsTol and RelTol are the requested absolute and relative accuracy.
+	// The integration stops when the estimate of the absolute error is at
+	// most max(AbsTol, RelTol·|I|), where I is the estimate of the
+	// integral. If both are zero, a default RelTol of 1e-6 is used.
+	// AbsTol and RelTol must not be negative.
+	AbsTol, RelTol float64
+
+	// MaxEvaluations is the maximum number of evaluations of the
+	// integrand. If MaxEvaluations is zero, a default value of 1e6 is
+	// used. The integrand is always evaluated at the points of the rule
+	// over the whole hyperrectangle, even if their number exceeds
+	// MaxEvaluations. MaxEvaluations must not be negative.
+	MaxEvaluations int
+}
+
+// Adaptive approximates the integral of f over the hyperrectangle with the
+// lower corner min and the upper corner max by globally adaptive subdivision.
+// The hyperrectangle with the largest error estimate is repeatedly bisected
+// along the axis in which the integrand varies most until the requested
+// accuracy or the maximum number of evaluations is reached. The integral
+// over each hyperrectangle is estimated with the cubature rule of degree 7
+// of Genz and Malik, and its error with the embedded rule of degree 5.
+//
+// The rule has 2^n + 2n² + 2n + 1 points in n dimensions, so Adaptive is
+// suited to dimensions up to about 10. Smolyak or QuasiMonteCarlo are
+// more appropriate for higher dimensions.
+//
+// f must not modify its argument. Adaptive panics if the bounds are not
+// finite, if min[i] > max[i] for some i, if len(min) != len(max), if the
+// dimension is less than 2, or if the settings are invalid. One-dimensional
+// integrals can be computed with quad.Adaptive.
+//
+// References:
+//   - Genz, A. C., Malik, A. A. (1980). Remarks on algorithm 006: An
+//     adaptive algorithm for numerical integration over an N-dimensional
+//     rectangular region. Journal of Computational and Applied Mathematics,
+//     6(4), 295-302.
+//   - Berntsen, J., Espelid, T. O., Genz, A. (1991). An adaptive algorithm
+//     for the approximate calculation of multiple integrals. ACM
+//     Transactions on Mathematical Software, 17(4), 437-451.
+func Adaptive(f func(x []float64) float64, min, max []float64, settings *Settings) (Result, Status) {
+	checkBounds(min, max)
+	n := len(min)
+	if n < 2 {
+		panic("cubature: dimension less than 2")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	absTol, relTol := settings.AbsTol, settings.RelTol
+	maxEval := settings.MaxEvaluations
+	if absTol < 0 || relTol < 0 || maxEval < 0 {
+		panic("cubature: negative setting")
+	}
+	if absTol == 0 && relTol == 0 {
+		relTol = defaultRelTol
+	}
+	if maxEval == 0 {
+		maxEval = defaultMaxEvaluations
+	}
+
+	rule := newGenzMalik(n)
+	r := &region{
+		center:    make([]float64, n),
+		halfWidth: make([]float64, n),
+	}
+	for i, a := range min {
+		r.center[i] = (a + max[i]) / 2
+		r.halfWidth[i] = (max[i] - a) / 2
+	}
+	rule.integrate(f, r)
+	evals := rule.points
+	regions := regionHeap{r}
+	value, abserr := r.value, r.err
+	for abserr > math.Max(absTol, relTol*math.Abs(value)) {
+		if evals+2*rule.points > maxEval {
+			break
+		}
+		r := heap.Pop(&regions).(*region)
+		r1, r2 := r.split()
+		rule.integrate(f, r1)
+		rule.integrate(f, r2)
+		evals += 2 * rule.points
+		value += r1.value + r2.value - r.value
+		abserr += r1.err + r2.err - r.err
+		heap.Push(&regions, r1)
+		heap.Push(&regions, r2)
+	}
+
+	// Sum the estimates over the regions again to remove the error
+	// accumulated by the updates.
+	value, abserr = 0, 0
+	for _, r := range regions {
+		value += r.value
+		abserr += r.err
+	}
+	status := Success
+	if abserr > math.Max(absTol, relTol*math.Abs(value)) {
+		status = EvaluationLimit
+	}
+	return Result{Value: value, Error: abserr, Evaluations: evals}, status
+}
+
+// region is a hyperrectangle of an adaptive integration.
+type region struct {
+	center, halfWidth []float64
+	// value and err are the estimates of the integral over the region and
+	// of its error, and axis is the axis along which the region is to be
+	// split.
+	value, err float64
+	axis       int
+}
+
+// split returns the two halves of r along r.axis.
+func (r *region) split() (r1, r2 *region) {
+	r1 = &region{
+		center:    append([]float64(nil), r.center...),
+		halfWidth: append([]float64(nil), r.halfWidth...),
+	}
+	r1.halfWidth[r.axis] /= 2
+	r2 = &region{
+		center:    append([]float64(nil), r1.center...),
+		halfWidth: r1.halfWidth,
+	}
+	r1.center[r.axis] -= r1.halfWidth[r.axis]
+	r2.center[r.axis] += r1.halfWidth[r.axis]
+	return r1, r2
+}
+
+// regionHeap is a max-heap of regions ordered by their error estimates.
+type regionHeap []*region
+
+func (h regionHeap) Len() int           { return len(h) }
+func (h regionHeap) Less(i, j int) bool { return h[i].err > h[j].err }
+func (h regionHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *regionHeap) Push(x any)        { *h = append(*h, x.(*region)) }
+func (h *regionHeap) Pop() any {
+	old := *h
+	n := len(old)
+	r := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return r
+}
+
+// genzMalik is the embedded pair of cubature rules of degrees 7 and 5 of
+// Genz and Malik over n-dimensional hyperrectangles.
+type genzMalik struct {
+	n      int
+	points int
+
+	// w and w5 hold the weights of the rules of degree 7 and 5 of the
+	// center, the points at ±lambda2 and ±lambda3 on the axes, the
+	// points at ±lambda4 on two axes and, for w, the corners at ±lambda5.
+	w  [5]float64
+	w5 [4]float64
+
+	x                         []float64
+	f2, f3                    []float64
+	lambda2, lambda4, lambda5 float64
+}
+
+func newGenzMalik(n int) *genzMalik {
+	nf := float64(n)
+	return &genzMalik{
+		n:      n,
+		points: 1<<uint(n) + 2*n*n + 2*n + 1,
+		w: [5]float64{
+			(12824 - 9120*nf + 400*nf*nf) / 19683,
+			980.0 / 6561,
+			(1820 - 400*nf) / 19683,
+			200.0 / 19683,
+			6859.0 / 19683 / math.Ldexp(1, n),
+		},
+		w5: [4]float64{
+			(729 - 950*nf + 50*nf*nf) / 729,
+			245.0 / 486,
+			(265 - 100*nf) / 1458,
+			25.0 / 729,
+		},
+		x:       make([]float64, n),
+		f2:      make([]float64, n),
+		f3:      make([]float64, n),
+		lambda2: math.Sqrt(9.0 / 70),
+		lambda4: math.Sqrt(9.0 / 10),
+		lambda5: math.Sqrt(9.0 / 19),
+	}
+}
+
+// integrate estimates the integral of f over r and its error, and chooses
+// the axis along which r is to be split.
+func (g *genzMalik) integrate(f func([]float64) float64, r *region) {
+	c, h, x := r.center, r.halfWidth, g.x
+	copy(x, c)
+	f1 := f(x)
+
+	// The points on the axes. lambda3 is equal to lambda4.
+	var sum2, sum3 float64
+	for i := range x {
+		g.f2[i] = f(shift(x, i, c[i]-g.lambda2*h[i])) + f(shift(x, i, c[i]+g.lambda2*h[i]))
+		g.f3[i] = f(shift(x, i, c[i]-g.lambda4*h[i])) + f(shift(x, i, c[i]+g.lambda4*h[i]))
+		x[i] = c[i]
+		sum2 += g.f2[i]
+		sum3 += g.f3[i]
+	}
+
+	// The points on two axes.
+	var sum4 float64
+	for i := range x {
+		for j := i + 1; j < len(x); j++ {
+			for _, si := range [2]float64{-1, 1} {
+				x[i] = c[i] + si*g.lambda4*h[i]
+				for _, sj := range [2]float64{-1, 1} {
+					x[j] = c[j] + sj*g.lambda4*h[j]
+					sum4 += f(x)
+				}
+			}
+			x[j] = c[j]
+		}
+		x[i] = c[i]
+	}
+
+	// The corners, visited in Gray code order so that a single coordinate
+	// changes between consecutive corners.
+	for i := range x {
+		x[i] = c[i] - g.lambda5*h[i]
+	}
+	sum5 := f(x)
+	for k := uint(1); k < 1<<uint(g.n); k++ {
+		i := bits.TrailingZeros(k)
+		if (k^k>>1)&(1<<uint(i)) != 0 {
+			x[i] = c[i] + g.lambda5*h[i]
+		} else {
+			x[i] = c[i] - g.lambda5*h[i]
+		}
+		sum5 += f(x)
+	}
+
+	vol := 1.0
+	for _, v := range h {
+		vol *= 2 * v
+	}
+	i7 := g.w[0]*f1 + g.w[1]*sum2 + g.w[2]*sum3 + g.w[3]*sum4 + g.w[4]*sum5
+	i5 := g.w5[0]*f1 + g.w5[1]*sum2 + g.w5[2]*sum3 + g.w5[3]*sum4
+	r.value = vol * i7
+	r.err = vol * math.Abs(i7-i5)
+
+	// Split along the axis with the largest fourth divided difference, or
+	// the widest of the axes with differences equal up to roundoff.
+	var maxDiff float64
+	noise := 50 * eps * 12 * math.Abs(f1)
+	r.axis = 0
+	for i := range x {
+		d := math.Abs(g.f3[i] + 12*f1 - 7*g.f2[i])
+		switch {
+		case i == 0:
+			maxDiff = d
+		case d > maxDiff+noise:
+			r.axis, maxDiff = i, d
+		case d >= maxDiff-noise && h[i] > h[r.axis]:
+			r.axis = i
+			maxDiff = math.Max(maxDiff, d)
+		}
+	}
+}
+
+// shift sets x[i] to v and returns x.
+func shift(x []float64, i int, v float64) []float64 {
+	x[i] = v
+	return x
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdaptive(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{2, 3, 5} {
+		min, max := unitCube(n)
+		for _, test := range genzFunctions(n, float64(n)) {
+			for _, tol := range []float64{1e-4, 1e-6} {
+				if !test.smooth && tol < 1e-4 {
+					continue
+				}
+				res, status := Adaptive(test.f, min, max, &Settings{RelTol: tol, MaxEvaluations: 5e6})
+				if status != Success {
+					t.Errorf("n=%d %s tol=%g: unexpected status: got %v, want %v", n, test.name, tol, status, Success)
+					continue
+				}
+				if res.Error > tol*math.Abs(res.Value) {
+					t.Errorf("n=%d %s tol=%g: error estimate larger than the tolerance: %v", n, test.name, tol, res.Error)
+				}
+				if err := math.Abs(res.Value - test.exact); err > 10*tol*math.Abs(test.exact) {
+					t.Errorf("n=%d %s tol=%g: unexpected value: got %v, want %v (error %v, estimate %v)",
+						n, test.name, tol, res.Value, test.exact, err, res.Error)
+				}
+			}
+		}
+	}
+}
+
+func TestAdaptiveDegree(t *testing.T) {
+	t.Parallel()
+	// The rule of degree 7 integrates polynomials of degree up to 7
+	// exactly over a single hyperrectangle. For polynomials of degree up
+	// to 5, the embedded rule of degree 5 is also exact, so the error
+	// estimate vanishes and the hyperrectangle is not subdivided.
+	for _, n := range []int{2, 3, 4, 6} {
+		min := make([]float64, n)
+		max := make([]float64, n)
+		for i := range min {
+			min[i] = -1 + 0.5*float64(i)
+			max[i] = 2 + float64(i)
+		}
+		for _, test := range []struct {
+			deg int
+			// exactly indicates that the error estimate vanishes.
+			exactly bool
+		}{
+			{deg: 5, exactly: true},
+			{deg: 7},
+		} {
+			// f is the sum of x_i^deg and of x_i^2 x_j^(deg-2).
+			f := func(x []float64) float64 {
+				var s float64
+				for i, v := range x {
+					s += math.Pow(v, float64(test.deg))
+					for j := i + 1; j < len(x); j++ {
+						s += v * v * math.Pow(x[j], float64(test.deg-2))
+					}
+				}
+				return s
+			}
+			moment := func(i, k int) float64 {
+				return (math.Pow(max[i], float64(k+1)) - math.Pow(min[i], float64(k+1))) / float64(k+1)
+			}
+			vol := volume(min, max)
+			var want float64
+			for i := 0; i < n; i++ {
+				want += vol / (max[i] - min[i]) * moment(i, test.deg)
+				for j := i + 1; j < n; j++ {
+					want += vol / ((max[i] - min[i]) * (max[j] - min[j])) * moment(i, 2) * moment(j, test.deg-2)
+				}
+			}
+			points := newGenzMalik(n).points
+			res, status := Adaptive(f, min, max, &Settings{AbsTol: 1e-8 * math.Abs(want), MaxEvaluations: points})
+			if math.Abs(res.Value-want) > 1e-12*math.Abs(want) {
+				t.Errorf("n=%d degree %d: polynomial not integrated exactly: got %v, want %v", n, test.deg, res.Value, want)
+			}
+			if res.Evaluations != points {
+				t.Errorf("n=%d degree %d: unexpected number of evaluations: got %d, want %d", n, test.deg, res.Evaluations, points)
+			}
+			if (status == Success) != test.exactly {
+				t.Errorf("n=%d degree %d: unexpected status: %v", n, test.deg, status)
+			}
+		}
+	}
+}
+
+func TestAdaptiveEvaluationLimit(t *testing.T) {
+	t.Parallel()
+	const n = 4
+	min, max := unitCube(n)
+	test := genzFunctions(n, n)[3]
+	const maxEval = 2000
+	var evals int
+	f := func(x []float64) float64 {
+		evals++
+		return test.f(x)
+	}
+	res, status := Adaptive(f, min, max, &Settings{RelTol: 1e-12, MaxEvaluations: maxEval})
+	if status != EvaluationLimit {
+		t.Errorf("unexpected status: got %v, want %v", status, EvaluationLimit)
+	}
+	if res.Evaluations != evals {
+		t.Errorf("unexpected number of evaluations: got %d, want %d", res.Evaluations, evals)
+	}
+	if res.Evaluations > maxEval {
+		t.Errorf("evaluation limit exceeded: %d", res.Evaluations)
+	}
+	if err := math.Abs(res.Value - test.exact); err > 1e-2*test.exact {
+		t.Errorf("unexpected value at the evaluation limit: got %v, want %v", res.Value, test.exact)
+	}
+}
+
+func TestStatusString(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		s    Status
+		want string
+	}{
+		{Success, "Success"},
+		{EvaluationLimit, "EvaluationLimit"},
+		{Status(5), "Status(5)"},
+	} {
+		if got := test.s.String(); got != test.want {
+			t.Errorf("unexpected string: got %q, want %q", got, test.want)
+		}
+	}
+}
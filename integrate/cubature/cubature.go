@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import "math"
+
+// eps is the machine epsilon for float64.
+const eps = 0x1p-52
+
+// Result holds the estimate of an integral.
+type Result struct {
+	// Value is the estimate of the integral and Error is an estimate of
+	// its absolute error.
+	Value, Error float64
+
+	// Evaluations is the number of evaluations of the integrand.
+	Evaluations int
+}
+
+// checkBounds panics if the hyperrectangle with the lower corner min and the
+// upper corner max is empty, not finite, or has mismatched dimensions.
+func checkBounds(min, max []float64) {
+	if len(min) == 0 {
+		panic("cubature: zero dimension")
+	}
+	if len(min) != len(max) {
+		panic("cubature: bounds length mismatch")
+	}
+	for i, a := range min {
+		b := max[i]
+		if math.IsInf(a, 0) || math.IsNaN(a) || math.IsInf(b, 0) || math.IsNaN(b) {
+			panic("cubature: bounds not finite")
+		}
+		if b < a {
+			panic("cubature: maximum less than minimum")
+		}
+	}
+}
+
+// volume returns the volume of the hyperrectangle with the lower corner min
+// and the upper corner max.
+func volume(min, max []float64) float64 {
+	v := 1.0
+	for i, a := range min {
+		v *= max[i] - a
+	}
+	return v
+}
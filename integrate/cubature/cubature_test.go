@@ -0,0 +1,185 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// genzFunction is a test integrand of the families of Genz over the unit
+// hypercube with its exact integral.
+type genzFunction struct {
+	name  string
+	f     func(x []float64) float64
+	exact float64
+	// smooth indicates an integrand with continuous derivatives.
+	smooth bool
+}
+
+// genzFunctions returns the oscillatory, product peak, Gaussian and
+// continuous integrands of Genz in n dimensions. The difficulty of the
+// integrands increases with the sum of their scale parameters, which is
+// difficulty.
+func genzFunctions(n int, difficulty float64) []genzFunction {
+	a := make([]float64, n)
+	u := make([]float64, n)
+	var sum float64
+	for i := range a {
+		a[i] = 1 + 2*float64(i)/float64(n)
+		u[i] = 0.3 + 0.4*float64(i)/float64(n)
+		sum += a[i]
+	}
+	for i := range a {
+		a[i] *= difficulty / sum
+	}
+
+	osc := cmplx.Exp(complex(0, 2*math.Pi*u[0]))
+	peak := 1.0
+	gauss := 1.0
+	cont := 1.0
+	for i, ai := range a {
+		osc *= (cmplx.Exp(complex(0, ai)) - 1) / complex(0, ai)
+		peak *= ai * (math.Atan(ai*(1-u[i])) + math.Atan(ai*u[i]))
+		gauss *= math.Sqrt(math.Pi) / (2 * ai) * (math.Erf(ai*(1-u[i])) + math.Erf(ai*u[i]))
+		cont *= (2 - math.Exp(-ai*u[i]) - math.Exp(-ai*(1-u[i]))) / ai
+	}
+
+	return []genzFunction{
+		{
+			name: "oscillatory",
+			f: func(x []float64) float64 {
+				s := 2 * math.Pi * u[0]
+				for i, v := range x {
+					s += a[i] * v
+				}
+				return math.Cos(s)
+			},
+			exact:  real(osc),
+			smooth: true,
+		},
+		{
+			name: "product peak",
+			f: func(x []float64) float64 {
+				p := 1.0
+				for i, v := range x {
+					d := v - u[i]
+					p *= 1 / (1/(a[i]*a[i]) + d*d)
+				}
+				return p
+			},
+			exact:  peak,
+			smooth: true,
+		},
+		{
+			name: "Gaussian",
+			f: func(x []float64) float64 {
+				var s float64
+				for i, v := range x {
+					d := a[i] * (v - u[i])
+					s += d * d
+				}
+				return math.Exp(-s)
+			},
+			exact:  gauss,
+			smooth: true,
+		},
+		{
+			name: "continuous",
+			f: func(x []float64) float64 {
+				var s float64
+				for i, v := range x {
+					s += a[i] * math.Abs(v-u[i])
+				}
+				return math.Exp(-s)
+			},
+			exact: cont,
+		},
+	}
+}
+
+// unitCube returns the bounds of the n-dimensional unit hypercube.
+func unitCube(n int) (min, max []float64) {
+	min = make([]float64, n)
+	max = make([]float64, n)
+	for i := range max {
+		max[i] = 1
+	}
+	return min, max
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	f := func(x []float64) float64 { return 1 }
+	for _, test := range []struct {
+		name     string
+		min, max []float64
+	}{
+		{name: "zero dimension"},
+		{name: "length mismatch", min: []float64{0, 0}, max: []float64{1}},
+		{name: "infinite bound", min: []float64{0, 0}, max: []float64{1, math.Inf(1)}},
+		{name: "NaN bound", min: []float64{math.NaN(), 0}, max: []float64{1, 1}},
+		{name: "reversed bounds", min: []float64{0, 1}, max: []float64{1, 0}},
+	} {
+		for _, fn := range []func(){
+			func() { Adaptive(f, test.min, test.max, nil) },
+			func() { Smolyak(f, test.min, test.max, 2) },
+			func() { MonteCarlo(f, test.min, test.max, 10, nil) },
+			func() { QuasiMonteCarlo(f, test.min, test.max, 10, 4, Halton, nil) },
+		} {
+			if !panics(fn) {
+				t.Errorf("%s: expected panic", test.name)
+			}
+		}
+	}
+
+	min, max := unitCube(2)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "Adaptive one dimension", fn: func() { Adaptive(f, []float64{0}, []float64{1}, nil) }},
+		{name: "Adaptive negative tolerance", fn: func() { Adaptive(f, min, max, &Settings{RelTol: -1}) }},
+		{name: "Smolyak level", fn: func() { Smolyak(f, min, max, 0) }},
+		{name: "MonteCarlo points", fn: func() { MonteCarlo(f, min, max, 1, nil) }},
+		{name: "QuasiMonteCarlo points", fn: func() { QuasiMonteCarlo(f, min, max, 0, 4, Halton, nil) }},
+		{name: "QuasiMonteCarlo replicates", fn: func() { QuasiMonteCarlo(f, min, max, 10, 1, Halton, nil) }},
+		{name: "QuasiMonteCarlo sampling", fn: func() { QuasiMonteCarlo(f, min, max, 10, 4, Sampling(-1), nil) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func TestDegenerate(t *testing.T) {
+	t.Parallel()
+	f := func(x []float64) float64 { return 1 }
+	min := []float64{0, 2, 0}
+	max := []float64{1, 2, 1}
+	res, status := Adaptive(f, min, max, nil)
+	if res.Value != 0 || res.Error != 0 || status != Success {
+		t.Errorf("Adaptive: unexpected result over an empty hyperrectangle: %+v %v", res, status)
+	}
+	for name, res := range map[string]Result{
+		"Smolyak":         Smolyak(f, min, max, 3),
+		"MonteCarlo":      MonteCarlo(f, min, max, 10, nil),
+		"QuasiMonteCarlo": QuasiMonteCarlo(f, min, max, 10, 4, LatinHypercube, nil),
+	} {
+		if res.Value != 0 || res.Error != 0 {
+			t.Errorf("%s: unexpected result over an empty hyperrectangle: %+v", name, res)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
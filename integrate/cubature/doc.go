@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cubature provides numerical evaluation of integrals of functions
+// of several variables over hyperrectangles.
+//
+// The package provides deterministic and randomized methods:
+//
+//   - Adaptive subdivides the hyperrectangle adaptively using the embedded
+//     cubature rules of Genz and Malik. It is efficient for smooth or
+//     locally peaked integrands in low to moderate dimensions.
+//   - Smolyak evaluates a sparse grid built from one-dimensional
+//     Gauss–Legendre rules. It is efficient for smooth integrands in
+//     moderate to high dimensions.
+//   - MonteCarlo and QuasiMonteCarlo average the integrand over random and
+//     low-discrepancy points. They are the methods of choice for
+//     non-smooth integrands and for high dimensions, and estimate their
+//     errors statistically.
+package cubature // import "gonum.org/v1/gonum/integrate/cubature"
@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/integrate/cubature"
+)
+
+func ExampleAdaptive() {
+	// The volume of the unit ball in 3 dimensions is the integral of its
+	// indicator function, whose discontinuity makes the integration
+	// difficult, so the integral of the height of the upper half of the
+	// ball over the unit disk in 2 dimensions is computed instead.
+	f := func(x []float64) float64 {
+		r2 := x[0]*x[0] + x[1]*x[1]
+		if r2 >= 1 {
+			return 0
+		}
+		return 2 * math.Sqrt(1-r2)
+	}
+	res, status := cubature.Adaptive(f, []float64{-1, -1}, []float64{1, 1}, &cubature.Settings{RelTol: 1e-6})
+	fmt.Printf("status: %v\n", status)
+	fmt.Printf("volume: %.5f (want %.5f)\n", res.Value, 4*math.Pi/3)
+
+	// Output:
+	// status: Success
+	// volume: 4.18879 (want 4.18879)
+}
+
+func ExampleSmolyak() {
+	// The expected value of exp(Σx_i/n) for x uniformly distributed over
+	// the 20-dimensional unit hypercube.
+	const n = 20
+	f := func(x []float64) float64 {
+		var s float64
+		for _, v := range x {
+			s += v
+		}
+		return math.Exp(s / n)
+	}
+	min := make([]float64, n)
+	max := make([]float64, n)
+	for i := range max {
+		max[i] = 1
+	}
+	want := math.Pow(n*(math.Exp(1.0/n)-1), n)
+	for level := 1; level <= 4; level++ {
+		res := cubature.Smolyak(f, min, max, level)
+		fmt.Printf("level %d: %.10f with %5d evaluations\n", level, res.Value, res.Evaluations)
+	}
+	fmt.Printf("exact:   %.10f\n", want)
+
+	// Output:
+	// level 1: 1.6487212707 with     1 evaluations
+	// level 2: 1.6521561663 with    41 evaluations
+	// level 3: 1.6521596132 with   841 evaluations
+	// level 4: 1.6521596154 with 11561 evaluations
+	// exact:   1.6521596154
+}
+
+func ExampleQuasiMonteCarlo() {
+	// The probability that the sum of 10 uniform random variables is
+	// less than 3.
+	const n = 10
+	f := func(x []float64) float64 {
+		var s float64
+		for _, v := range x {
+			s += v
+		}
+		if s < 3 {
+			return 1
+		}
+		return 0
+	}
+	min := make([]float64, n)
+	max := make([]float64, n)
+	for i := range max {
+		max[i] = 1
+	}
+	src := rand.NewPCG(1, 1)
+	res := cubature.QuasiMonteCarlo(f, min, max, 10000, 10, cubature.Halton, src)
+	fmt.Printf("probability: %.4f ± %.4f\n", res.Value, res.Error)
+
+	// The exact probability is given by the Irwin–Hall distribution.
+	want := (math.Pow(3, n) - n*math.Pow(2, n) + n*(n-1)/2) / 3628800
+	fmt.Printf("exact:       %.4f\n", want)
+
+	// Output:
+	// probability: 0.0138 ± 0.0003
+	// exact:       0.0135
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/r1"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distmv"
+	"gonum.org/v1/gonum/stat/samplemv"
+)
+
+// MonteCarlo approximates the integral of f over the hyperrectangle with the
+// lower corner min and the upper corner max by the average of f at n points
+// sampled independently from the uniform distribution over the
+// hyperrectangle, multiplied by its volume. The error estimate is the
+// standard error of the estimate, which decreases as 1/√n independently of
+// the dimension. If src is not nil, it is used to generate the points,
+// otherwise the global rand package is used.
+//
+// f must not modify its argument. MonteCarlo panics if the bounds are not
+// finite, if min[i] > max[i] for some i, if len(min) != len(max), or if n is
+// less than 2.
+func MonteCarlo(f func(x []float64) float64, min, max []float64, n int, src rand.Source) Result {
+	checkBounds(min, max)
+	if n < 2 {
+		panic("cubature: fewer than two points")
+	}
+	vol := volume(min, max)
+	if vol == 0 {
+		return Result{}
+	}
+	bounds := make([]r1.Interval, len(min))
+	for i, a := range min {
+		bounds[i] = r1.Interval{Min: a, Max: max[i]}
+	}
+	batch := mat.NewDense(n, len(min), nil)
+	samplemv.IID{Dist: distmv.NewUniform(bounds, src)}.Sample(batch)
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = f(batch.RawRowView(i))
+	}
+	mean, std := stat.MeanStdDev(values, nil)
+	return Result{
+		Value:       vol * mean,
+		Error:       vol * std / math.Sqrt(float64(n)),
+		Evaluations: n,
+	}
+}
+
+// Sampling specifies the point sets used by QuasiMonteCarlo.
+type Sampling int
+
+const (
+	// Halton uses the Halton sequence scrambled by the randomized van der
+	// Corput algorithm of samplemv.Halton.
+	Halton Sampling = iota
+	// LatinHypercube uses the Latin hypercube samples of
+	// samplemv.LatinHypercube.
+	LatinHypercube
+)
+
+// QuasiMonteCarlo approximates the integral of f over the hyperrectangle
+// with the lower corner min and the upper corner max by randomized
+// quasi-Monte Carlo integration. The integrand is averaged over the given
+// number of independent randomizations of a point set of n points given by
+// sampling, each of which gives an unbiased estimate of the integral. The
+// estimate is the mean of the estimates of the replicates, and the error
+// estimate is its standard error. If src is not nil, it is used to
+// generate the randomizations, otherwise the global rand package is used.
+//
+// For smooth integrands, the error of the estimate of the Halton sequence
+// decreases almost as 1/n, much faster than that of MonteCarlo for the
+// same number of evaluations. The Latin hypercube samples reduce the
+// variance of the estimate relative to MonteCarlo for integrands that are
+// close to sums of functions of single variables.
+//
+// f must not modify its argument. QuasiMonteCarlo panics if the bounds are
+// not finite, if min[i] > max[i] for some i, if len(min) != len(max), if n
+// is less than 1, if replicates is less than 2, or if sampling is unknown.
+//
+// Reference:
+//
+//	Owen, A. B. (2013). Monte Carlo theory, methods and examples. Chapter 17.
+func QuasiMonteCarlo(f func(x []float64) float64, min, max []float64, n, replicates int, sampling Sampling, src rand.Source) Result {
+	checkBounds(min, max)
+	if n < 1 {
+		panic("cubature: fewer than one point")
+	}
+	if replicates < 2 {
+		panic("cubature: fewer than two replicates")
+	}
+	if sampling != Halton && sampling != LatinHypercube {
+		panic("cubature: unknown sampling")
+	}
+	vol := volume(min, max)
+	if vol == 0 {
+		return Result{}
+	}
+
+	seed := rand.Uint64
+	if src != nil {
+		seed = rand.New(src).Uint64
+	}
+	d := len(min)
+	unit := distmv.NewUnitUniform(d, nil)
+	batch := mat.NewDense(n, d, nil)
+	x := make([]float64, d)
+	estimates := make([]float64, replicates)
+	for r := range estimates {
+		rsrc := rand.NewPCG(seed(), seed())
+		// samplemv.Halton adds the scrambled digits to the batch, so the
+		// batch is cleared before every replicate.
+		batch.Zero()
+		switch sampling {
+		case Halton:
+			samplemv.Halton{Kind: samplemv.Owen, Q: unit, Src: rsrc}.Sample(batch)
+		case LatinHypercube:
+			samplemv.LatinHypercube{Q: unit, Src: rsrc}.Sample(batch)
+		}
+		var sum float64
+		for i := 0; i < n; i++ {
+			for j, u := range batch.RawRowView(i) {
+				x[j] = min[j] + u*(max[j]-min[j])
+			}
+			sum += f(x)
+		}
+		estimates[r] = vol * sum / float64(n)
+	}
+	mean, std := stat.MeanStdDev(estimates, nil)
+	return Result{
+		Value:       mean,
+		Error:       std / math.Sqrt(float64(replicates)),
+		Evaluations: n * replicates,
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestMonteCarlo(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{3, 8, 20} {
+		min, max := unitCube(n)
+		for _, test := range genzFunctions(n, 5) {
+			const points = 20000
+			res := MonteCarlo(test.f, min, max, points, rand.NewPCG(1, uint64(n)))
+			if res.Evaluations != points {
+				t.Errorf("n=%d %s: unexpected number of evaluations: got %d, want %d", n, test.name, res.Evaluations, points)
+			}
+			// The estimate is approximately normally distributed,
+			// so an error larger than 5 standard errors is
+			// extremely unlikely.
+			if err := math.Abs(res.Value - test.exact); err > 5*res.Error {
+				t.Errorf("n=%d %s: error larger than the error estimate: got %v, want %v (error %v, estimate %v)",
+					n, test.name, res.Value, test.exact, err, res.Error)
+			}
+			if res.Error > 0.1*math.Abs(test.exact) {
+				t.Errorf("n=%d %s: error estimate too large: %v", n, test.name, res.Error)
+			}
+		}
+	}
+}
+
+func TestQuasiMonteCarlo(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{3, 8, 20} {
+		min, max := unitCube(n)
+		for _, test := range genzFunctions(n, 5) {
+			const (
+				points     = 1000
+				replicates = 20
+			)
+			mc := MonteCarlo(test.f, min, max, points*replicates, rand.NewPCG(2, uint64(n)))
+			for _, sampling := range []Sampling{Halton, LatinHypercube} {
+				res := QuasiMonteCarlo(test.f, min, max, points, replicates, sampling, rand.NewPCG(3, uint64(n)))
+				if res.Evaluations != points*replicates {
+					t.Errorf("n=%d %s sampling=%d: unexpected number of evaluations: got %d, want %d",
+						n, test.name, sampling, res.Evaluations, points*replicates)
+				}
+				// The mean of the replicates has a Student's t
+				// distribution with 19 degrees of freedom, for
+				// which 6 standard errors are extremely unlikely.
+				if err := math.Abs(res.Value - test.exact); err > 6*res.Error {
+					t.Errorf("n=%d %s sampling=%d: error larger than the error estimate: got %v, want %v (error %v, estimate %v)",
+						n, test.name, sampling, res.Value, test.exact, err, res.Error)
+				}
+				// Randomized quasi-Monte Carlo does not increase
+				// the variance much relative to Monte Carlo, and
+				// the Halton points reduce it substantially for
+				// smooth integrands in moderate dimensions.
+				if res.Error > 2*mc.Error {
+					t.Errorf("n=%d %s sampling=%d: error estimate larger than for Monte Carlo: got %v, Monte Carlo %v",
+						n, test.name, sampling, res.Error, mc.Error)
+				}
+				if sampling == Halton && test.smooth && n <= 8 && res.Error > mc.Error/5 {
+					t.Errorf("n=%d %s: error estimate not reduced relative to Monte Carlo: got %v, Monte Carlo %v",
+						n, test.name, res.Error, mc.Error)
+				}
+
+				again := QuasiMonteCarlo(test.f, min, max, points, replicates, sampling, rand.NewPCG(3, uint64(n)))
+				if again != res {
+					t.Errorf("n=%d %s sampling=%d: result not reproducible with the same source", n, test.name, sampling)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/integrate/quad"
+	"gonum.org/v1/gonum/stat/combin"
+)
+
+// Smolyak approximates the integral of f over the hyperrectangle with the
+// lower corner min and the upper corner max with the Smolyak sparse grid of
+// the given level built from the Gauss–Legendre rules of quad.Legendre. The
+// one-dimensional rule of level l has l points, and the sparse grid of level
+// L is exact for polynomials of total degree at most 2L-1. Level 1 is the
+// midpoint rule.
+//
+// For a fixed level, the number of points of the sparse grid grows
+// polynomially with the dimension instead of exponentially as for tensor
+// product rules, so Smolyak is suited to smooth integrands in high
+// dimensions.
+//
+// The error is estimated by the difference with the sparse grid of level
+// L-1, which overestimates the error of smooth integrands. The error
+// estimate is +Inf for level 1.
+//
+// f must not modify its argument. Smolyak panics if the bounds are not
+// finite, if min[i] > max[i] for some i, if len(min) != len(max), or if
+// level is less than 1.
+//
+// Reference:
+//
+//	Gerstner, T., Griebel, M. (1998). Numerical integration using sparse
+//	grids. Numerical Algorithms, 18(3-4), 209-232.
+func Smolyak(f func(x []float64) float64, min, max []float64, level int) Result {
+	checkBounds(min, max)
+	if level < 1 {
+		panic("cubature: level less than 1")
+	}
+	if volume(min, max) == 0 {
+		return Result{}
+	}
+	s := newSparseGrid(f, min, max, level)
+	v := s.integrate(level)
+	if level == 1 {
+		return Result{Value: v, Error: math.Inf(1), Evaluations: len(s.values)}
+	}
+	e := math.Abs(v - s.integrate(level-1))
+	return Result{Value: v, Error: e, Evaluations: len(s.values)}
+}
+
+// sparseGrid evaluates Smolyak sparse grids, caching the values of the
+// integrand at the points shared between the grids.
+type sparseGrid struct {
+	f func([]float64) float64
+	n int
+	// nodes[i][l-1] and weights[i][l-1] hold the Gauss–Legendre rule of
+	// level l along the axis i.
+	nodes, weights [][][]float64
+
+	values map[string]float64
+
+	x   []float64
+	key []byte
+}
+
+func newSparseGrid(f func([]float64) float64, min, max []float64, level int) *sparseGrid {
+	n := len(min)
+	s := &sparseGrid{
+		f:       f,
+		n:       n,
+		nodes:   make([][][]float64, n),
+		weights: make([][][]float64, n),
+		values:  make(map[string]float64),
+		x:       make([]float64, n),
+		key:     make([]byte, 8*n),
+	}
+	for i := range s.nodes {
+		s.nodes[i] = make([][]float64, level)
+		s.weights[i] = make([][]float64, level)
+		for l := 1; l <= level; l++ {
+			x := make([]float64, l)
+			w := make([]float64, l)
+			quad.Legendre{}.FixedLocations(x, w, min[i], max[i])
+			s.nodes[i][l-1] = x
+			s.weights[i][l-1] = w
+		}
+	}
+	return s
+}
+
+// integrate returns the sparse grid estimate of the given level by the
+// combination technique
+//
+//	A(L) = Σ (-1)^(q-|l|) C(n-1, q-|l|) U(l_1) ⊗ ... ⊗ U(l_n)
+//
+// over the multi-indices l with l_i ≥ 1 and q-n+1 ≤ |l| ≤ q, where
+// q = L+n-1 and U(l) is the one-dimensional rule of level l.
+func (s *sparseGrid) integrate(level int) float64 {
+	q := level + s.n - 1
+	l := make([]int, s.n)
+	var sum float64
+	var visit func(i, remaining int)
+	visit = func(i, remaining int) {
+		if i == s.n-1 {
+			// |l| ranges over [max(n, q-n+1), q].
+			used := q - remaining
+			for li := 1; li <= remaining; li++ {
+				norm := used + li
+				if norm < q-s.n+1 {
+					continue
+				}
+				l[i] = li
+				c := float64(combin.Binomial(s.n-1, q-norm))
+				if (q-norm)%2 == 1 {
+					c = -c
+				}
+				sum += c * s.tensor(l)
+			}
+			return
+		}
+		// Leave at least one level for each of the remaining axes.
+		for li := 1; li <= remaining-(s.n-1-i); li++ {
+			l[i] = li
+			visit(i+1, remaining-li)
+		}
+	}
+	visit(0, q)
+	return sum
+}
+
+// tensor returns the estimate of the tensor product rule of the levels l.
+func (s *sparseGrid) tensor(l []int) float64 {
+	idx := make([]int, s.n)
+	var sum float64
+	for {
+		w := 1.0
+		for i, k := range idx {
+			s.x[i] = s.nodes[i][l[i]-1][k]
+			w *= s.weights[i][l[i]-1][k]
+		}
+		sum += w * s.eval(s.x)
+
+		// Advance the multi-index of the points.
+		i := 0
+		for ; i < s.n; i++ {
+			idx[i]++
+			if idx[i] < l[i] {
+				break
+			}
+			idx[i] = 0
+		}
+		if i == s.n {
+			return sum
+		}
+	}
+}
+
+// eval returns f(x), evaluating f only at the points where it has not been
+// evaluated before.
+func (s *sparseGrid) eval(x []float64) float64 {
+	for i, v := range x {
+		b := math.Float64bits(v)
+		for j := 0; j < 8; j++ {
+			s.key[8*i+j] = byte(b >> (8 * j))
+		}
+	}
+	if v, ok := s.values[string(s.key)]; ok {
+		return v
+	}
+	v := s.f(x)
+	s.values[string(s.key)] = v
+	return v
+}
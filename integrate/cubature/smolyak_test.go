@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSmolyakExactness(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 3, 5, 8} {
+		for level := 1; level <= 4; level++ {
+			min := make([]float64, n)
+			max := make([]float64, n)
+			for i := range min {
+				min[i] = rnd.Float64() - 1
+				max[i] = min[i] + 0.5 + rnd.Float64()
+			}
+			// f is a sum of random monomials of total degree at most
+			// 2*level-1, with the exponents spread over random axes.
+			deg := 2*level - 1
+			type monomial struct {
+				coef float64
+				pow  []int
+			}
+			var terms []monomial
+			for k := 0; k < 10; k++ {
+				m := monomial{coef: rnd.NormFloat64(), pow: make([]int, n)}
+				for d := rnd.IntN(deg + 1); d > 0; d-- {
+					m.pow[rnd.IntN(n)]++
+				}
+				terms = append(terms, m)
+			}
+			f := func(x []float64) float64 {
+				var s float64
+				for _, m := range terms {
+					p := m.coef
+					for i, k := range m.pow {
+						p *= math.Pow(x[i], float64(k))
+					}
+					s += p
+				}
+				return s
+			}
+			var want float64
+			for _, m := range terms {
+				p := m.coef
+				for i, k := range m.pow {
+					p *= (math.Pow(max[i], float64(k+1)) - math.Pow(min[i], float64(k+1))) / float64(k+1)
+				}
+				want += p
+			}
+			res := Smolyak(f, min, max, level)
+			if math.Abs(res.Value-want) > 1e-12*math.Max(1, math.Abs(want)) {
+				t.Errorf("n=%d level=%d: polynomial of degree %d not integrated exactly: got %v, want %v",
+					n, level, deg, res.Value, want)
+			}
+			if level == 1 && !math.IsInf(res.Error, 1) {
+				t.Errorf("n=%d level=1: unexpected error estimate: got %v, want +Inf", n, res.Error)
+			}
+		}
+	}
+}
+
+func TestSmolyakConvergence(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{3, 10, 20} {
+		min, max := unitCube(n)
+		for _, test := range genzFunctions(n, 5)[:3] {
+			prev := math.Inf(1)
+			for level := 2; level <= 5; level++ {
+				var evals int
+				f := func(x []float64) float64 {
+					evals++
+					return test.f(x)
+				}
+				res := Smolyak(f, min, max, level)
+				if res.Evaluations != evals {
+					t.Errorf("n=%d %s level=%d: unexpected number of evaluations: got %d, want %d",
+						n, test.name, level, res.Evaluations, evals)
+				}
+				err := math.Abs(res.Value - test.exact)
+				if err > prev {
+					t.Errorf("n=%d %s level=%d: error increased: got %v, previous %v", n, test.name, level, err, prev)
+				}
+				if err > res.Error {
+					t.Errorf("n=%d %s level=%d: error estimate too small: got %v, actual error %v", n, test.name, level, res.Error, err)
+				}
+				prev = err
+			}
+			if rel := prev / math.Abs(test.exact); rel > 1e-2 {
+				t.Errorf("n=%d %s: relative error too large at level 5: %v", n, test.name, rel)
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package integrate
+
+import "sort"
+
+// gregoryCoefficients are the absolute values of the Gregory coefficients
+// G_2, ..., G_10 used by the end corrections of Gregory.
+var gregoryCoefficients = [...]float64{
+	1.0 / 12,
+	1.0 / 24,
+	19.0 / 720,
+	3.0 / 160,
+	863.0 / 60480,
+	275.0 / 24192,
+	33953.0 / 3628800,
+	8183.0 / 1036800,
+	3250433.0 / 479001600,
+}
+
+// Gregory returns an approximate value of the integral
+//
+//	\int_a^b f(x)dx
+//
+// computed using the trapezoidal rule with the Gregory end corrections of
+// the given order. The function f is given as a slice of samples evaluated
+// at locations in x, that is,
+//
+//	f[i] = f(x[i]), x[0] = a, x[len(x)-1] = b
+//
+// For equally spaced samples with the spacing h, the corrections of order k
+// replace the derivatives in the Euler–Maclaurin expansion of the error of
+// the trapezoidal rule by the forward differences of order up to k at the
+// start and the backward differences at the end,
+//
+//	\int_a^b f(x)dx ≈ T - h \sum_{j=1}^k G_{j+1} (∇^j f[n-1] + (-1)^j Δ^j f[0]),
+//
+// where T is the value of the trapezoidal rule, G_j are the Gregory
+// coefficients and n is the number of samples. The rule is then exact if f is
+// a polynomial of degree at most k for odd k and k+1 for even k, and only
+// modifies the weights of the k+1 samples at each end, so it is well suited
+// to long sequences of samples of smooth functions. Order zero gives the
+// trapezoidal rule.
+//
+// For samples that are not equally spaced, the locations are treated as a
+// smooth function x(u) of the sample index u, and the equally spaced rule is
+// applied to the integral of f(x(u)) x'(u) over u. The derivative x'(u) at
+// every sample is computed by differentiating the polynomial of degree k+2
+// interpolating the locations of the nearest k+3 samples. The rule keeps its
+// order of accuracy if the spacing of the samples varies smoothly, as for
+// graded or stretched grids. For irregularly spaced samples, such as jittered
+// measurement times, NewtonCotes should be used instead.
+//
+// The slice x must be sorted in strictly increasing order. x and f must be of
+// equal length, the order must be between 0 and 9, and the length must be at
+// least order+1 and at least 2.
+//
+// See https://en.wikipedia.org/wiki/Gregory_coefficients for the
+// coefficients.
+func Gregory(x, f []float64, order int) float64 {
+	n := len(x)
+	switch {
+	case len(f) != n:
+		panic("integrate: slice length mismatch")
+	case order < 0 || order > len(gregoryCoefficients):
+		panic("integrate: invalid order")
+	case n < 2 || n < order+1:
+		panic("integrate: input data too small")
+	case !sort.Float64sAreSorted(x):
+		panic("integrate: input must be sorted")
+	}
+
+	// g holds the samples of f(x(u)) x'(u) at the sample indices.
+	g := make([]float64, n)
+	p := min(order+2, n-1)
+	weights := derivativeWeights(p)
+	for i := range g {
+		// The stencil of the p+1 samples is centered at i as far as
+		// the bounds allow.
+		lo := max(0, min(i-p/2, n-1-p))
+		var d float64
+		for j, w := range weights[i-lo] {
+			d += w * x[lo+j]
+		}
+		if !(d > 0) {
+			panic("integrate: repeated abscissa")
+		}
+		g[i] = f[i] * d
+	}
+
+	integral := (g[0] + g[n-1]) / 2
+	for _, v := range g[1 : n-1] {
+		integral += v
+	}
+
+	// fwd and bwd hold the forward differences at the start and the
+	// backward differences at the end of the samples of the current order.
+	fwd := make([]float64, order+1)
+	bwd := make([]float64, order+1)
+	copy(fwd, g[:order+1])
+	copy(bwd, g[n-order-1:])
+	sign := 1.0
+	for j := 1; j <= order; j++ {
+		for i := 0; i < order+1-j; i++ {
+			fwd[i] = fwd[i+1] - fwd[i]
+			bwd[i] = bwd[i+1] - bwd[i]
+		}
+		sign = -sign
+		// After j differences, fwd[0] is Δ^j g[0] and bwd[order-j] is
+		// ∇^j g[n-1].
+		integral -= gregoryCoefficients[j-1] * (bwd[order-j] + sign*fwd[0])
+	}
+	return integral
+}
+
+// derivativeWeights returns the weights of the derivatives at the locations
+// 0, ..., p of the polynomial of degree p interpolating values at the same
+// locations. The derivative at location k is the sum of the products of the
+// values and the weights in the k-th element of the result.
+func derivativeWeights(p int) [][]float64 {
+	weights := make([][]float64, p+1)
+	for k := range weights {
+		weights[k] = make([]float64, p+1)
+		t := float64(k)
+		// The derivative of the j-th Lagrange basis polynomial is the
+		// sum over m of the products of the factors other than m.
+		for j := range weights[k] {
+			var d float64
+			for m := 0; m <= p; m++ {
+				if m == j {
+					continue
+				}
+				prod := 1 / float64(j-m)
+				for l := 0; l <= p; l++ {
+					if l == j || l == m {
+						continue
+					}
+					prod *= (t - float64(l)) / float64(j-l)
+				}
+				d += prod
+			}
+			weights[k][j] = d
+		}
+	}
+	return weights
+}
@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package integrate
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/integrate/testquad"
+)
+
+func TestGregory(t *testing.T) {
+	t.Parallel()
+	for order := 0; order <= 9; order++ {
+		// The rule is exact for polynomials of degree order, and of
+		// degree order+1 for even order.
+		exact := order
+		if order%2 == 0 {
+			exact++
+		}
+		for _, n := range []int{order + 1, order + 4, 3*order + 10} {
+			if n < 2 {
+				continue
+			}
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = -1 + 3*float64(i)/float64(n-1)
+			}
+			for k := 0; k <= exact; k++ {
+				f := make([]float64, n)
+				for i, xi := range x {
+					f[i] = math.Pow(xi, float64(k))
+				}
+				got := Gregory(x, f, order)
+				want := (math.Pow(2, float64(k+1)) - math.Pow(-1, float64(k+1))) / float64(k+1)
+				if math.Abs(got-want) > 1e-11*math.Max(1, math.Abs(want)) {
+					t.Errorf("order=%d, n=%d: x^%d not integrated exactly: got=%v want=%v", order, n, k, got, want)
+				}
+			}
+		}
+	}
+
+	for i, test := range []struct {
+		integral testquad.Integral
+		n        int
+		order    int
+		tol      float64
+	}{
+		{integral: testquad.Sin(), n: 101, order: 0, tol: 1e-4},
+		{integral: testquad.Sin(), n: 101, order: 2, tol: 1e-8},
+		{integral: testquad.Sin(), n: 101, order: 6, tol: 1e-13},
+		{integral: testquad.XExpMinusX(), n: 201, order: 4, tol: 1e-11},
+		{integral: testquad.ExpOverX2Plus1(), n: 101, order: 8, tol: 1e-12},
+	} {
+		a, b := test.integral.A, test.integral.B
+		x := make([]float64, test.n)
+		f := make([]float64, test.n)
+		for j := range f {
+			x[j] = a + float64(j)*(b-a)/float64(test.n-1)
+			f[j] = test.integral.F(x[j])
+		}
+		got := Gregory(x, f, test.order)
+		if diff := math.Abs(got - test.integral.Value); diff > test.tol {
+			t.Errorf("Test #%d: %v, n=%v, order=%d: unexpected result; got=%v want=%v diff=%v",
+				i, test.integral.Name, test.n, test.order, got, test.integral.Value, diff)
+		}
+	}
+}
+
+func TestGregoryNonUniform(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		integral testquad.Integral
+		order    int
+		tol      float64
+	}{
+		{integral: testquad.Sin(), order: 2, tol: 1e-7},
+		{integral: testquad.Sin(), order: 5, tol: 1e-10},
+		{integral: testquad.XExpMinusX(), order: 4, tol: 1e-9},
+		{integral: testquad.ExpOverX2Plus1(), order: 8, tol: 1e-11},
+	} {
+		a, b := test.integral.A, test.integral.B
+		var prev float64
+		for _, n := range []int{101, 201} {
+			// The samples are graded towards a with the spacing
+			// growing by a factor of about e^3 over the interval.
+			const s = 3
+			x := make([]float64, n)
+			f := make([]float64, n)
+			for i := range x {
+				u := float64(i) / float64(n-1)
+				x[i] = a + (b-a)*math.Expm1(s*u)/math.Expm1(s)
+				f[i] = test.integral.F(x[i])
+			}
+			x[n-1] = b
+			got := Gregory(x, f, test.order)
+			diff := math.Abs(got - test.integral.Value)
+			if n == 201 && diff > test.tol {
+				t.Errorf("%v, n=%d, order=%d: unexpected result; got=%v want=%v diff=%v",
+					test.integral.Name, n, test.order, got, test.integral.Value, diff)
+			}
+			if trap := math.Abs(Trapezoidal(x, f) - test.integral.Value); diff > trap/100 {
+				t.Errorf("%v, n=%d, order=%d: error not smaller than for Trapezoidal: got %v, Trapezoidal %v",
+					test.integral.Name, n, test.order, diff, trap)
+			}
+			// The error decreases at least as h^(order+1) when the
+			// number of samples is doubled.
+			if n == 201 && diff > 4*prev/math.Pow(2, float64(test.order+1)) && diff > 1e-13 {
+				t.Errorf("%v, order=%d: error not reduced enough: got %v, previous %v",
+					test.integral.Name, test.order, diff, prev)
+			}
+			prev = diff
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package integrate
+
+import "gonum.org/v1/gonum/mat"
+
+// Trapezoidal2D returns an approximate value of the integral
+//
+//	\int_a^b \int_c^d f(x, y) dy dx
+//
+// computed by applying the trapezoidal rule in y and then in x. The function
+// f is given as a matrix of samples evaluated on the grid of locations in x
+// and y, that is,
+//
+//	f.At(i, j) = f(x[i], y[j]), x[0] = a, x[len(x)-1] = b, y[0] = c, y[len(y)-1] = d
+//
+// The slices x and y must be sorted in strictly increasing order, their
+// lengths must be at least 2 and f must be a len(x)×len(y) matrix.
+func Trapezoidal2D(x, y []float64, f mat.Matrix) float64 {
+	return integrate2D(x, y, f, Trapezoidal)
+}
+
+// Simpsons2D returns an approximate value of the integral
+//
+//	\int_a^b \int_c^d f(x, y) dy dx
+//
+// computed by applying the Simpsons's method in y and then in x. The function
+// f is given as a matrix of samples evaluated on the grid of locations in x
+// and y, that is,
+//
+//	f.At(i, j) = f(x[i], y[j]), x[0] = a, x[len(x)-1] = b, y[0] = c, y[len(y)-1] = d
+//
+// The slices x and y must be sorted in strictly increasing order, their
+// lengths must be at least 3 and f must be a len(x)×len(y) matrix.
+func Simpsons2D(x, y []float64, f mat.Matrix) float64 {
+	return integrate2D(x, y, f, Simpsons)
+}
+
+// integrate2D integrates the gridded samples in f by applying the rule to
+// every row and then to the integrals of the rows.
+func integrate2D(x, y []float64, f mat.Matrix, rule func(x, f []float64) float64) float64 {
+	r, c := f.Dims()
+	if r != len(x) || c != len(y) {
+		panic("integrate: grid dimension mismatch")
+	}
+	rows := make([]float64, r)
+	row := make([]float64, c)
+	for i := range rows {
+		mat.Row(row, i, f)
+		rows[i] = rule(y, row)
+	}
+	return rule(x, rows)
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package integrate
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestIntegrate2D(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name       string
+		f          func(x, y float64) float64
+		a, b, c, d float64
+		want       float64
+		nx, ny     int
+		trapTol    float64
+		simpTol    float64
+	}{
+		{
+			// Bilinear functions are integrated exactly by both
+			// rules and quadratics by Simpsons2D.
+			name: "bilinear",
+			f:    func(x, y float64) float64 { return 1 + 2*x - y + 3*x*y },
+			a:    0, b: 2, c: -1, d: 1,
+			want: 12,
+			nx:   3, ny: 4,
+			trapTol: 1e-14,
+			simpTol: 1e-14,
+		},
+		{
+			name: "quadratic",
+			f:    func(x, y float64) float64 { return x*x*y + y*y },
+			a:    0, b: 3, c: 1, d: 2,
+			want: 9*1.5 + 3*7.0/3,
+			nx:   7, ny: 8,
+			trapTol: 0.5,
+			simpTol: 1e-13,
+		},
+		{
+			name: "Gaussian",
+			f:    func(x, y float64) float64 { return math.Exp(-x*x - y*y) },
+			a:    -3, b: 3, c: -3, d: 3,
+			want: math.Pi * math.Erf(3) * math.Erf(3),
+			nx:   101, ny: 121,
+			trapTol: 1e-3,
+			simpTol: 1e-4,
+		},
+	} {
+		x := jitterSpan(test.nx, test.a, test.b, rnd)
+		y := jitterSpan(test.ny, test.c, test.d, rnd)
+		f := mat.NewDense(test.nx, test.ny, nil)
+		for i, xi := range x {
+			for j, yj := range y {
+				f.Set(i, j, test.f(xi, yj))
+			}
+		}
+		if got := Trapezoidal2D(x, y, f); math.Abs(got-test.want) > test.trapTol {
+			t.Errorf("%s: unexpected Trapezoidal2D result: got=%v want=%v", test.name, got, test.want)
+		}
+		if got := Simpsons2D(x, y, f); math.Abs(got-test.want) > test.simpTol {
+			t.Errorf("%s: unexpected Simpsons2D result: got=%v want=%v", test.name, got, test.want)
+		}
+		// The transposed grid gives the integral in the reverse order.
+		if got, want := Simpsons2D(y, x, f.T()), Simpsons2D(x, y, f); math.Abs(got-want) > 1e-12 {
+			t.Errorf("%s: mismatch between the orders of integration: got=%v want=%v", test.name, got, want)
+		}
+	}
+
+	panicked := func(fn func()) (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		fn()
+		return
+	}
+	if !panicked(func() { Trapezoidal2D([]float64{0, 1}, []float64{0, 1, 2}, mat.NewDense(2, 2, nil)) }) {
+		t.Errorf("expected panic for a grid dimension mismatch")
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package integrate
+
+import "sort"
+
+// NewtonCotes returns an approximate value of the integral
+//
+//	\int_a^b f(x)dx
+//
+// computed using the composite closed Newton–Cotes rule of the given degree.
+// The function f is given as a slice of samples evaluated at locations in x,
+// that is,
+//
+//	f[i] = f(x[i]), x[0] = a, x[len(x)-1] = b
+//
+// The samples need not be equally spaced. The rule integrates over panels of
+// degree consecutive intervals the polynomial of the given degree that
+// interpolates f at the degree+1 samples of the panel. If the number of
+// intervals is not a multiple of degree, the remaining intervals at the end
+// are integrated using the polynomial interpolating the last degree+1
+// samples. The rule is exact if f is a polynomial of degree at most degree.
+// Degree 1 gives the trapezoidal rule and degree 2 gives Simpsons's method,
+// as computed by Trapezoidal and Simpsons.
+//
+// Interpolating polynomials of high degree may oscillate strongly between
+// the samples, so degrees above 6 are rarely useful.
+//
+// The slice x must be sorted in strictly increasing order. x and f must be of
+// equal length, degree must be positive and the length must be at least
+// degree+1.
+func NewtonCotes(x, f []float64, degree int) float64 {
+	n := len(x)
+	switch {
+	case len(f) != n:
+		panic("integrate: slice length mismatch")
+	case degree < 1:
+		panic("integrate: degree less than 1")
+	case n < degree+1:
+		panic("integrate: input data too small")
+	case !sort.Float64sAreSorted(x):
+		panic("integrate: input must be sorted")
+	}
+
+	w := make([]float64, degree+1)
+	work := make([]float64, degree+1)
+	var integral float64
+	last := n - 1
+	for i := 0; i+degree <= last; i += degree {
+		panelWeights(w, work, x[i:i+degree+1], x[i], x[i+degree])
+		for k, wk := range w {
+			integral += wk * f[i+k]
+		}
+	}
+	if rem := last % degree; rem != 0 {
+		start := last - degree
+		panelWeights(w, work, x[start:], x[last-rem], x[last])
+		for k, wk := range w {
+			integral += wk * f[start+k]
+		}
+	}
+	return integral
+}
+
+// panelWeights stores in w the weights of the interpolatory quadrature rule
+// for the locations in x over the interval [a, b], that is, the integrals
+// over [a, b] of the Lagrange basis polynomials of x. work must have the
+// length of x.
+func panelWeights(w, work, x []float64, a, b float64) {
+	// The polynomials are evaluated in coordinates centered and scaled
+	// with respect to the locations to improve their conditioning.
+	c := (x[0] + x[len(x)-1]) / 2
+	s := (x[len(x)-1] - x[0]) / 2
+	ta := (a - c) / s
+	tb := (b - c) / s
+	for k, xk := range x {
+		tk := (xk - c) / s
+		// Compute the monomial coefficients of the k-th Lagrange basis
+		// polynomial in work by multiplying its linear factors.
+		coef := work[:1]
+		coef[0] = 1
+		for j, xj := range x {
+			if j == k {
+				continue
+			}
+			tj := (xj - c) / s
+			d := tk - tj
+			if d == 0 {
+				panic("integrate: repeated abscissa")
+			}
+			coef = coef[:len(coef)+1]
+			coef[len(coef)-1] = 0
+			for m := len(coef) - 1; m >= 0; m-- {
+				v := -tj * coef[m]
+				if m > 0 {
+					v += coef[m-1]
+				}
+				coef[m] = v / d
+			}
+		}
+		// Integrate the polynomial over [ta, tb] using Horner's scheme
+		// for its antiderivative.
+		var ia, ib float64
+		for m := len(coef) - 1; m >= 0; m-- {
+			ia = (ia + coef[m]/float64(m+1)) * ta
+			ib = (ib + coef[m]/float64(m+1)) * tb
+		}
+		w[k] = (ib - ia) * s
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package integrate
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/integrate/testquad"
+)
+
+func TestNewtonCotes(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for degree := 1; degree <= 8; degree++ {
+		// The numbers of samples give complete panels and panels with
+		// a remainder.
+		for _, n := range []int{degree + 1, 3*degree + 1, 3*degree + 2, 4*degree + degree/2 + 1} {
+			x := jitterSpan(n, -1, 2, rnd)
+			for k := 0; k <= degree; k++ {
+				f := make([]float64, n)
+				for i, xi := range x {
+					f[i] = math.Pow(xi, float64(k))
+				}
+				got := NewtonCotes(x, f, degree)
+				want := (math.Pow(2, float64(k+1)) - math.Pow(-1, float64(k+1))) / float64(k+1)
+				if math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+					t.Errorf("degree=%d, n=%d: x^%d not integrated exactly: got=%v want=%v", degree, n, k, got, want)
+				}
+			}
+		}
+	}
+
+	for _, n := range []int{3, 4, 11, 50} {
+		x := jitterSpan(n, 0, 3, rnd)
+		f := make([]float64, n)
+		for i, xi := range x {
+			f[i] = math.Exp(math.Sin(xi))
+		}
+		if got, want := NewtonCotes(x, f, 1), Trapezoidal(x, f); math.Abs(got-want) > 1e-14 {
+			t.Errorf("n=%d: mismatch with Trapezoidal: got=%v want=%v", n, got, want)
+		}
+		if got, want := NewtonCotes(x, f, 2), Simpsons(x, f); math.Abs(got-want) > 1e-13 {
+			t.Errorf("n=%d: mismatch with Simpsons: got=%v want=%v", n, got, want)
+		}
+	}
+
+	for i, test := range []struct {
+		integral testquad.Integral
+		n        int
+		degree   int
+		tol      float64
+	}{
+		{integral: testquad.Sin(), n: 101, degree: 4, tol: 1e-10},
+		{integral: testquad.Sin(), n: 103, degree: 6, tol: 1e-12},
+		{integral: testquad.XExpMinusX(), n: 201, degree: 4, tol: 1e-10},
+		{integral: testquad.ExpOverX2Plus1(), n: 100, degree: 3, tol: 1e-8},
+	} {
+		x := jitterSpan(test.n, test.integral.A, test.integral.B, rnd)
+		f := make([]float64, test.n)
+		for i, xi := range x {
+			f[i] = test.integral.F(xi)
+		}
+		got := NewtonCotes(x, f, test.degree)
+		if diff := math.Abs(got - test.integral.Value); diff > test.tol {
+			t.Errorf("Test #%d: %v, n=%v: unexpected result; got=%v want=%v diff=%v",
+				i, test.integral.Name, test.n, got, test.integral.Value, diff)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	bdfMaxOrder      = 5
+	bdfNewtonMaxIter = 4
+)
+
+// BDF is the implicit variable-order variable-step method based on the
+// backward differentiation formulas of orders 1 to 5 for stiff problems.
+// The nonlinear equations of the steps are solved by a simplified Newton
+// iteration using the LU decomposition of the iteration matrix. The
+// Jacobian is evaluated only when the iteration fails to converge. The
+// order and the step size are changed to the ones that maximize the step
+// size after order+1 steps with the same step size. The solution is
+// represented by the backward differences of the solution at equally spaced
+// times, which also give the dense output.
+//
+// BDF is efficient for large stiff problems at moderate tolerances. The
+// formulas of orders 3 to 5 are not stable for problems with eigenvalues
+// of the Jacobian close to the imaginary axis, for which Radau or a lower
+// MaxOrder is more appropriate.
+//
+// References:
+//   - Shampine, L. F., Reichelt, M. W. (1997). The MATLAB ODE suite. SIAM
+//     Journal on Scientific Computing, 18(1), 1-22.
+//   - Byrne, G. D., Hindmarsh, A. C. (1975). A polyalgorithm for the
+//     numerical solution of ordinary differential equations. ACM
+//     Transactions on Mathematical Software, 1(1), 71-96.
+type BDF struct {
+	// MaxOrder is the maximum order of the formulas. If MaxOrder is zero,
+	// a default value of 5 is used. MaxOrder must not be greater than 5.
+	MaxOrder int
+
+	sys *system
+
+	maxOrder  int
+	newtonTol float64
+	// gamma, alpha and errorConst hold the coefficients of the formulas
+	// of each order.
+	gamma, alpha, errorConst [bdfMaxOrder + 2]float64
+
+	t, hAbs     float64
+	tOld        float64
+	order       int
+	nEqualSteps int
+	// d holds the backward differences of the solution scaled by the
+	// powers of the step size. d[0] is the solution at t.
+	d [bdfMaxOrder + 3][]float64
+
+	jac        *mat.Dense
+	currentJac bool
+	lu         *mat.LU
+	luValid    bool
+
+	yPredict, psi, scale, yNew, corr, dy, dyVec, f, rhs []float64
+	iter                                                *mat.Dense
+}
+
+func (m *BDF) init(sys *system, t float64, y, f []float64) {
+	n := len(y)
+	m.maxOrder = m.MaxOrder
+	if m.maxOrder == 0 {
+		m.maxOrder = bdfMaxOrder
+	}
+	if m.maxOrder < 1 || bdfMaxOrder < m.maxOrder {
+		panic("ode: invalid BDF order")
+	}
+	m.sys = sys
+	m.newtonTol = math.Max(10*eps/sys.relTol, math.Min(0.03, math.Sqrt(sys.relTol)))
+	for k := 1; k < len(m.gamma); k++ {
+		m.gamma[k] = m.gamma[k-1] + 1/float64(k)
+	}
+	for k := range m.alpha {
+		m.alpha[k] = m.gamma[k]
+		m.errorConst[k] = 1 / float64(k+1)
+	}
+
+	m.t = t
+	m.tOld = t
+	m.hAbs = sys.firstStep(t, y, f, 1)
+	m.order = 1
+	m.nEqualSteps = 0
+	for i := range m.d {
+		m.d[i] = resize(m.d[i], n)
+		for j := range m.d[i] {
+			m.d[i][j] = 0
+		}
+	}
+	copy(m.d[0], y)
+	floats.ScaleTo(m.d[1], sys.dir*m.hAbs, f)
+
+	m.jac = mat.NewDense(n, n, nil)
+	sys.jacobian(m.jac, t, y, f)
+	m.currentJac = true
+	m.iter = mat.NewDense(n, n, nil)
+	m.lu = &mat.LU{}
+	m.luValid = false
+
+	m.yPredict = resize(m.yPredict, n)
+	m.psi = resize(m.psi, n)
+	m.scale = resize(m.scale, n)
+	m.yNew = resize(m.yNew, n)
+	m.corr = resize(m.corr, n)
+	m.dy = resize(m.dy, n)
+	m.dyVec = resize(m.dyVec, n)
+	m.f = resize(m.f, n)
+	m.rhs = resize(m.rhs, n)
+}
+
+func (m *BDF) step() error {
+	sys := m.sys
+	minStep := sys.minStep(m.t)
+	hAbs := m.hAbs
+	switch {
+	case hAbs > sys.maxStep:
+		hAbs = sys.maxStep
+		m.changeD(sys.maxStep / m.hAbs)
+		m.nEqualSteps = 0
+	case hAbs < minStep:
+		hAbs = minStep
+		m.changeD(minStep / m.hAbs)
+		m.nEqualSteps = 0
+	}
+
+	order := m.order
+	var (
+		nIter int
+		tNew  float64
+		d     []float64
+	)
+	for {
+		if hAbs < minStep {
+			return ErrStepSizeTooSmall
+		}
+		h := sys.dir * hAbs
+		tNew = m.t + h
+		if sys.dir*(tNew-sys.tEnd) > 0 {
+			tNew = sys.tEnd
+			m.changeD(math.Abs(tNew-m.t) / hAbs)
+			m.nEqualSteps = 0
+			m.luValid = false
+		}
+		h = tNew - m.t
+		hAbs = math.Abs(h)
+
+		for i := range m.yPredict {
+			m.yPredict[i] = 0
+		}
+		for k := 0; k <= order; k++ {
+			floats.Add(m.yPredict, m.d[k])
+		}
+		sys.scale(m.scale, m.yPredict, nil)
+		for i := range m.psi {
+			m.psi[i] = 0
+		}
+		for k := 1; k <= order; k++ {
+			floats.AddScaled(m.psi, m.gamma[k]/m.alpha[order], m.d[k])
+		}
+
+		c := h / m.alpha[order]
+		var converged bool
+		for {
+			if !m.luValid {
+				m.factorize(c)
+			}
+			converged, nIter, d = m.newton(tNew, c)
+			if converged || m.currentJac {
+				break
+			}
+			sys.eval(m.f, tNew, m.yPredict)
+			sys.jacobian(m.jac, tNew, m.yPredict, m.f)
+			m.currentJac = true
+			m.luValid = false
+		}
+		if !converged {
+			sys.stats.RejectedSteps++
+			factor := 0.5
+			hAbs *= factor
+			m.changeD(factor)
+			m.nEqualSteps = 0
+			m.luValid = false
+			continue
+		}
+
+		sf := safety * float64(2*bdfNewtonMaxIter+1) / float64(2*bdfNewtonMaxIter+nIter)
+		sys.scale(m.scale, m.yNew, nil)
+		for i, v := range d {
+			m.dy[i] = m.errorConst[order] * v
+		}
+		errNorm := rmsNorm(m.dy, m.scale)
+		if errNorm <= 1 {
+			m.hAbs = hAbs
+			break
+		}
+		sys.stats.RejectedSteps++
+		factor := math.Max(minFactor, sf*math.Pow(errNorm, -1/float64(order+1)))
+		hAbs *= factor
+		m.changeD(factor)
+		m.nEqualSteps = 0
+		// The iteration has converged, so the LU decomposition is
+		// kept for the smaller step.
+	}
+
+	m.nEqualSteps++
+	m.tOld = m.t
+	m.t = tNew
+	m.currentJac = false
+
+	// Update the differences.
+	floats.SubTo(m.d[order+2], d, m.d[order+1])
+	copy(m.d[order+1], d)
+	for k := order; k >= 0; k-- {
+		floats.Add(m.d[k], m.d[k+1])
+	}
+	if m.nEqualSteps < order+1 {
+		return nil
+	}
+
+	// Choose the order and the step size for the next steps from the
+	// error estimates of the neighboring orders.
+	sf := safety * float64(2*bdfNewtonMaxIter+1) / float64(2*bdfNewtonMaxIter+nIter)
+	errNorm := rmsNorm(m.scaled(m.errorConst[order], d), m.scale)
+	errM := math.Inf(1)
+	if order > 1 {
+		errM = rmsNorm(m.scaled(m.errorConst[order-1], m.d[order]), m.scale)
+	}
+	errP := math.Inf(1)
+	if order < m.maxOrder {
+		errP = rmsNorm(m.scaled(m.errorConst[order+1], m.d[order+2]), m.scale)
+	}
+	best, delta := 0.0, 0
+	for i, e := range []float64{errM, errNorm, errP} {
+		factor := math.Pow(e, -1/float64(order+i))
+		if e == 0 {
+			factor = math.Inf(1)
+		}
+		if factor > best {
+			best, delta = factor, i-1
+		}
+	}
+	m.order += delta
+	factor := math.Min(maxFactor, sf*best)
+	m.hAbs *= factor
+	m.changeD(factor)
+	m.nEqualSteps = 0
+	m.luValid = false
+	return nil
+}
+
+// scaled returns c*x using the storage of m.dy.
+func (m *BDF) scaled(c float64, x []float64) []float64 {
+	floats.ScaleTo(m.dy, c, x)
+	return m.dy
+}
+
+// factorize computes the LU decomposition of I - c*J.
+func (m *BDF) factorize(c float64) {
+	m.iter.Scale(-c, m.jac)
+	n, _ := m.iter.Dims()
+	for i := 0; i < n; i++ {
+		m.iter.Set(i, i, m.iter.At(i, i)+1)
+	}
+	m.lu.Factorize(m.iter)
+	m.sys.stats.LUDecompositions++
+	m.luValid = true
+}
+
+// newton solves the equations of the step to tNew by the simplified Newton
+// iteration starting from the predicted solution. It returns whether the
+// iteration has converged, the number of iterations and the correction of
+// the predicted solution. The solution is stored in m.yNew.
+func (m *BDF) newton(tNew, c float64) (converged bool, iter int, d []float64) {
+	sys := m.sys
+	copy(m.yNew, m.yPredict)
+	d = m.corr
+	for i := range d {
+		d[i] = 0
+	}
+	dyVec := mat.NewVecDense(len(m.dyVec), m.dyVec)
+	rhs := mat.NewVecDense(len(m.rhs), m.rhs)
+	var dyNormOld float64
+	for k := 0; k < bdfNewtonMaxIter; k++ {
+		iter = k + 1
+		sys.eval(m.f, tNew, m.yNew)
+		if !isFinite(m.f) {
+			return false, iter, d
+		}
+		for i := range m.rhs {
+			m.rhs[i] = c*m.f[i] - m.psi[i] - d[i]
+		}
+		if !solveLU(m.lu, dyVec, rhs) {
+			return false, iter, d
+		}
+		dyNorm := rmsNorm(m.dyVec, m.scale)
+		var rate float64
+		if k > 0 {
+			rate = dyNorm / dyNormOld
+			if rate >= 1 || math.Pow(rate, float64(bdfNewtonMaxIter-k))/(1-rate)*dyNorm > m.newtonTol {
+				return false, iter, d
+			}
+		}
+		floats.Add(m.yNew, m.dyVec)
+		floats.Add(d, m.dyVec)
+		if dyNorm == 0 || k > 0 && rate/(1-rate)*dyNorm < m.newtonTol {
+			return true, iter, d
+		}
+		dyNormOld = dyNorm
+	}
+	return false, iter, d
+}
+
+// changeD changes the differences to the step size multiplied by factor.
+func (m *BDF) changeD(factor float64) {
+	order := m.order
+	r := bdfChangeMatrix(order, factor)
+	u := bdfChangeMatrix(order, 1)
+	var ru mat.Dense
+	ru.Mul(r, u)
+	n := len(m.d[0])
+	tmp := make([][]float64, order+1)
+	for k := range tmp {
+		tmp[k] = make([]float64, n)
+		for j := 0; j <= order; j++ {
+			floats.AddScaled(tmp[k], ru.At(j, k), m.d[j])
+		}
+	}
+	for k := range tmp {
+		copy(m.d[k], tmp[k])
+	}
+}
+
+// bdfChangeMatrix returns the matrix of the change of the step size of the
+// differences of the given order by factor.
+func bdfChangeMatrix(order int, factor float64) *mat.Dense {
+	r := mat.NewDense(order+1, order+1, nil)
+	for j := 0; j <= order; j++ {
+		r.Set(0, j, 1)
+	}
+	for i := 1; i <= order; i++ {
+		for j := 1; j <= order; j++ {
+			v := (float64(i-1) - factor*float64(j)) / float64(i)
+			r.Set(i, j, r.At(i-1, j)*v)
+		}
+	}
+	return r
+}
+
+func (m *BDF) state() (t float64, y []float64) {
+	return m.t, m.d[0]
+}
+
+func (m *BDF) interpolant() interpolant {
+	d := make([][]float64, m.order+1)
+	for k := range d {
+		d[k] = make([]float64, len(m.d[k]))
+		copy(d[k], m.d[k])
+	}
+	return &bdfInterpolant{t: m.t, h: m.sys.dir * m.hAbs, d: d}
+}
+
+// bdfInterpolant is the interpolating polynomial of the solution at the
+// times t, t-h, ..., t-order*h given by its backward differences.
+type bdfInterpolant struct {
+	t, h float64
+	d    [][]float64
+}
+
+func (in *bdfInterpolant) at(dst []float64, t float64) {
+	copy(dst, in.d[0])
+	p := 1.0
+	for k := 1; k < len(in.d); k++ {
+		p *= (t - (in.t - float64(k-1)*in.h)) / (float64(k) * in.h)
+		floats.AddScaled(dst, p, in.d[k])
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestBDFChangeMatrix(t *testing.T) {
+	t.Parallel()
+	for order := 1; order <= bdfMaxOrder; order++ {
+		// Changing the step size by a factor of one leaves the
+		// differences unchanged.
+		u := bdfChangeMatrix(order, 1)
+		var got mat.Dense
+		got.Mul(u, u)
+		id := mat.NewDiagDense(order+1, nil)
+		for i := 0; i <= order; i++ {
+			id.SetDiag(i, 1)
+		}
+		if !mat.EqualApprox(&got, id, 1e-12) {
+			t.Errorf("order %d: change matrix for a unit factor is not an involution:\n%v", order, mat.Formatted(&got))
+		}
+	}
+}
+
+func TestBDFMaxOrder(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-6
+	test := testProblems[3]
+	want := make([]float64, 1)
+	test.exact(want, test.t1)
+	prevSteps := -1
+	for order := 1; order <= bdfMaxOrder; order++ {
+		res, err := Solve(test.p, test.t0, test.t1, test.y0, &Settings{AbsTol: tol, RelTol: tol}, &BDF{MaxOrder: order})
+		if err != nil {
+			t.Fatalf("order %d: unexpected error: %v", order, err)
+		}
+		if e := scaledError(res.Y, want, tol); e > 100 {
+			t.Errorf("order %d: solution error too large: got %v tolerances", order, e)
+		}
+		if prevSteps >= 0 && res.Steps >= prevSteps {
+			t.Errorf("order %d: number of steps not reduced by a higher order: got %d, previous %d", order, res.Steps, prevSteps)
+		}
+		prevSteps = res.Steps
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import "sort"
+
+// interpolant is a continuous approximation of the solution over a step.
+type interpolant interface {
+	// at stores the approximation of the solution at t in dst.
+	at(dst []float64, t float64)
+}
+
+// Solution is the dense output of an integration, a piecewise continuous
+// approximation of the solution over the integration interval built from
+// the interpolants of the steps.
+type Solution struct {
+	// y0 is the initial value.
+	y0 []float64
+	// ts holds the times of the steps, monotonic in the direction of
+	// the integration. pieces[i] is the interpolant over the step from
+	// ts[i] to ts[i+1].
+	ts     []float64
+	pieces []interpolant
+}
+
+// Interval returns the times of the start and the end of the integration.
+func (s *Solution) Interval() (t0, t1 float64) {
+	return s.ts[0], s.ts[len(s.ts)-1]
+}
+
+// Times returns the times of the accepted steps, including the start and
+// the end of the integration. If dst is nil, a new slice is allocated and
+// returned. Otherwise, dst must have the length of the number of steps plus
+// one.
+func (s *Solution) Times(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(s.ts))
+	}
+	if len(dst) != len(s.ts) {
+		panic("ode: slice length mismatch")
+	}
+	copy(dst, s.ts)
+	return dst
+}
+
+// At returns the approximation of the solution at t. If dst is nil, a new
+// slice is allocated and returned. Otherwise, dst must have the length of
+// the solution.
+//
+// At panics if t is outside the integration interval.
+func (s *Solution) At(dst []float64, t float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(s.y0))
+	}
+	if len(dst) != len(s.y0) {
+		panic("ode: slice length mismatch")
+	}
+	t0, t1 := s.Interval()
+	forward := t0 <= t1
+	if (forward && (t < t0 || t1 < t)) || (!forward && (t > t0 || t1 > t)) {
+		panic("ode: time outside the integration interval")
+	}
+	if len(s.pieces) == 0 {
+		copy(dst, s.y0)
+		return dst
+	}
+	// Find the first step that ends at or after t.
+	i := sort.Search(len(s.pieces), func(i int) bool {
+		if forward {
+			return s.ts[i+1] >= t
+		}
+		return s.ts[i+1] <= t
+	})
+	s.pieces[i].at(dst, t)
+	return dst
+}
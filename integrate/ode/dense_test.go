@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestSolution(t *testing.T) {
+	t.Parallel()
+	for _, test := range []testProblem{testProblems[1], testProblems[2]} {
+		res, err := Solve(test.p, test.t0, test.t1, test.y0, &Settings{Dense: true}, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		sol := res.Solution
+		ts := sol.Times(nil)
+		if ts[0] != test.t0 || ts[len(ts)-1] != test.t1 {
+			t.Errorf("%s: times do not span the interval: %v", test.name, ts)
+		}
+		for i := 1; i < len(ts); i++ {
+			if (ts[i]-ts[i-1])*(test.t1-test.t0) <= 0 {
+				t.Errorf("%s: times not monotonic in the direction of the integration at %d", test.name, i)
+			}
+		}
+		if got := sol.At(nil, test.t0); !floats.EqualApprox(got, test.y0, 1e-14) {
+			t.Errorf("%s: unexpected dense output at the start: got %v, want %v", test.name, got, test.y0)
+		}
+		if got := sol.At(nil, test.t1); !floats.EqualApprox(got, res.Y, 1e-14) {
+			t.Errorf("%s: unexpected dense output at the end: got %v, want %v", test.name, got, res.Y)
+		}
+
+		mid := (test.t0 + test.t1) / 2
+		for _, fn := range []func(){
+			func() { sol.At(nil, test.t0-(test.t1-test.t0)) },
+			func() { sol.At(nil, test.t1+(test.t1-test.t0)) },
+			func() { sol.At(make([]float64, len(test.y0)+1), mid) },
+			func() { sol.Times(make([]float64, 1)) },
+		} {
+			if !panics(fn) {
+				t.Errorf("%s: expected panic", test.name)
+			}
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ode provides adaptive solvers for initial value problems of
+// systems of ordinary differential equations.
+//
+// Solve integrates a Problem with one of the methods of the package:
+//
+//   - DormandPrince5, Tsitouras5 and Verner8 are explicit Runge–Kutta
+//     methods with embedded error estimates for non-stiff problems.
+//   - BDF, the variable-order backward differentiation formulas, and Radau,
+//     the implicit Runge–Kutta method of order 5 of the Radau IIA family, are
+//     implicit methods for stiff problems. They solve the systems of
+//     nonlinear equations of the steps by Newton iterations using LU
+//     decompositions of matrices built from the Jacobian of the problem,
+//     which is approximated by finite differences if it is not provided.
+//
+// All methods control the local error of the steps with respect to the
+// absolute and relative tolerances given in Settings. Solve can also return
+// the dense output of the integration, a continuous approximation of the
+// solution over the integration interval, and locate the events where the
+// given event functions of the solution cross zero.
+package ode // import "gonum.org/v1/gonum/integrate/ode"
@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/optimize/scalar"
+)
+
+// Event describes an event to locate during an integration. An event occurs
+// where the event function crosses zero.
+type Event struct {
+	// Func is the event function. Func must not modify y.
+	Func func(t float64, y []float64) float64
+
+	// Direction restricts the crossings of zero that are events. If
+	// Direction is positive, only crossings where Func increases are
+	// events, if Direction is negative, only crossings where Func
+	// decreases are events, and if Direction is zero, all crossings are
+	// events.
+	Direction int
+
+	// Terminal specifies whether the integration stops at the event.
+	Terminal bool
+}
+
+// EventRecord records an occurrence of an event.
+type EventRecord struct {
+	// Index is the index of the event in Settings.Events.
+	Index int
+	// T is the time of the occurrence and Y is the solution at T.
+	T float64
+	Y []float64
+}
+
+// eventLocator locates the events over the steps of an integration.
+type eventLocator struct {
+	events []Event
+	// g holds the values of the event functions at the end of the last
+	// step.
+	g       []float64
+	records []EventRecord
+
+	y []float64
+}
+
+// newEventLocator returns an eventLocator for the events starting from t
+// with the solution y, or nil if there are no events.
+func newEventLocator(events []Event, t float64, y []float64) *eventLocator {
+	if len(events) == 0 {
+		return nil
+	}
+	l := &eventLocator{
+		events: events,
+		g:      make([]float64, len(events)),
+		y:      make([]float64, len(y)),
+	}
+	for i, e := range events {
+		if e.Func == nil {
+			panic("ode: nil event function")
+		}
+		l.g[i] = e.Func(t, y)
+	}
+	return l
+}
+
+// locate records the events over the step from tOld to t, where y is the
+// solution at t and in is the interpolant of the step. If a terminal event
+// occurs, locate returns its time and true, and no later events are recorded.
+//
+// A crossing of zero is located when the event function changes from a
+// non-zero value to zero or a value of the opposite sign, so that an event
+// at the end of a step is not located again at the start of the next step.
+// Events are located using the interpolant of the step, so events where
+// the event function changes sign twice within a step are missed.
+func (l *eventLocator) locate(tOld, t float64, y []float64, in interpolant) (tEvent float64, terminal bool) {
+	first := len(l.records)
+	for i, e := range l.events {
+		gOld := l.g[i]
+		gNew := e.Func(t, y)
+		l.g[i] = gNew
+		up := gOld < 0 && gNew >= 0
+		down := gOld > 0 && gNew <= 0
+		if !(up && e.Direction >= 0 || down && e.Direction <= 0) {
+			continue
+		}
+		g := func(s float64) float64 {
+			in.at(l.y, s)
+			return e.Func(s, l.y)
+		}
+		a, b := tOld, t
+		if a > b {
+			a, b = b, a
+		}
+		root := t
+		if gNew != 0 {
+			r, err := scalar.Brent(g, a, b, &scalar.Settings{Absolute: eps * (b - a)})
+			// The interpolant may not reproduce the change of
+			// sign at the ends of the step exactly, in which case
+			// the event is placed at the end of the step. For
+			// other errors r holds the best estimate.
+			if err != scalar.ErrNotBracketed {
+				root = r.X
+			}
+		}
+		rec := EventRecord{Index: i, T: root, Y: make([]float64, len(y))}
+		if root == t {
+			copy(rec.Y, y)
+		} else {
+			in.at(rec.Y, root)
+		}
+		l.records = append(l.records, rec)
+	}
+	recs := l.records[first:]
+	dir := math.Copysign(1, t-tOld)
+	sort.SliceStable(recs, func(i, j int) bool {
+		return dir*recs[i].T < dir*recs[j].T
+	})
+	for k, rec := range recs {
+		if l.events[rec.Index].Terminal {
+			l.records = l.records[:first+k+1]
+			return rec.T, true
+		}
+	}
+	return t, false
+}
@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestEventTerminal(t *testing.T) {
+	t.Parallel()
+	const (
+		g  = 9.81
+		h0 = 10
+	)
+	p := Problem{
+		Func: func(dy []float64, _ float64, y []float64) {
+			dy[0] = y[1]
+			dy[1] = -g
+		},
+	}
+	want := math.Sqrt(2 * h0 / g)
+	for _, m := range testMethods {
+		settings := &Settings{
+			AbsTol: 1e-10,
+			RelTol: 1e-10,
+			Dense:  true,
+			Events: []Event{{
+				Func:      func(_ float64, y []float64) float64 { return y[0] },
+				Direction: -1,
+				Terminal:  true,
+			}},
+		}
+		res, err := Solve(p, 0, 10, []float64{h0, 0}, settings, m.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		if res.Status != TerminalEvent {
+			t.Errorf("%s: unexpected status: got %v, want %v", m.name, res.Status, TerminalEvent)
+		}
+		if !scalar.EqualWithinAbsOrRel(res.T, want, 1e-8, 1e-8) {
+			t.Errorf("%s: unexpected time of the event: got %v, want %v", m.name, res.T, want)
+		}
+		if math.Abs(res.Y[0]) > 1e-8 || !scalar.EqualWithinAbsOrRel(res.Y[1], -g*want, 1e-8, 1e-8) {
+			t.Errorf("%s: unexpected solution at the event: got %v, want [0 %v]", m.name, res.Y, -g*want)
+		}
+		if len(res.Events) != 1 {
+			t.Fatalf("%s: unexpected number of events: got %d, want 1", m.name, len(res.Events))
+		}
+		if ev := res.Events[0]; ev.Index != 0 || ev.T != res.T || ev.Y[0] != res.Y[0] || ev.Y[1] != res.Y[1] {
+			t.Errorf("%s: event record does not match the result: %+v", m.name, ev)
+		}
+		if _, t1 := res.Solution.Interval(); t1 != res.T {
+			t.Errorf("%s: dense output does not end at the event: got %v, want %v", m.name, t1, res.T)
+		}
+	}
+}
+
+func TestEventDirection(t *testing.T) {
+	t.Parallel()
+	var (
+		pos  = func(_ float64, y []float64) float64 { return y[0] }
+		velo = func(_ float64, y []float64) float64 { return y[1] }
+	)
+	for _, test := range []struct {
+		name   string
+		events []Event
+		// want holds the times and the indices of the expected events.
+		want  []float64
+		index []int
+	}{
+		{
+			name:   "any",
+			events: []Event{{Func: pos}},
+			want:   []float64{math.Pi / 2, 3 * math.Pi / 2, 5 * math.Pi / 2},
+			index:  []int{0, 0, 0},
+		},
+		{
+			name:   "increasing",
+			events: []Event{{Func: pos, Direction: 1}},
+			want:   []float64{3 * math.Pi / 2},
+			index:  []int{0},
+		},
+		{
+			name:   "decreasing",
+			events: []Event{{Func: pos, Direction: -1}},
+			want:   []float64{math.Pi / 2, 5 * math.Pi / 2},
+			index:  []int{0, 0},
+		},
+		{
+			name:   "two functions",
+			events: []Event{{Func: pos, Direction: 1}, {Func: velo}},
+			want:   []float64{math.Pi, 3 * math.Pi / 2, 2 * math.Pi, 3 * math.Pi},
+			index:  []int{1, 0, 1, 1},
+		},
+		{
+			name:   "terminal",
+			events: []Event{{Func: pos}, {Func: velo, Direction: 1, Terminal: true}},
+			want:   []float64{math.Pi / 2, math.Pi},
+			index:  []int{0, 1},
+		},
+	} {
+		for _, m := range testMethods {
+			prob := testProblems[1]
+			settings := &Settings{AbsTol: 1e-10, RelTol: 1e-10, Events: test.events}
+			res, err := Solve(prob.p, prob.t0, prob.t1, prob.y0, settings, m.method())
+			if err != nil {
+				t.Fatalf("%s %s: unexpected error: %v", test.name, m.name, err)
+			}
+			checkOscillatorEvents(t, test.name+" "+m.name, res.Events, test.want, test.index)
+		}
+	}
+}
+
+func TestEventBackward(t *testing.T) {
+	t.Parallel()
+	// The position of the oscillator is even in time, so the crossings
+	// in the direction of the integration mirror those forward in time.
+	prob := testProblems[1]
+	events := []Event{{Func: func(_ float64, y []float64) float64 { return y[0] }, Direction: -1}}
+	want := []float64{-math.Pi / 2, -5 * math.Pi / 2}
+	for _, m := range testMethods {
+		settings := &Settings{AbsTol: 1e-10, RelTol: 1e-10, Events: events}
+		res, err := Solve(prob.p, prob.t0, -prob.t1, prob.y0, settings, m.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		checkOscillatorEvents(t, m.name, res.Events, want, []int{0, 0})
+	}
+}
+
+// checkOscillatorEvents checks the events of the oscillator of testProblems
+// against the expected times and indices.
+func checkOscillatorEvents(t *testing.T, name string, got []EventRecord, want []float64, index []int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s: unexpected number of events: got %d, want %d", name, len(got), len(want))
+		return
+	}
+	for i, ev := range got {
+		if ev.Index != index[i] || !scalar.EqualWithinAbs(ev.T, want[i], 1e-7) {
+			t.Errorf("%s: unexpected event %d: got index %d at %v, want index %d at %v",
+				name, i, ev.Index, ev.T, index[i], want[i])
+		}
+		y := []float64{math.Cos(ev.T), -math.Sin(ev.T)}
+		if scaledError(ev.Y, y, 1e-10) > 1000 {
+			t.Errorf("%s: unexpected solution at event %d: got %v, want %v", name, i, ev.Y, y)
+		}
+	}
+}
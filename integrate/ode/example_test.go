@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/integrate/ode"
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleSolve() {
+	// The Lotka–Volterra equations describe the populations of prey
+	// and predators.
+	p := ode.Problem{
+		Func: func(dy []float64, _ float64, y []float64) {
+			dy[0] = 1.5*y[0] - y[0]*y[1]
+			dy[1] = -3*y[1] + y[0]*y[1]
+		},
+	}
+	settings := &ode.Settings{RelTol: 1e-8, Dense: true}
+	res, err := ode.Solve(p, 0, 10, []float64{10, 5}, settings, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("status: %v\n", res.Status)
+	for _, t := range []float64{0, 2.5, 5, 7.5, 10} {
+		y := res.Solution.At(nil, t)
+		fmt.Printf("t=%4.1f prey=%6.3f predators=%6.3f\n", t, y[0], y[1])
+	}
+
+	// Output:
+	// status: Success
+	// t= 0.0 prey=10.000 predators= 5.000
+	// t= 2.5 prey= 1.258 predators= 0.034
+	// t= 5.0 prey= 0.264 predators= 3.134
+	// t= 7.5 prey= 3.554 predators= 0.019
+	// t=10.0 prey= 0.287 predators= 0.450
+}
+
+func ExampleSolve_events() {
+	// A ball is thrown upward from a height of 2 m at 10 m/s.
+	const g = 9.81
+	p := ode.Problem{
+		Func: func(dy []float64, _ float64, y []float64) {
+			dy[0] = y[1]
+			dy[1] = -g
+		},
+	}
+	settings := &ode.Settings{
+		RelTol: 1e-10,
+		Events: []ode.Event{
+			// The ball reaches its highest point.
+			{Func: func(_ float64, y []float64) float64 { return y[1] }},
+			// The ball hits the ground, which ends the integration.
+			{Func: func(_ float64, y []float64) float64 { return y[0] }, Direction: -1, Terminal: true},
+		},
+	}
+	res, err := ode.Solve(p, 0, 10, []float64{2, 10}, settings, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, ev := range res.Events {
+		fmt.Printf("event %d at t=%.4f s: height=%.4f m speed=%.4f m/s\n", ev.Index, ev.T, math.Abs(ev.Y[0]), ev.Y[1])
+	}
+	fmt.Printf("status: %v\n", res.Status)
+
+	// Output:
+	// event 0 at t=1.0194 s: height=7.0968 m speed=0.0000 m/s
+	// event 1 at t=2.2222 s: height=0.0000 m speed=-11.8000 m/s
+	// status: TerminalEvent
+}
+
+func ExampleRadau() {
+	// The Robertson problem models a stiff system of chemical reactions.
+	p := ode.Problem{
+		Func: func(dy []float64, _ float64, y []float64) {
+			dy[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+			dy[1] = 0.04*y[0] - 1e4*y[1]*y[2] - 3e7*y[1]*y[1]
+			dy[2] = 3e7 * y[1] * y[1]
+		},
+		Jac: func(dst *mat.Dense, _ float64, y []float64) {
+			dst.Set(0, 0, -0.04)
+			dst.Set(0, 1, 1e4*y[2])
+			dst.Set(0, 2, 1e4*y[1])
+			dst.Set(1, 0, 0.04)
+			dst.Set(1, 1, -1e4*y[2]-6e7*y[1])
+			dst.Set(1, 2, -1e4*y[1])
+			dst.Set(2, 0, 0)
+			dst.Set(2, 1, 6e7*y[1])
+			dst.Set(2, 2, 0)
+		},
+	}
+	settings := &ode.Settings{AbsTol: 1e-12, RelTol: 1e-8}
+	res, err := ode.Solve(p, 0, 1e5, []float64{1, 0, 0}, settings, &ode.Radau{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("y(1e5) = [%.6f %.6e %.6f]\n", res.Y[0], res.Y[1], res.Y[2])
+	fmt.Printf("status: %v\n", res.Status)
+
+	// Output:
+	// y(1e5) = [0.017866 7.274751e-08 0.982134]
+	// status: Success
+}
@@ -0,0 +1,409 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultAbsTol = 1e-9
+	defaultRelTol = 1e-6
+
+	// eps is the machine epsilon for float64.
+	eps = 0x1p-52
+
+	// safety, minFactor and maxFactor control the change of the step size
+	// after a step.
+	safety    = 0.9
+	minFactor = 0.2
+	maxFactor = 10
+)
+
+var (
+	// ErrStepSizeTooSmall is returned when the step size has to be reduced
+	// below the spacing of floating point numbers at the current time to
+	// satisfy the tolerances or to converge the iterations of an implicit
+	// method.
+	ErrStepSizeTooSmall = errors.New("ode: step size too small")
+	// ErrStepLimit is returned when the maximum number of steps has been
+	// taken before reaching the end of the integration interval.
+	ErrStepLimit = errors.New("ode: step limit reached")
+)
+
+// Problem describes the system of ordinary differential equations
+//
+//	dy/dt = f(t, y)
+//
+// to be integrated.
+type Problem struct {
+	// Func evaluates f(t, y) and stores the result in dy. Func must not
+	// modify y.
+	Func func(dy []float64, t float64, y []float64)
+
+	// Jac evaluates the Jacobian ∂f/∂y at (t, y) and stores the result
+	// in dst. Jac must not modify y. Jac is only used by the implicit
+	// methods. If Jac is nil, the Jacobian is approximated by forward
+	// differences using fd.Jacobian.
+	Jac func(dst *mat.Dense, t float64, y []float64)
+}
+
+// Settings holds the parameters of the integration. A nil *Settings is
+// equivalent to the zero value, see the field comments for the default
+// values.
+type Settings struct {
+	// AbsTol and RelTol are the absolute and relative tolerances of the
+	// local error. The step size is chosen so that the root mean square of
+	// the local error estimates err_i scaled by
+	//  AbsTol + RelTol*|y_i|
+	// is at most one. If AbsTol is zero, a default value of 1e-9 is used.
+	// If RelTol is zero, a default value of 1e-6 is used. RelTol less than
+	// 100ε, where ε is the machine epsilon, is increased to 100ε.
+	AbsTol, RelTol float64
+
+	// InitialStep is the size of the first step. If InitialStep is zero,
+	// it is chosen from the problem and the tolerances.
+	InitialStep float64
+
+	// MaxStep is the maximum size of a step. If MaxStep is zero, the step
+	// size is not limited.
+	MaxStep float64
+
+	// MaxSteps is the maximum number of accepted steps. If MaxSteps is
+	// zero, the number of steps is not limited.
+	MaxSteps int
+
+	// Dense specifies whether the dense output of the integration is
+	// returned in Result.Solution.
+	Dense bool
+
+	// Events are the events to locate during the integration.
+	Events []Event
+}
+
+// Status is the termination status of an integration.
+type Status int
+
+const (
+	// Success indicates that the end of the integration interval has been
+	// reached.
+	Success Status = iota
+	// TerminalEvent indicates that the integration has been stopped by a
+	// terminal event.
+	TerminalEvent
+	// Failure indicates that the integration has failed.
+	Failure
+)
+
+func (s Status) String() string {
+	switch s {
+	case Success:
+		return "Success"
+	case TerminalEvent:
+		return "TerminalEvent"
+	case Failure:
+		return "Failure"
+	}
+	return "Status(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Result holds the result of an integration.
+type Result struct {
+	// T is the time at which the integration has stopped and Y is the
+	// solution at T.
+	T float64
+	Y []float64
+
+	// Status is the termination status of the integration.
+	Status Status
+
+	// Events holds the occurrences of the events in the order of their
+	// times.
+	Events []EventRecord
+
+	// Solution is the dense output of the integration. It is nil unless
+	// Settings.Dense is true.
+	Solution *Solution
+
+	Stats
+}
+
+// Stats holds the statistics of an integration.
+type Stats struct {
+	Steps               int // Number of accepted steps
+	RejectedSteps       int // Number of rejected steps
+	FuncEvaluations     int // Number of evaluations of Func, including those for finite differences
+	JacobianEvaluations int // Number of evaluations of the Jacobian
+	LUDecompositions    int // Number of LU decompositions
+}
+
+// Method is an integration method. Method is implemented by DormandPrince5,
+// Tsitouras5, Verner8, BDF and Radau.
+type Method interface {
+	// init initializes the method for integrating sys from t with the
+	// initial value y and the derivative f = f(t, y).
+	init(sys *system, t float64, y, f []float64)
+	// step advances the solution by one step. The step may end at
+	// sys.tEnd but never goes beyond it.
+	step() error
+	// state returns the time and the solution at the end of the last
+	// step. The returned slice must not be modified.
+	state() (t float64, y []float64)
+	// interpolant returns the continuous approximation of the solution
+	// over the last step.
+	interpolant() interpolant
+}
+
+// Solve integrates the initial value problem
+//
+//	dy/dt = f(t, y), y(t0) = y0
+//
+// from t0 to t1 using the given method. t1 may be less than t0, in which case
+// the problem is integrated backward in time. If method is nil, a default
+// DormandPrince5 is used. If settings is nil, the default settings are used.
+//
+// Solve returns the solution at t1, or at the first terminal event, along
+// with the occurrences of the events and the statistics of the integration.
+// If the integration fails, Solve returns the result at the last accepted
+// step with the Failure status and a non-nil error.
+//
+// Solve panics if y0 has zero length, if t0 or t1 is not finite, or if the
+// settings are invalid.
+func Solve(p Problem, t0, t1 float64, y0 []float64, settings *Settings, method Method) (*Result, error) {
+	if p.Func == nil {
+		panic("ode: nil Func")
+	}
+	if len(y0) == 0 {
+		panic("ode: zero length initial value")
+	}
+	if math.IsInf(t0, 0) || math.IsNaN(t0) || math.IsInf(t1, 0) || math.IsNaN(t1) {
+		panic("ode: interval not finite")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &DormandPrince5{}
+	}
+
+	res := &Result{}
+	sys := newSystem(p, t0, t1, len(y0), settings, &res.Stats)
+	y := make([]float64, len(y0))
+	copy(y, y0)
+	f := make([]float64, len(y0))
+	sys.eval(f, t0, y)
+
+	var sol *Solution
+	if settings.Dense {
+		sol = &Solution{y0: append([]float64(nil), y0...), ts: []float64{t0}}
+	}
+	events := newEventLocator(settings.Events, t0, y)
+
+	t := t0
+	if t0 != t1 {
+		method.init(sys, t0, y, f)
+	}
+	for t != t1 {
+		if settings.MaxSteps > 0 && res.Steps == settings.MaxSteps {
+			res.T, res.Y, res.Status = t, y, Failure
+			res.Solution = sol
+			return res, ErrStepLimit
+		}
+		err := method.step()
+		if err != nil {
+			res.T, res.Y, res.Status = t, y, Failure
+			res.Solution = sol
+			return res, err
+		}
+		res.Steps++
+
+		tOld := t
+		var yNew []float64
+		t, yNew = method.state()
+		var in interpolant
+		if sol != nil || events != nil {
+			in = method.interpolant()
+		}
+		if sol != nil {
+			sol.ts = append(sol.ts, t)
+			sol.pieces = append(sol.pieces, in)
+		}
+		copy(y, yNew)
+		if events == nil {
+			continue
+		}
+		tEvent, terminal := events.locate(tOld, t, y, in)
+		res.Events = events.records
+		if terminal {
+			t = tEvent
+			in.at(y, t)
+			if sol != nil {
+				sol.ts[len(sol.ts)-1] = t
+			}
+			res.Status = TerminalEvent
+			break
+		}
+	}
+	res.T, res.Y = t, y
+	res.Solution = sol
+	return res, nil
+}
+
+// system holds the problem and the parameters of an integration that are
+// shared by the methods.
+type system struct {
+	p Problem
+	n int
+
+	tEnd float64
+	// dir is the direction of the integration, +1 or -1.
+	dir float64
+
+	absTol, relTol float64
+	maxStep        float64
+	initialStep    float64
+
+	stats *Stats
+}
+
+func newSystem(p Problem, t0, t1 float64, n int, settings *Settings, stats *Stats) *system {
+	sys := &system{
+		p:           p,
+		n:           n,
+		tEnd:        t1,
+		dir:         1,
+		absTol:      settings.AbsTol,
+		relTol:      settings.RelTol,
+		maxStep:     settings.MaxStep,
+		initialStep: settings.InitialStep,
+		stats:       stats,
+	}
+	if t1 < t0 {
+		sys.dir = -1
+	}
+	if sys.absTol < 0 || sys.relTol < 0 || sys.maxStep < 0 || sys.initialStep < 0 || settings.MaxSteps < 0 {
+		panic("ode: negative setting")
+	}
+	if sys.absTol == 0 {
+		sys.absTol = defaultAbsTol
+	}
+	if sys.relTol == 0 {
+		sys.relTol = defaultRelTol
+	}
+	sys.relTol = math.Max(sys.relTol, 100*eps)
+	if sys.maxStep == 0 {
+		sys.maxStep = math.Inf(1)
+	}
+	return sys
+}
+
+// eval evaluates f(t, y) into dy.
+func (sys *system) eval(dy []float64, t float64, y []float64) {
+	sys.stats.FuncEvaluations++
+	sys.p.Func(dy, t, y)
+}
+
+// jacobian evaluates the Jacobian at (t, y) into dst. f must hold f(t, y).
+func (sys *system) jacobian(dst *mat.Dense, t float64, y, f []float64) {
+	sys.stats.JacobianEvaluations++
+	if sys.p.Jac != nil {
+		sys.p.Jac(dst, t, y)
+		return
+	}
+	fd.Jacobian(dst, func(dy, y []float64) {
+		sys.eval(dy, t, y)
+	}, y, &fd.JacobianSettings{
+		Formula:     fd.Forward,
+		OriginValue: f,
+		Step:        math.Sqrt(eps) * math.Max(1, floats.Norm(y, math.Inf(1))),
+	})
+}
+
+// scale stores in dst the scale of the local error, AbsTol + RelTol*|y_i|,
+// or AbsTol + RelTol*max(|y_i|, |yNew_i|) if yNew is not nil.
+func (sys *system) scale(dst, y, yNew []float64) {
+	for i, v := range y {
+		v = math.Abs(v)
+		if yNew != nil {
+			v = math.Max(v, math.Abs(yNew[i]))
+		}
+		dst[i] = sys.absTol + sys.relTol*v
+	}
+}
+
+// minStep returns the minimum step size at t.
+func (sys *system) minStep(t float64) float64 {
+	return 10 * math.Abs(math.Nextafter(t, sys.dir*math.Inf(1))-t)
+}
+
+// firstStep returns the size of the first step from t with the solution y
+// and the derivative f for a method whose local error is of the given order.
+func (sys *system) firstStep(t float64, y, f []float64, order int) float64 {
+	if sys.initialStep != 0 {
+		return math.Min(sys.initialStep, sys.maxStep)
+	}
+	// The step size is chosen so that the local error of the Euler
+	// step is small, following Hairer, Nørsett and Wanner.
+	scale := make([]float64, sys.n)
+	sys.scale(scale, y, nil)
+	d0 := rmsNorm(y, scale)
+	d1 := rmsNorm(f, scale)
+	h0 := 1e-6
+	if d0 >= 1e-5 && d1 >= 1e-5 {
+		h0 = 0.01 * d0 / d1
+	}
+	h0 = math.Min(h0, math.Abs(sys.tEnd-t))
+	y1 := make([]float64, sys.n)
+	floats.AddScaledTo(y1, y, sys.dir*h0, f)
+	f1 := make([]float64, sys.n)
+	sys.eval(f1, t+sys.dir*h0, y1)
+	floats.Sub(f1, f)
+	d2 := rmsNorm(f1, scale) / h0
+	var h1 float64
+	if d1 <= 1e-15 && d2 <= 1e-15 {
+		h1 = math.Max(1e-6, h0*1e-3)
+	} else {
+		h1 = math.Pow(0.01/math.Max(d1, d2), 1/float64(order+1))
+	}
+	return math.Min(math.Min(100*h0, h1), sys.maxStep)
+}
+
+// solveLU solves the system of linear equations with the LU decomposition
+// lu and right-hand side b, storing the result in x. solveLU returns false
+// if the matrix is exactly singular. Ill-conditioned systems are solved and
+// left to be detected by the iterations using the solution.
+func solveLU(lu *mat.LU, x, b *mat.VecDense) bool {
+	err := lu.SolveVecTo(x, false, b)
+	if c, ok := err.(mat.Condition); ok && math.IsInf(float64(c), 1) {
+		return false
+	}
+	return true
+}
+
+// rmsNorm returns the root mean square of x_i/scale_i.
+func rmsNorm(x, scale []float64) float64 {
+	var s float64
+	for i, v := range x {
+		v /= scale[i]
+		s += v * v
+	}
+	return math.Sqrt(s / float64(len(x)))
+}
+
+// isFinite returns whether all elements of x are finite.
+func isFinite(x []float64) bool {
+	for _, v := range x {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,470 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+type testProblem struct {
+	name   string
+	p      Problem
+	t0, t1 float64
+	y0     []float64
+	// exact stores the exact solution at t in dst.
+	exact func(dst []float64, t float64)
+	// stiff indicates a problem that is only solved by the explicit
+	// methods at a large cost.
+	stiff bool
+}
+
+var testProblems = []testProblem{
+	{
+		name: "decay",
+		p: Problem{
+			Func: func(dy []float64, _ float64, y []float64) { dy[0] = -2 * y[0] },
+			Jac:  func(dst *mat.Dense, _ float64, _ []float64) { dst.Set(0, 0, -2) },
+		},
+		t0: 0,
+		t1: 3,
+		y0: []float64{1},
+		exact: func(dst []float64, t float64) {
+			dst[0] = math.Exp(-2 * t)
+		},
+	},
+	{
+		name: "oscillator",
+		p: Problem{
+			Func: func(dy []float64, _ float64, y []float64) {
+				dy[0] = y[1]
+				dy[1] = -y[0]
+			},
+		},
+		t0: 0,
+		t1: 10,
+		y0: []float64{1, 0},
+		exact: func(dst []float64, t float64) {
+			dst[0] = math.Cos(t)
+			dst[1] = -math.Sin(t)
+		},
+	},
+	{
+		name: "oscillator backward",
+		p: Problem{
+			Func: func(dy []float64, _ float64, y []float64) {
+				dy[0] = y[1]
+				dy[1] = -y[0]
+			},
+		},
+		t0: 10,
+		t1: 0,
+		y0: []float64{math.Cos(10), -math.Sin(10)},
+		exact: func(dst []float64, t float64) {
+			dst[0] = math.Cos(t)
+			dst[1] = -math.Sin(t)
+		},
+	},
+	{
+		name: "logistic",
+		p: Problem{
+			Func: func(dy []float64, _ float64, y []float64) { dy[0] = y[0] * (1 - y[0]) },
+			Jac:  func(dst *mat.Dense, _ float64, y []float64) { dst.Set(0, 0, 1-2*y[0]) },
+		},
+		t0: -5,
+		t1: 5,
+		y0: []float64{1 / (1 + math.Exp(5))},
+		exact: func(dst []float64, t float64) {
+			dst[0] = 1 / (1 + math.Exp(-t))
+		},
+	},
+	{
+		name: "time dependent",
+		p: Problem{
+			Func: func(dy []float64, t float64, y []float64) { dy[0] = math.Cos(t) * y[0] },
+		},
+		t0: 0,
+		t1: 8,
+		y0: []float64{1},
+		exact: func(dst []float64, t float64) {
+			dst[0] = math.Exp(math.Sin(t))
+		},
+	},
+	{
+		name: "stiff linear",
+		p: Problem{
+			Func: func(dy []float64, _ float64, y []float64) {
+				dy[0] = 998*y[0] + 1998*y[1]
+				dy[1] = -999*y[0] - 1999*y[1]
+			},
+			Jac: func(dst *mat.Dense, _ float64, _ []float64) {
+				dst.Set(0, 0, 998)
+				dst.Set(0, 1, 1998)
+				dst.Set(1, 0, -999)
+				dst.Set(1, 1, -1999)
+			},
+		},
+		t0: 0,
+		t1: 5,
+		y0: []float64{1, 0},
+		exact: func(dst []float64, t float64) {
+			dst[0] = 2*math.Exp(-t) - math.Exp(-1000*t)
+			dst[1] = -math.Exp(-t) + math.Exp(-1000*t)
+		},
+		stiff: true,
+	},
+}
+
+var testMethods = []struct {
+	name     string
+	method   func() Method
+	implicit bool
+	// endTol and denseTol are the accepted errors of the solution at the
+	// end of the integration and of the dense output relative to the
+	// requested tolerance.
+	endTol, denseTol float64
+}{
+	{name: "DormandPrince5", method: func() Method { return &DormandPrince5{} }, endTol: 20, denseTol: 100},
+	{name: "Tsitouras5", method: func() Method { return &Tsitouras5{} }, endTol: 20, denseTol: 100},
+	{name: "Verner8", method: func() Method { return &Verner8{} }, endTol: 10, denseTol: 5000},
+	{name: "BDF", method: func() Method { return &BDF{} }, implicit: true, endTol: 500, denseTol: 500},
+	{name: "Radau", method: func() Method { return &Radau{} }, implicit: true, endTol: 10, denseTol: 10},
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	for _, test := range testProblems {
+		for _, m := range testMethods {
+			for _, tol := range []float64{1e-4, 1e-7, 1e-10} {
+				for _, jac := range []bool{false, true} {
+					if jac && (!m.implicit || test.p.Jac == nil) {
+						continue
+					}
+					name := fmt.Sprintf("%s/%s/tol=%g/jac=%t", test.name, m.name, tol, jac)
+					p := test.p
+					if !jac {
+						p.Jac = nil
+					}
+					settings := &Settings{AbsTol: tol, RelTol: tol, Dense: true}
+					res, err := Solve(p, test.t0, test.t1, test.y0, settings, m.method())
+					if err != nil {
+						t.Errorf("%s: unexpected error: %v", name, err)
+						continue
+					}
+					if res.Status != Success {
+						t.Errorf("%s: unexpected status: got %v, want %v", name, res.Status, Success)
+					}
+					if res.T != test.t1 {
+						t.Errorf("%s: unexpected end time: got %v, want %v", name, res.T, test.t1)
+					}
+					want := make([]float64, len(test.y0))
+					test.exact(want, test.t1)
+					if e := scaledError(res.Y, want, tol); e > m.endTol {
+						t.Errorf("%s: solution error too large: got %v tolerances, want at most %v", name, e, m.endTol)
+					}
+					if m.implicit && test.stiff && tol >= 1e-7 && res.Steps > 200 {
+						t.Errorf("%s: too many steps for a stiff problem: %d", name, res.Steps)
+					}
+
+					sol := res.Solution
+					t0, t1 := sol.Interval()
+					if t0 != test.t0 || t1 != test.t1 {
+						t.Errorf("%s: unexpected dense output interval: got [%v, %v], want [%v, %v]",
+							name, t0, t1, test.t0, test.t1)
+					}
+					var maxErr float64
+					got := make([]float64, len(test.y0))
+					const n = 200
+					for i := 0; i <= n; i++ {
+						s := test.t0 + (test.t1-test.t0)*float64(i)/n
+						sol.At(got, s)
+						test.exact(want, s)
+						maxErr = math.Max(maxErr, scaledError(got, want, tol))
+					}
+					if maxErr > m.denseTol {
+						t.Errorf("%s: dense output error too large: got %v tolerances, want at most %v", name, maxErr, m.denseTol)
+					}
+				}
+			}
+		}
+	}
+}
+
+// scaledError returns the maximum error of got scaled by tol*(1 + |want|).
+func scaledError(got, want []float64, tol float64) float64 {
+	var e float64
+	for i, v := range got {
+		e = math.Max(e, math.Abs(v-want[i])/(tol*(1+math.Abs(want[i]))))
+	}
+	return e
+}
+
+func TestSolveStats(t *testing.T) {
+	t.Parallel()
+	for _, m := range rkTableaux {
+		prob := testProblems[1]
+		res, err := Solve(prob.p, prob.t0, prob.t1, prob.y0, &Settings{RelTol: 1e-8}, m.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		// Solve evaluates the derivative at the start and the initial step
+		// selection evaluates it once more. Every attempted step evaluates
+		// all stages but the first.
+		want := 2 + len(m.tab.b)*(res.Steps+res.RejectedSteps)
+		if res.FuncEvaluations != want {
+			t.Errorf("%s: unexpected number of evaluations: got %d, want %d", m.name, res.FuncEvaluations, want)
+		}
+		if res.JacobianEvaluations != 0 || res.LUDecompositions != 0 {
+			t.Errorf("%s: unexpected linear algebra for an explicit method: %+v", m.name, res.Stats)
+		}
+	}
+
+	test := testProblems[5]
+	for _, method := range []Method{&BDF{}, &Radau{}} {
+		res, err := Solve(test.p, test.t0, test.t1, test.y0, nil, method)
+		if err != nil {
+			t.Fatalf("%T: unexpected error: %v", method, err)
+		}
+		if res.JacobianEvaluations == 0 || res.LUDecompositions == 0 {
+			t.Errorf("%T: missing linear algebra statistics: %+v", method, res.Stats)
+		}
+		if res.JacobianEvaluations > res.LUDecompositions {
+			t.Errorf("%T: more Jacobian evaluations than LU decompositions: %+v", method, res.Stats)
+		}
+	}
+}
+
+func TestSolveReuse(t *testing.T) {
+	t.Parallel()
+	for _, m := range testMethods {
+		method := m.method()
+		var res [2]*Result
+		for i, test := range []testProblem{testProblems[0], testProblems[1], testProblems[0]} {
+			r, err := Solve(test.p, test.t0, test.t1, test.y0, nil, method)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", m.name, err)
+			}
+			if i != 1 {
+				res[i/2] = r
+			}
+		}
+		if !floats.Equal(res[0].Y, res[1].Y) || res[0].Stats != res[1].Stats {
+			t.Errorf("%s: result depends on a previous use of the method", m.name)
+		}
+	}
+}
+
+func TestSolveEmptyInterval(t *testing.T) {
+	t.Parallel()
+	test := testProblems[1]
+	res, err := Solve(test.p, 2, 2, test.y0, &Settings{Dense: true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.T != 2 || !floats.Equal(res.Y, test.y0) || res.Status != Success || res.Steps != 0 {
+		t.Errorf("unexpected result for an empty interval: %+v", res)
+	}
+	if got := res.Solution.At(nil, 2); !floats.Equal(got, test.y0) {
+		t.Errorf("unexpected dense output for an empty interval: got %v, want %v", got, test.y0)
+	}
+}
+
+func TestSolveMaxStep(t *testing.T) {
+	t.Parallel()
+	test := testProblems[0]
+	const maxStep = 0.1
+	res, err := Solve(test.p, test.t0, test.t1, test.y0, &Settings{MaxStep: maxStep, Dense: true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ts := res.Solution.Times(nil)
+	if len(ts) != res.Steps+1 {
+		t.Errorf("unexpected number of times: got %d, want %d", len(ts), res.Steps+1)
+	}
+	for i := 1; i < len(ts); i++ {
+		if ts[i]-ts[i-1] > maxStep*(1+1e-14) {
+			t.Errorf("step %d larger than MaxStep: %v", i, ts[i]-ts[i-1])
+		}
+	}
+}
+
+func TestSolveMaxSteps(t *testing.T) {
+	t.Parallel()
+	test := testProblems[1]
+	const maxSteps = 5
+	res, err := Solve(test.p, test.t0, test.t1, test.y0, &Settings{MaxSteps: maxSteps, MaxStep: 0.5}, nil)
+	if err != ErrStepLimit {
+		t.Fatalf("unexpected error: got %v, want %v", err, ErrStepLimit)
+	}
+	if res.Status != Failure {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, Failure)
+	}
+	if res.Steps != maxSteps {
+		t.Errorf("unexpected number of steps: got %d, want %d", res.Steps, maxSteps)
+	}
+	want := make([]float64, len(test.y0))
+	test.exact(want, res.T)
+	if res.T <= test.t0 || res.T >= test.t1 || scaledError(res.Y, want, 1e-6) > 100 {
+		t.Errorf("unexpected result at the step limit: t=%v y=%v, want y=%v", res.T, res.Y, want)
+	}
+}
+
+func TestSolveStepSizeTooSmall(t *testing.T) {
+	t.Parallel()
+	// The solution of y' = y^2 blows up at t = 1.
+	p := Problem{
+		Func: func(dy []float64, _ float64, y []float64) { dy[0] = y[0] * y[0] },
+	}
+	for _, m := range testMethods {
+		res, err := Solve(p, 0, 2, []float64{1}, nil, m.method())
+		if err != ErrStepSizeTooSmall {
+			t.Errorf("%s: unexpected error: got %v, want %v", m.name, err, ErrStepSizeTooSmall)
+			continue
+		}
+		if res.Status != Failure || math.Abs(res.T-1) > 1e-3 {
+			t.Errorf("%s: unexpected result at failure: status=%v t=%v", m.name, res.Status, res.T)
+		}
+	}
+}
+
+func TestSolvePanics(t *testing.T) {
+	t.Parallel()
+	f := func(dy []float64, _ float64, y []float64) { copy(dy, y) }
+	for _, test := range []struct {
+		name     string
+		p        Problem
+		t0, t1   float64
+		y0       []float64
+		settings *Settings
+		method   Method
+	}{
+		{name: "nil Func", t1: 1, y0: []float64{1}},
+		{name: "empty y0", p: Problem{Func: f}, t1: 1},
+		{name: "infinite t1", p: Problem{Func: f}, t1: math.Inf(1), y0: []float64{1}},
+		{name: "NaN t0", p: Problem{Func: f}, t0: math.NaN(), t1: 1, y0: []float64{1}},
+		{name: "negative AbsTol", p: Problem{Func: f}, t1: 1, y0: []float64{1}, settings: &Settings{AbsTol: -1}},
+		{name: "negative MaxSteps", p: Problem{Func: f}, t1: 1, y0: []float64{1}, settings: &Settings{MaxSteps: -1}},
+		{name: "BDF order", p: Problem{Func: f}, t1: 1, y0: []float64{1}, method: &BDF{MaxOrder: 6}},
+		{name: "nil event", p: Problem{Func: f}, t1: 1, y0: []float64{1}, settings: &Settings{Events: []Event{{}}}},
+	} {
+		if !panics(func() { Solve(test.p, test.t0, test.t1, test.y0, test.settings, test.method) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func TestStatusString(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		s    Status
+		want string
+	}{
+		{Success, "Success"},
+		{TerminalEvent, "TerminalEvent"},
+		{Failure, "Failure"},
+		{Status(10), "Status(10)"},
+	} {
+		if got := test.s.String(); got != test.want {
+			t.Errorf("unexpected string: got %q, want %q", got, test.want)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
+
+func TestSolveStiff(t *testing.T) {
+	t.Parallel()
+	robertson := Problem{
+		Func: func(dy []float64, _ float64, y []float64) {
+			dy[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+			dy[1] = 0.04*y[0] - 1e4*y[1]*y[2] - 3e7*y[1]*y[1]
+			dy[2] = 3e7 * y[1] * y[1]
+		},
+		Jac: func(dst *mat.Dense, _ float64, y []float64) {
+			dst.Set(0, 0, -0.04)
+			dst.Set(0, 1, 1e4*y[2])
+			dst.Set(0, 2, 1e4*y[1])
+			dst.Set(1, 0, 0.04)
+			dst.Set(1, 1, -1e4*y[2]-6e7*y[1])
+			dst.Set(1, 2, -1e4*y[1])
+			dst.Set(2, 0, 0)
+			dst.Set(2, 1, 6e7*y[1])
+			dst.Set(2, 2, 0)
+		},
+	}
+	const mu = 1000
+	vanDerPol := Problem{
+		Func: func(dy []float64, _ float64, y []float64) {
+			dy[0] = y[1]
+			dy[1] = mu*(1-y[0]*y[0])*y[1] - y[0]
+		},
+		Jac: func(dst *mat.Dense, _ float64, y []float64) {
+			dst.Set(0, 0, 0)
+			dst.Set(0, 1, 1)
+			dst.Set(1, 0, -2*mu*y[0]*y[1]-1)
+			dst.Set(1, 1, mu*(1-y[0]*y[0]))
+		},
+	}
+
+	var vdp []float64
+	for _, method := range []func() Method{
+		func() Method { return &BDF{} },
+		func() Method { return &Radau{} },
+	} {
+		for _, jac := range []bool{false, true} {
+			p := robertson
+			if !jac {
+				p.Jac = nil
+			}
+			// The reference solution is from Hairer and Wanner.
+			want := []float64{0.7158270687193455, 9.185534764557338e-6, 0.2841637457458970}
+			const tol = 1e-8
+			res, err := Solve(p, 0, 40, []float64{1, 0, 0}, &Settings{AbsTol: tol * 1e-4, RelTol: tol}, method())
+			if err != nil {
+				t.Fatalf("%T Robertson: unexpected error: %v", method(), err)
+			}
+			for i, v := range res.Y {
+				if math.Abs(v-want[i]) > 100*tol*math.Abs(want[i]) {
+					t.Errorf("%T Robertson jac=%t: unexpected solution component %d: got %v, want %v", method(), jac, i, v, want[i])
+				}
+			}
+			if res.Steps > 1000 {
+				t.Errorf("%T Robertson jac=%t: too many steps: %d", method(), jac, res.Steps)
+			}
+
+			p = vanDerPol
+			if !jac {
+				p.Jac = nil
+			}
+			res, err = Solve(p, 0, 2*mu, []float64{2, 0}, &Settings{RelTol: 1e-6}, method())
+			if err != nil {
+				t.Fatalf("%T Van der Pol: unexpected error: %v", method(), err)
+			}
+			if res.Steps > 2000 {
+				t.Errorf("%T Van der Pol jac=%t: too many steps: %d", method(), jac, res.Steps)
+			}
+			// There is no closed form solution, so the results of
+			// the methods are compared.
+			if vdp == nil {
+				vdp = res.Y
+				continue
+			}
+			if math.Abs(res.Y[0]-vdp[0]) > 1e-4*math.Abs(vdp[0]) {
+				t.Errorf("%T Van der Pol jac=%t: solution does not agree: got %v, want %v", method(), jac, res.Y, vdp)
+			}
+		}
+	}
+}
@@ -0,0 +1,439 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const radauNewtonMaxIter = 6
+
+var (
+	radauSqrt6 = math.Sqrt(6)
+
+	// radauC holds the nodes of the method.
+	radauC = [3]float64{(4 - radauSqrt6) / 10, (4 + radauSqrt6) / 10, 1}
+	// radauE holds the weights of the stages in the error estimate.
+	radauE = [3]float64{(-13 - 7*radauSqrt6) / 3, (-13 + 7*radauSqrt6) / 3, -1.0 / 3}
+
+	// The inverse of the coefficient matrix A of the method has the real
+	// eigenvalue radauMu and the complex eigenvalues radauAlpha ± i
+	// radauBeta, and radauTI A⁻¹ radauT is the real block diagonal form.
+	radauMu    = 3 + math.Cbrt(9) - math.Cbrt(3)
+	radauAlpha = 3 + 0.5*(math.Cbrt(3)-math.Cbrt(9))
+	radauBeta  = 0.5 * (math.Pow(3, 5.0/6) + math.Pow(3, 7.0/6))
+	radauT     = [3][3]float64{
+		{0.09443876248897524, -0.14125529502095421, 0.03002919410514742},
+		{0.25021312296533332, 0.20412935229379994, -0.38294211275726192},
+		{1, 1, 0},
+	}
+	radauTI = [3][3]float64{
+		{4.17871859155190428, 0.32768282076106237, 0.52337644549944951},
+		{-4.17871859155190428, -0.32768282076106237, 0.47662355450055044},
+		{0.50287263494578682, -2.57192694985560522, 0.59603920482822492},
+	}
+
+	// radauP holds the coefficients of the collocation polynomial
+	//  y(t + θh) = y(t) + Σ_j θ^(j+1) Σ_i P[i][j] Z_i
+	// in terms of the stage increments Z_i.
+	radauP = [3][3]float64{
+		{13.0/3 + 7*radauSqrt6/3, -23.0/3 - 22*radauSqrt6/3, 10.0/3 + 5*radauSqrt6},
+		{13.0/3 - 7*radauSqrt6/3, -23.0/3 + 22*radauSqrt6/3, 10.0/3 - 5*radauSqrt6},
+		{1.0 / 3, -8.0 / 3, 10.0 / 3},
+	}
+)
+
+// Radau is the implicit Runge–Kutta method of order 5 of the Radau IIA
+// family with 3 stages for stiff problems. The nonlinear equations of the
+// stages are transformed to a real and a complex system of the size of the
+// problem, which are solved by a simplified Newton iteration using their LU
+// decompositions. The Jacobian is evaluated when the iteration converges
+// slowly or fails to converge. The error is estimated using an embedded
+// formula of order 3 and the step size is controlled by the predictive
+// controller of Gustafsson. The dense output is the collocation polynomial
+// of order 3.
+//
+// Radau is L-stable and efficient for stiff problems at tight tolerances,
+// including problems with eigenvalues of the Jacobian close to the
+// imaginary axis.
+//
+// Reference:
+//
+//	Hairer, E., Wanner, G. (1996). Solving Ordinary Differential Equations
+//	II: Stiff and Differential-Algebraic Problems. Springer. Section IV.8.
+type Radau struct {
+	sys *system
+
+	newtonTol float64
+
+	// t, y and f are the time, the solution and the derivative at the end
+	// of the last step, and hAbs is the size of the next step.
+	t, hAbs float64
+	y, f    []float64
+	// hAbsOld and errNormOld are the size and the error norm of the last
+	// accepted step, used by the step size controller if haveOld is true.
+	hAbsOld, errNormOld float64
+	haveOld             bool
+
+	// tOld, h and yOld are the start time, the signed size and the
+	// initial value of the last step, and q holds the coefficients of its
+	// collocation polynomial. haveStep specifies whether a step has been
+	// taken.
+	tOld, h  float64
+	yOld     []float64
+	q        [3][]float64
+	haveStep bool
+
+	jac        *mat.Dense
+	currentJac bool
+	luReal     *mat.LU
+	luComplex  *mat.LU
+	luValid    bool
+	iterReal   *mat.Dense
+	iterCmplx  *mat.Dense
+
+	z, w, dw, fz         [3][]float64
+	yNew, scale, tmp, ze []float64
+	rhsReal, solReal     *mat.VecDense
+	rhsCmplx, solCmplx   *mat.VecDense
+}
+
+func (m *Radau) init(sys *system, t float64, y, f []float64) {
+	n := len(y)
+	m.sys = sys
+	m.newtonTol = math.Max(10*eps/sys.relTol, math.Min(0.03, math.Sqrt(sys.relTol)))
+	m.t = t
+	m.y = resize(m.y, n)
+	copy(m.y, y)
+	m.f = resize(m.f, n)
+	copy(m.f, f)
+	m.hAbs = sys.firstStep(t, y, f, 3)
+	m.haveOld = false
+	m.haveStep = false
+	m.yOld = resize(m.yOld, n)
+	for i := 0; i < 3; i++ {
+		m.q[i] = resize(m.q[i], n)
+		m.z[i] = resize(m.z[i], n)
+		m.w[i] = resize(m.w[i], n)
+		m.dw[i] = resize(m.dw[i], n)
+		m.fz[i] = resize(m.fz[i], n)
+	}
+	m.yNew = resize(m.yNew, n)
+	m.scale = resize(m.scale, n)
+	m.tmp = resize(m.tmp, n)
+	m.ze = resize(m.ze, n)
+
+	m.jac = mat.NewDense(n, n, nil)
+	sys.jacobian(m.jac, t, y, f)
+	m.currentJac = true
+	m.luReal = &mat.LU{}
+	m.luComplex = &mat.LU{}
+	m.luValid = false
+	m.iterReal = mat.NewDense(n, n, nil)
+	m.iterCmplx = mat.NewDense(2*n, 2*n, nil)
+	m.rhsReal = mat.NewVecDense(n, nil)
+	m.solReal = mat.NewVecDense(n, nil)
+	m.rhsCmplx = mat.NewVecDense(2*n, nil)
+	m.solCmplx = mat.NewVecDense(2*n, nil)
+}
+
+func (m *Radau) step() error {
+	sys := m.sys
+	minStep := sys.minStep(m.t)
+	hAbs := m.hAbs
+	switch {
+	case hAbs > sys.maxStep:
+		hAbs = sys.maxStep
+		m.haveOld = false
+	case hAbs < minStep:
+		hAbs = minStep
+		m.haveOld = false
+	}
+
+	var (
+		tNew, h, errNorm, rate float64
+		nIter                  int
+	)
+	rejected := false
+	for {
+		if hAbs < minStep {
+			return ErrStepSizeTooSmall
+		}
+		tNew = m.t + sys.dir*hAbs
+		if sys.dir*(tNew-sys.tEnd) > 0 {
+			tNew = sys.tEnd
+		}
+		h = tNew - m.t
+		hAbs = math.Abs(h)
+
+		// Predict the stages by extrapolating the collocation
+		// polynomial of the last step.
+		for i := 0; i < 3; i++ {
+			if m.haveStep {
+				m.collocation(m.z[i], m.t+radauC[i]*h)
+				floats.Sub(m.z[i], m.y)
+			} else {
+				for j := range m.z[i] {
+					m.z[i][j] = 0
+				}
+			}
+		}
+		sys.scale(m.scale, m.y, nil)
+
+		var converged bool
+		for {
+			if !m.luValid {
+				m.factorize(h)
+			}
+			converged, nIter, rate = m.newton(h)
+			if converged || m.currentJac {
+				break
+			}
+			sys.jacobian(m.jac, m.t, m.y, m.f)
+			m.currentJac = true
+			m.luValid = false
+		}
+		if !converged {
+			sys.stats.RejectedSteps++
+			hAbs *= 0.5
+			m.luValid = false
+			continue
+		}
+
+		copy(m.yNew, m.y)
+		floats.Add(m.yNew, m.z[2])
+		for i := range m.ze {
+			m.ze[i] = 0
+		}
+		for i := 0; i < 3; i++ {
+			floats.AddScaled(m.ze, radauE[i]/h, m.z[i])
+		}
+		errVec := m.solReal
+		floats.AddTo(m.rhsReal.RawVector().Data, m.f, m.ze)
+		singular := !solveLU(m.luReal, errVec, m.rhsReal)
+		sys.scale(m.scale, m.y, m.yNew)
+		errNorm = rmsNorm(errVec.RawVector().Data, m.scale)
+		if rejected && errNorm > 1 && !singular {
+			// Improve the estimate for stiff problems.
+			floats.AddTo(m.tmp, m.y, errVec.RawVector().Data)
+			sys.eval(m.rhsReal.RawVector().Data, m.t, m.tmp)
+			floats.Add(m.rhsReal.RawVector().Data, m.ze)
+			singular = !solveLU(m.luReal, errVec, m.rhsReal)
+			errNorm = rmsNorm(errVec.RawVector().Data, m.scale)
+		}
+		sf := safety * float64(2*radauNewtonMaxIter+1) / float64(2*radauNewtonMaxIter+nIter)
+		if errNorm <= 1 && !singular {
+			break
+		}
+		sys.stats.RejectedSteps++
+		factor := float64(minFactor)
+		if !math.IsNaN(errNorm) && !singular {
+			factor = math.Max(minFactor, sf*m.predictFactor(hAbs, errNorm))
+		}
+		hAbs *= factor
+		m.luValid = false
+		rejected = true
+	}
+
+	sf := safety * float64(2*radauNewtonMaxIter+1) / float64(2*radauNewtonMaxIter+nIter)
+	recomputeJac := nIter > 2 && rate > 1e-3
+	factor := math.Min(maxFactor, sf*m.predictFactor(hAbs, errNorm))
+	if !recomputeJac && factor < 1.2 {
+		factor = 1
+	} else {
+		m.luValid = false
+	}
+
+	// Store the last step for the dense output and the prediction of
+	// the stages of the next step.
+	m.tOld, m.h = m.t, h
+	copy(m.yOld, m.y)
+	for j := 0; j < 3; j++ {
+		for k := range m.q[j] {
+			m.q[j][k] = 0
+		}
+		for i := 0; i < 3; i++ {
+			floats.AddScaled(m.q[j], radauP[i][j], m.z[i])
+		}
+	}
+	m.haveStep = true
+
+	m.t = tNew
+	copy(m.y, m.yNew)
+	sys.eval(m.f, m.t, m.y)
+	if recomputeJac {
+		sys.jacobian(m.jac, m.t, m.y, m.f)
+		m.currentJac = true
+	} else {
+		m.currentJac = false
+	}
+	m.hAbsOld, m.errNormOld, m.haveOld = hAbs, errNorm, true
+	m.hAbs = hAbs * factor
+	return nil
+}
+
+// predictFactor returns the factor of the step size predicted by the
+// controller of Gustafsson from the error norm of the current step of size
+// hAbs and of the last accepted step.
+func (m *Radau) predictFactor(hAbs, errNorm float64) float64 {
+	multiplier := 1.0
+	if m.haveOld && errNorm != 0 {
+		multiplier = hAbs / m.hAbsOld * math.Pow(m.errNormOld/errNorm, 0.25)
+	}
+	return math.Min(1, multiplier) * math.Pow(errNorm, -0.25)
+}
+
+// factorize computes the LU decompositions of the real and of the complex
+// iteration matrices μ/h I - J and (α - iβ)/h I - J for the step size h.
+// The complex matrix is represented by the real matrix
+//
+//	[ α/h I - J      β/h I   ]
+//	[   -β/h I     α/h I - J ]
+func (m *Radau) factorize(h float64) {
+	n, _ := m.jac.Dims()
+	m.iterReal.Scale(-1, m.jac)
+	for i := 0; i < n; i++ {
+		m.iterReal.Set(i, i, m.iterReal.At(i, i)+radauMu/h)
+	}
+	m.luReal.Factorize(m.iterReal)
+
+	a := radauAlpha / h
+	b := radauBeta / h
+	m.iterCmplx.Zero()
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v := -m.jac.At(i, j)
+			if i == j {
+				v += a
+			}
+			m.iterCmplx.Set(i, j, v)
+			m.iterCmplx.Set(n+i, n+j, v)
+		}
+		m.iterCmplx.Set(i, n+i, b)
+		m.iterCmplx.Set(n+i, i, -b)
+	}
+	m.luComplex.Factorize(m.iterCmplx)
+	m.sys.stats.LUDecompositions += 2
+	m.luValid = true
+}
+
+// newton solves the collocation equations of the step of size h by the
+// simplified Newton iteration starting from the predicted stage increments
+// in m.z. It returns whether the iteration has converged, the number of
+// iterations and the estimate of the rate of convergence.
+func (m *Radau) newton(h float64) (converged bool, iter int, rate float64) {
+	sys := m.sys
+	n := len(m.y)
+	mu := radauMu / h
+	a := radauAlpha / h
+	b := radauBeta / h
+	for i := 0; i < 3; i++ {
+		for k := 0; k < n; k++ {
+			m.w[i][k] = radauTI[i][0]*m.z[0][k] + radauTI[i][1]*m.z[1][k] + radauTI[i][2]*m.z[2][k]
+		}
+	}
+	rhsReal := m.rhsReal.RawVector().Data
+	rhsCmplx := m.rhsCmplx.RawVector().Data
+	solReal := m.solReal.RawVector().Data
+	solCmplx := m.solCmplx.RawVector().Data
+	var dwNormOld float64
+	for k := 0; k < radauNewtonMaxIter; k++ {
+		iter = k + 1
+		for i := 0; i < 3; i++ {
+			floats.AddTo(m.tmp, m.y, m.z[i])
+			sys.eval(m.fz[i], m.t+radauC[i]*h, m.tmp)
+			if !isFinite(m.fz[i]) {
+				return false, iter, rate
+			}
+		}
+		for j := 0; j < n; j++ {
+			f0, f1, f2 := m.fz[0][j], m.fz[1][j], m.fz[2][j]
+			rhsReal[j] = radauTI[0][0]*f0 + radauTI[0][1]*f1 + radauTI[0][2]*f2 - mu*m.w[0][j]
+			// (α - iβ)/h (w1 + i w2) = (a w1 + b w2) + i (a w2 - b w1).
+			rhsCmplx[j] = radauTI[1][0]*f0 + radauTI[1][1]*f1 + radauTI[1][2]*f2 - (a*m.w[1][j] + b*m.w[2][j])
+			rhsCmplx[n+j] = radauTI[2][0]*f0 + radauTI[2][1]*f1 + radauTI[2][2]*f2 - (a*m.w[2][j] - b*m.w[1][j])
+		}
+		if !solveLU(m.luReal, m.solReal, m.rhsReal) || !solveLU(m.luComplex, m.solCmplx, m.rhsCmplx) {
+			return false, iter, rate
+		}
+		copy(m.dw[0], solReal)
+		copy(m.dw[1], solCmplx[:n])
+		copy(m.dw[2], solCmplx[n:])
+		var s float64
+		for i := 0; i < 3; i++ {
+			for j, v := range m.dw[i] {
+				v /= m.scale[j]
+				s += v * v
+			}
+		}
+		dwNorm := math.Sqrt(s / float64(3*n))
+		if k > 0 {
+			rate = dwNorm / dwNormOld
+			if rate >= 1 || math.Pow(rate, float64(radauNewtonMaxIter-k))/(1-rate)*dwNorm > m.newtonTol {
+				return false, iter, rate
+			}
+		}
+		for i := 0; i < 3; i++ {
+			floats.Add(m.w[i], m.dw[i])
+		}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < n; j++ {
+				m.z[i][j] = radauT[i][0]*m.w[0][j] + radauT[i][1]*m.w[1][j] + radauT[i][2]*m.w[2][j]
+			}
+		}
+		if dwNorm == 0 || k > 0 && rate/(1-rate)*dwNorm < m.newtonTol {
+			return true, iter, rate
+		}
+		dwNormOld = dwNorm
+	}
+	return false, iter, rate
+}
+
+// collocation stores in dst the value of the collocation polynomial of the
+// last step at t.
+func (m *Radau) collocation(dst []float64, t float64) {
+	radauPoly(dst, t, m.tOld, m.h, m.yOld, m.q)
+}
+
+func (m *Radau) state() (t float64, y []float64) {
+	return m.t, m.y
+}
+
+func (m *Radau) interpolant() interpolant {
+	in := &radauInterpolant{t: m.tOld, h: m.h, y: make([]float64, len(m.yOld))}
+	copy(in.y, m.yOld)
+	for j := range in.q {
+		in.q[j] = make([]float64, len(m.q[j]))
+		copy(in.q[j], m.q[j])
+	}
+	return in
+}
+
+// radauInterpolant is the collocation polynomial of a step of Radau.
+type radauInterpolant struct {
+	t, h float64
+	y    []float64
+	q    [3][]float64
+}
+
+func (in *radauInterpolant) at(dst []float64, t float64) {
+	radauPoly(dst, t, in.t, in.h, in.y, in.q)
+}
+
+// radauPoly stores in dst the value at t of the collocation polynomial of
+// the step from t0 of size h with the initial value y and the coefficients
+// q.
+func radauPoly(dst []float64, t, t0, h float64, y []float64, q [3][]float64) {
+	x := (t - t0) / h
+	copy(dst, y)
+	p := x
+	for j := 0; j < 3; j++ {
+		floats.AddScaled(dst, p, q[j])
+		p *= x
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestRadauCoefficients(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	s6 := math.Sqrt(6)
+	a := mat.NewDense(3, 3, []float64{
+		(88 - 7*s6) / 360, (296 - 169*s6) / 1800, (-2 + 3*s6) / 225,
+		(296 + 169*s6) / 1800, (88 + 7*s6) / 360, (-2 - 3*s6) / 225,
+		(16 - s6) / 36, (16 + s6) / 36, 1.0 / 9,
+	})
+	for i := 0; i < 3; i++ {
+		if sum := mat.Sum(a.RowView(i)); !scalar.EqualWithinAbs(sum, radauC[i], tol) {
+			t.Errorf("row %d of A does not sum to c: got %v, want %v", i, sum, radauC[i])
+		}
+	}
+
+	var ainv mat.Dense
+	err := ainv.Inverse(a)
+	if err != nil {
+		t.Fatalf("unexpected error inverting A: %v", err)
+	}
+	tm := mat.NewDense(3, 3, nil)
+	ti := mat.NewDense(3, 3, nil)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			tm.Set(i, j, radauT[i][j])
+			ti.Set(i, j, radauTI[i][j])
+		}
+	}
+	var got mat.Dense
+	got.Mul(ti, tm)
+	if !mat.EqualApprox(&got, mat.NewDiagDense(3, []float64{1, 1, 1}), tol) {
+		t.Errorf("TI is not the inverse of T:\n%v", mat.Formatted(&got))
+	}
+	got.Product(ti, &ainv, tm)
+	want := mat.NewDense(3, 3, []float64{
+		radauMu, 0, 0,
+		0, radauAlpha, radauBeta,
+		0, -radauBeta, radauAlpha,
+	})
+	if !mat.EqualApprox(&got, want, 1e-12) {
+		t.Errorf("unexpected block diagonal form of the inverse of A:\ngot:\n%v\nwant:\n%v",
+			mat.Formatted(&got), mat.Formatted(want))
+	}
+
+	// The collocation polynomial interpolates the stage increments at
+	// the nodes.
+	for k, c := range radauC {
+		for i := range radauP {
+			var v float64
+			for j, p := range radauP[i] {
+				v += math.Pow(c, float64(j+1)) * p
+			}
+			var want float64
+			if i == k {
+				want = 1
+			}
+			if !scalar.EqualWithinAbs(v, want, 1e-12) {
+				t.Errorf("collocation weight of stage %d at node %d: got %v, want %v", i, k, v, want)
+			}
+		}
+	}
+}
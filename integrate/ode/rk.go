@@ -0,0 +1,295 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// DormandPrince5 is the explicit Runge–Kutta method of order 5 of Dormand
+// and Prince with an embedded method of order 4 for the error estimate. It
+// is a good default for non-stiff problems at moderate tolerances. Its
+// dense output is of order 4.
+//
+// References:
+//   - Dormand, J. R., Prince, P. J. (1980). A family of embedded Runge-Kutta
+//     formulae. Journal of Computational and Applied Mathematics, 6(1),
+//     19-26.
+//   - Hairer, E., Nørsett, S. P., Wanner, G. (1993). Solving Ordinary
+//     Differential Equations I: Nonstiff Problems. Springer. Section II.6.
+type DormandPrince5 struct {
+	explicitRK
+}
+
+func (m *DormandPrince5) init(sys *system, t float64, y, f []float64) {
+	m.explicitRK.setup(&dormandPrince5, sys, t, y, f)
+}
+
+// Tsitouras5 is the explicit Runge–Kutta method of order 5 of Tsitouras with
+// an embedded method of order 4 for the error estimate. It is usually
+// slightly more efficient than DormandPrince5. Its dense output is of
+// order 4.
+//
+// Reference:
+//
+//	Tsitouras, Ch. (2011). Runge–Kutta pairs of order 5(4) satisfying only
+//	the first column simplifying assumption. Computers & Mathematics with
+//	Applications, 62(2), 770-775.
+type Tsitouras5 struct {
+	explicitRK
+}
+
+func (m *Tsitouras5) init(sys *system, t float64, y, f []float64) {
+	m.explicitRK.setup(&tsitouras5, sys, t, y, f)
+}
+
+// Verner8 is the 13-stage explicit Runge–Kutta method of order 8 of Verner
+// with an embedded method of order 7 for the error estimate. It is efficient
+// for non-stiff problems at tight tolerances. Its dense output is of order 5,
+// so the accuracy of the dense output and of the location of events may be
+// lower than that of the steps unless the step size is limited by
+// Settings.MaxStep.
+//
+// Reference:
+//
+//	Verner, J. H. (2010). Numerically optimal Runge–Kutta pairs with
+//	interpolants. Numerical Algorithms, 53(2-3), 383-396.
+type Verner8 struct {
+	explicitRK
+}
+
+func (m *Verner8) init(sys *system, t float64, y, f []float64) {
+	m.explicitRK.setup(&verner8, sys, t, y, f)
+}
+
+// rkTableau holds the coefficients of an explicit Runge–Kutta pair. The
+// pair has s = len(b) stages and the stage s+1 is the derivative at the end
+// of the step, which is the first stage of the next step.
+type rkTableau struct {
+	c []float64
+	a [][]float64
+	// b holds the weights of the propagated solution.
+	b []float64
+	// e holds the differences between the weights of the propagated
+	// solution and of the error estimator for the s+1 stages.
+	e []float64
+	// errOrder is the order of the error estimator.
+	errOrder int
+
+	// dense stores in w the weights of the s+1 stages in the continuous
+	// extension at theta. If dense is nil, the continuous extension is
+	// given by hermiteWeights with the correction weights d.
+	dense func(w []float64, theta float64)
+	d     []float64
+}
+
+// denseWeights stores in w the weights of the continuous extension
+//
+//	y(t + θh) = y(t) + h Σ_i w_i(θ) k_i.
+func (tab *rkTableau) denseWeights(w []float64, theta float64) {
+	if tab.dense != nil {
+		tab.dense(w, theta)
+		return
+	}
+	hermiteWeights(w, theta, tab.b, tab.d)
+}
+
+// hermiteWeights stores in w the weights of the stages of the continuous
+// extension
+//
+//	y + θ(Δ + (1-θ)(hk_0 - Δ + θ(2Δ - hk_0 - hk_s + (1-θ) h Σ_i d_i k_i)))
+//
+// where Δ = h Σ_i b_i k_i is the increment of the step, k_0 and k_s are the
+// derivatives at the start and the end of the step, and d are the weights
+// of a quartic correction. If d is nil, the continuous extension is the
+// cubic Hermite interpolant.
+func hermiteWeights(w []float64, theta float64, b, d []float64) {
+	s := len(w) - 1
+	for i := range w {
+		var bi, di, e0, es float64
+		if i < len(b) {
+			bi = b[i]
+		}
+		if d != nil {
+			di = d[i]
+		}
+		if i == 0 {
+			e0 = 1
+		}
+		if i == s {
+			es = 1
+		}
+		w[i] = theta * (bi + (1-theta)*(e0-bi+theta*(2*bi-e0-es+(1-theta)*di)))
+	}
+}
+
+// explicitRK implements the adaptive integration with an explicit
+// Runge–Kutta pair.
+type explicitRK struct {
+	tab *rkTableau
+	sys *system
+
+	// t and y are the time and the solution at the end of the last step,
+	// and hAbs is the size of the next step.
+	t, hAbs float64
+	y       []float64
+	// tOld, h and yOld are the start time, the signed size and the
+	// initial value of the last step.
+	tOld, h float64
+	yOld    []float64
+
+	// k holds the stages of the last step. k[s] is the derivative at t.
+	k [][]float64
+	// swap specifies whether k[s] has to be moved to k[0] before the next
+	// step.
+	swap bool
+
+	yNew, err, scale []float64
+}
+
+func (m *explicitRK) setup(tab *rkTableau, sys *system, t float64, y, f []float64) {
+	n := len(y)
+	s := len(tab.b)
+	m.tab = tab
+	m.sys = sys
+	m.t = t
+	m.y = resize(m.y, n)
+	copy(m.y, y)
+	m.yOld = resize(m.yOld, n)
+	m.yNew = resize(m.yNew, n)
+	m.err = resize(m.err, n)
+	m.scale = resize(m.scale, n)
+	if len(m.k) != s+1 {
+		m.k = make([][]float64, s+1)
+	}
+	for i := range m.k {
+		m.k[i] = resize(m.k[i], n)
+	}
+	copy(m.k[0], f)
+	m.swap = false
+	m.hAbs = sys.firstStep(t, y, f, tab.errOrder)
+}
+
+func (m *explicitRK) step() error {
+	sys := m.sys
+	tab := m.tab
+	s := len(tab.b)
+	if m.swap {
+		m.k[0], m.k[s] = m.k[s], m.k[0]
+		m.swap = false
+	}
+	exponent := -1 / float64(tab.errOrder+1)
+
+	minStep := sys.minStep(m.t)
+	hAbs := math.Max(math.Min(m.hAbs, sys.maxStep), minStep)
+	rejected := false
+	for {
+		if hAbs < minStep {
+			return ErrStepSizeTooSmall
+		}
+		tNew := m.t + sys.dir*hAbs
+		if sys.dir*(tNew-sys.tEnd) > 0 {
+			tNew = sys.tEnd
+		}
+		h := tNew - m.t
+		hAbs = math.Abs(h)
+
+		for i := 1; i < s; i++ {
+			copy(m.yNew, m.y)
+			for j, a := range tab.a[i] {
+				if a != 0 {
+					floats.AddScaled(m.yNew, h*a, m.k[j])
+				}
+			}
+			sys.eval(m.k[i], m.t+tab.c[i]*h, m.yNew)
+		}
+		copy(m.yNew, m.y)
+		for j, b := range tab.b {
+			if b != 0 {
+				floats.AddScaled(m.yNew, h*b, m.k[j])
+			}
+		}
+		sys.eval(m.k[s], tNew, m.yNew)
+
+		for i := range m.err {
+			m.err[i] = 0
+		}
+		for j, e := range tab.e {
+			if e != 0 {
+				floats.AddScaled(m.err, h*e, m.k[j])
+			}
+		}
+		sys.scale(m.scale, m.y, m.yNew)
+		errNorm := rmsNorm(m.err, m.scale)
+
+		if errNorm < 1 {
+			factor := float64(maxFactor)
+			if errNorm != 0 {
+				factor = math.Min(maxFactor, safety*math.Pow(errNorm, exponent))
+			}
+			if rejected {
+				factor = math.Min(1, factor)
+			}
+			m.tOld, m.h = m.t, h
+			m.t = tNew
+			m.y, m.yOld, m.yNew = m.yNew, m.y, m.yOld
+			m.hAbs = hAbs * factor
+			m.swap = true
+			return nil
+		}
+		sys.stats.RejectedSteps++
+		factor := float64(minFactor)
+		if !math.IsNaN(errNorm) {
+			factor = math.Max(minFactor, safety*math.Pow(errNorm, exponent))
+		}
+		hAbs *= factor
+		rejected = true
+	}
+}
+
+func (m *explicitRK) state() (t float64, y []float64) {
+	return m.t, m.y
+}
+
+func (m *explicitRK) interpolant() interpolant {
+	k := make([][]float64, len(m.k))
+	for i, v := range m.k {
+		k[i] = make([]float64, len(v))
+		copy(k[i], v)
+	}
+	y := make([]float64, len(m.yOld))
+	copy(y, m.yOld)
+	return &rkInterpolant{tab: m.tab, t: m.tOld, h: m.h, y: y, k: k}
+}
+
+// rkInterpolant is the continuous extension of an explicit Runge–Kutta step.
+type rkInterpolant struct {
+	tab  *rkTableau
+	t, h float64
+	y    []float64
+	k    [][]float64
+}
+
+func (in *rkInterpolant) at(dst []float64, t float64) {
+	w := make([]float64, len(in.k))
+	in.tab.denseWeights(w, (t-in.t)/in.h)
+	copy(dst, in.y)
+	for i, k := range in.k {
+		if w[i] != 0 {
+			floats.AddScaled(dst, in.h*w[i], k)
+		}
+	}
+}
+
+// resize returns a slice of length n, reusing the storage of s if it is
+// large enough.
+func resize(s []float64, n int) []float64 {
+	if cap(s) < n {
+		return make([]float64, n)
+	}
+	return s[:n]
+}
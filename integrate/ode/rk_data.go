@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+// dormandPrince5 is the 5(4) pair of Dormand and Prince with the continuous
+// extension of order 4 of Hairer, Nørsett and Wanner.
+var dormandPrince5 = rkTableau{
+	c: []float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1},
+	a: [][]float64{
+		{},
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+	},
+	b: []float64{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	e: []float64{
+		35.0/384 - 5179.0/57600,
+		0,
+		500.0/1113 - 7571.0/16695,
+		125.0/192 - 393.0/640,
+		-2187.0/6784 + 92097.0/339200,
+		11.0/84 - 187.0/2100,
+		-1.0 / 40,
+	},
+	d: []float64{
+		-12715105075.0 / 11282082432,
+		0,
+		87487479700.0 / 32700410799,
+		-10690763975.0 / 1880347072,
+		701980252875.0 / 199316789632,
+		-1453857185.0 / 822651844,
+		69997945.0 / 29380423,
+	},
+	errOrder: 4,
+}
+
+// tsitouras5 is the 5(4) pair of Tsitouras with its continuous extension of
+// order 4.
+var tsitouras5 = rkTableau{
+	c: []float64{0, 0.161, 0.327, 0.9, 0.9800255409045097, 1},
+	a: [][]float64{
+		{},
+		{0.161},
+		{-0.008480655492356989, 0.335480655492357},
+		{2.897153057105493, -6.359448489975075, 4.3622954328695815},
+		{5.325864828439257, -11.748883564062828, 7.4955393428898365, -0.09249506636175525},
+		{5.86145544294642, -12.92096931784711, 8.159367898576159, -0.071584973281401, -0.028269050394068383},
+	},
+	b: []float64{0.09646076681806523, 0.01, 0.4798896504144996, 1.379008574103742, -3.290069515436081, 2.324710524099774},
+	e: []float64{
+		-0.00178001105222577714,
+		-0.0008164344596567469,
+		0.007880878010261995,
+		-0.1447110071732629,
+		0.5823571654525552,
+		-0.45808210592918697,
+		1.0 / 66,
+	},
+	errOrder: 4,
+	dense: func(w []float64, t float64) {
+		t2 := t * t
+		w[0] = -1.0530884977290216 * t * (t - 1.3299890189751412) * (t2 - 1.4364028541716351*t + 0.7139816917074209)
+		w[1] = 0.1017 * t2 * (t2 - 2.1966568338249754*t + 1.2949852507374631)
+		w[2] = 2.490627285651252793 * t2 * (t2 - 2.38535645472061657*t + 1.57803468208092486)
+		w[3] = -16.54810288924490272 * (t - 1.21712927295533244) * (t - 0.61620406037800089) * t2
+		w[4] = 47.37952196281928122 * (t - 1.203071208372362603) * (t - 0.658047292653547382) * t2
+		w[5] = -34.87065786149660974 * (t - 1.2) * (t - 0.666666666666666667) * t2
+		w[6] = 2.5 * (t - 1) * (t - 0.6) * t2
+	},
+}
+
+// verner8 is the 13-stage 8(7) pair of Verner with a continuous extension
+// of order 5 that uses the derivative at the end of the step as its only
+// additional stage.
+var verner8 = rkTableau{
+	c: []float64{0, 0.05, 0.1065625, 0.15984375, 0.39, 0.465, 0.155, 0.943, 0.9018020417358569, 0.909, 0.94, 1, 1},
+	a: [][]float64{
+		{},
+		{0.05},
+		{-0.0069931640625, 0.1135556640625},
+		{0.0399609375, 0, 0.1198828125},
+		{0.36139756280045754, 0, -1.3415240667004928, 1.3701265039000352},
+		{0.049047202797202795, 0, 0, 0.23509720422144048, 0.18085559298135673},
+		{0.06169289044289044, 0, 0, 0.11236568314640277, -0.03885046071451367, 0.01979188712522046},
+		{-1.767630240222327, 0, 0, -62.5, -6.061889377376669, 5.6508231982227635, 65.62169641937624},
+		{-1.1809450665549708, 0, 0, -41.50473441114321, -4.434438319103725, 4.260408188586133, 43.75364022446172, 0.00787142548991231},
+		{-1.2814059994414884, 0, 0, -45.047139960139866, -4.731362069449576, 4.514967016593808, 47.44909557172985, 0.01059228297111661, -0.0057468422638446166},
+		{-1.7244701342624853, 0, 0, -60.92349008483054, -5.951518376222392, 5.556523730698456, 63.98301198033305, 0.014642028250414961, 0.06460408772358203, -0.0793032316900888},
+		{-3.301622667747085, 0, 0, -118.01127235975272, -10.141422388456133, 9.139311332232056, 123.37594282840467, 4.623244378874429, -3.383277738068066, 4.527592100324793, -5.828495485811629},
+		{-3.039515033763688, 0, 0, -109.26086808932477, -9.290642497392753, 8.43050498175845, 114.20100103773652, -0.9637271342144306, -5.034884088803593, 5.958130824004275, 0, 0},
+	},
+	b: []float64{0.04427989419007951, 0, 0, 0, 0, 0.3541049391724449, 0.24796921549564377, -15.694202038838085, 25.084064965558564, -31.738367786260277, 22.938283273988784, -0.2361324633071542, 0},
+	e: []float64{
+		0.04427989419007951 - 0.0443126152290898,
+		0,
+		0,
+		0,
+		0,
+		0.3541049391724449 - 0.3546095642343227,
+		0.24796921549564377 - 0.24784804313666528,
+		-15.694202038838085 - 4.448134732475345,
+		25.084064965558564 - 19.84688636612563,
+		-31.738367786260277 + 23.581623377472056,
+		22.938283273988784,
+		-0.2361324633071542,
+		0.36016794372899924,
+		0,
+	},
+	errOrder: 7,
+	dense: func(w []float64, t float64) {
+		for i, p := range verner8Dense {
+			var v float64
+			for j := len(p) - 1; j >= 0; j-- {
+				v = (v + p[j]) * t
+			}
+			w[i] = v
+		}
+	},
+}
+
+// verner8Dense holds the coefficients of the weights of the continuous
+// extension of verner8, so that w_i(θ) = Σ_n verner8Dense[i][n-1] θ^n. The
+// weights satisfy the order conditions up to order 5, w(1) = b, and the
+// conditions for the derivative of the extension to match the first stage
+// at θ = 0 and the derivative at the end of the step at θ = 1, so that the
+// dense output is continuously differentiable. The residuals of the
+// conditions of order 6 are minimized in the least squares sense over the
+// step.
+var verner8Dense = [][]float64{
+	{1, -5.4810166966025138, 11.878876933679217, -10.782455077953925, 2.5543278868810977, 0.6500235400801474, 1.0127442357500762, -0.78822092764423601},
+	{},
+	{},
+	{},
+	{},
+	{0, -2.5692743697507612, 15.036901523523596, -19.396685026761293, 3.94193692830043, 2.480176092172496, 3.8645552520650464, -3.003505460377065},
+	{0, 7.2389227617030745, -21.78473759297086, 22.293108177464216, -5.2542786609946575, -1.6682455355047443, -2.5992005372624081, 2.02240060306102},
+	{0, -24.527059343885096, 30.489187151726405, -40.09319476362861, -19.031808507529924, 24.444160530575321, 37.742687509927457, -24.718174616023639},
+	{0, 40.421231190122434, -59.249552050569029, 79.055637144111714, 25.07328899355273, -38.339484963454765, -60.370552793616376, 38.493497445411869},
+	{0, -43.42378818607736, 8.4203899961150945, -9.3145035822600555, -5.2541382496254521, 4.7082512073590141, 8.1377635090050351, 4.9876575192234496},
+	{0, 26.983558082017726, 29.975498323861505, -38.469417866509062, -18.710079924459841, 22.930681038307156, 35.873975243297828, -35.645931622526533},
+	{0, -1.3247143810426498, -1.0627625003613534, 18.049767124045083, 7.214480755972648, -26.24910899296944, -29.971253696552317, 33.107459227601069},
+	{0, 2.870322991581804, -13.271661089449822, -3.1214659781845695, 8.2610191305916789, 13.260641869401274, 10.317890279918997, -18.316747203859357},
+	{0, -0.18818204806664476, -0.43214069555475465, 1.7792098496765112, 1.2052516473112904, -2.2170947859664607, -4.0086090025333512, 3.8615650351334101},
+}
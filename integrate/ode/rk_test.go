@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var rkTableaux = []struct {
+	name   string
+	tab    *rkTableau
+	method func() Method
+	// order is the order of the propagated solution and denseOrder is
+	// the order of the continuous extension.
+	order, denseOrder int
+}{
+	{name: "DormandPrince5", tab: &dormandPrince5, method: func() Method { return &DormandPrince5{} }, order: 5, denseOrder: 4},
+	{name: "Tsitouras5", tab: &tsitouras5, method: func() Method { return &Tsitouras5{} }, order: 5, denseOrder: 4},
+	{name: "Verner8", tab: &verner8, method: func() Method { return &Verner8{} }, order: 8, denseOrder: 5},
+}
+
+func TestRKTableau(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	for _, test := range rkTableaux {
+		tab := test.tab
+		s := len(tab.b)
+		if len(tab.c) != s || len(tab.a) != s || len(tab.e) != s+1 {
+			t.Errorf("%s: inconsistent tableau sizes", test.name)
+			continue
+		}
+		if tab.errOrder != test.order-1 {
+			t.Errorf("%s: unexpected error order: got %d, want %d", test.name, tab.errOrder, test.order-1)
+		}
+		for i, row := range tab.a {
+			if len(row) > i {
+				t.Errorf("%s: row %d of a is not strictly lower triangular", test.name, i)
+			}
+			if sum := floats.Sum(row); !scalar.EqualWithinAbs(sum, tab.c[i], tol) {
+				t.Errorf("%s: row %d of a does not sum to c: got %v, want %v", test.name, i, sum, tab.c[i])
+			}
+		}
+		if sum := floats.Sum(tab.b); !scalar.EqualWithinAbs(sum, 1, tol) {
+			t.Errorf("%s: weights do not sum to one: got %v", test.name, sum)
+		}
+		if sum := floats.Sum(tab.e); !scalar.EqualWithinAbs(sum, 0, tol) {
+			t.Errorf("%s: error weights do not sum to zero: got %v", test.name, sum)
+		}
+
+		// The continuous extension starts at the start of the step,
+		// ends at the propagated solution and is consistent with the
+		// derivatives at both ends of the step.
+		w := make([]float64, s+1)
+		wl := make([]float64, s+1)
+		wr := make([]float64, s+1)
+		tab.denseWeights(w, 0)
+		for i, v := range w {
+			if !scalar.EqualWithinAbs(v, 0, tol) {
+				t.Errorf("%s: non-zero weight %d at the start of the step: %v", test.name, i, v)
+			}
+		}
+		tab.denseWeights(w, 1)
+		for i, v := range w {
+			var want float64
+			if i < s {
+				want = tab.b[i]
+			}
+			if !scalar.EqualWithinAbs(v, want, 1e-10) {
+				t.Errorf("%s: unexpected weight %d at the end of the step: got %v, want %v", test.name, i, v, want)
+			}
+		}
+		const h = 1e-6
+		for _, theta := range []float64{0, 1} {
+			tab.denseWeights(wl, theta-h)
+			tab.denseWeights(wr, theta+h)
+			for i := range w {
+				var want float64
+				if theta == 0 && i == 0 || theta == 1 && i == s {
+					want = 1
+				}
+				if got := (wr[i] - wl[i]) / (2 * h); !scalar.EqualWithinAbs(got, want, 1e-6) {
+					t.Errorf("%s: unexpected derivative of weight %d at θ=%v: got %v, want %v", test.name, i, theta, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRKConvergence(t *testing.T) {
+	t.Parallel()
+	// The step size of the methods is set by MaxStep with tolerances
+	// large enough not to limit it further, and the error of the
+	// solution and of the dense output is measured for halved step sizes.
+	test := testProblems[4]
+	exact := make([]float64, 1)
+	got := make([]float64, 1)
+	for _, m := range rkTableaux {
+		method := m.method()
+		var prevEnd, prevDense float64
+		for k, h := range []float64{0.4, 0.2, 0.1} {
+			settings := &Settings{AbsTol: 1, RelTol: 1, InitialStep: h, MaxStep: h, Dense: true}
+			res, err := Solve(test.p, test.t0, test.t1, test.y0, settings, method)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", m.name, err)
+			}
+			test.exact(exact, test.t1)
+			errEnd := math.Abs(res.Y[0] - exact[0])
+			var errDense float64
+			for i := 0; i <= 1000; i++ {
+				s := test.t0 + (test.t1-test.t0)*float64(i)/1000
+				res.Solution.At(got, s)
+				test.exact(exact, s)
+				errDense = math.Max(errDense, math.Abs(got[0]-exact[0]))
+			}
+			if k > 0 {
+				// The global error of the solution is of order p
+				// and the local error of the dense output is of
+				// order q+1. Allow a margin of one half in the
+				// observed orders.
+				if rate := math.Log2(prevEnd / errEnd); errEnd > 1e-12 && rate < float64(m.order)-0.5 {
+					t.Errorf("%s: observed order of the solution too low at h=%v: %v", m.name, h, rate)
+				}
+				if rate := math.Log2(prevDense / errDense); rate < float64(m.denseOrder+1)-0.5 {
+					t.Errorf("%s: observed order of the dense output too low at h=%v: %v", m.name, h, rate)
+				}
+			}
+			prevEnd, prevDense = errEnd, errDense
+		}
+	}
+}
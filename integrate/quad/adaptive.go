@@ -0,0 +1,720 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	eps   = 0x1p-52   // Machine epsilon.
+	uflow = 0x1p-1022 // Smallest positive normal number.
+)
+
+// Status is the termination status of an adaptive integration.
+type Status int
+
+const (
+	// Success indicates that the requested accuracy has been achieved.
+	Success Status = iota
+	// SubdivisionLimit indicates that the maximum number of subintervals
+	// has been reached before achieving the requested accuracy.
+	SubdivisionLimit
+	// RoundoffError indicates that roundoff error prevents achieving the
+	// requested accuracy.
+	RoundoffError
+	// BadIntegrand indicates extremely bad behavior of the integrand, such
+	// as a non-integrable singularity, at some points of the integration
+	// interval.
+	BadIntegrand
+	// NoConvergence indicates that the extrapolation of the estimates of
+	// the integral does not converge due to roundoff error.
+	NoConvergence
+	// Divergent indicates that the integral is probably divergent or
+	// converges too slowly.
+	Divergent
+)
+
+func (s Status) String() string {
+	switch s {
+	case Success:
+		return "Success"
+	case SubdivisionLimit:
+		return "SubdivisionLimit"
+	case RoundoffError:
+		return "RoundoffError"
+	case BadIntegrand:
+		return "BadIntegrand"
+	case NoConvergence:
+		return "NoConvergence"
+	case Divergent:
+		return "Divergent"
+	}
+	return "Status(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Settings holds the parameters of the adaptive integration routines. A nil
+// *Settings is equivalent to the zero value.
+type Settings struct {
+	// AbsTol and RelTol are the requested absolute and relative accuracy.
+	// The integration stops when the estimate of the absolute error is at
+	// most max(AbsTol, RelTol·|I|), where I is the estimate of the
+	// integral. If both are zero, default values of 1e-10 are used for
+	// both. If AbsTol is zero, RelTol is increased to at least 50ε. AbsTol
+	// and RelTol must not be negative.
+	AbsTol, RelTol float64
+
+	// Limit is the maximum number of subintervals. If Limit is zero, a
+	// default value of 1000 is used. Limit must not be negative.
+	Limit int
+
+	// Rule is the Gauss–Kronrod pair used by Adaptive on every
+	// subinterval. If Rule is zero, GaussKronrod21 is used.
+	Rule GaussKronrod
+}
+
+// Adaptive approximates the integral of f from min to max by globally
+// adaptive bisection of the subinterval with the largest error estimate,
+// estimating the integral over each subinterval with the Gauss–Kronrod pair
+// settings.Rule. It returns the estimate of the integral, an estimate of its
+// absolute error and the termination status. Adaptive is equivalent to the
+// QAG routine of QUADPACK and is suitable for integrands without
+// singularities.
+//
+// Infinite bounds are mapped to [0, 1] by the transformation
+// x = a ± (1-t)/t, as in AdaptiveSingular.
+//
+// min must be less than or equal to max, otherwise Adaptive will panic.
+//
+// Reference:
+//
+//	Piessens, R., de Doncker-Kapenga, E., Überhuber, C. W., Kahaner, D. K.
+//	(1983). QUADPACK: A Subroutine Package for Automatic Integration.
+//	Springer.
+func Adaptive(f func(float64) float64, min, max float64, settings *Settings) (v, abserr float64, status Status) {
+	if min > max {
+		panic("quad: min > max")
+	}
+	if min == max {
+		return 0, 0, Success
+	}
+	rule := GaussKronrod21
+	if settings != nil && settings.Rule != 0 {
+		rule = settings.Rule
+	}
+	gk := rule.rule()
+	f, min, max = infiniteTransform(f, min, max)
+	ad := newAdaptive(settings, func(a, b float64, _ int) (result, abserr, resabs, resasc float64) {
+		return gk.integrate(f, a, b)
+	})
+	return ad.bisect(min, max, nil, false)
+}
+
+// AdaptiveSingular approximates the integral of f from min to max by globally
+// adaptive bisection with the 21-point Gauss–Kronrod pair, accelerating the
+// convergence of the sequence of estimates by Wynn's epsilon algorithm. It
+// returns the estimate of the integral, an estimate of its absolute error and
+// the termination status. AdaptiveSingular is equivalent to the QAGS routine
+// of QUADPACK and handles integrands with integrable singularities at the
+// ends of the interval, or at points inside it that the bisection reaches
+// quickly.
+//
+// If min or max is infinite, the integral is mapped to [0, 1] by the
+// transformation x = a + (1-t)/t, x = b - (1-t)/t or x = ±(1-t)/t and is
+// computed with the 15-point Gauss–Kronrod pair, which is equivalent to the
+// QAGI routine of QUADPACK.
+//
+// min must be less than or equal to max, otherwise AdaptiveSingular will
+// panic.
+func AdaptiveSingular(f func(float64) float64, min, max float64, settings *Settings) (v, abserr float64, status Status) {
+	if min > max {
+		panic("quad: min > max")
+	}
+	if min == max {
+		return 0, 0, Success
+	}
+	gk := &gk21
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		gk = &gk15
+	}
+	f, min, max = infiniteTransform(f, min, max)
+	ad := newAdaptive(settings, func(a, b float64, _ int) (result, abserr, resabs, resasc float64) {
+		return gk.integrate(f, a, b)
+	})
+	return ad.extrapolate(min, max, 0)
+}
+
+// infiniteTransform returns the integrand and the bounds of the integral of
+// f from min to max after mapping infinite bounds to [0, 1].
+func infiniteTransform(f func(float64) float64, min, max float64) (func(float64) float64, float64, float64) {
+	switch {
+	case math.IsInf(min, -1) && math.IsInf(max, 1):
+		return func(t float64) float64 {
+			x := (1 - t) / t
+			return (f(x) + f(-x)) / (t * t)
+		}, 0, 1
+	case math.IsInf(max, 1):
+		return func(t float64) float64 {
+			return f(min+(1-t)/t) / (t * t)
+		}, 0, 1
+	case math.IsInf(min, -1):
+		return func(t float64) float64 {
+			return f(max-(1-t)/t) / (t * t)
+		}, 0, 1
+	}
+	return f, min, max
+}
+
+// adaptive holds the state of an adaptive integration.
+type adaptive struct {
+	// rule returns the estimate of the integral over [a, b], the estimate
+	// of its absolute error and the estimates of the integrals of |f| and
+	// |f - I/(b-a)|. level is the number of bisections leading to [a, b].
+	// An error estimate equal to resasc is considered unreliable for the
+	// detection of roundoff.
+	rule func(a, b float64, level int) (result, abserr, resabs, resasc float64)
+
+	absTol, relTol float64
+	limit          int
+
+	w workspace
+}
+
+func newAdaptive(settings *Settings, rule func(a, b float64, level int) (result, abserr, resabs, resasc float64)) *adaptive {
+	if settings == nil {
+		settings = &Settings{}
+	}
+	absTol, relTol := settings.AbsTol, settings.RelTol
+	if absTol < 0 || relTol < 0 {
+		panic("quad: negative tolerance")
+	}
+	if absTol == 0 && relTol == 0 {
+		absTol, relTol = 1e-10, 1e-10
+	}
+	if absTol == 0 {
+		relTol = math.Max(relTol, 50*eps)
+	}
+	limit := settings.Limit
+	if limit < 0 {
+		panic("quad: negative subdivision limit")
+	}
+	if limit == 0 {
+		limit = 1000
+	}
+	return &adaptive{
+		rule:   rule,
+		absTol: absTol,
+		relTol: relTol,
+		limit:  limit,
+	}
+}
+
+func (ad *adaptive) tolerance(v float64) float64 {
+	return math.Max(ad.absTol, ad.relTol*math.Abs(v))
+}
+
+// bisect integrates over [a, b] by repeatedly bisecting the subinterval with
+// the largest error estimate. If split is not nil, subintervals are split at
+// the point it returns instead of their midpoint. If principal is true, the
+// initial estimate is only accepted with an additional margin of 1% relative
+// error.
+func (ad *adaptive) bisect(a, b float64, split func(a, b float64) float64, principal bool) (v, abserr float64, status Status) {
+	result0, abserr0, resabs0, resasc0 := ad.rule(a, b, 0)
+	ad.w.init(a, b, result0, abserr0, ad.limit)
+	tol := ad.tolerance(result0)
+	if principal {
+		if abserr0 < tol && abserr0 < 0.01*math.Abs(result0) {
+			return result0, abserr0, Success
+		}
+	} else {
+		if abserr0 <= 50*eps*resabs0 && abserr0 > tol {
+			return result0, abserr0, RoundoffError
+		}
+		if (abserr0 <= tol && abserr0 != resasc0) || abserr0 == 0 {
+			return result0, abserr0, Success
+		}
+	}
+	if ad.limit == 1 {
+		return result0, abserr0, SubdivisionLimit
+	}
+
+	area, errsum := result0, abserr0
+	var roundoff1, roundoff2 int
+	for iter := 1; iter < ad.limit && status == Success && errsum > tol; iter++ {
+		iv := ad.w.intervals[ad.w.next()]
+		a1, b2 := iv.a, iv.b
+		b1 := 0.5 * (a1 + b2)
+		if split != nil {
+			b1 = split(a1, b2)
+		}
+		a2 := b1
+		area1, error1, _, resasc1 := ad.rule(a1, b1, iv.level+1)
+		area2, error2, _, resasc2 := ad.rule(a2, b2, iv.level+1)
+		area12 := area1 + area2
+		error12 := error1 + error2
+		errsum += error12 - iv.err
+		area += area12 - iv.result
+		if resasc1 != error1 && resasc2 != error2 {
+			if math.Abs(iv.result-area12) <= 1e-5*math.Abs(area12) && error12 >= 0.99*iv.err {
+				roundoff1++
+			}
+			if iter >= 10 && error12 > iv.err {
+				roundoff2++
+			}
+		}
+		tol = ad.tolerance(area)
+		if errsum > tol {
+			if roundoff1 >= 6 || roundoff2 >= 20 {
+				status = RoundoffError
+			}
+			if tooSmall(a1, a2, b2) {
+				status = BadIntegrand
+			}
+		}
+		ad.w.update(a1, b1, area1, error1, a2, b2, area2, error2)
+	}
+	v = ad.w.sum()
+	if errsum <= tol {
+		return v, errsum, Success
+	}
+	if status == Success {
+		status = SubdivisionLimit
+	}
+	return v, errsum, status
+}
+
+// extrapolate integrates over [a, b] by repeatedly bisecting the subinterval
+// with the largest error estimate and extrapolating the sequence of the
+// estimates of the integral with the epsilon algorithm. If omega is not zero,
+// extrapolation only starts once the subintervals are small compared to the
+// period 2π/|omega| of an oscillatory weight.
+func (ad *adaptive) extrapolate(a, b, omega float64) (v, abserr float64, status Status) {
+	result0, abserr0, resabs0, resasc0 := ad.rule(a, b, 0)
+	ad.w.init(a, b, result0, abserr0, ad.limit)
+	tol := ad.tolerance(result0)
+	if abserr0 <= 100*eps*resabs0 && abserr0 > tol {
+		return result0, abserr0, RoundoffError
+	}
+	if (abserr0 <= tol && abserr0 != resasc0) || abserr0 == 0 {
+		return result0, abserr0, Success
+	}
+	if ad.limit == 1 {
+		return result0, abserr0, SubdivisionLimit
+	}
+
+	omega = math.Abs(omega)
+	var table epsilonTable
+	// extall indicates that all subintervals are small enough for the
+	// extrapolation.
+	extall := 0.5*(b-a)*omega <= 2
+	if extall {
+		table.append(result0)
+	}
+	area, errsum := result0, abserr0
+	resExt, errExt := result0, math.MaxFloat64
+	positive := math.Abs(result0) >= (1-50*eps)*resabs0
+
+	var (
+		// errLarge is the sum of the error estimates of the subintervals
+		// that are larger than the smallest subintervals.
+		errLarge float64
+		ertest   float64
+		correc   float64
+
+		ktmin       int
+		extrap      bool // Bisect the large subintervals before extrapolating.
+		noExtrap    bool
+		roundoffExt bool // Roundoff error in the extrapolation.
+
+		roundoff1, roundoff2, roundoff3 int
+	)
+	for iter := 1; iter < ad.limit; {
+		iv := ad.w.intervals[ad.w.next()]
+		level := iv.level + 1
+		a1, b2 := iv.a, iv.b
+		b1 := 0.5 * (a1 + b2)
+		a2 := b1
+		iter++
+		area1, error1, _, resasc1 := ad.rule(a1, b1, level)
+		area2, error2, _, resasc2 := ad.rule(a2, b2, level)
+		area12 := area1 + area2
+		error12 := error1 + error2
+		errsum += error12 - iv.err
+		area += area12 - iv.result
+		tol = ad.tolerance(area)
+		if resasc1 != error1 && resasc2 != error2 {
+			if math.Abs(iv.result-area12) <= 1e-5*math.Abs(area12) && error12 >= 0.99*iv.err {
+				if extrap {
+					roundoff2++
+				} else {
+					roundoff1++
+				}
+			}
+			if iter > 10 && error12 > iv.err {
+				roundoff3++
+			}
+		}
+		if roundoff1+roundoff2 >= 10 || roundoff3 >= 20 {
+			status = RoundoffError
+		}
+		if roundoff2 >= 5 {
+			roundoffExt = true
+		}
+		if tooSmall(a1, a2, b2) {
+			status = BadIntegrand
+		}
+		ad.w.update(a1, b1, area1, error1, a2, b2, area2, error2)
+
+		if errsum <= tol {
+			return ad.w.sum(), errsum, Success
+		}
+		if status != Success {
+			break
+		}
+		if iter >= ad.limit-1 {
+			status = SubdivisionLimit
+			break
+		}
+		if iter == 2 && extall {
+			errLarge = errsum
+			ertest = tol
+			table.append(area)
+			continue
+		}
+		if noExtrap {
+			continue
+		}
+		if extall {
+			errLarge -= iv.err
+			if level < ad.w.maxLevel {
+				errLarge += error12
+			}
+		}
+		if !extrap {
+			// Continue bisecting while the next subinterval is not one of
+			// the smallest.
+			if ad.w.largeInterval() {
+				continue
+			}
+			if !extall {
+				next := ad.w.intervals[ad.w.next()]
+				if 0.25*(next.b-next.a)*omega > 2 {
+					continue
+				}
+				extall = true
+				errLarge = errsum
+				ertest = tol
+				continue
+			}
+			extrap = true
+			ad.w.nrmax = 1
+		}
+		if !roundoffExt && errLarge > ertest {
+			// Bisect the large subintervals first.
+			if ad.w.increaseNrmax() {
+				continue
+			}
+		}
+
+		table.append(area)
+		if table.n < 3 {
+			ad.w.resetNrmax()
+			extrap = false
+			errLarge = errsum
+			continue
+		}
+		reseps, abseps := table.extrapolate()
+		ktmin++
+		if ktmin > 5 && errExt < 1e-3*errsum {
+			status = NoConvergence
+		}
+		if abseps < errExt {
+			ktmin = 0
+			errExt = abseps
+			resExt = reseps
+			correc = errLarge
+			ertest = ad.tolerance(reseps)
+			if errExt <= ertest {
+				break
+			}
+		}
+		if table.n == 1 {
+			noExtrap = true
+		}
+		if status == NoConvergence {
+			break
+		}
+		// Continue with the subinterval with the largest error.
+		ad.w.resetNrmax()
+		extrap = false
+		errLarge = errsum
+	}
+
+	if errExt == math.MaxFloat64 {
+		return ad.w.sum(), errsum, status
+	}
+	if status != Success || roundoffExt {
+		if roundoffExt {
+			errExt += correc
+		}
+		if status == Success {
+			status = RoundoffError
+		}
+		switch {
+		case resExt != 0 && area != 0:
+			if errExt/math.Abs(resExt) > errsum/math.Abs(area) {
+				return ad.w.sum(), errsum, status
+			}
+		case errExt > errsum:
+			return ad.w.sum(), errsum, status
+		case area == 0:
+			return resExt, errExt, status
+		}
+	}
+	// Test for divergence.
+	if !positive && math.Max(math.Abs(resExt), math.Abs(area)) < 0.01*resabs0 {
+		return resExt, errExt, status
+	}
+	ratio := resExt / area
+	if ratio < 0.01 || ratio > 100 || errsum > math.Abs(area) {
+		status = Divergent
+	}
+	return resExt, errExt, status
+}
+
+// tooSmall returns whether the subintervals [a1, a2] and [a2, b2] are too
+// small to be bisected further in floating point arithmetic.
+func tooSmall(a1, a2, b2 float64) bool {
+	tmp := (1 + 100*eps) * (math.Abs(a2) + 1000*uflow)
+	return math.Abs(a1) <= tmp && math.Abs(b2) <= tmp
+}
+
+// interval is a subinterval of an adaptive integration.
+type interval struct {
+	a, b   float64
+	result float64 // Estimate of the integral over [a, b].
+	err    float64 // Estimate of the absolute error of result.
+	level  int     // Number of bisections leading to [a, b].
+}
+
+// workspace holds the subintervals of an adaptive integration.
+type workspace struct {
+	intervals []interval
+	// order holds the indices of intervals in decreasing order of their
+	// error estimate.
+	order []int
+	// nrmax is the position in order of the subinterval to bisect next.
+	nrmax    int
+	maxLevel int
+	limit    int
+}
+
+func (w *workspace) init(a, b, result, err float64, limit int) {
+	w.intervals = append(w.intervals[:0], interval{a: a, b: b, result: result, err: err})
+	w.order = append(w.order[:0], 0)
+	w.nrmax = 0
+	w.maxLevel = 0
+	w.limit = limit
+}
+
+// next returns the index of the subinterval to bisect next.
+func (w *workspace) next() int {
+	return w.order[w.nrmax]
+}
+
+// update replaces the subinterval returned by next with the subintervals
+// [a1, b1] and [a2, b2].
+func (w *workspace) update(a1, b1, area1, error1, a2, b2, area2, error2 float64) {
+	i := w.next()
+	level := w.intervals[i].level + 1
+	w.maxLevel = max(w.maxLevel, level)
+	iv1 := interval{a: a1, b: b1, result: area1, err: error1, level: level}
+	iv2 := interval{a: a2, b: b2, result: area2, err: error2, level: level}
+	if error2 > error1 {
+		iv1, iv2 = iv2, iv1
+	}
+	// The subinterval with the larger error estimate takes the place of
+	// the bisected one.
+	w.intervals[i] = iv1
+	w.intervals = append(w.intervals, iv2)
+
+	w.order = append(w.order[:w.nrmax], w.order[w.nrmax+1:]...)
+	w.insert(i)
+	w.insert(len(w.intervals) - 1)
+	// A subdivision that increased the error estimate moves the subinterval
+	// in front of the skipped subintervals.
+	for p := 0; p < w.nrmax; p++ {
+		if w.order[p] == i {
+			w.nrmax = p
+			break
+		}
+	}
+}
+
+// insert inserts the index i into order after the subintervals with an error
+// estimate at least as large.
+func (w *workspace) insert(i int) {
+	e := w.intervals[i].err
+	p := sort.Search(len(w.order), func(k int) bool {
+		return w.intervals[w.order[k]].err < e
+	})
+	w.order = append(w.order, 0)
+	copy(w.order[p+1:], w.order[p:])
+	w.order[p] = i
+}
+
+// largeInterval returns whether the subinterval to bisect next is larger
+// than the smallest subintervals.
+func (w *workspace) largeInterval() bool {
+	return w.intervals[w.next()].level < w.maxLevel
+}
+
+// increaseNrmax advances nrmax to the subinterval with the largest error
+// estimate among those larger than the smallest subintervals. It returns
+// false if there is no such subinterval that can be bisected within the
+// subdivision limit.
+func (w *workspace) increaseNrmax() bool {
+	last := len(w.intervals) - 1
+	jupbnd := last
+	if last > 1+w.limit/2 {
+		jupbnd = w.limit + 1 - last
+	}
+	for k := w.nrmax; k <= jupbnd && w.nrmax < len(w.order); k++ {
+		if w.intervals[w.order[w.nrmax]].level < w.maxLevel {
+			return true
+		}
+		w.nrmax++
+	}
+	return false
+}
+
+func (w *workspace) resetNrmax() {
+	w.nrmax = 0
+}
+
+// sum returns the sum of the estimates of the integral over the subintervals.
+func (w *workspace) sum() float64 {
+	var s float64
+	for _, iv := range w.intervals {
+		s += iv.result
+	}
+	return s
+}
+
+// epsilonTable holds the table of Wynn's epsilon algorithm for the
+// extrapolation of the sequence of estimates of the integral.
+type epsilonTable struct {
+	n      int
+	rlist2 [52]float64
+	nres   int
+	res3la [3]float64 // Last three extrapolated values.
+}
+
+func (t *epsilonTable) append(v float64) {
+	if t.n < len(t.rlist2) {
+		t.rlist2[t.n] = v
+		t.n++
+	}
+}
+
+// extrapolate returns the extrapolated limit of the sequence in the table
+// and an estimate of its absolute error, and updates the table.
+//
+// Reference:
+//
+//	Wynn, P. (1956). On a device for computing the e_m(S_n)
+//	transformation. Mathematical Tables and Other Aids to Computation,
+//	10(54), 91-96.
+func (t *epsilonTable) extrapolate() (result, abserr float64) {
+	const limexp = 49
+
+	epstab := t.rlist2[:]
+	n := t.n - 1
+	current := epstab[n]
+	result = current
+	abserr = math.MaxFloat64
+	if n < 2 {
+		return result, math.Max(abserr, 5*eps*math.Abs(current))
+	}
+
+	newelm := n / 2
+	nOrig := n
+	nFinal := n
+	epstab[n+2] = epstab[n]
+	epstab[n] = math.MaxFloat64
+	for i := 0; i < newelm; i++ {
+		res := epstab[n-2*i+2]
+		e0 := epstab[n-2*i-2]
+		e1 := epstab[n-2*i-1]
+		e2 := res
+		e1abs := math.Abs(e1)
+		delta2 := e2 - e1
+		err2 := math.Abs(delta2)
+		tol2 := math.Max(math.Abs(e2), e1abs) * eps
+		delta3 := e1 - e0
+		err3 := math.Abs(delta3)
+		tol3 := math.Max(e1abs, math.Abs(e0)) * eps
+		if err2 < tol2 && err3 < tol3 {
+			// e0, e1 and e2 are equal to within machine accuracy and
+			// convergence is assumed.
+			return res, math.Max(err2+err3, 5*eps*math.Abs(res))
+		}
+		e3 := epstab[n-2*i]
+		epstab[n-2*i] = e1
+		delta1 := e1 - e3
+		err1 := math.Abs(delta1)
+		tol1 := math.Max(e1abs, math.Abs(e3)) * eps
+		if err1 < tol1 || err2 < tol2 || err3 < tol3 {
+			// Two elements are very close to each other, omit a part
+			// of the table.
+			nFinal = 2 * i
+			break
+		}
+		ss := (1/delta1 + 1/delta2) - 1/delta3
+		if math.Abs(ss*e1) <= 1e-4 {
+			// Irregular behavior in the table, omit a part of it.
+			nFinal = 2 * i
+			break
+		}
+		res = e1 + 1/ss
+		epstab[n-2*i] = res
+		if err := err2 + math.Abs(res-e2) + err3; err <= abserr {
+			abserr = err
+			result = res
+		}
+	}
+
+	// Shift the table.
+	if nFinal == limexp {
+		nFinal = 2 * (limexp / 2)
+	}
+	if nOrig%2 == 1 {
+		for i := 0; i <= newelm; i++ {
+			epstab[1+2*i] = epstab[2*i+3]
+		}
+	} else {
+		for i := 0; i <= newelm; i++ {
+			epstab[2*i] = epstab[2*i+2]
+		}
+	}
+	if nOrig != nFinal {
+		for i := 0; i <= nFinal; i++ {
+			epstab[i] = epstab[nOrig-nFinal+i]
+		}
+	}
+	t.n = nFinal + 1
+
+	if t.nres < 3 {
+		t.res3la[t.nres] = result
+		abserr = math.MaxFloat64
+	} else {
+		abserr = math.Abs(result-t.res3la[2]) + math.Abs(result-t.res3la[1]) + math.Abs(result-t.res3la[0])
+		t.res3la[0], t.res3la[1], t.res3la[2] = t.res3la[1], t.res3la[2], result
+	}
+	t.nres++
+	return result, math.Max(abserr, 5*eps*math.Abs(result))
+}
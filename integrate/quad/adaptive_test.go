@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+var adaptiveTests = []struct {
+	name     string
+	f        func(float64) float64
+	min, max float64
+	want     float64
+	// singular indicates an integrand for which only AdaptiveSingular is
+	// expected to achieve the requested accuracy.
+	singular bool
+}{
+	{
+		name: "smooth",
+		f:    func(x float64) float64 { return math.Pow(x, 2.6) * math.Log(1/x) },
+		min:  0,
+		max:  1,
+		want: 1 / (3.6 * 3.6),
+	},
+	{
+		name: "oscillating",
+		f:    func(x float64) float64 { return math.Cos(100 * math.Sin(x)) },
+		min:  0,
+		max:  math.Pi,
+		want: math.Pi * 0.019985850304223122, // π J_0(100).
+	},
+	{
+		name:     "endpoint singularity",
+		f:        func(x float64) float64 { return math.Pow(x, -0.9) * math.Log(1/x) },
+		min:      0,
+		max:      1,
+		want:     100,
+		singular: true,
+	},
+	{
+		name:     "interior singularity",
+		f:        func(x float64) float64 { return 1 / math.Sqrt(math.Abs(x-0.3)) },
+		min:      0,
+		max:      1,
+		want:     2*math.Sqrt(0.3) + 2*math.Sqrt(0.7),
+		singular: true,
+	},
+	{
+		name: "semi-infinite",
+		f:    func(x float64) float64 { return math.Log(x) / (1 + 100*x*x) },
+		min:  0,
+		max:  math.Inf(1),
+		want: -math.Pi * math.Log(10) / 20,
+		// The transformed integrand has a singularity at t = 1.
+		singular: true,
+	},
+	{
+		name: "lower infinite",
+		f:    func(x float64) float64 { return math.Exp(x) },
+		min:  math.Inf(-1),
+		max:  -5,
+		want: math.Exp(-5),
+	},
+	{
+		name: "infinite",
+		f:    func(x float64) float64 { return math.Exp(-x * x) },
+		min:  math.Inf(-1),
+		max:  math.Inf(1),
+		want: math.Sqrt(math.Pi),
+	},
+	{
+		name: "empty",
+		f:    math.Exp,
+		min:  2,
+		max:  2,
+		want: 0,
+	},
+}
+
+func TestAdaptive(t *testing.T) {
+	t.Parallel()
+	for _, rule := range []GaussKronrod{0, GaussKronrod15, GaussKronrod21, GaussKronrod61} {
+		for _, test := range adaptiveTests {
+			settings := &Settings{AbsTol: 1e-12, RelTol: 1e-10, Rule: rule}
+			got, abserr, status := Adaptive(test.f, test.min, test.max, settings)
+			if test.singular {
+				if math.IsNaN(got) || math.IsNaN(abserr) {
+					t.Errorf("%s, rule %d: unexpected NaN result", test.name, rule)
+				}
+				continue
+			}
+			checkAdaptive(t, "Adaptive", test.name, got, abserr, status, test.want, 1e-10)
+		}
+	}
+}
+
+func TestAdaptiveSingular(t *testing.T) {
+	t.Parallel()
+	for _, test := range adaptiveTests {
+		settings := &Settings{AbsTol: 1e-12, RelTol: 1e-10}
+		got, abserr, status := AdaptiveSingular(test.f, test.min, test.max, settings)
+		checkAdaptive(t, "AdaptiveSingular", test.name, got, abserr, status, test.want, 1e-10)
+	}
+}
+
+func checkAdaptive(t *testing.T, method, name string, got, abserr float64, status Status, want, tol float64) {
+	t.Helper()
+	if status != Success {
+		t.Errorf("%s, %s: unexpected status: got %v, want %v", method, name, status, Success)
+	}
+	if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+		t.Errorf("%s, %s: unexpected result: got %v, want %v", method, name, got, want)
+	}
+	// The error estimates are pessimistic, allow for a small excess.
+	if err := math.Abs(got - want); err > 10*abserr+1e-14 {
+		t.Errorf("%s, %s: error estimate too small: got %v, actual error %v", method, name, abserr, err)
+	}
+}
+
+func TestAdaptiveFailure(t *testing.T) {
+	t.Parallel()
+	// A divergent integral.
+	_, _, status := AdaptiveSingular(func(x float64) float64 { return 1 / x }, 0, 1, nil)
+	if status == Success {
+		t.Errorf("unexpected success for a divergent integral")
+	}
+	_, _, status = Adaptive(func(x float64) float64 { return 1 / x }, 0, 1, nil)
+	if status == Success {
+		t.Errorf("unexpected success for a divergent integral")
+	}
+
+	// Subdivision limit.
+	f := func(x float64) float64 { return x * math.Sin(200*x) }
+	_, _, status = Adaptive(f, 0, math.Pi, &Settings{Limit: 5})
+	if status != SubdivisionLimit {
+		t.Errorf("unexpected status: got %v, want %v", status, SubdivisionLimit)
+	}
+	_, _, status = AdaptiveSingular(f, 0, math.Pi, &Settings{Limit: 5})
+	if status != SubdivisionLimit {
+		t.Errorf("unexpected status: got %v, want %v", status, SubdivisionLimit)
+	}
+	got, _, status := Adaptive(f, 0, math.Pi, nil)
+	if want := -math.Pi / 200; status != Success || !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+		t.Errorf("unexpected result with the default limit: got %v (%v), want %v", got, status, want)
+	}
+}
+
+func TestEpsilonTable(t *testing.T) {
+	t.Parallel()
+	// The partial sums of the alternating series for log(2) converge
+	// slowly, the epsilon algorithm accelerates them.
+	var table epsilonTable
+	var sum float64
+	var result, abserr float64
+	for k := 1; k <= 20; k++ {
+		sum += math.Pow(-1, float64(k+1)) / float64(k)
+		table.append(sum)
+		if table.n >= 3 {
+			result, abserr = table.extrapolate()
+		}
+	}
+	if math.Abs(sum-math.Ln2) < 1e-2 {
+		t.Fatalf("partial sum converged unexpectedly fast")
+	}
+	if !scalar.EqualWithinAbs(result, math.Ln2, 1e-12) {
+		t.Errorf("unexpected extrapolated limit: got %v, want %v", result, math.Ln2)
+	}
+	if abserr > 1e-10 {
+		t.Errorf("unexpected error estimate: got %v", abserr)
+	}
+}
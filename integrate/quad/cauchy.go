@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// CauchyPrincipalValue approximates the Cauchy principal value of the
+// integral of f(x)/(x-c) from min to max,
+//
+//	lim_{ε→0} (∫_min^{c-ε} + ∫_{c+ε}^max) f(x)/(x-c) dx,
+//
+// by globally adaptive bisection. It returns the estimate of the integral, an
+// estimate of its absolute error and the termination status.
+// CauchyPrincipalValue is equivalent to the QAWC routine of QUADPACK.
+//
+// Subintervals are never split at c. On subintervals close to c, f is
+// interpolated by Chebyshev polynomials of degrees 12 and 24 whose products
+// with 1/(x-c) are integrated exactly using modified Chebyshev moments, and f
+// is evaluated at the ends of these subintervals. The remaining subintervals
+// are integrated with the 15-point Gauss–Kronrod pair.
+//
+// min and max must be finite with min < c < max, otherwise
+// CauchyPrincipalValue will panic.
+func CauchyPrincipalValue(f func(float64) float64, min, max, c float64, settings *Settings) (v, abserr float64, status Status) {
+	if min > max {
+		panic("quad: min > max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("quad: infinite bound")
+	}
+	if !(min < c && c < max) {
+		panic("quad: singularity not inside the interval")
+	}
+	weighted := func(x float64) float64 {
+		return f(x) / (x - c)
+	}
+	rule := func(a, b float64, _ int) (result, abserr, resabs, resasc float64) {
+		// cc is the location of c in the subinterval mapped to [-1, 1].
+		cc := (2*c - b - a) / (b - a)
+		if math.Abs(cc) > 1.1 {
+			return gk15.integrate(weighted, a, b)
+		}
+		var cheb12 [13]float64
+		var cheb24 [25]float64
+		chebyshev(&cheb12, &cheb24, f, a, b)
+		m := cauchyMoments(cc)
+		var res12, res24 float64
+		for k, v := range cheb12 {
+			res12 += v * m[k]
+		}
+		for k, v := range cheb24 {
+			res24 += v * m[k]
+			resabs += math.Abs(v * m[k])
+		}
+		abserr = math.Abs(res24 - res12)
+		// The error estimate is not reliable for the detection of
+		// roundoff.
+		return res24, abserr, resabs, abserr
+	}
+	split := func(a, b float64) float64 {
+		mid := 0.5 * (a + b)
+		switch {
+		case c > a && c <= mid:
+			return 0.5 * (c + b)
+		case c > mid && c < b:
+			return 0.5 * (a + c)
+		}
+		return mid
+	}
+	ad := newAdaptive(settings, rule)
+	return ad.bisect(min, max, split, true)
+}
+
+// cauchyMoments returns the modified Chebyshev moments
+//
+//	PV ∫_{-1}^{1} T_k(t)/(t-cc) dt
+//
+// for k = 0, ..., 24.
+func cauchyMoments(cc float64) [25]float64 {
+	var m [25]float64
+	a0 := math.Log(math.Abs((1 - cc) / (1 + cc)))
+	a1 := 2 + a0*cc
+	m[0], m[1] = a0, a1
+	for k := 2; k < 25; k++ {
+		a2 := 2*cc*a1 - a0
+		if k%2 == 1 {
+			km1 := float64(k - 1)
+			a2 -= 4 / (km1*km1 - 1)
+		}
+		m[k] = a2
+		a0, a1 = a1, a2
+	}
+	return m
+}
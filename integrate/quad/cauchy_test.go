@@ -0,0 +1,102 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCauchyPrincipalValue(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		f        func(float64) float64
+		min, max float64
+		c        float64
+	}{
+		{
+			name: "rational",
+			f:    func(x float64) float64 { return 1 / (5*x*x*x + 6) },
+			min:  -1,
+			max:  5,
+			c:    0,
+		},
+		{
+			name: "constant",
+			f:    func(float64) float64 { return 1 },
+			min:  0,
+			max:  5,
+			c:    2,
+		},
+		{
+			name: "exponential",
+			f:    math.Exp,
+			min:  -1,
+			max:  1,
+			c:    0.9,
+		},
+		{
+			name: "oscillating",
+			f:    func(x float64) float64 { return math.Cos(20 * x) },
+			min:  0,
+			max:  3,
+			c:    1.234,
+		},
+	} {
+		// Compute the principal value by subtracting the singularity,
+		//  PV ∫ f(x)/(x-c) dx = ∫ (f(x)-f(c))/(x-c) dx + f(c) log((max-c)/(c-min)).
+		fc := test.f(test.c)
+		g := func(x float64) float64 {
+			if x == test.c {
+				return 0
+			}
+			return (test.f(x) - fc) / (x - test.c)
+		}
+		regular, _, status := Adaptive(g, test.min, test.max, &Settings{AbsTol: 1e-14, RelTol: 1e-13})
+		if status != Success {
+			t.Fatalf("%s: unexpected status computing the reference value: %v", test.name, status)
+		}
+		want := regular + fc*math.Log((test.max-test.c)/(test.c-test.min))
+
+		settings := &Settings{AbsTol: 1e-12, RelTol: 1e-10}
+		got, abserr, status := CauchyPrincipalValue(test.f, test.min, test.max, test.c, settings)
+		checkAdaptive(t, "CauchyPrincipalValue", test.name, got, abserr, status, want, 1e-10)
+	}
+}
+
+func TestCauchyMoments(t *testing.T) {
+	t.Parallel()
+	for _, cc := range []float64{-1.05, -0.5, 0, 0.3, 0.99} {
+		m := cauchyMoments(cc)
+		for k := range m {
+			// PV ∫ T_k(t)/(t-cc) dt = ∫ (T_k(t)-T_k(cc))/(t-cc) dt + T_k(cc) log|(1-cc)/(1+cc)|.
+			tk := func(t float64) float64 {
+				return math.Cos(float64(k) * math.Acos(t))
+			}
+			var tc float64
+			if math.Abs(cc) <= 1 {
+				tc = tk(cc)
+			} else {
+				tc = math.Cosh(float64(k) * math.Acosh(-cc))
+				if k%2 == 1 {
+					tc = -tc
+				}
+			}
+			var want float64
+			const n = 40
+			for i := 0; i < n; i++ {
+				x, w := Legendre{}.FixedLocationSingle(n, i, -1, 1)
+				want += w * (tk(x) - tc) / (x - cc)
+			}
+			want += tc * math.Log(math.Abs((1-cc)/(1+cc)))
+			// The reference value suffers from cancellation when
+			// T_k(cc) is large outside [-1, 1].
+			if math.Abs(m[k]-want) > 1e-12*math.Max(1, math.Abs(tc)) {
+				t.Errorf("cc=%v: unexpected moment %d: got %v, want %v", cc, k, m[k], want)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// ChebyshevFirst generates sample locations and weights for performing
+// quadrature with the Chebyshev weight of the first kind over finite bounds
+//
+//	int_min^max f(x) / sqrt((x-min)(max-x)) dx .
+//
+// The locations and weights are known in closed form.
+type ChebyshevFirst struct{}
+
+func (c ChebyshevFirst) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("chebyshev: slice length mismatch")
+	}
+	for i := range x {
+		x[i], weight[i] = c.FixedLocationSingle(len(x), i, min, max)
+	}
+}
+
+func (c ChebyshevFirst) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkChebyshevBounds(min, max)
+	t := -math.Cos(float64(2*k+1) * math.Pi / float64(2*n))
+	return min + (max-min)/2*(t+1), math.Pi / float64(n)
+}
+
+// ChebyshevSecond generates sample locations and weights for performing
+// quadrature with the Chebyshev weight of the second kind over finite bounds
+//
+//	int_min^max sqrt((x-min)(max-x)) f(x) dx .
+//
+// The locations and weights are known in closed form.
+type ChebyshevSecond struct{}
+
+func (c ChebyshevSecond) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("chebyshev: slice length mismatch")
+	}
+	for i := range x {
+		x[i], weight[i] = c.FixedLocationSingle(len(x), i, min, max)
+	}
+}
+
+func (c ChebyshevSecond) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkChebyshevBounds(min, max)
+	h := (max - min) / 2
+	theta := float64(k+1) * math.Pi / float64(n+1)
+	s := math.Sin(theta)
+	return min + h*(1-math.Cos(theta)), h * h * math.Pi / float64(n+1) * s * s
+}
+
+func checkChebyshevBounds(min, max float64) {
+	if min >= max {
+		panic("chebyshev: min >= max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("chebyshev: infinite bound")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestChebyshevRules(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		rule interface {
+			FixedLocationer
+			FixedLocationSingler
+		}
+		jacobi   Jacobi
+		min, max float64
+	}{
+		{name: "first kind", rule: ChebyshevFirst{}, jacobi: Jacobi{Alpha: -0.5, Beta: -0.5}, min: -1, max: 1},
+		{name: "first kind", rule: ChebyshevFirst{}, jacobi: Jacobi{Alpha: -0.5, Beta: -0.5}, min: 2, max: 7},
+		{name: "second kind", rule: ChebyshevSecond{}, jacobi: Jacobi{Alpha: 0.5, Beta: 0.5}, min: -1, max: 1},
+		{name: "second kind", rule: ChebyshevSecond{}, jacobi: Jacobi{Alpha: 0.5, Beta: 0.5}, min: -3, max: -2},
+	} {
+		for _, n := range []int{1, 2, 5, 20} {
+			x := make([]float64, n)
+			weight := make([]float64, n)
+			test.rule.FixedLocations(x, weight, test.min, test.max)
+			wantX := make([]float64, n)
+			wantWeight := make([]float64, n)
+			test.jacobi.FixedLocations(wantX, wantWeight, test.min, test.max)
+			tol := 1e-13 * (test.max - test.min)
+			if !floats.EqualApprox(x, wantX, tol) {
+				t.Errorf("%s [%v, %v] n=%d: location mismatch:\ngot  %v\nwant %v", test.name, test.min, test.max, n, x, wantX)
+			}
+			if !floats.EqualApprox(weight, wantWeight, tol) {
+				t.Errorf("%s [%v, %v] n=%d: weight mismatch:\ngot  %v\nwant %v", test.name, test.min, test.max, n, weight, wantWeight)
+			}
+			for i := range x {
+				xi, wi := test.rule.FixedLocationSingle(n, i, test.min, test.max)
+				if xi != x[i] || wi != weight[i] {
+					t.Errorf("%s n=%d: mismatch batch and single at %d", test.name, n, i)
+				}
+			}
+		}
+	}
+
+	// The integral of e^x / sqrt(1-x^2) over [-1, 1] is π I_0(1).
+	const besselI01 = 1.2660658777520082
+	got := Fixed(math.Exp, -1, 1, 20, ChebyshevFirst{}, 3)
+	if want := math.Pi * besselI01; !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected integral: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestClenshawCurtis(t *testing.T) {
+	t.Parallel()
+	// The three-point rule is Simpson's rule.
+	x := make([]float64, 3)
+	weight := make([]float64, 3)
+	ClenshawCurtis{}.FixedLocations(x, weight, 0, 2)
+	if want := []float64{0, 1, 2}; !floats.EqualApprox(x, want, 1e-15) {
+		t.Errorf("location mismatch: got %v, want %v", x, want)
+	}
+	if want := []float64{1.0 / 3, 4.0 / 3, 1.0 / 3}; !floats.EqualApprox(weight, want, 1e-15) {
+		t.Errorf("weight mismatch: got %v, want %v", weight, want)
+	}
+
+	const min, max = -2.0, 3.0
+	for n := 1; n <= 30; n++ {
+		x := make([]float64, n)
+		weight := make([]float64, n)
+		ClenshawCurtis{}.FixedLocations(x, weight, min, max)
+		for k := 0; k < n; k++ {
+			var got float64
+			for i, v := range x {
+				got += weight[i] * math.Pow(v, float64(k))
+			}
+			want := (math.Pow(max, float64(k+1)) - math.Pow(min, float64(k+1))) / float64(k+1)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("n=%d: moment %d mismatch: got %v, want %v", n, k, got, want)
+			}
+		}
+	}
+
+	for i, test := range []struct {
+		f        func(float64) float64
+		min, max float64
+		n        []int
+		tol      []float64
+		ans      float64
+	}{
+		{
+			f:   math.Exp,
+			min: -3,
+			max: 5,
+			n:   []int{5, 9, 17, 33},
+			tol: []float64{1e-1, 1e-4, 1e-12, 1e-14},
+			ans: math.Exp(5) - math.Exp(-3),
+		},
+		{
+			f:   func(x float64) float64 { return 1 / (1 + 16*x*x) },
+			min: -1,
+			max: 1,
+			n:   []int{17, 65, 129},
+			tol: []float64{1e-2, 1e-8, 1e-14},
+			ans: math.Atan(4) / 2,
+		},
+	} {
+		for j, n := range test.n {
+			ans := Fixed(test.f, test.min, test.max, n, ClenshawCurtis{}, 0)
+			if !scalar.EqualWithinAbsOrRel(ans, test.ans, test.tol[j], test.tol[j]) {
+				t.Errorf("Mismatch. Case = %d, n = %d. Want %v, got %v", i, n, test.ans, ans)
+			}
+		}
+	}
+}
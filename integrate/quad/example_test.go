@@ -45,3 +45,77 @@ func Example() {
 	// Estimate using parallel evaluations of f.
 	// EV = 4.19064
 }
+
+func ExampleAdaptiveSingular() {
+	// The integrand has an integrable singularity at 0.
+	f := func(x float64) float64 {
+		return math.Log(x) / math.Sqrt(x)
+	}
+	v, abserr, status := quad.AdaptiveSingular(f, 0, 1, nil)
+	fmt.Printf("integral = %.10f (want -4)\n", v)
+	fmt.Printf("error estimate < 1e-10: %t\n", abserr < 1e-10)
+	fmt.Println("status:", status)
+
+	// Integral of a Gaussian density over the real line.
+	v, _, status = quad.AdaptiveSingular(distuv.UnitNormal.Prob, math.Inf(-1), math.Inf(1), nil)
+	fmt.Printf("integral = %.10f (%v)\n", v, status)
+
+	// Output:
+	// integral = -4.0000000000 (want -4)
+	// error estimate < 1e-10: true
+	// status: Success
+	// integral = 1.0000000000 (Success)
+}
+
+func ExampleOscillatory() {
+	// Fourier coefficient of exp(-x) at a high frequency.
+	omega := 1000.0
+	v, _, status := quad.Oscillatory(func(x float64) float64 { return math.Exp(-x) }, 0, 1, omega, quad.Sine, nil)
+	want := (omega - math.Exp(-1)*(math.Sin(omega)+omega*math.Cos(omega))) / (1 + omega*omega)
+	fmt.Printf("integral = %.12f (want %.12f, %v)\n", v, want, status)
+
+	// Output:
+	// integral = 0.000792807315 (want 0.000792807315, Success)
+}
+
+func ExampleJacobi() {
+	// The integrand cos(x)/sqrt(x) has an integrable singularity at 0,
+	// which is absorbed into the weight of the Gauss–Jacobi rule.
+	v := quad.Fixed(math.Cos, 0, 1, 8, quad.Jacobi{Beta: -0.5}, 0)
+	fmt.Printf("Gauss–Jacobi with 8 points:   %.12f\n", v)
+
+	// The tanh-sinh rule integrates the singular integrand directly.
+	f := func(x float64) float64 { return math.Cos(x) / math.Sqrt(x) }
+	v = quad.Fixed(f, 0, 1, 60, quad.TanhSinh{}, 0)
+	fmt.Printf("tanh-sinh with 60 points:     %.7f\n", v)
+
+	// Output:
+	// Gauss–Jacobi with 8 points:   1.809048475801
+	// tanh-sinh with 60 points:     1.8090485
+}
+
+func ExampleMomentRecurrence() {
+	// Construct the Gauss rule for the weight -log(x) over [0, 1] from
+	// its moments 1/(k+1)^2.
+	const n = 5
+	moments := make([]float64, 2*n)
+	for k := range moments {
+		moments[k] = 1 / float64((k+1)*(k+1))
+	}
+	alpha := make([]float64, n)
+	beta := make([]float64, n)
+	quad.MomentRecurrence(alpha, beta, moments)
+	x := make([]float64, n)
+	weight := make([]float64, n)
+	quad.GolubWelsch(x, weight, alpha, beta)
+
+	// The integral of -log(x) cos(x) over [0, 1] is Si(1).
+	var v float64
+	for i, xi := range x {
+		v += weight[i] * math.Cos(xi)
+	}
+	fmt.Printf("integral = %.12f (want 0.946083070367)\n", v)
+
+	// Output:
+	// integral = 0.946083070367 (want 0.946083070367)
+}
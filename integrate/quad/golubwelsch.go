@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// GolubWelsch computes the locations and weights of the len(x)-point Gauss
+// quadrature rule for a weight function w(x) from the recurrence coefficients
+// of its monic orthogonal polynomials
+//
+//	p_{k+1}(x) = (x - alpha[k]) p_k(x) - beta[k] p_{k-1}(x),  p_{-1} = 0,  p_0 = 1,
+//
+// where beta[0] is the integral of w(x). The rule integrates
+//
+//	int w(x) f(x) dx
+//
+// over the support of w exactly if f is a polynomial of degree at most
+// 2*len(x)-1. The locations are stored in x in increasing order and the
+// weights in weight.
+//
+// GolubWelsch panics if len(x) != len(weight), if alpha or beta have fewer
+// than len(x) elements, or if beta[k] <= 0 for some k < len(x).
+//
+// Reference:
+//
+//	Golub, G. H. and Welsch, J. H. (1969). Calculation of Gauss quadrature
+//	rules. Mathematics of Computation 23(106), 221-230.
+func GolubWelsch(x, weight, alpha, beta []float64) {
+	n := len(x)
+	if len(weight) != n {
+		panic("quad: slice length mismatch")
+	}
+	if len(alpha) < n || len(beta) < n {
+		panic("quad: too few recurrence coefficients")
+	}
+	for _, b := range beta[:n] {
+		if !(b > 0) {
+			panic("quad: non-positive recurrence coefficient")
+		}
+	}
+	if n == 0 {
+		return
+	}
+
+	// The locations are the eigenvalues of the symmetric tridiagonal Jacobi
+	// matrix and the weights are beta[0] times the squares of the first
+	// components of its normalized eigenvectors.
+	j := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		j.SetSym(i, i, alpha[i])
+		if i > 0 {
+			j.SetSym(i-1, i, math.Sqrt(beta[i]))
+		}
+	}
+	var eig mat.EigenSym
+	if !eig.Factorize(j, true) {
+		panic("quad: eigendecomposition failed")
+	}
+	eig.Values(x)
+	var v mat.Dense
+	eig.VectorsTo(&v)
+	for i := range weight {
+		vi := v.At(0, i)
+		weight[i] = beta[0] * vi * vi
+	}
+}
+
+// MomentRecurrence computes the recurrence coefficients alpha and beta of the
+// monic orthogonal polynomials of a weight function w(x), as used by
+// GolubWelsch, from its moments
+//
+//	moments[k] = int x^k w(x) dx,  k = 0, ..., 2*len(alpha)-1,
+//
+// using the Chebyshev algorithm.
+//
+// The map from the moments to the recurrence coefficients is severely
+// ill-conditioned, so only rules with a small number of points can be
+// computed accurately this way, especially if the support of w is far from
+// the interval [-1, 1].
+//
+// MomentRecurrence panics if len(alpha) != len(beta), if len(moments) is less
+// than 2*len(alpha), or if the moments are not those of a positive weight
+// function to working precision.
+//
+// Reference:
+//
+//	Gautschi, W. (2004). Orthogonal polynomials: computation and
+//	approximation. Oxford University Press. Section 2.1.7.
+func MomentRecurrence(alpha, beta, moments []float64) {
+	n := len(alpha)
+	if len(beta) != n {
+		panic("quad: slice length mismatch")
+	}
+	if len(moments) < 2*n {
+		panic("quad: too few moments")
+	}
+	if n == 0 {
+		return
+	}
+	if !(moments[0] > 0) {
+		panic("quad: non-positive moment")
+	}
+
+	// prev and cur hold the mixed moments sigma_{k-1,l} and sigma_{k,l}
+	// of the monic orthogonal polynomials, int p_k(x) x^l w(x) dx.
+	prev := make([]float64, 2*n)
+	cur := make([]float64, 2*n)
+	copy(cur, moments[:2*n])
+	alpha[0] = moments[1] / moments[0]
+	beta[0] = moments[0]
+	for k := 1; k < n; k++ {
+		next := make([]float64, 2*n)
+		for l := k; l < 2*n-k; l++ {
+			next[l] = cur[l+1] - alpha[k-1]*cur[l] - beta[k-1]*prev[l]
+		}
+		if !(next[k] > 0) {
+			panic("quad: moments not of a positive weight function")
+		}
+		alpha[k] = next[k+1]/next[k] - cur[k]/cur[k-1]
+		beta[k] = next[k] / cur[k-1]
+		prev, cur = cur, next
+	}
+}
+
+// Recurrence is a Gauss quadrature rule for an arbitrary weight function w(x)
+// given by the recurrence coefficients of its monic orthogonal polynomials.
+// The function stores the first len(alpha) coefficients in alpha and beta
+// in the convention of GolubWelsch. Recurrence integrates
+//
+//	int w(x) f(x) dx
+//
+// over the support of w, so the bounds passed to FixedLocations are only
+// checked for consistency and should be the bounds of the support.
+type Recurrence func(alpha, beta []float64)
+
+func (r Recurrence) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("quad: slice length mismatch")
+	}
+	if min >= max {
+		panic("quad: min >= max")
+	}
+	alpha := make([]float64, len(x))
+	beta := make([]float64, len(x))
+	r(alpha, beta)
+	GolubWelsch(x, weight, alpha, beta)
+}
+
+// fixLocations modifies the last of the first n recurrence coefficients in
+// alpha and beta so that the n-point Gauss rule computed by GolubWelsch has
+// a location at a and, if b is not NaN, at b. The resulting rules are the
+// Gauss–Radau and Gauss–Lobatto rules of the weight function.
+//
+// Reference:
+//
+//	Golub, G. H. (1973). Some modified matrix eigenvalue problems. SIAM
+//	Review 15(2), 318-334.
+func fixLocations(alpha, beta []float64, n int, a, b float64) {
+	if math.IsNaN(b) {
+		alpha[n-1] = a - beta[n-1]*polyRatio(alpha, beta, n-1, a)
+		return
+	}
+	ra := polyRatio(alpha, beta, n-1, a)
+	rb := polyRatio(alpha, beta, n-1, b)
+	beta[n-1] = (b - a) / (rb - ra)
+	alpha[n-1] = a - beta[n-1]*ra
+}
+
+// polyRatio returns p_{n-1}(x) / p_n(x) for the monic orthogonal polynomials
+// with the recurrence coefficients alpha and beta. The ratio is computed by
+// its own recurrence to avoid the overflow of the polynomials.
+func polyRatio(alpha, beta []float64, n int, x float64) float64 {
+	var r float64
+	for k := 0; k < n; k++ {
+		r = 1 / (x - alpha[k] - beta[k]*r)
+	}
+	return r
+}
+
+// jacobiRecurrence stores in alpha and beta the recurrence coefficients of
+// the monic Jacobi polynomials, orthogonal with respect to the weight
+// (1-x)^a (1+x)^b over [-1, 1].
+func jacobiRecurrence(alpha, beta []float64, a, b float64) {
+	s := a + b
+	for k := range alpha {
+		fk := float64(k)
+		switch k {
+		case 0:
+			alpha[0] = (b - a) / (s + 2)
+			la, _ := math.Lgamma(a + 1)
+			lb, _ := math.Lgamma(b + 1)
+			ls, _ := math.Lgamma(s + 2)
+			beta[0] = math.Exp((s+1)*math.Ln2 + la + lb - ls)
+		case 1:
+			alpha[1] = (b*b - a*a) / ((s + 2) * (s + 4))
+			beta[1] = 4 * (1 + a) * (1 + b) / ((s + 2) * (s + 2) * (s + 3))
+		default:
+			t := 2*fk + s
+			alpha[k] = (b*b - a*a) / (t * (t + 2))
+			beta[k] = 4 * fk * (fk + a) * (fk + b) * (fk + s) / (t * t * (t + 1) * (t - 1))
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGolubWelsch(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name       string
+		rule       FixedLocationer
+		min, max   float64
+		recurrence func(alpha, beta []float64)
+		n          []int
+		tol        float64
+	}{
+		{
+			name: "Legendre",
+			rule: Legendre{},
+			min:  -1,
+			max:  1,
+			recurrence: func(alpha, beta []float64) {
+				jacobiRecurrence(alpha, beta, 0, 0)
+			},
+			n:   []int{1, 2, 5, 20, 100},
+			tol: 1e-13,
+		},
+		{
+			name: "Hermite",
+			rule: Hermite{},
+			min:  math.Inf(-1),
+			max:  math.Inf(1),
+			recurrence: func(alpha, beta []float64) {
+				for k := range beta {
+					alpha[k] = 0
+					beta[k] = float64(k) / 2
+				}
+				beta[0] = math.Sqrt(math.Pi)
+			},
+			n:   []int{1, 2, 5, 20, 50},
+			tol: 1e-12,
+		},
+	} {
+		for _, n := range test.n {
+			x := make([]float64, n)
+			weight := make([]float64, n)
+			Recurrence(test.recurrence).FixedLocations(x, weight, test.min, test.max)
+
+			wantX := make([]float64, n)
+			wantWeight := make([]float64, n)
+			test.rule.FixedLocations(wantX, wantWeight, test.min, test.max)
+			// Sort the reference rule, whose order of locations is
+			// not specified.
+			inds := make([]int, n)
+			floats.Argsort(wantX, inds)
+			for i, j := range inds {
+				if !scalar.EqualWithinAbsOrRel(weight[i], wantWeight[j], test.tol, test.tol) {
+					t.Errorf("%s n=%d: weight %d mismatch: got %v, want %v", test.name, n, i, weight[i], wantWeight[j])
+				}
+			}
+			if !floats.EqualApprox(x, wantX, test.tol) {
+				t.Errorf("%s n=%d: location mismatch:\ngot  %v\nwant %v", test.name, n, x, wantX)
+			}
+		}
+	}
+}
+
+func TestMomentRecurrence(t *testing.T) {
+	t.Parallel()
+	const n = 6
+	for _, test := range []struct {
+		name   string
+		moment func(k int) float64
+		a, b   float64 // Jacobi exponents of the weight over [-1, 1].
+		tol    float64
+	}{
+		{
+			name: "Legendre",
+			moment: func(k int) float64 {
+				if k%2 == 1 {
+					return 0
+				}
+				return 2 / float64(k+1)
+			},
+			tol: 1e-12,
+		},
+		{
+			// The moments of (1-x)(1+x)^2 are those of 1+x-x^2-x^3.
+			name: "Jacobi(1,2)",
+			moment: func(k int) float64 {
+				m := func(j int) float64 {
+					if j%2 == 1 {
+						return 0
+					}
+					return 2 / float64(j+1)
+				}
+				return m(k) + m(k+1) - m(k+2) - m(k+3)
+			},
+			a:   1,
+			b:   2,
+			tol: 1e-10,
+		},
+	} {
+		moments := make([]float64, 2*n)
+		for k := range moments {
+			moments[k] = test.moment(k)
+		}
+		alpha := make([]float64, n)
+		beta := make([]float64, n)
+		MomentRecurrence(alpha, beta, moments)
+
+		wantAlpha := make([]float64, n)
+		wantBeta := make([]float64, n)
+		jacobiRecurrence(wantAlpha, wantBeta, test.a, test.b)
+		if !floats.EqualApprox(alpha, wantAlpha, test.tol) {
+			t.Errorf("%s: alpha mismatch:\ngot  %v\nwant %v", test.name, alpha, wantAlpha)
+		}
+		if !floats.EqualApprox(beta, wantBeta, test.tol) {
+			t.Errorf("%s: beta mismatch:\ngot  %v\nwant %v", test.name, beta, wantBeta)
+		}
+	}
+
+	// Moments of a function that is not a positive weight function.
+	if !panics(func() {
+		MomentRecurrence(make([]float64, 2), make([]float64, 2), []float64{1, 0, -1, 0})
+	}) {
+		t.Errorf("expected panic for invalid moments")
+	}
+}
+
+func TestGolubWelschExactness(t *testing.T) {
+	t.Parallel()
+	// The weight -log(x) over [0, 1] has the moments 1/(k+1)^2.
+	for _, n := range []int{1, 2, 4, 7} {
+		moments := make([]float64, 2*n)
+		for k := range moments {
+			moments[k] = 1 / float64((k+1)*(k+1))
+		}
+		alpha := make([]float64, n)
+		beta := make([]float64, n)
+		MomentRecurrence(alpha, beta, moments)
+		x := make([]float64, n)
+		weight := make([]float64, n)
+		GolubWelsch(x, weight, alpha, beta)
+		for i, v := range x {
+			if v <= 0 || 1 <= v {
+				t.Errorf("n=%d: location outside the support: %v", n, v)
+			}
+			if weight[i] <= 0 {
+				t.Errorf("n=%d: non-positive weight: %v", n, weight[i])
+			}
+		}
+		for k := 0; k < 2*n; k++ {
+			var got float64
+			for i, v := range x {
+				got += weight[i] * math.Pow(v, float64(k))
+			}
+			if !scalar.EqualWithinAbsOrRel(got, moments[k], 1e-10, 1e-10) {
+				t.Errorf("n=%d: moment %d mismatch: got %v, want %v", n, k, got, moments[k])
+			}
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Jacobi generates sample locations and weights for performing quadrature
+// with the Jacobi weight over finite bounds
+//
+//	int_min^max (max-x)^Alpha (x-min)^Beta f(x) dx .
+//
+// The weight allows integrable singularities of f at the bounds to be
+// integrated accurately. Alpha and Beta must be greater than -1. If Alpha and
+// Beta are both zero, Jacobi is equivalent to Legendre.
+type Jacobi struct {
+	Alpha, Beta float64
+}
+
+func (j Jacobi) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("jacobi: slice length mismatch")
+	}
+	if min >= max {
+		panic("jacobi: min >= max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("jacobi: infinite bound")
+	}
+	if !(j.Alpha > -1) || !(j.Beta > -1) {
+		panic("jacobi: exponent not greater than -1")
+	}
+	alpha := make([]float64, len(x))
+	beta := make([]float64, len(x))
+	jacobiRecurrence(alpha, beta, j.Alpha, j.Beta)
+	GolubWelsch(x, weight, alpha, beta)
+	h := (max - min) / 2
+	scale := math.Pow(h, j.Alpha+j.Beta+1)
+	for i, v := range x {
+		x[i] = min + h*(v+1)
+		weight[i] *= scale
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mathext"
+)
+
+func TestJacobi(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		alpha, beta float64
+		min, max    float64
+	}{
+		{alpha: 0, beta: 0, min: -1, max: 1},
+		{alpha: 0.5, beta: -0.5, min: 0, max: 1},
+		{alpha: -0.7, beta: 2, min: -3, max: 5},
+		{alpha: 3, beta: -0.25, min: 1, max: 1.5},
+	} {
+		rule := Jacobi{Alpha: test.alpha, Beta: test.beta}
+		length := test.max - test.min
+		for _, n := range []int{1, 2, 5, 12} {
+			// The n-point rule integrates (x-min)^k exactly for
+			// k < 2n, and the integral is a beta function.
+			for k := 0; k < 2*n; k++ {
+				fk := float64(k)
+				f := func(x float64) float64 { return math.Pow(x-test.min, fk) }
+				got := Fixed(f, test.min, test.max, n, rule, 0)
+				want := math.Pow(length, test.alpha+test.beta+fk+1) * mathext.Beta(test.beta+fk+1, test.alpha+1)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+					t.Errorf("alpha=%v beta=%v n=%d: moment %d mismatch: got %v, want %v",
+						test.alpha, test.beta, n, k, got, want)
+				}
+			}
+		}
+	}
+
+	// Jacobi with zero exponents is Legendre.
+	f := func(x float64) float64 { return math.Exp(x) }
+	got := Fixed(f, -3, 5, 16, Jacobi{}, 0)
+	want := Fixed(f, -3, 5, 16, Legendre{}, 0)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-13) {
+		t.Errorf("mismatch with Legendre: got %v, want %v", got, want)
+	}
+
+	if !panics(func() { Fixed(f, 0, 1, 4, Jacobi{Alpha: -1}, 0) }) {
+		t.Errorf("expected panic for an exponent of -1")
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+// The Gauss–Kronrod nodes and weights below were computed in exact rational
+// and 90-digit arithmetic from the Stieltjes polynomials of the Legendre
+// polynomials, and agree with the tables of QUADPACK.
+
+var gk15 = gaussKronrod{
+	xgk: []float64{
+		0.9914553711208126392068547,
+		0.9491079123427585245261897,
+		0.8648644233597690727897128,
+		0.7415311855993944398638648,
+		0.5860872354676911302941448,
+		0.4058451513773971669066064,
+		0.2077849550078984676006894,
+		0,
+	},
+	wgk: []float64{
+		0.022935322010529224963732,
+		0.0630920926299785532907007,
+		0.1047900103222501838398763,
+		0.1406532597155259187451896,
+		0.1690047266392679028265834,
+		0.1903505780647854099132564,
+		0.204432940075298892414162,
+		0.2094821410847278280129992,
+	},
+	wg: []float64{
+		0.1294849661688696932706114,
+		0.2797053914892766679014678,
+		0.3818300505051189449503698,
+		0.417959183673469387755102,
+	},
+}
+
+var gk21 = gaussKronrod{
+	xgk: []float64{
+		0.9956571630258080807355273,
+		0.973906528517171720077964,
+		0.9301574913557082260012072,
+		0.8650633666889845107320967,
+		0.7808177265864168970637176,
+		0.6794095682990244062343274,
+		0.5627571346686046833390001,
+		0.4333953941292471907992659,
+		0.2943928627014601981311266,
+		0.148874338981631210884826,
+		0,
+	},
+	wgk: []float64{
+		0.0116946388673718742780644,
+		0.032558162307964727478819,
+		0.0547558965743519960313813,
+		0.0750396748109199527670431,
+		0.0931254545836976055350655,
+		0.1093871588022976418992106,
+		0.1234919762620658510779581,
+		0.134709217311473325928054,
+		0.1427759385770600807970943,
+		0.1477391049013384913748415,
+		0.1494455540029169056649365,
+	},
+	wg: []float64{
+		0.0666713443086881375935688,
+		0.1494513491505805931457763,
+		0.2190863625159820439955349,
+		0.2692667193099963550912269,
+		0.295524224714752870173893,
+	},
+}
+
+var gk61 = gaussKronrod{
+	xgk: []float64{
+		0.9994844100504906375713259,
+		0.99689348407464954027163,
+		0.9916309968704045948586284,
+		0.9836681232797472099700326,
+		0.9731163225011262683746939,
+		0.960021864968307512216871,
+		0.9443744447485599794158313,
+		0.9262000474292743258793243,
+		0.9055733076999077985465226,
+		0.8825605357920526815431165,
+		0.8572052335460610989586585,
+		0.8295657623827683974428981,
+		0.7997278358218390830136689,
+		0.7677774321048261949179773,
+		0.7337900624532268047261711,
+		0.6978504947933157969322924,
+		0.6600610641266269613700537,
+		0.6205261829892428611404776,
+		0.5793452358263616917560249,
+		0.5366241481420198992641698,
+		0.4924804678617785749936931,
+		0.4470337695380891767806099,
+		0.4004012548303943925354762,
+		0.3527047255308781134710372,
+		0.3040732022736250773726771,
+		0.2546369261678898464398051,
+		0.2045251166823098914389577,
+		0.1538699136085835469637947,
+		0.1028069379667370301470968,
+		0.0514718425553176958330252,
+		0,
+	},
+	wgk: []float64{
+		0.0013890136986770076245516,
+		0.0038904611270998840512672,
+		0.0066307039159312921733198,
+		0.0092732796595177634284411,
+		0.0118230152534963417422329,
+		0.0143697295070458048124514,
+		0.0169208891890532726275723,
+		0.019414141193942381173409,
+		0.0218280358216091922971675,
+		0.0241911620780806013656864,
+		0.0265099548823331016106017,
+		0.0287540487650412928439788,
+		0.0309072575623877624728843,
+		0.0329814470574837260318142,
+		0.0349793380280600241374997,
+		0.0368823646518212292239111,
+		0.0386789456247275929503487,
+		0.0403745389515359591119953,
+		0.0419698102151642461471475,
+		0.0434525397013560693168317,
+		0.0448148001331626631923556,
+		0.0460592382710069881162717,
+		0.0471855465692991539452615,
+		0.0481858617570871291407795,
+		0.0490554345550297788875282,
+		0.0497956834270742063578116,
+		0.0504059214027823468408931,
+		0.0508817958987496064922975,
+		0.0512215478492587721706563,
+		0.0514261285374590259338629,
+		0.0514947294294515675583404,
+	},
+	wg: []float64{
+		0.0079681924961666056154659,
+		0.0184664683110909591423021,
+		0.0287847078833233693497192,
+		0.0387991925696270495968019,
+		0.0484026728305940529029381,
+		0.0574931562176190664817217,
+		0.0659742298821804951281285,
+		0.0737559747377052062682438,
+		0.0807558952294202153546949,
+		0.0868997872010829798023875,
+		0.0921225222377861287176327,
+		0.0963687371746442596394686,
+		0.0995934205867952670627803,
+		0.101762389748405504596429,
+		0.1028526528935588403412856,
+	},
+}
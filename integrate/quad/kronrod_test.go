@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestGaussKronrodExactness(t *testing.T) {
+	t.Parallel()
+	for _, rule := range []GaussKronrod{GaussKronrod15, GaussKronrod21, GaussKronrod61} {
+		gk := rule.rule()
+		n := int(rule) / 2 // Number of Gauss nodes.
+		if len(gk.xgk) != n+1 || len(gk.wgk) != n+1 || len(gk.wg) != (n+1)/2 {
+			t.Errorf("%d: unexpected table sizes", rule)
+			continue
+		}
+		// The Kronrod rule is exact for polynomials of degree 3n+1 and the
+		// Gauss rule for polynomials of degree 2n-1. The integrals are
+		// computed over [0, 2] to avoid cancellation for odd degrees.
+		for deg := 0; deg <= 3*n+1; deg++ {
+			f := func(x float64) float64 { return math.Pow(x, float64(deg)) }
+			want := math.Pow(2, float64(deg+1)) / float64(deg+1)
+			got, abserr, _, _ := gk.integrate(f, 0, 2)
+			if !scalar.EqualWithinRel(got, want, 1e-13) {
+				t.Errorf("%d: Kronrod rule not exact for degree %d: got %v, want %v", rule, deg, got, want)
+			}
+			if deg < 2*n && !scalar.EqualWithinRel(got, got+abserr, 1e-12) {
+				// abserr is the rescaled difference to the Gauss rule.
+				t.Errorf("%d: Gauss rule not exact for degree %d: error estimate %v", rule, deg, abserr)
+			}
+		}
+	}
+}
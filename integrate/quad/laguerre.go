@@ -0,0 +1,45 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Laguerre generates sample locations and weights for performing quadrature
+// with the generalized Laguerre weight over a semi-infinite interval
+//
+//	int_min^inf (x-min)^Alpha e^(-(x-min)) f(x) dx .
+//
+// Alpha must be greater than -1. The lower bound must be finite and the
+// upper bound must be positive infinity.
+type Laguerre struct {
+	Alpha float64
+}
+
+func (l Laguerre) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("laguerre: slice length mismatch")
+	}
+	if min >= max {
+		panic("laguerre: min >= max")
+	}
+	if math.IsInf(min, 0) || !math.IsInf(max, 1) {
+		panic("laguerre: bounds not [min, inf)")
+	}
+	if !(l.Alpha > -1) {
+		panic("laguerre: exponent not greater than -1")
+	}
+	alpha := make([]float64, len(x))
+	beta := make([]float64, len(x))
+	for k := range alpha {
+		fk := float64(k)
+		alpha[k] = 2*fk + 1 + l.Alpha
+		beta[k] = fk * (fk + l.Alpha)
+	}
+	beta[0] = math.Gamma(l.Alpha + 1)
+	GolubWelsch(x, weight, alpha, beta)
+	for i := range x {
+		x[i] += min
+	}
+}
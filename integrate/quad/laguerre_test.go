@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLaguerre(t *testing.T) {
+	t.Parallel()
+	for _, alpha := range []float64{0, -0.5, 1.5} {
+		for _, min := range []float64{0, -2} {
+			rule := Laguerre{Alpha: alpha}
+			for _, n := range []int{1, 3, 8, 15} {
+				for k := 0; k < 2*n; k++ {
+					fk := float64(k)
+					f := func(x float64) float64 { return math.Pow(x-min, fk) }
+					got := Fixed(f, min, math.Inf(1), n, rule, 0)
+					want := math.Gamma(alpha + fk + 1)
+					if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+						t.Errorf("alpha=%v min=%v n=%d: moment %d mismatch: got %v, want %v",
+							alpha, min, n, k, got, want)
+					}
+				}
+			}
+		}
+	}
+
+	// The integral of x^-1/2 e^-x cos(x) over [0, inf) is
+	// Γ(1/2) cos(π/8) / 2^(1/4).
+	f := func(x float64) float64 { return math.Cos(x) }
+	got := Fixed(f, 0, math.Inf(1), 60, Laguerre{Alpha: -0.5}, 0)
+	want := math.Sqrt(math.Pi) * math.Cos(math.Pi/8) / math.Pow(2, 0.25)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("oscillatory integrand mismatch: got %v, want %v", got, want)
+	}
+
+	for _, test := range []struct {
+		name     string
+		min, max float64
+		rule     Laguerre
+	}{
+		{name: "finite upper bound", min: 0, max: 1},
+		{name: "infinite lower bound", min: math.Inf(-1), max: math.Inf(1)},
+		{name: "exponent", min: 0, max: math.Inf(1), rule: Laguerre{Alpha: -2}},
+	} {
+		if !panics(func() { Fixed(f, test.min, test.max, 4, test.rule, 0) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
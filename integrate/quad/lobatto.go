@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Lobatto integrates an unweighted function over finite bounds
+//
+//	int_min^max f(x) dx
+//
+// with the Gauss–Lobatto rule, whose locations include both bounds. The
+// n-point rule integrates polynomials of degree at most 2n-3 exactly. At
+// least two locations are required.
+type Lobatto struct{}
+
+func (l Lobatto) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("lobatto: slice length mismatch")
+	}
+	if min >= max {
+		panic("lobatto: min >= max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("lobatto: infinite bound")
+	}
+	n := len(x)
+	if n < 2 {
+		panic("lobatto: fewer than two locations")
+	}
+	alpha := make([]float64, n)
+	beta := make([]float64, n)
+	jacobiRecurrence(alpha, beta, 0, 0)
+	fixLocations(alpha, beta, n, -1, 1)
+	GolubWelsch(x, weight, alpha, beta)
+	scaleLegendre(x, weight, min, max)
+	// The bounds are exact locations of the rule.
+	x[0] = min
+	x[n-1] = max
+}
+
+// Radau integrates an unweighted function over finite bounds
+//
+//	int_min^max f(x) dx
+//
+// with the Gauss–Radau rule, whose locations include one of the bounds. The
+// n-point rule integrates polynomials of degree at most 2n-2 exactly. The
+// included bound is min, or max if Max is true.
+type Radau struct {
+	Max bool
+}
+
+func (r Radau) FixedLocations(x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic("radau: slice length mismatch")
+	}
+	if min >= max {
+		panic("radau: min >= max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("radau: infinite bound")
+	}
+	n := len(x)
+	if n == 0 {
+		return
+	}
+	alpha := make([]float64, n)
+	beta := make([]float64, n)
+	jacobiRecurrence(alpha, beta, 0, 0)
+	if r.Max {
+		fixLocations(alpha, beta, n, 1, math.NaN())
+	} else {
+		fixLocations(alpha, beta, n, -1, math.NaN())
+	}
+	GolubWelsch(x, weight, alpha, beta)
+	scaleLegendre(x, weight, min, max)
+	// The bound is an exact location of the rule.
+	if r.Max {
+		x[n-1] = max
+	} else {
+		x[0] = min
+	}
+}
+
+// scaleLegendre transforms the locations and weights of a rule for the unit
+// weight over [-1, 1] to the interval [min, max].
+func scaleLegendre(x, weight []float64, min, max float64) {
+	h := (max - min) / 2
+	for i, v := range x {
+		x[i] = min + h*(v+1)
+		weight[i] *= h
+	}
+}
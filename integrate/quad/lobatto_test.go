@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLobattoRadau(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name         string
+		rule         FixedLocationer
+		x, weight    []float64
+		degree       func(n int) int
+		minN         int
+		fixMin       bool
+		fixMax       bool
+		min, max     float64
+		tol          float64
+		wantConverge float64
+	}{
+		{
+			name:   "Lobatto",
+			rule:   Lobatto{},
+			x:      []float64{-1, 0, 1},
+			weight: []float64{1.0 / 3, 4.0 / 3, 1.0 / 3},
+			degree: func(n int) int { return 2*n - 3 },
+			minN:   2,
+			fixMin: true,
+			fixMax: true,
+		},
+		{
+			name:   "Radau min",
+			rule:   Radau{},
+			x:      []float64{-1, (1 - math.Sqrt(6)) / 5, (1 + math.Sqrt(6)) / 5},
+			weight: []float64{2.0 / 9, (16 + math.Sqrt(6)) / 18, (16 - math.Sqrt(6)) / 18},
+			degree: func(n int) int { return 2*n - 2 },
+			minN:   1,
+			fixMin: true,
+		},
+		{
+			name:   "Radau max",
+			rule:   Radau{Max: true},
+			x:      []float64{-(1 + math.Sqrt(6)) / 5, -(1 - math.Sqrt(6)) / 5, 1},
+			weight: []float64{(16 - math.Sqrt(6)) / 18, (16 + math.Sqrt(6)) / 18, 2.0 / 9},
+			degree: func(n int) int { return 2*n - 2 },
+			minN:   1,
+			fixMax: true,
+		},
+	} {
+		x := make([]float64, 3)
+		weight := make([]float64, 3)
+		test.rule.FixedLocations(x, weight, -1, 1)
+		if !floats.EqualApprox(x, test.x, 1e-14) {
+			t.Errorf("%s: location mismatch: got %v, want %v", test.name, x, test.x)
+		}
+		if !floats.EqualApprox(weight, test.weight, 1e-14) {
+			t.Errorf("%s: weight mismatch: got %v, want %v", test.name, weight, test.weight)
+		}
+
+		const min, max = -2.0, 3.0
+		for n := test.minN; n <= 30; n++ {
+			x := make([]float64, n)
+			weight := make([]float64, n)
+			test.rule.FixedLocations(x, weight, min, max)
+			if test.fixMin && x[0] != min {
+				t.Errorf("%s n=%d: first location not min: %v", test.name, n, x[0])
+			}
+			if test.fixMax && x[n-1] != max {
+				t.Errorf("%s n=%d: last location not max: %v", test.name, n, x[n-1])
+			}
+			for k := 0; k <= test.degree(n); k++ {
+				var got float64
+				for i, v := range x {
+					got += weight[i] * math.Pow(v, float64(k))
+				}
+				want := (math.Pow(max, float64(k+1)) - math.Pow(min, float64(k+1))) / float64(k+1)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+					t.Errorf("%s n=%d: moment %d mismatch: got %v, want %v", test.name, n, k, got, want)
+				}
+			}
+		}
+	}
+
+	if !panics(func() { Lobatto{}.FixedLocations(make([]float64, 1), make([]float64, 1), 0, 1) }) {
+		t.Errorf("expected panic for a single Lobatto location")
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Oscillation specifies the oscillatory weight function of Oscillatory.
+type Oscillation int
+
+const (
+	// Cosine is the weight function cos(ωx).
+	Cosine Oscillation = iota
+	// Sine is the weight function sin(ωx).
+	Sine
+)
+
+// Oscillatory approximates the integral of f(x)·cos(ωx) or f(x)·sin(ωx) from
+// min to max, depending on weight, by globally adaptive bisection with
+// extrapolation. It returns the estimate of the integral, an estimate of its
+// absolute error and the termination status. Oscillatory is equivalent to the
+// QAWO routine of QUADPACK.
+//
+// On subintervals that contain many periods of the weight function, f is
+// interpolated by Chebyshev polynomials of degrees 12 and 24 and the
+// products with the weight are integrated exactly using modified Chebyshev
+// moments, so that the number of evaluations of f does not grow with omega.
+// f is evaluated at the ends of these subintervals, including min and max.
+// The remaining subintervals are integrated with the 15-point Gauss–Kronrod
+// pair.
+//
+// min must be less than or equal to max and both must be finite, otherwise
+// Oscillatory will panic.
+//
+// Reference:
+//
+//	Piessens, R., Branders, M. (1975). Computation of oscillating
+//	integrals. Journal of Computational and Applied Mathematics, 1(2),
+//	153-164.
+func Oscillatory(f func(float64) float64, min, max, omega float64, weight Oscillation, settings *Settings) (v, abserr float64, status Status) {
+	if weight != Cosine && weight != Sine {
+		panic("quad: unknown oscillation")
+	}
+	if min > max {
+		panic("quad: min > max")
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic("quad: infinite bound")
+	}
+	if min == max {
+		return 0, 0, Success
+	}
+	o := &oscillatory{
+		f:      f,
+		omega:  omega,
+		sine:   weight == Sine,
+		length: max - min,
+	}
+	if o.sine {
+		o.weighted = func(x float64) float64 { return f(x) * math.Sin(omega*x) }
+	} else {
+		o.weighted = func(x float64) float64 { return f(x) * math.Cos(omega*x) }
+	}
+	ad := newAdaptive(settings, o.integrate)
+	return ad.extrapolate(min, max, omega)
+}
+
+// oscillatory is the integration rule of Oscillatory.
+type oscillatory struct {
+	f        func(float64) float64
+	weighted func(float64) float64
+
+	omega  float64
+	sine   bool
+	length float64
+
+	// moments holds the Chebyshev moments for each bisection level.
+	moments [][25]float64
+}
+
+func (o *oscillatory) integrate(a, b float64, level int) (result, abserr, resabs, resasc float64) {
+	// The half length of the subintervals at level is length/2^(level+1).
+	par := o.omega * math.Ldexp(o.length, -level-1)
+	if math.Abs(par) < 2 {
+		return gk15.integrate(o.weighted, a, b)
+	}
+	for len(o.moments) <= level {
+		l := len(o.moments)
+		o.moments = append(o.moments, oscillatoryMoments(o.omega*math.Ldexp(o.length, -l-1)))
+	}
+	m := &o.moments[level]
+
+	var cheb12 [13]float64
+	var cheb24 [25]float64
+	chebyshev(&cheb12, &cheb24, o.f, a, b)
+	var res12Cos, res12Sin, res24Cos, res24Sin float64
+	for k := 0; k < 13; k += 2 {
+		res12Cos += cheb12[k] * m[k]
+	}
+	for k := 1; k < 13; k += 2 {
+		res12Sin += cheb12[k] * m[k]
+	}
+	for k := 0; k < 25; k += 2 {
+		res24Cos += cheb24[k] * m[k]
+	}
+	for k := 1; k < 25; k += 2 {
+		res24Sin += cheb24[k] * m[k]
+	}
+	for _, c := range cheb24 {
+		resabs += math.Abs(c)
+	}
+	estCos := math.Abs(res24Cos - res12Cos)
+	estSin := math.Abs(res24Sin - res12Sin)
+
+	// With x = center + h·t,
+	//  cos(ωx) = cos(ω·center)cos(par·t) - sin(ω·center)sin(par·t),
+	//  sin(ωx) = sin(ω·center)cos(par·t) + cos(ω·center)sin(par·t).
+	center := 0.5 * (a + b)
+	halfLength := 0.5 * (b - a)
+	c := halfLength * math.Cos(o.omega*center)
+	s := halfLength * math.Sin(o.omega*center)
+	if o.sine {
+		result = c*res24Sin + s*res24Cos
+		abserr = math.Abs(c*estSin) + math.Abs(s*estCos)
+	} else {
+		result = c*res24Cos - s*res24Sin
+		abserr = math.Abs(c*estCos) + math.Abs(s*estSin)
+	}
+	return result, abserr, resabs * math.Abs(halfLength), math.MaxFloat64
+}
+
+// oscillatoryMoments returns the modified Chebyshev moments
+//
+//	∫_{-1}^{1} cos(par·t) T_k(t) dt for even k,
+//	∫_{-1}^{1} sin(par·t) T_k(t) dt for odd k,
+//
+// for k = 0, ..., 24.
+func oscillatoryMoments(par float64) [25]float64 {
+	var m [25]float64
+	if math.Abs(par) <= 24 {
+		// Forward recurrence is unstable for small par, and the moments
+		// are integrals of polynomials of low effective degree that a
+		// Gauss–Legendre rule integrates to machine precision.
+		const n = 64
+		var l Legendre
+		for i := 0; i < n; i++ {
+			x, w := l.FixedLocationSingle(n, i, -1, 1)
+			cos := w * math.Cos(par*x)
+			sin := w * math.Sin(par*x)
+			t0, t1 := 1.0, x
+			m[0] += cos
+			m[1] += sin * x
+			for k := 2; k < 25; k++ {
+				t0, t1 = t1, 2*x*t1-t0
+				if k%2 == 0 {
+					m[k] += cos * t1
+				} else {
+					m[k] += sin * t1
+				}
+			}
+		}
+		return m
+	}
+
+	// Compute the moments by the forward recurrence of the moments of even
+	// and odd order.
+	par2 := par * par
+	par22 := par2 + 2
+	sinPar := math.Sin(par)
+	cosPar := math.Cos(par)
+
+	var v [13]float64
+	v[0] = 2 * sinPar / par
+	v[1] = (8*cosPar + (2*par2-8)*sinPar/par) / par2
+	v[2] = (32*(par2-12)*cosPar + (2*((par2-80)*par2+192)*sinPar)/par) / (par2 * par2)
+	ac := 8 * cosPar
+	as := 24 * par * sinPar
+	an := 4.0
+	for k := 3; k < 13; k++ {
+		an2 := an * an
+		v[k] = ((an2-4)*(2*(par22-2*an2)*v[k-1]-ac) + as - par2*(an+1)*(an+2)*v[k-2]) / (par2 * (an - 1) * (an - 2))
+		an += 2
+	}
+	for i := 0; i < 13; i++ {
+		m[2*i] = v[i]
+	}
+
+	v[0] = 2 * (sinPar - par*cosPar) / par2
+	v[1] = (18-48/par2)*sinPar/par2 + (-2+48/par2)*cosPar/par
+	ac = -24 * par * cosPar
+	as = -8 * sinPar
+	an = 3
+	for k := 2; k < 12; k++ {
+		an2 := an * an
+		v[k] = ((an2-4)*(2*(par22-2*an2)*v[k-1]+as) + ac - par2*(an+1)*(an+2)*v[k-2]) / (par2 * (an - 1) * (an - 2))
+		an += 2
+	}
+	for i := 0; i < 12; i++ {
+		m[2*i+1] = v[i]
+	}
+	return m
+}
+
+// chebCos holds cos(kπ/24) for k = 0, ..., 47.
+var chebCos = func() [48]float64 {
+	var c [48]float64
+	for k := range c {
+		c[k] = math.Cos(float64(k) * math.Pi / 24)
+	}
+	return c
+}()
+
+// chebyshev computes the coefficients of the polynomials of degrees 12 and
+// 24 that interpolate f on [a, b] at the Chebyshev points
+// (a+b)/2 + (b-a)/2·cos(jπ/24), so that
+//
+//	f((a+b)/2 + (b-a)/2·t) ≈ Σ_k cheb24[k] T_k(t),
+//
+// and similarly for cheb12 using every other point.
+func chebyshev(cheb12 *[13]float64, cheb24 *[25]float64, f func(float64) float64, a, b float64) {
+	center := 0.5 * (a + b)
+	halfLength := 0.5 * (b - a)
+	var fv [25]float64
+	for j := range fv {
+		fv[j] = f(center + halfLength*chebCos[j])
+	}
+	fv[0] /= 2
+	fv[24] /= 2
+	for k := range cheb24 {
+		var s float64
+		for j, v := range fv {
+			s += v * chebCos[(j*k)%48]
+		}
+		cheb24[k] = s / 12
+	}
+	cheb24[0] /= 2
+	cheb24[24] /= 2
+	for k := range cheb12 {
+		var s float64
+		for j := 0; j <= 12; j++ {
+			s += fv[2*j] * chebCos[(2*j*k)%48]
+		}
+		cheb12[k] = s / 6
+	}
+	cheb12[0] /= 2
+	cheb12[12] /= 2
+}
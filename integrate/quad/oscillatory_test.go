@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestOscillatory(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		f        func(float64) float64
+		min, max float64
+		omega    float64
+		weight   Oscillation
+		want     float64
+		maxEval  int
+	}{
+		{
+			name: "log singularity",
+			f: func(x float64) float64 {
+				// f is evaluated at the ends of the subintervals.
+				if x == 0 {
+					return 0
+				}
+				return math.Log(x)
+			},
+			min:    0,
+			max:    1,
+			omega:  10 * math.Pi,
+			weight: Sine,
+			want:   -0.1281368483991674,
+		},
+		{
+			name:   "polynomial",
+			f:      func(x float64) float64 { return x * x },
+			min:    0,
+			max:    math.Pi,
+			omega:  100,
+			weight: Cosine,
+			want:   2 * math.Pi / 1e4,
+		},
+		{
+			name:   "negative omega",
+			f:      func(x float64) float64 { return x * x },
+			min:    0,
+			max:    math.Pi,
+			omega:  -100,
+			weight: Sine,
+			want:   math.Pi * math.Pi / 100,
+		},
+		{
+			name:   "high frequency",
+			f:      func(x float64) float64 { return math.Exp(-x) },
+			min:    0,
+			max:    1,
+			omega:  1e4,
+			weight: Cosine,
+			want:   (math.Exp(-1)*(1e4*math.Sin(1e4)-math.Cos(1e4)) + 1) / (1 + 1e8),
+			// The number of evaluations does not grow with omega.
+			maxEval: 100,
+		},
+		{
+			name:   "low frequency",
+			f:      func(x float64) float64 { return math.Exp(-x) },
+			min:    0,
+			max:    1,
+			omega:  1,
+			weight: Sine,
+			want:   (1 - math.Exp(-1)*(math.Sin(1)+math.Cos(1))) / 2,
+		},
+	} {
+		var evals int
+		f := func(x float64) float64 {
+			evals++
+			return test.f(x)
+		}
+		settings := &Settings{AbsTol: 1e-14, RelTol: 1e-10}
+		got, abserr, status := Oscillatory(f, test.min, test.max, test.omega, test.weight, settings)
+		checkAdaptive(t, "Oscillatory", test.name, got, abserr, status, test.want, 1e-10)
+		if test.maxEval != 0 && evals > test.maxEval {
+			t.Errorf("%s: too many evaluations: got %d, want at most %d", test.name, evals, test.maxEval)
+		}
+	}
+}
+
+func TestOscillatoryMoments(t *testing.T) {
+	t.Parallel()
+	for _, par := range []float64{-100, -24.5, -3, 2, 10, 24, 24.5, 30, 1000} {
+		got := oscillatoryMoments(par)
+		// Compute the moments with a Gauss–Legendre rule with enough
+		// nodes to integrate the oscillations.
+		var want [25]float64
+		n := 100 + int(math.Abs(par))
+		for i := 0; i < n; i++ {
+			x, w := Legendre{}.FixedLocationSingle(n, i, -1, 1)
+			for k := range want {
+				tk := math.Cos(float64(k) * math.Acos(x))
+				if k%2 == 0 {
+					want[k] += w * math.Cos(par*x) * tk
+				} else {
+					want[k] += w * math.Sin(par*x) * tk
+				}
+			}
+		}
+		for k := range got {
+			if !scalar.EqualWithinAbs(got[k], want[k], 1e-13) {
+				t.Errorf("par=%v: unexpected moment %d: got %v, want %v", par, k, got[k], want[k])
+			}
+		}
+	}
+}
+
+func TestChebyshev(t *testing.T) {
+	t.Parallel()
+	// Polynomials of degree at most 12 are interpolated exactly.
+	f := func(x float64) float64 {
+		t := (2*x - 3) / 1 // x in [1, 2] mapped to [-1, 1].
+		return 3 - 2*t + math.Pow(t, 5) - 0.5*math.Pow(t, 12)
+	}
+	var cheb12 [13]float64
+	var cheb24 [25]float64
+	chebyshev(&cheb12, &cheb24, f, 1, 2)
+	for _, x := range []float64{1, 1.1, 1.37, 1.5, 1.99, 2} {
+		tx := 2*x - 3
+		var p12, p24 float64
+		for k, c := range cheb12 {
+			p12 += c * math.Cos(float64(k)*math.Acos(tx))
+		}
+		for k, c := range cheb24 {
+			p24 += c * math.Cos(float64(k)*math.Acos(tx))
+		}
+		if want := f(x); !scalar.EqualWithinAbs(p12, want, 1e-13) || !scalar.EqualWithinAbs(p24, want, 1e-13) {
+			t.Errorf("unexpected interpolant at %v: got %v and %v, want %v", x, p12, p24, want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestTanhSinh(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f        func(float64) float64
+		min, max float64
+		n        []int
+		tol      []float64
+		ans      float64
+	}{
+		{
+			f:   math.Exp,
+			min: -3,
+			max: 5,
+			n:   []int{1, 10, 30, 60},
+			tol: []float64{10, 1e-1, 1e-9, 1e-14},
+			ans: math.Exp(5) - math.Exp(-3),
+		},
+		{
+			// Logarithmic singularity at min.
+			f:   math.Log,
+			min: 0,
+			max: 1,
+			n:   []int{10, 30, 60},
+			tol: []float64{1e-3, 1e-9, 1e-13},
+			ans: -1,
+		},
+		{
+			// Inverse square root singularities at both bounds.
+			f:   func(x float64) float64 { return 1 / math.Sqrt(1-x*x) },
+			min: -1,
+			max: 1,
+			n:   []int{10, 30, 60},
+			tol: []float64{1e-2, 1e-5, 1e-7},
+			ans: math.Pi,
+		},
+	} {
+		for j, n := range test.n {
+			for _, concurrent := range []int{0, 3} {
+				ans := Fixed(test.f, test.min, test.max, n, TanhSinh{}, concurrent)
+				if math.IsNaN(ans) || math.IsInf(ans, 0) || !scalar.EqualWithinAbsOrRel(ans, test.ans, test.tol[j], test.tol[j]) {
+					t.Errorf("Mismatch. Case = %d, n = %d, concurrent = %d. Want %v, got %v", i, n, concurrent, test.ans, ans)
+				}
+			}
+		}
+	}
+
+	// The locations are strictly inside the bounds.
+	for _, n := range []int{2, 7, 100, 1000} {
+		x := make([]float64, n)
+		weight := make([]float64, n)
+		TanhSinh{}.FixedLocations(x, weight, 1, 2)
+		for i, v := range x {
+			if v <= 1 || 2 <= v {
+				t.Errorf("n=%d: location %d not strictly inside the bounds: %v", n, i, v)
+			}
+			if i > 0 && v <= x[i-1] {
+				t.Errorf("n=%d: locations not increasing at %d", n, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sde provides solvers for systems of Itô stochastic differential
+// equations.
+//
+// Solve simulates a sample path of the solution of a Problem with steps of
+// equal size using one of the methods of the package:
+//
+//   - EulerMaruyama has strong order 1/2 and weak order 1 for any noise.
+//   - Milstein has strong order 1 and weak order 1 for diagonal noise.
+//   - SRI, the stochastic Runge–Kutta method SRIW1 of Rößler, has strong
+//     order 3/2 and weak order 2 for diagonal noise.
+//   - SRA, the stochastic Runge–Kutta method SRA1 of Rößler, has strong
+//     order 3/2 and weak order 2 for additive noise.
+//
+// The Wiener increments are generated from a rand.Source, so sample paths
+// are reproducible. Ensemble simulates many sample paths concurrently with
+// a reproducible source for every path.
+package sde // import "gonum.org/v1/gonum/integrate/sde"
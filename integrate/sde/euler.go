@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import "math"
+
+// EulerMaruyama is the Euler–Maruyama method
+//
+//	X_{k+1} = X_k + f(t_k, X_k) h + g(t_k, X_k) ΔW_k .
+//
+// EulerMaruyama has strong order 1/2 and weak order 1, and strong order 1
+// for additive noise. It supports Diagonal and General noise.
+type EulerMaruyama struct{}
+
+func (EulerMaruyama) stepper(sys *system) stepper {
+	return &eulerMaruyama{
+		sys: sys,
+		f:   make([]float64, sys.n),
+		g:   make([]float64, sys.diffusionLen()),
+	}
+}
+
+func (EulerMaruyama) timeIntegrals() bool { return false }
+
+type eulerMaruyama struct {
+	sys  *system
+	f, g []float64
+}
+
+func (e *eulerMaruyama) step(x []float64, t float64, inc *increment) {
+	e.sys.p.Drift(e.f, t, x)
+	e.sys.p.Diffusion(e.g, t, x)
+	for i, fi := range e.f {
+		x[i] += inc.h * fi
+	}
+	e.sys.addNoise(x, 1, e.g, inc.dW)
+}
+
+// Milstein is the Milstein method
+//
+//	X_{k+1} = X_k + f h + g ΔW_k + 1/2 g ∂g/∂x (ΔW_k^2 - h)
+//
+// for Diagonal noise, where f, g and ∂g/∂x are evaluated at (t_k, X_k)
+// componentwise. Milstein has strong order 1 and weak order 1.
+//
+// If Problem.DiffusionDeriv is nil, the derivative term is replaced by the
+// finite difference
+//
+//	(g(t_k, X_k + f h + g √h) - g) / (2√h) (ΔW_k^2 - h),
+//
+// which has the same order.
+//
+// Reference:
+//
+//	Kloeden, P. E. and Platen, E. (1992). Numerical solution of stochastic
+//	differential equations. Springer. Sections 10.3 and 11.1.
+type Milstein struct{}
+
+func (Milstein) stepper(sys *system) stepper {
+	if !sys.diagonal() {
+		panic("sde: Milstein requires diagonal noise")
+	}
+	return &milstein{
+		sys: sys,
+		f:   make([]float64, sys.n),
+		g:   make([]float64, sys.n),
+		dg:  make([]float64, sys.n),
+		y:   make([]float64, sys.n),
+	}
+}
+
+func (Milstein) timeIntegrals() bool { return false }
+
+type milstein struct {
+	sys         *system
+	f, g, dg, y []float64
+}
+
+func (m *milstein) step(x []float64, t float64, inc *increment) {
+	p := m.sys.p
+	p.Drift(m.f, t, x)
+	p.Diffusion(m.g, t, x)
+	h := inc.h
+	if p.DiffusionDeriv != nil {
+		p.DiffusionDeriv(m.dg, t, x)
+		for i, fi := range m.f {
+			dw := inc.dW[i]
+			x[i] += h*fi + m.g[i]*dw + m.g[i]*m.dg[i]*(dw*dw-h)/2
+		}
+		return
+	}
+	sqrtH := math.Sqrt(h)
+	for i, fi := range m.f {
+		m.y[i] = x[i] + h*fi + m.g[i]*sqrtH
+	}
+	p.Diffusion(m.dg, t, m.y)
+	for i, fi := range m.f {
+		dw := inc.dW[i]
+		x[i] += h*fi + m.g[i]*dw + (m.dg[i]-m.g[i])*(dw*dw-h)/(2*sqrtH)
+	}
+}
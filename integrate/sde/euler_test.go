@@ -0,0 +1,28 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import "testing"
+
+func TestEulerMaruyama(t *testing.T) {
+	t.Parallel()
+	testOrders(t, []orderTest{
+		{problem: gbm(), method: EulerMaruyama{}, strong: 0.5, weak: 1},
+		{problem: gbmGeneral(), method: EulerMaruyama{}, strong: 0.5, weak: 1},
+		// The strong order is 1 for additive noise.
+		{problem: additive(General), method: EulerMaruyama{}, strong: 1},
+	})
+}
+
+func TestMilstein(t *testing.T) {
+	t.Parallel()
+	noDeriv := gbm()
+	noDeriv.p.DiffusionDeriv = nil
+	noDeriv.name = "GBM without derivative"
+	testOrders(t, []orderTest{
+		{problem: gbm(), method: Milstein{}, strong: 1, weak: 1},
+		{problem: noDeriv, method: Milstein{}, strong: 1, weak: 1},
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde_test
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+
+	"gonum.org/v1/gonum/integrate/sde"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+func ExampleEnsemble() {
+	// Price a European call option on a stock following a geometric
+	// Brownian motion under the risk-neutral measure
+	//  dS = r S dt + σ S dW
+	// by Monte Carlo simulation.
+	const (
+		r      = 0.05
+		sigma  = 0.2
+		s0     = 100.0
+		strike = 105.0
+		expiry = 1.0
+	)
+	p := sde.Problem{
+		Drift: func(dst []float64, t float64, x []float64) {
+			dst[0] = r * x[0]
+		},
+		Diffusion: func(dst []float64, t float64, x []float64) {
+			dst[0] = sigma * x[0]
+		},
+	}
+	const paths = 20000
+	res := sde.Ensemble(p, 0, expiry, []float64{s0}, paths, &sde.Settings{Steps: 50}, sde.SRI{}, 1, runtime.GOMAXPROCS(0))
+	payoff := make([]float64, paths)
+	for i, path := range res {
+		payoff[i] = math.Exp(-r*expiry) * math.Max(path.X[0]-strike, 0)
+	}
+	price, std := stat.MeanStdDev(payoff, nil)
+	fmt.Printf("Monte Carlo price:   %.2f ± %.2f\n", price, std/math.Sqrt(paths))
+
+	// The Black–Scholes formula gives the exact price.
+	d1 := (math.Log(s0/strike) + (r+sigma*sigma/2)*expiry) / (sigma * math.Sqrt(expiry))
+	d2 := d1 - sigma*math.Sqrt(expiry)
+	exact := s0*distuv.UnitNormal.CDF(d1) - strike*math.Exp(-r*expiry)*distuv.UnitNormal.CDF(d2)
+	fmt.Printf("Black–Scholes price: %.2f\n", exact)
+
+	// Output:
+	// Monte Carlo price:   8.01 ± 0.09
+	// Black–Scholes price: 8.02
+}
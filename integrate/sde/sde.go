@@ -0,0 +1,307 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultSteps = 1000
+
+// Noise is the structure of the noise of a Problem.
+type Noise int
+
+const (
+	// Diagonal noise drives every component of the solution by its own
+	// independent Wiener process, so that the diffusion is a diagonal
+	// matrix. The diffusion is given by its n diagonal elements, where n
+	// is the dimension of the system. The methods of higher order assume
+	// in addition that the i-th diagonal element depends only on the i-th
+	// component of the solution.
+	Diagonal Noise = iota
+	// General noise drives the system by Problem.Wiener independent Wiener
+	// processes through an n×m diffusion matrix, stored in row-major order.
+	General
+)
+
+// Problem describes the system of Itô stochastic differential equations
+//
+//	dX = f(t, X) dt + g(t, X) dW
+//
+// to be integrated, where W is a vector of independent Wiener processes.
+type Problem struct {
+	// Drift evaluates f(t, x) and stores the result in dst. Drift must not
+	// modify x.
+	Drift func(dst []float64, t float64, x []float64)
+
+	// Diffusion evaluates g(t, x) and stores the result in dst. For
+	// Diagonal noise, dst has the length of x, and for General noise, dst
+	// holds the len(x)×Wiener matrix g in row-major order. Diffusion must
+	// not modify x.
+	Diffusion func(dst []float64, t float64, x []float64)
+
+	// DiffusionDeriv evaluates the derivatives ∂g_i/∂x_i of the diagonal
+	// elements of the diffusion for Diagonal noise and stores the result
+	// in dst. DiffusionDeriv must not modify x. DiffusionDeriv is only
+	// used by Milstein. If DiffusionDeriv is nil, Milstein uses the
+	// derivative-free Milstein scheme, which approximates the derivatives
+	// with one additional evaluation of Diffusion per step.
+	DiffusionDeriv func(dst []float64, t float64, x []float64)
+
+	// Noise is the structure of the noise.
+	Noise Noise
+
+	// Wiener is the number of Wiener processes for General noise. Wiener
+	// is ignored for Diagonal noise.
+	Wiener int
+}
+
+// Settings holds the parameters of the integration. A nil *Settings is
+// equivalent to the zero value, see the field comments for the default
+// values.
+type Settings struct {
+	// Steps is the number of steps of equal size from t0 to t1. If Steps
+	// is zero, a default value of 1000 is used.
+	Steps int
+
+	// Path specifies whether the solution after every step is recorded in
+	// Result.Path.
+	Path bool
+}
+
+// Result holds a simulated sample path.
+type Result struct {
+	// X is the solution at t1.
+	X []float64
+
+	// W holds the values of the Wiener processes at t1. The Wiener
+	// processes are zero at t0.
+	W []float64
+
+	// Path holds the solution at the times t0 + k*(t1-t0)/Steps for
+	// k = 0, ..., Steps in its rows. Path is nil unless Settings.Path is
+	// true.
+	Path *mat.Dense
+}
+
+// Method is an integration method. Method is implemented by EulerMaruyama,
+// Milstein, SRI and SRA.
+type Method interface {
+	// stepper returns the stepper of the method for sys. It panics if
+	// the method does not support the noise of sys.
+	stepper(sys *system) stepper
+	// timeIntegrals reports whether the method uses the integrals of the
+	// Wiener processes over time in the increments.
+	timeIntegrals() bool
+}
+
+// stepper advances the solution over single steps.
+type stepper interface {
+	// step advances x in place over the step from t given by inc.
+	step(x []float64, t float64, inc *increment)
+}
+
+// increment holds the increments of the Wiener processes over a step.
+type increment struct {
+	// h is the step size.
+	h float64
+	// dW holds the increments W(t+h) - W(t).
+	dW []float64
+	// i10 holds the integrals of W(s) - W(t) over s from t to t+h if
+	// the method uses them.
+	i10 []float64
+}
+
+// system holds the problem and its dimensions.
+type system struct {
+	p Problem
+	// n is the dimension of the system and m is the number of Wiener
+	// processes.
+	n, m int
+}
+
+// diagonal reports whether the system has diagonal noise.
+func (sys *system) diagonal() bool {
+	return sys.p.Noise == Diagonal
+}
+
+// diffusionLen returns the length of the result of Diffusion.
+func (sys *system) diffusionLen() int {
+	if sys.diagonal() {
+		return sys.n
+	}
+	return sys.n * sys.m
+}
+
+// addNoise adds alpha*g*w to dst, where g is the diffusion as evaluated by
+// Diffusion and w is a vector of length m.
+func (sys *system) addNoise(dst []float64, alpha float64, g, w []float64) {
+	if sys.diagonal() {
+		for i, gi := range g {
+			dst[i] += alpha * gi * w[i]
+		}
+		return
+	}
+	for i := range dst {
+		var s float64
+		for j, gij := range g[i*sys.m : (i+1)*sys.m] {
+			s += gij * w[j]
+		}
+		dst[i] += alpha * s
+	}
+}
+
+// Solve simulates a sample path of the solution of the initial value
+// problem
+//
+//	dX = f(t, X) dt + g(t, X) dW, X(t0) = x0
+//
+// from t0 to t1 using the given method with steps of equal size. If method
+// is nil, EulerMaruyama is used. If settings is nil, the default settings
+// are used. If src is not nil, it is used to generate the Wiener increments,
+// otherwise the global rand package is used.
+//
+// Solve panics if Drift or Diffusion is nil, if x0 has zero length, if t0 or
+// t1 is not finite, if t1 <= t0, if the settings are invalid, or if the
+// method does not support the noise of the problem.
+func Solve(p Problem, t0, t1 float64, x0 []float64, settings *Settings, method Method, src rand.Source) *Result {
+	if p.Drift == nil || p.Diffusion == nil {
+		panic("sde: nil Drift or Diffusion")
+	}
+	if len(x0) == 0 {
+		panic("sde: zero length initial value")
+	}
+	if math.IsInf(t0, 0) || math.IsNaN(t0) || math.IsInf(t1, 0) || math.IsNaN(t1) {
+		panic("sde: interval not finite")
+	}
+	if t1 <= t0 {
+		panic("sde: t1 <= t0")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	steps := settings.Steps
+	switch {
+	case steps < 0:
+		panic("sde: negative number of steps")
+	case steps == 0:
+		steps = defaultSteps
+	}
+	if method == nil {
+		method = EulerMaruyama{}
+	}
+
+	sys := &system{p: p, n: len(x0)}
+	switch p.Noise {
+	case Diagonal:
+		sys.m = sys.n
+	case General:
+		if p.Wiener < 1 {
+			panic("sde: fewer than one Wiener process")
+		}
+		sys.m = p.Wiener
+	default:
+		panic("sde: unknown noise")
+	}
+	s := method.stepper(sys)
+
+	norm := rand.NormFloat64
+	if src != nil {
+		norm = rand.New(src).NormFloat64
+	}
+
+	res := &Result{
+		X: make([]float64, sys.n),
+		W: make([]float64, sys.m),
+	}
+	copy(res.X, x0)
+	if settings.Path {
+		res.Path = mat.NewDense(steps+1, sys.n, nil)
+		res.Path.SetRow(0, x0)
+	}
+	h := (t1 - t0) / float64(steps)
+	sqrtH := math.Sqrt(h)
+	inc := &increment{h: h, dW: make([]float64, sys.m)}
+	if method.timeIntegrals() {
+		inc.i10 = make([]float64, sys.m)
+	}
+	for k := 0; k < steps; k++ {
+		for j := range inc.dW {
+			inc.dW[j] = sqrtH * norm()
+			res.W[j] += inc.dW[j]
+		}
+		if inc.i10 != nil {
+			// The integral of W(s) - W(t) over the step is normally
+			// distributed with variance h^3/3 and covariance h^2/2
+			// with the increment.
+			for j, dw := range inc.dW {
+				inc.i10[j] = h / 2 * (dw + sqrtH*norm()/math.Sqrt(3))
+			}
+		}
+		// The time is computed from the step index to avoid the
+		// accumulation of rounding errors.
+		s.step(res.X, t0+float64(k)*h, inc)
+		if res.Path != nil {
+			res.Path.SetRow(k+1, res.X)
+		}
+	}
+	return res
+}
+
+// Ensemble simulates the given number of independent sample paths of the
+// solution of the initial value problem with Solve. The Wiener increments of
+// the i-th path are generated by rand.NewPCG(seed, uint64(i)), so the
+// results are reproducible for the same seed and do not depend on the
+// concurrency. The i-th element of the returned slice is the i-th path.
+//
+// If concurrent <= 0, the paths are simulated serially, while if
+// concurrent > 0, at most concurrent paths are simulated simultaneously, in
+// which case the functions of p must be safe for concurrent use.
+//
+// Ensemble panics if paths is negative and under the same conditions as
+// Solve.
+func Ensemble(p Problem, t0, t1 float64, x0 []float64, paths int, settings *Settings, method Method, seed uint64, concurrent int) []*Result {
+	if paths < 0 {
+		panic("sde: negative number of paths")
+	}
+	res := make([]*Result, paths)
+	if paths == 0 {
+		return res
+	}
+	// The first path is simulated on the calling goroutine so that
+	// invalid arguments panic there.
+	res[0] = Solve(p, t0, t1, x0, settings, method, rand.NewPCG(seed, 0))
+	if concurrent > paths-1 {
+		concurrent = paths - 1
+	}
+	if concurrent <= 0 {
+		for i := 1; i < paths; i++ {
+			res[i] = Solve(p, t0, t1, x0, settings, method, rand.NewPCG(seed, uint64(i)))
+		}
+		return res
+	}
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for w := 0; w < concurrent; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				res[i] = Solve(p, t0, t1, x0, settings, method, rand.NewPCG(seed, uint64(i)))
+			}
+		}()
+	}
+	for i := 1; i < paths; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return res
+}
@@ -0,0 +1,291 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/stat"
+)
+
+// testProblem is a test problem whose solution at t1 is a known function of
+// the values of the Wiener processes at t1.
+type testProblem struct {
+	name   string
+	p      Problem
+	t0, t1 float64
+	x0     []float64
+	// exact stores in dst the solution at t1 given the values w of the
+	// Wiener processes at t1.
+	exact func(dst, w []float64)
+}
+
+// gbm returns geometric Brownian motions with Diagonal noise.
+func gbm() testProblem {
+	mu := []float64{1, -0.5}
+	sigma := []float64{0.5, 0.8}
+	x0 := []float64{1, 2}
+	const t0, t1 = 0, 1
+	return testProblem{
+		name: "GBM",
+		p: Problem{
+			Drift: func(dst []float64, t float64, x []float64) {
+				for i, v := range x {
+					dst[i] = mu[i] * v
+				}
+			},
+			Diffusion: func(dst []float64, t float64, x []float64) {
+				for i, v := range x {
+					dst[i] = sigma[i] * v
+				}
+			},
+			DiffusionDeriv: func(dst []float64, t float64, x []float64) {
+				copy(dst, sigma)
+			},
+		},
+		t0: t0,
+		t1: t1,
+		x0: x0,
+		exact: func(dst, w []float64) {
+			for i := range dst {
+				dst[i] = x0[i] * math.Exp((mu[i]-sigma[i]*sigma[i]/2)*(t1-t0)+sigma[i]*w[i])
+			}
+		},
+	}
+}
+
+// gbmGeneral returns a geometric Brownian motion driven by two Wiener
+// processes with General noise.
+func gbmGeneral() testProblem {
+	const mu, s1, s2 = 1, 0.3, 0.4
+	const t0, t1 = 0, 1
+	return testProblem{
+		name: "GBM general",
+		p: Problem{
+			Drift: func(dst []float64, t float64, x []float64) {
+				dst[0] = mu * x[0]
+			},
+			Diffusion: func(dst []float64, t float64, x []float64) {
+				dst[0] = s1 * x[0]
+				dst[1] = s2 * x[0]
+			},
+			Noise:  General,
+			Wiener: 2,
+		},
+		t0: t0,
+		t1: t1,
+		x0: []float64{1},
+		exact: func(dst, w []float64) {
+			dst[0] = math.Exp((mu-(s1*s1+s2*s2)/2)*(t1-t0) + s1*w[0] + s2*w[1])
+		},
+	}
+}
+
+// additive returns a linear problem with additive noise whose solution is
+//
+//	X(t) = x0 + (1+t) B W(t) + c(t) ,
+//
+// with c(t) = (sin(t), t^2) and a full matrix B for General noise or a
+// diagonal matrix B for Diagonal noise.
+func additive(noise Noise) testProblem {
+	b := []float64{0.5, 0.2, -0.3, 0.6}
+	if noise == Diagonal {
+		b = []float64{0.5, 0.6}
+	}
+	x0 := []float64{1, -1}
+	const t0, t1 = 0, 1
+	name := "additive general"
+	if noise == Diagonal {
+		name = "additive diagonal"
+	}
+	return testProblem{
+		name: name,
+		p: Problem{
+			Drift: func(dst []float64, t float64, x []float64) {
+				// B W(t) = (X(t) - x0 - c(t)) / (1+t).
+				dst[0] = (x[0]-x0[0]-math.Sin(t))/(1+t) + math.Cos(t)
+				dst[1] = (x[1]-x0[1]-t*t)/(1+t) + 2*t
+			},
+			Diffusion: func(dst []float64, t float64, x []float64) {
+				for i, v := range b {
+					dst[i] = (1 + t) * v
+				}
+			},
+			Noise:  noise,
+			Wiener: 2,
+		},
+		t0: t0,
+		t1: t1,
+		x0: x0,
+		exact: func(dst, w []float64) {
+			bw := []float64{b[0] * w[0], b[1] * w[1]}
+			if noise == General {
+				bw = []float64{b[0]*w[0] + b[1]*w[1], b[2]*w[0] + b[3]*w[1]}
+			}
+			dst[0] = x0[0] + (1+t1)*bw[0] + math.Sin(t1)
+			dst[1] = x0[1] + (1+t1)*bw[1] + t1*t1
+		},
+	}
+}
+
+// convergenceOrder returns the order of convergence of the error at t1 of
+// the paths simulated by method with the given numbers of steps, estimated
+// by the least squares fit of the logarithms of the errors and the step
+// sizes. If strong is true, the error is the mean of the errors of the
+// paths, otherwise it is the error of the mean of the first component of
+// the solution.
+func convergenceOrder(test testProblem, method Method, steps []int, paths int, strong bool) (order float64, errs []float64) {
+	var logH, logErr []float64
+	want := make([]float64, len(test.x0))
+	for _, n := range steps {
+		res := Ensemble(test.p, test.t0, test.t1, test.x0, paths, &Settings{Steps: n}, method, uint64(n), 4)
+		var sum float64
+		for _, r := range res {
+			test.exact(want, r.W)
+			if strong {
+				sum += floats.Distance(r.X, want, math.Inf(1))
+			} else {
+				// The mean of the exact solution at the values of
+				// the Wiener processes of the paths is a control
+				// variate for the mean of the simulated solution,
+				// which reduces the variance of the estimate of
+				// the weak error.
+				sum += r.X[0] - want[0]
+			}
+		}
+		err := math.Abs(sum / float64(paths))
+		errs = append(errs, err)
+		logH = append(logH, math.Log((test.t1-test.t0)/float64(n)))
+		logErr = append(logErr, math.Log(err))
+	}
+	_, order = stat.LinearRegression(logH, logErr, nil, false)
+	return order, errs
+}
+
+type orderTest struct {
+	problem testProblem
+	method  Method
+	strong  float64
+	weak    float64
+}
+
+func testOrders(t *testing.T, tests []orderTest) {
+	for _, test := range tests {
+		// The estimated orders are allowed to be slightly lower than
+		// the theoretical orders because of the statistical errors and
+		// the terms of higher order.
+		order, errs := convergenceOrder(test.problem, test.method, []int{4, 8, 16, 32, 64}, 200, true)
+		if order < test.strong-0.2 {
+			t.Errorf("%s %T: strong order too low: got %.3f, want %v (errors %v)",
+				test.problem.name, test.method, order, test.strong, errs)
+		}
+		if test.weak == 0 {
+			continue
+		}
+		order, errs = convergenceOrder(test.problem, test.method, []int{2, 4, 8, 16}, 10000, false)
+		if order < test.weak-0.4 {
+			t.Errorf("%s %T: weak order too low: got %.3f, want %v (errors %v)",
+				test.problem.name, test.method, order, test.weak, errs)
+		}
+	}
+}
+
+func TestSolvePath(t *testing.T) {
+	t.Parallel()
+	test := gbm()
+	const steps = 50
+	for _, method := range []Method{EulerMaruyama{}, Milstein{}, SRI{}, SRA{}} {
+		res := Solve(test.p, test.t0, test.t1, test.x0, &Settings{Steps: steps, Path: true}, method, rand.NewPCG(1, 2))
+		if r, c := res.Path.Dims(); r != steps+1 || c != len(test.x0) {
+			t.Errorf("%T: unexpected path dimensions: %d×%d", method, r, c)
+		}
+		if !floats.Equal(res.Path.RawRowView(0), test.x0) {
+			t.Errorf("%T: path does not start at the initial value", method)
+		}
+		if !floats.Equal(res.Path.RawRowView(steps), res.X) {
+			t.Errorf("%T: path does not end at the solution", method)
+		}
+		again := Solve(test.p, test.t0, test.t1, test.x0, &Settings{Steps: steps}, method, rand.NewPCG(1, 2))
+		if again.Path != nil {
+			t.Errorf("%T: unexpected path", method)
+		}
+		if !floats.Equal(again.X, res.X) || !floats.Equal(again.W, res.W) {
+			t.Errorf("%T: sample path not reproducible with the same source", method)
+		}
+	}
+}
+
+func TestEnsemble(t *testing.T) {
+	t.Parallel()
+	test := gbmGeneral()
+	const paths = 20
+	serial := Ensemble(test.p, test.t0, test.t1, test.x0, paths, &Settings{Steps: 10}, nil, 7, 0)
+	concurrent := Ensemble(test.p, test.t0, test.t1, test.x0, paths, &Settings{Steps: 10}, nil, 7, 5)
+	for i := range serial {
+		single := Solve(test.p, test.t0, test.t1, test.x0, &Settings{Steps: 10}, nil, rand.NewPCG(7, uint64(i)))
+		if !floats.Equal(serial[i].X, single.X) || !floats.Equal(serial[i].W, single.W) {
+			t.Errorf("path %d: mismatch between Ensemble and Solve", i)
+		}
+		if !floats.Equal(serial[i].X, concurrent[i].X) {
+			t.Errorf("path %d: mismatch between serial and concurrent simulation", i)
+		}
+		if i > 0 && serial[i].X[0] == serial[i-1].X[0] {
+			t.Errorf("path %d: paths not independent", i)
+		}
+	}
+	if res := Ensemble(test.p, test.t0, test.t1, test.x0, 0, nil, nil, 7, 2); len(res) != 0 {
+		t.Errorf("unexpected paths: %d", len(res))
+	}
+}
+
+func TestPanics(t *testing.T) {
+	t.Parallel()
+	diag := gbm()
+	general := gbmGeneral()
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "nil Drift", fn: func() {
+			p := diag.p
+			p.Drift = nil
+			Solve(p, 0, 1, diag.x0, nil, nil, nil)
+		}},
+		{name: "empty initial value", fn: func() { Solve(diag.p, 0, 1, nil, nil, nil, nil) }},
+		{name: "infinite interval", fn: func() { Solve(diag.p, 0, math.Inf(1), diag.x0, nil, nil, nil) }},
+		{name: "reversed interval", fn: func() { Solve(diag.p, 1, 0, diag.x0, nil, nil, nil) }},
+		{name: "negative steps", fn: func() { Solve(diag.p, 0, 1, diag.x0, &Settings{Steps: -1}, nil, nil) }},
+		{name: "unknown noise", fn: func() {
+			p := diag.p
+			p.Noise = Noise(5)
+			Solve(p, 0, 1, diag.x0, nil, nil, nil)
+		}},
+		{name: "no Wiener process", fn: func() {
+			p := general.p
+			p.Wiener = 0
+			Solve(p, 0, 1, general.x0, nil, nil, nil)
+		}},
+		{name: "Milstein general noise", fn: func() { Solve(general.p, 0, 1, general.x0, nil, Milstein{}, nil) }},
+		{name: "SRI general noise", fn: func() { Solve(general.p, 0, 1, general.x0, nil, SRI{}, nil) }},
+		{name: "negative paths", fn: func() { Ensemble(diag.p, 0, 1, diag.x0, -1, nil, nil, 1, 0) }},
+		{name: "Ensemble invalid", fn: func() { Ensemble(general.p, 0, 1, general.x0, 10, nil, SRI{}, 1, 4) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
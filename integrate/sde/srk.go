@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import "math"
+
+// SRI is the stochastic Runge–Kutta method SRIW1 of Rößler for Itô
+// equations with Diagonal noise, where the i-th diagonal element of the
+// diffusion depends only on the i-th component of the solution. SRI has
+// strong order 3/2 and weak order 2, and uses two evaluations of the drift
+// and four evaluations of the diffusion per step.
+//
+// Reference:
+//
+//	Rößler, A. (2010). Runge–Kutta methods for the strong approximation of
+//	solutions of stochastic differential equations. SIAM Journal on
+//	Numerical Analysis 48(3), 922-952.
+type SRI struct{}
+
+func (SRI) stepper(sys *system) stepper {
+	if !sys.diagonal() {
+		panic("sde: SRI requires diagonal noise")
+	}
+	n := sys.n
+	return &sri{
+		sys: sys,
+		f0:  make([]float64, n),
+		f1:  make([]float64, n),
+		g0:  make([]float64, n),
+		g1:  make([]float64, n),
+		g2:  make([]float64, n),
+		g3:  make([]float64, n),
+		y:   make([]float64, n),
+	}
+}
+
+func (SRI) timeIntegrals() bool { return true }
+
+type sri struct {
+	sys               *system
+	f0, f1            []float64
+	g0, g1, g2, g3, y []float64
+}
+
+func (s *sri) step(x []float64, t float64, inc *increment) {
+	p := s.sys.p
+	h := inc.h
+	sqrtH := math.Sqrt(h)
+
+	p.Drift(s.f0, t, x)
+	p.Diffusion(s.g0, t, x)
+	for i, xi := range x {
+		s.y[i] = xi + h*s.f0[i]/4 + sqrtH*s.g0[i]/2
+	}
+	p.Diffusion(s.g1, t+h/4, s.y)
+	for i, xi := range x {
+		s.y[i] = xi + h*s.f0[i] - sqrtH*s.g0[i]
+	}
+	p.Diffusion(s.g2, t+h, s.y)
+	for i, xi := range x {
+		s.y[i] = xi + h*s.f0[i]/4 + sqrtH*(-5*s.g0[i]+3*s.g1[i]+s.g2[i]/2)
+	}
+	p.Diffusion(s.g3, t+h/4, s.y)
+	for i, xi := range x {
+		s.y[i] = xi + 3*h*s.f0[i]/4 + 3*s.g0[i]*inc.i10[i]/(2*h)
+	}
+	p.Drift(s.f1, t+3*h/4, s.y)
+
+	for i := range x {
+		// The iterated integrals I_(1), I_(1,1)/√h, I_(1,0)/h and
+		// I_(1,1,1)/h of the i-th Wiener process.
+		dw := inc.dW[i]
+		chi1 := (dw*dw - h) / (2 * sqrtH)
+		chi2 := inc.i10[i] / h
+		chi3 := (dw*dw*dw - 3*h*dw) / (6 * h)
+		g0, g1, g2, g3 := s.g0[i], s.g1[i], s.g2[i], s.g3[i]
+		x[i] += h*(s.f0[i]+2*s.f1[i])/3 +
+			dw*(-g0+4*g1/3+2*g2/3) +
+			chi1*(-g0+4*g1/3-g2/3) +
+			chi2*(2*g0-4*g1/3-2*g2/3) +
+			chi3*(-2*g0+5*g1/3-2*g2/3+g3)
+	}
+}
+
+// SRA is the stochastic Runge–Kutta method SRA1 of Rößler for Itô equations
+// with additive noise, where the diffusion does not depend on the solution.
+// SRA has strong order 3/2 and weak order 2 for additive noise, and uses two
+// evaluations of the drift and of the diffusion per step. SRA supports
+// Diagonal and General noise. The diffusion is evaluated at the solution at
+// the start of the step, and the order of SRA is reduced if the diffusion
+// depends on it.
+//
+// Reference:
+//
+//	Rößler, A. (2010). Runge–Kutta methods for the strong approximation of
+//	solutions of stochastic differential equations. SIAM Journal on
+//	Numerical Analysis 48(3), 922-952.
+type SRA struct{}
+
+func (SRA) stepper(sys *system) stepper {
+	return &sra{
+		sys: sys,
+		f0:  make([]float64, sys.n),
+		f1:  make([]float64, sys.n),
+		g0:  make([]float64, sys.diffusionLen()),
+		g1:  make([]float64, sys.diffusionLen()),
+		y:   make([]float64, sys.n),
+		w:   make([]float64, sys.m),
+	}
+}
+
+func (SRA) timeIntegrals() bool { return true }
+
+type sra struct {
+	sys            *system
+	f0, f1, g0, g1 []float64
+	y, w           []float64
+}
+
+func (s *sra) step(x []float64, t float64, inc *increment) {
+	p := s.sys.p
+	h := inc.h
+
+	p.Drift(s.f0, t, x)
+	p.Diffusion(s.g0, t, x)
+	p.Diffusion(s.g1, t+h, x)
+	for i, xi := range x {
+		s.y[i] = xi + 3*h*s.f0[i]/4
+	}
+	s.sys.addNoise(s.y, 3/(2*h), s.g1, inc.i10)
+	p.Drift(s.f1, t+3*h/4, s.y)
+
+	for i := range x {
+		x[i] += h * (s.f0[i] + 2*s.f1[i]) / 3
+	}
+	for j, dw := range inc.dW {
+		s.w[j] = dw - inc.i10[j]/h
+	}
+	s.sys.addNoise(x, 1, s.g1, s.w)
+	s.sys.addNoise(x, 1/h, s.g0, inc.i10)
+}
@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import "testing"
+
+func TestSRI(t *testing.T) {
+	t.Parallel()
+	testOrders(t, []orderTest{
+		{problem: gbm(), method: SRI{}, strong: 1.5, weak: 2},
+		{problem: additive(Diagonal), method: SRI{}, strong: 1.5},
+	})
+}
+
+func TestSRA(t *testing.T) {
+	t.Parallel()
+	testOrders(t, []orderTest{
+		{problem: additive(General), method: SRA{}, strong: 1.5, weak: 2},
+		{problem: additive(Diagonal), method: SRA{}, strong: 1.5},
+	})
+}
@@ -70,3 +70,64 @@ func Simpsons(x, f []float64) float64 {
 
 	return integral
 }
+
+// CumulativeSimpsons computes the running integral
+//
+//	dst[i] = \int_x[0]^x[i] f(x) dx
+//
+// using the Simpsons's method and returns dst. The function f is given as a
+// slice of samples evaluated at locations in x as for Simpsons. The integral
+// over every interval is that of the quadratic interpolating f on the pair of
+// intervals it belongs to in Simpsons, so that dst[len(x)-1] is equal to
+// Simpsons(x, f) up to rounding. If dst is nil, a new slice is allocated,
+// otherwise dst must have the length of x.
+//
+// The slice x must be sorted in strictly increasing order. x and f must be of
+// equal length and the length must be at least 3.
+func CumulativeSimpsons(dst, x, f []float64) []float64 {
+	n := len(x)
+	switch {
+	case len(f) != n:
+		panic("integrate: slice length mismatch")
+	case n < 3:
+		panic("integrate: input data too small")
+	case !sort.Float64sAreSorted(x):
+		panic("integrate: must be sorted")
+	}
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("integrate: destination length mismatch")
+	}
+
+	dst[0] = 0
+	for i := 1; i < n; i += 2 {
+		// The quadratic interpolates f at x[j-1], x[j] and x[j+1]. For
+		// an even number of samples, the last interval is integrated
+		// using the quadratic of the last three samples.
+		j := i
+		if j == n-1 {
+			j = n - 2
+		}
+		h0 := x[j] - x[j-1]
+		h1 := x[j+1] - x[j]
+		if h0 == 0 || h1 == 0 {
+			panic("integrate: repeated abscissa")
+		}
+		hph := h0 + h1
+		if j == i {
+			// Integral over [x[j-1], x[j]].
+			a0 := h0 * (2*h0 + 3*h1) / (6 * hph)
+			a1 := h0 * (h0 + 3*h1) / (6 * h1)
+			a2 := -h0 * h0 * h0 / (6 * h1 * hph)
+			dst[i] = dst[i-1] + a0*f[j-1] + a1*f[j] + a2*f[j+1]
+		}
+		// Integral over [x[j], x[j+1]].
+		a0 := -h1 * h1 * h1 / (6 * h0 * hph)
+		a1 := h1 * (h1 + 3*h0) / (6 * h0)
+		a2 := h1 * (2*h1 + 3*h0) / (6 * hph)
+		dst[j+1] = dst[j] + a0*f[j-1] + a1*f[j] + a2*f[j+1]
+	}
+	return dst
+}
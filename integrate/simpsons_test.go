@@ -60,3 +60,46 @@ func TestSimpsons(t *testing.T) {
 		}
 	}
 }
+
+func TestCumulativeSimpsons(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{3, 4, 5, 10, 11} {
+		x := jitterSpan(n, -1, 2, rnd)
+
+		// The running integral of a quadratic is exact.
+		f := make([]float64, n)
+		for i, xi := range x {
+			f[i] = 3*xi*xi - 2*xi + 1
+		}
+		got := CumulativeSimpsons(nil, x, f)
+		for i, xi := range x {
+			want := (xi*xi*xi - xi*xi + xi) - (-1 - 1 - 1)
+			if math.Abs(got[i]-want) > 1e-13 {
+				t.Errorf("n=%d: unexpected running integral at %d: got=%v want=%v", n, i, got[i], want)
+			}
+		}
+
+		for i, xi := range x {
+			f[i] = math.Exp(xi)
+		}
+		got = CumulativeSimpsons(nil, x, f)
+		if want := Simpsons(x, f); math.Abs(got[n-1]-want) > 1e-13 {
+			t.Errorf("n=%d: mismatch with Simpsons: got=%v want=%v", n, got[n-1], want)
+		}
+	}
+
+	// The running integral converges at every sample.
+	const n = 201
+	x := jitterSpan(n, 0, math.Pi, rnd)
+	f := make([]float64, n)
+	for i, xi := range x {
+		f[i] = math.Sin(xi)
+	}
+	got := CumulativeSimpsons(nil, x, f)
+	for i, xi := range x {
+		if want := 1 - math.Cos(xi); math.Abs(got[i]-want) > 1e-7 {
+			t.Errorf("unexpected running integral at %d: got=%v want=%v", i, got[i], want)
+		}
+	}
+}
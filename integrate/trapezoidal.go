@@ -47,3 +47,38 @@ func Trapezoidal(x, f []float64) float64 {
 
 	return integral
 }
+
+// CumulativeTrapezoidal computes the running integral
+//
+//	dst[i] = \int_x[0]^x[i] f(x) dx
+//
+// using the trapezoidal rule and returns dst. The function f is given as a
+// slice of samples evaluated at locations in x as for Trapezoidal, and
+// dst[len(x)-1] is equal to Trapezoidal(x, f). If dst is nil, a new slice is
+// allocated, otherwise dst must have the length of x.
+//
+// The slice x must be sorted in strictly increasing order. x and f must be of
+// equal length and the length must be at least 2.
+func CumulativeTrapezoidal(dst, x, f []float64) []float64 {
+	n := len(x)
+	switch {
+	case len(f) != n:
+		panic("integrate: slice length mismatch")
+	case n < 2:
+		panic("integrate: input data too small")
+	case !sort.Float64sAreSorted(x):
+		panic("integrate: input must be sorted")
+	}
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("integrate: destination length mismatch")
+	}
+
+	dst[0] = 0
+	for i := 0; i < n-1; i++ {
+		dst[i+1] = dst[i] + 0.5*(x[i+1]-x[i])*(f[i+1]+f[i])
+	}
+	return dst
+}
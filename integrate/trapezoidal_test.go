@@ -71,3 +71,49 @@ func jitterSpan(n int, a, b float64, rnd *rand.Rand) []float64 {
 	x[n-1] = b
 	return x
 }
+
+func TestCumulativeTrapezoidal(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		integral testquad.Integral
+		n        int
+	}{
+		{integral: testquad.Poly(1), n: 2},
+		{integral: testquad.Poly(3), n: 17},
+		{integral: testquad.Sin(), n: 100},
+	} {
+		x := jitterSpan(test.n, test.integral.A, test.integral.B, rnd)
+		f := make([]float64, test.n)
+		for i, xi := range x {
+			f[i] = test.integral.F(xi)
+		}
+		got := CumulativeTrapezoidal(nil, x, f)
+		if got[0] != 0 {
+			t.Errorf("%v, n=%d: non-zero initial value: %v", test.integral.Name, test.n, got[0])
+		}
+		for i := 1; i < test.n; i++ {
+			want := Trapezoidal(x[:i+1], f[:i+1])
+			if math.Abs(got[i]-want) > 1e-13 {
+				t.Errorf("%v, n=%d: unexpected running integral at %d: got=%v want=%v",
+					test.integral.Name, test.n, i, got[i], want)
+			}
+		}
+		dst := make([]float64, test.n)
+		if CumulativeTrapezoidal(dst, x, f); !equalSlices(dst, got) {
+			t.Errorf("%v, n=%d: mismatch with the provided destination", test.integral.Name, test.n)
+		}
+	}
+}
+
+func equalSlices(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
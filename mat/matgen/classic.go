@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matgen
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Hilbert returns the n×n Hilbert matrix
+//
+//	A[i,j] = 1 / (i+j+1).
+//
+// The Hilbert matrix is symmetric positive definite and notoriously
+// ill-conditioned; its condition number grows like exp(3.5*n).
+func Hilbert(n int) *mat.SymDense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, 1/float64(i+j+1))
+		}
+	}
+	return a
+}
+
+// InverseHilbert returns the inverse of the n×n Hilbert matrix. Its elements
+// are integers given by
+//
+//	A[i,j] = (-1)^(i+j) (i+j+1) C(n+i,n-j-1) C(n+j,n-i-1) C(i+j,i)^2,
+//
+// where C is the binomial coefficient. The elements are computed exactly
+// in floating point for n up to about 13.
+func InverseHilbert(n int) *mat.SymDense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			c := binomial(i+j, i)
+			v := float64(i+j+1) * binomial(n+i, n-j-1) * binomial(n+j, n-i-1) * c * c
+			if (i+j)%2 == 1 {
+				v = -v
+			}
+			a.SetSym(i, j, v)
+		}
+	}
+	return a
+}
+
+// Kahan returns the n×n upper triangular Kahan matrix
+//
+//	A = diag(1, s, ..., s^(n-1)) * (I - c*N),
+//
+// where s = sin(theta), c = cos(theta) and N is the strictly upper triangular
+// matrix of ones. The diagonal is then perturbed by pert*eps*diag(n, n-1, ..., 1)
+// so that QR factorization with column pivoting does not reorder the columns
+// in floating point arithmetic.
+//
+// The Kahan matrix is ill-conditioned, yet its diagonal gives no indication of
+// this, so it is a standard example of the failure of rank-revealing QR
+// factorizations.
+func Kahan(n int, theta, pert float64) *mat.TriDense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	s, c := math.Sincos(theta)
+	const eps = 0x1p-52
+	a := mat.NewTriDense(n, mat.Upper, nil)
+	scale := 1.0
+	for i := 0; i < n; i++ {
+		a.SetTri(i, i, scale+pert*eps*float64(n-i))
+		for j := i + 1; j < n; j++ {
+			a.SetTri(i, j, -c*scale)
+		}
+		scale *= s
+	}
+	return a
+}
+
+// Wilkinson returns the n×n Wilkinson matrix W_n⁺, the symmetric tridiagonal
+// matrix with ones on its off-diagonals and
+//
+//	A[i,i] = |(n-1)/2 - i|
+//
+// on its diagonal. For odd n the diagonal is integral. The largest
+// eigenvalues of W_n⁺ occur in nearly, but not exactly, equal pairs.
+func Wilkinson(n int) *mat.SymDense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	a := mat.NewSymDense(n, nil)
+	m := float64(n-1) / 2
+	for i := 0; i < n; i++ {
+		a.SetSym(i, i, math.Abs(m-float64(i)))
+		if i < n-1 {
+			a.SetSym(i, i+1, 1)
+		}
+	}
+	return a
+}
+
+// Frank returns the n×n Frank matrix, the upper Hessenberg matrix given by
+//
+//	A[i,j] = n - max(i,j)  if j >= i-1,
+//	       = 0             otherwise.
+//
+// The Frank matrix has determinant one and real positive eigenvalues
+// occurring in reciprocal pairs, the smallest of which are ill-conditioned.
+func Frank(n int) *mat.Dense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-1); j < n; j++ {
+			a.Set(i, j, float64(n-max(i, j)))
+		}
+	}
+	return a
+}
+
+// Pascal returns the n×n symmetric Pascal matrix
+//
+//	A[i,j] = C(i+j, i),
+//
+// where C is the binomial coefficient. The Pascal matrix is positive
+// definite with determinant one, and its eigenvalues occur in reciprocal
+// pairs.
+func Pascal(n int) *mat.SymDense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, binomial(i+j, i))
+		}
+	}
+	return a
+}
+
+// Lehmer returns the n×n Lehmer matrix
+//
+//	A[i,j] = (min(i,j)+1) / (max(i,j)+1).
+//
+// The Lehmer matrix is symmetric positive definite and its inverse is
+// tridiagonal.
+func Lehmer(n int) *mat.SymDense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, float64(i+1)/float64(j+1))
+		}
+	}
+	return a
+}
+
+// binomial returns the binomial coefficient C(n,k) as a float64.
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	k = min(k, n-k)
+	v := 1.0
+	for i := 1; i <= k; i++ {
+		v = v * float64(n-k+i) / float64(i)
+	}
+	return math.Round(v)
+}
@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matgen
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestHilbert(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 10; n++ {
+		var got mat.Dense
+		got.Mul(Hilbert(n), InverseHilbert(n))
+		if !mat.EqualApprox(&got, eye(n), 1e-5*math.Pow(10, float64(n)/2)) {
+			t.Errorf("n=%d: Hilbert times InverseHilbert is not identity", n)
+		}
+	}
+	want := []float64{16, -120, 240, -140}
+	ih := InverseHilbert(4)
+	for j, v := range want {
+		if ih.At(0, j) != v {
+			t.Errorf("unexpected InverseHilbert(4)[0,%d]: got %v, want %v", j, ih.At(0, j), v)
+		}
+	}
+}
+
+func TestKahan(t *testing.T) {
+	t.Parallel()
+	const n = 30
+	theta := 1.2
+	a := Kahan(n, theta, 0)
+	s, c := math.Sincos(theta)
+	if a.At(n-1, n-1) != math.Pow(s, n-1) {
+		t.Errorf("unexpected last diagonal element: got %v, want %v", a.At(n-1, n-1), math.Pow(s, n-1))
+	}
+	if a.At(0, n-1) != -c {
+		t.Errorf("unexpected corner element: got %v, want %v", a.At(0, n-1), -c)
+	}
+	var svd mat.SVD
+	if !svd.Factorize(a, mat.SVDNone) {
+		t.Fatal("SVD failed")
+	}
+	// The smallest singular value is much smaller than the smallest
+	// diagonal element.
+	sv := svd.Values(nil)
+	if sv[n-1] > 1e-3*a.At(n-1, n-1) {
+		t.Errorf("Kahan matrix not ill-conditioned: σ_min=%v, a[n-1,n-1]=%v", sv[n-1], a.At(n-1, n-1))
+	}
+}
+
+func TestWilkinson(t *testing.T) {
+	t.Parallel()
+	// Largest eigenvalues of W_21⁺ from Wilkinson, The Algebraic Eigenvalue
+	// Problem, 1965.
+	want := []float64{10.746194182903393, 10.746194182903322}
+	var e mat.EigenSym
+	if !e.Factorize(Wilkinson(21), false) {
+		t.Fatal("eigendecomposition failed")
+	}
+	vals := e.Values(nil)
+	got := []float64{vals[20], vals[19]}
+	if !floats.EqualApprox(got, want, 1e-13) {
+		t.Errorf("unexpected largest eigenvalues: got %v, want %v", got, want)
+	}
+}
+
+func TestFrank(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 8; n++ {
+		a := Frank(n)
+		det := mat.Det(a)
+		if !scalar.EqualWithinAbsOrRel(det, 1, 1e-10, 1e-10) {
+			t.Errorf("n=%d: unexpected determinant: got %v, want 1", n, det)
+		}
+		for i := 2; i < n; i++ {
+			for j := 0; j < i-1; j++ {
+				if a.At(i, j) != 0 {
+					t.Errorf("n=%d: Frank matrix not upper Hessenberg", n)
+				}
+			}
+		}
+	}
+}
+
+func TestPascalLehmer(t *testing.T) {
+	t.Parallel()
+	for n := 1; n <= 8; n++ {
+		det := mat.Det(Pascal(n))
+		if !scalar.EqualWithinAbsOrRel(det, 1, 1e-6, 1e-6) {
+			t.Errorf("n=%d: unexpected Pascal determinant: got %v, want 1", n, det)
+		}
+
+		var inv mat.Dense
+		err := inv.Inverse(Lehmer(n))
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error inverting Lehmer matrix: %v", n, err)
+		}
+		checkBandwidth(t, "inverse Lehmer", roundSmall(&inv, 1e-10), 1, 1)
+	}
+}
+
+func roundSmall(a *mat.Dense, tol float64) *mat.Dense {
+	a.Apply(func(_, _ int, v float64) float64 {
+		if math.Abs(v) < tol {
+			return 0
+		}
+		return v
+	}, a)
+	return a
+}
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package matgen provides routines for generating test matrices with
+// prescribed properties.
+//
+// The random generators construct matrices with given singular values or
+// eigenvalues, condition number and bandwidth in the spirit of the LAPACK
+// testing routines DLATMS and DLAGSY. Classic test matrices such as the
+// Hilbert, Kahan, Wilkinson and Frank matrices are also provided.
+package matgen // import "gonum.org/v1/gonum/mat/matgen"
@@ -0,0 +1,406 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matgen
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	badCond      = "matgen: condition number less than one"
+	badMode      = "matgen: invalid mode"
+	badBandwidth = "matgen: invalid bandwidth"
+	badLength    = "matgen: bad length of values"
+	badDimension = "matgen: non-positive dimension"
+)
+
+// Mode specifies how the values returned by Spectrum are distributed
+// between 1/cond and 1.
+type Mode int
+
+const (
+	// OneLarge sets d[0] = 1 and d[i] = 1/cond for i > 0.
+	OneLarge Mode = iota + 1
+	// OneSmall sets d[i] = 1 for i < n-1 and d[n-1] = 1/cond.
+	OneSmall
+	// Geometric sets d[i] = cond^(-i/(n-1)).
+	Geometric
+	// Arithmetic sets d[i] = 1 - i/(n-1)*(1-1/cond).
+	Arithmetic
+	// LogUniform sets d[i] to random values in (1/cond, 1] such that their
+	// logarithms are uniformly distributed.
+	LogUniform
+)
+
+// Spectrum fills dst with values in [1/cond, 1] distributed according to mode
+// and returns dst. The values are suitable as singular values or eigenvalues
+// of a matrix with 2-norm condition number cond. With the exception of
+// LogUniform the values are in non-increasing order.
+//
+// If src is not nil it is used as the source of random numbers for
+// LogUniform, otherwise the functions in math/rand/v2 are used.
+//
+// Spectrum panics if cond < 1 or if mode is not one of the Mode constants.
+func Spectrum(dst []float64, mode Mode, cond float64, src rand.Source) []float64 {
+	if cond < 1 {
+		panic(badCond)
+	}
+	n := len(dst)
+	if n == 0 {
+		return dst
+	}
+	switch mode {
+	default:
+		panic(badMode)
+	case OneLarge:
+		dst[0] = 1
+		for i := 1; i < n; i++ {
+			dst[i] = 1 / cond
+		}
+	case OneSmall:
+		for i := 0; i < n-1; i++ {
+			dst[i] = 1
+		}
+		dst[n-1] = 1 / cond
+	case Geometric:
+		dst[0] = 1
+		for i := 1; i < n; i++ {
+			dst[i] = math.Pow(cond, -float64(i)/float64(n-1))
+		}
+	case Arithmetic:
+		dst[0] = 1
+		for i := 1; i < n; i++ {
+			dst[i] = 1 - float64(i)/float64(n-1)*(1-1/cond)
+		}
+	case LogUniform:
+		uniform := rand.Float64
+		if src != nil {
+			uniform = rand.New(src).Float64
+		}
+		alpha := -math.Log(cond)
+		for i := range dst {
+			dst[i] = math.Exp(alpha * uniform())
+		}
+	}
+	return dst
+}
+
+// Orthogonal returns a random n×n orthogonal matrix distributed according
+// to the Haar measure on the orthogonal group.
+//
+// If src is not nil it is used as the source of random numbers, otherwise
+// the functions in math/rand/v2 are used.
+func Orthogonal(n int, src rand.Source) *mat.Dense {
+	if n <= 0 {
+		panic(badDimension)
+	}
+	norm := rand.NormFloat64
+	if src != nil {
+		norm = rand.New(src).NormFloat64
+	}
+	g := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			g.Set(i, j, norm())
+		}
+	}
+	var qr mat.QR
+	qr.Factorize(g)
+	var q mat.Dense
+	qr.QTo(&q)
+	// Fix the signs of the columns so that the diagonal of R is positive.
+	// Without this the distribution of Q is not uniform.
+	for j := 0; j < n; j++ {
+		if qr.At(j, j) < 0 {
+			for i := 0; i < n; i++ {
+				q.Set(i, j, -q.At(i, j))
+			}
+		}
+	}
+	return &q
+}
+
+// General returns a random m×n matrix A with singular values sv, with kl
+// sub-diagonals and ku super-diagonals. The matrix is computed as
+//
+//	A = U * D * Vᵀ,
+//
+// where D is the m×n diagonal matrix with sv on its diagonal and U and V are
+// random orthogonal matrices. The bandwidth of A is then reduced to kl and ku
+// by a sequence of orthogonal Householder transformations which preserve the
+// singular values. If both kl and ku are zero, the returned matrix is D.
+//
+// The 2-norm condition number of the returned matrix is the ratio of the
+// largest and smallest absolute values in sv, so Spectrum can be used to
+// generate a matrix with a given condition number.
+//
+// If src is not nil it is used as the source of random numbers, otherwise
+// the functions in math/rand/v2 are used.
+//
+// General panics if len(sv) != min(m,n), or if kl or ku are negative.
+func General(m, n, kl, ku int, sv []float64, src rand.Source) *mat.Dense {
+	if m <= 0 || n <= 0 {
+		panic(badDimension)
+	}
+	if kl < 0 || ku < 0 {
+		panic(badBandwidth)
+	}
+	if len(sv) != min(m, n) {
+		panic(badLength)
+	}
+	a := mat.NewDense(m, n, nil)
+	for i, v := range sv {
+		a.Set(i, i, v)
+	}
+	if kl == 0 && ku == 0 {
+		return a
+	}
+	u := Orthogonal(m, src)
+	v := Orthogonal(n, src)
+	a.Mul(u, a)
+	a.Mul(a, v.T())
+	reduceBandwidth(a, kl, ku)
+	return a
+}
+
+// Band returns a random m×n band matrix with singular values sv, kl
+// sub-diagonals and ku super-diagonals. See General for details. The
+// bandwidths are limited to m-1 and n-1 respectively.
+func Band(m, n, kl, ku int, sv []float64, src rand.Source) *mat.BandDense {
+	a := General(m, n, kl, ku, sv, src)
+	kl = min(kl, m-1)
+	ku = min(ku, n-1)
+	b := mat.NewBandDense(m, n, kl, ku, nil)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b.SetBand(i, j, a.At(i, j))
+		}
+	}
+	return b
+}
+
+// Symmetric returns a random n×n symmetric matrix A with eigenvalues eig and
+// k sub- and super-diagonals, where n = len(eig). The matrix is computed as
+//
+//	A = Q * D * Qᵀ,
+//
+// where D is the diagonal matrix with eig on its diagonal and Q is a random
+// orthogonal matrix. The bandwidth of A is then reduced to k by a sequence of
+// orthogonal similarity transformations which preserve the eigenvalues. If k
+// is zero, the returned matrix is D.
+//
+// A symmetric positive definite matrix is obtained when all values in eig
+// are positive.
+//
+// If src is not nil it is used as the source of random numbers, otherwise
+// the functions in math/rand/v2 are used.
+//
+// Symmetric panics if eig is empty or if k is negative.
+func Symmetric(k int, eig []float64, src rand.Source) *mat.SymDense {
+	n := len(eig)
+	if n == 0 {
+		panic(badDimension)
+	}
+	if k < 0 {
+		panic(badBandwidth)
+	}
+	a := mat.NewDense(n, n, nil)
+	for i, v := range eig {
+		a.Set(i, i, v)
+	}
+	if k > 0 {
+		q := Orthogonal(n, src)
+		a.Mul(q, a)
+		a.Mul(a, q.T())
+		reduceSymBandwidth(a, k)
+	}
+	s := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, 0.5*(a.At(i, j)+a.At(j, i)))
+		}
+	}
+	return s
+}
+
+// SymBand returns a random n×n symmetric band matrix with eigenvalues eig
+// and k sub- and super-diagonals. See Symmetric for details. The bandwidth is
+// limited to n-1.
+func SymBand(k int, eig []float64, src rand.Source) *mat.SymBandDense {
+	a := Symmetric(k, eig, src)
+	n := len(eig)
+	k = min(k, n-1)
+	b := mat.NewSymBandDense(n, k, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < min(n, i+k+1); j++ {
+			b.SetSymBand(i, j, a.At(i, j))
+		}
+	}
+	return b
+}
+
+// Nonsymmetric returns a random n×n non-symmetric matrix A with the real
+// eigenvalues eig, where n = len(eig). The matrix is computed as
+//
+//	A = Q * T * Qᵀ,
+//
+// where T is an upper triangular matrix with eig on its diagonal and
+// independent normally distributed values scaled by offDiag above its
+// diagonal, and Q is a random orthogonal matrix. T is the real Schur form of
+// A. Larger values of offDiag make the eigenvalues of A more sensitive to
+// perturbations.
+//
+// If src is not nil it is used as the source of random numbers, otherwise
+// the functions in math/rand/v2 are used.
+func Nonsymmetric(eig []float64, offDiag float64, src rand.Source) *mat.Dense {
+	n := len(eig)
+	if n == 0 {
+		panic(badDimension)
+	}
+	norm := rand.NormFloat64
+	if src != nil {
+		norm = rand.New(src).NormFloat64
+	}
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, eig[i])
+		for j := i + 1; j < n; j++ {
+			a.Set(i, j, offDiag*norm())
+		}
+	}
+	q := Orthogonal(n, src)
+	a.Mul(q, a)
+	a.Mul(a, q.T())
+	return a
+}
+
+// reduceBandwidth reduces a to a band matrix with kl sub-diagonals and ku
+// super-diagonals using Householder transformations applied from the left
+// and the right, and explicitly sets the annihilated elements to zero.
+// At least one of kl and ku must be positive.
+func reduceBandwidth(a *mat.Dense, kl, ku int) {
+	m, n := a.Dims()
+	for j := 0; j < min(m, n); j++ {
+		if ku > 0 {
+			annihilateColumn(a, j, j+kl)
+			annihilateRow(a, j, j+ku)
+		} else {
+			annihilateRow(a, j, j+ku)
+			annihilateColumn(a, j, j+kl)
+		}
+	}
+}
+
+// annihilateColumn zeroes a[r+1:m, j] by a Householder reflection applied
+// from the left to rows r:m of a.
+func annihilateColumn(a *mat.Dense, j, r int) {
+	m, n := a.Dims()
+	if m-r < 2 {
+		return
+	}
+	x := make([]float64, m-r)
+	mat.Col(x, j, a.Slice(r, m, 0, n))
+	v, tau, beta := householder(x)
+	if tau == 0 {
+		return
+	}
+	applyLeft(a, r, j, v, tau)
+	a.Set(r, j, beta)
+	for i := r + 1; i < m; i++ {
+		a.Set(i, j, 0)
+	}
+}
+
+// annihilateRow zeroes a[i, c+1:n] by a Householder reflection applied
+// from the right to columns c:n of a.
+func annihilateRow(a *mat.Dense, i, c int) {
+	m, n := a.Dims()
+	if n-c < 2 {
+		return
+	}
+	x := make([]float64, n-c)
+	mat.Row(x, i, a.Slice(0, m, c, n))
+	v, tau, beta := householder(x)
+	if tau == 0 {
+		return
+	}
+	applyRight(a, i, c, v, tau)
+	a.Set(i, c, beta)
+	for j := c + 1; j < n; j++ {
+		a.Set(i, j, 0)
+	}
+}
+
+// reduceSymBandwidth reduces the symmetric matrix a stored in full to a band
+// matrix with k sub- and super-diagonals using orthogonal similarity
+// transformations.
+func reduceSymBandwidth(a *mat.Dense, k int) {
+	n, _ := a.Dims()
+	for j := 0; j < n-k-1; j++ {
+		r := j + k
+		x := make([]float64, n-r)
+		mat.Col(x, j, a.Slice(r, n, 0, n))
+		v, tau, beta := householder(x)
+		if tau == 0 {
+			continue
+		}
+		applyLeft(a, r, j, v, tau)
+		applyRight(a, j, r, v, tau)
+		a.Set(r, j, beta)
+		a.Set(j, r, beta)
+		for i := r + 1; i < n; i++ {
+			a.Set(i, j, 0)
+			a.Set(j, i, 0)
+		}
+	}
+}
+
+// householder computes a Householder reflector H = I - tau * v * vᵀ such that
+// H * x = beta * e_1. The first element of v is one.
+func householder(x []float64) (v []float64, tau, beta float64) {
+	v = make([]float64, len(x))
+	copy(v, x)
+	norm := floats.Norm(x, 2)
+	if norm == 0 {
+		return v, 0, 0
+	}
+	beta = -math.Copysign(norm, x[0])
+	v0 := x[0] - beta
+	floats.Scale(1/v0, v[1:])
+	v[0] = 1
+	tau = (beta - x[0]) / beta
+	return v, tau, beta
+}
+
+// applyLeft computes a[r:r+len(v), c:] = H * a[r:r+len(v), c:] where
+// H = I - tau * v * vᵀ.
+func applyLeft(a *mat.Dense, r, c int, v []float64, tau float64) {
+	_, n := a.Dims()
+	for j := c; j < n; j++ {
+		var s float64
+		for i, vi := range v {
+			s += vi * a.At(r+i, j)
+		}
+		s *= tau
+		for i, vi := range v {
+			a.Set(r+i, j, a.At(r+i, j)-s*vi)
+		}
+	}
+}
+
+// applyRight computes a[r:, c:c+len(v)] = a[r:, c:c+len(v)] * H where
+// H = I - tau * v * vᵀ.
+func applyRight(a *mat.Dense, r, c int, v []float64, tau float64) {
+	m, _ := a.Dims()
+	for i := r; i < m; i++ {
+		row := a.RawRowView(i)[c : c+len(v)]
+		s := tau * floats.Dot(row, v)
+		floats.AddScaled(row, -s, v)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matgen
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestSpectrum(t *testing.T) {
+	t.Parallel()
+	const cond = 1e4
+	for _, test := range []struct {
+		mode Mode
+		want []float64
+	}{
+		{mode: OneLarge, want: []float64{1, 1e-4, 1e-4, 1e-4, 1e-4}},
+		{mode: OneSmall, want: []float64{1, 1, 1, 1, 1e-4}},
+		{mode: Geometric, want: []float64{1, 1e-1, 1e-2, 1e-3, 1e-4}},
+		{mode: Arithmetic, want: []float64{1, 0.750025, 0.50005, 0.250075, 1e-4}},
+	} {
+		got := Spectrum(make([]float64, len(test.want)), test.mode, cond, nil)
+		if !floats.EqualApprox(got, test.want, 1e-14) {
+			t.Errorf("unexpected spectrum for mode %d: got %v, want %v", test.mode, got, test.want)
+		}
+	}
+
+	src := rand.NewPCG(1, 1)
+	got := Spectrum(make([]float64, 100), LogUniform, cond, src)
+	for _, v := range got {
+		if v < 1/cond || 1 < v {
+			t.Errorf("LogUniform value %v out of range", v)
+		}
+	}
+}
+
+func TestOrthogonal(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, n := range []int{1, 2, 5, 20} {
+		q := Orthogonal(n, src)
+		var qtq mat.Dense
+		qtq.Mul(q.T(), q)
+		if !mat.EqualApprox(&qtq, eye(n), 1e-13) {
+			t.Errorf("n=%d: Qᵀ*Q is not identity", n)
+		}
+	}
+}
+
+func TestGeneral(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, m := range []int{1, 2, 5, 10} {
+		for _, n := range []int{1, 2, 5, 10} {
+			for _, kl := range []int{0, 1, 2, m} {
+				for _, ku := range []int{0, 1, 3, n} {
+					name := fmt.Sprintf("m=%d,n=%d,kl=%d,ku=%d", m, n, kl, ku)
+					sv := Spectrum(make([]float64, min(m, n)), Geometric, 1e6, nil)
+					a := General(m, n, kl, ku, sv, src)
+					checkBandwidth(t, name, a, kl, ku)
+					checkSingularValues(t, name, a, sv)
+
+					b := Band(m, n, kl, ku, sv, src)
+					checkSingularValues(t, name+" (band)", b, sv)
+				}
+			}
+		}
+	}
+}
+
+func TestSymmetric(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, n := range []int{1, 2, 5, 10} {
+		for _, k := range []int{0, 1, 2, n} {
+			name := fmt.Sprintf("n=%d,k=%d", n, k)
+			eig := make([]float64, n)
+			for i := range eig {
+				eig[i] = float64(i) - float64(n)/2
+			}
+			a := Symmetric(k, eig, src)
+			checkBandwidth(t, name, a, k, k)
+			checkEigenvalues(t, name, a, eig)
+
+			b := SymBand(k, eig, src)
+			checkEigenvalues(t, name+" (band)", b, eig)
+		}
+	}
+}
+
+func TestNonsymmetric(t *testing.T) {
+	t.Parallel()
+	src := rand.NewPCG(1, 1)
+	for _, n := range []int{1, 2, 5, 10} {
+		eig := make([]float64, n)
+		for i := range eig {
+			eig[i] = float64(i + 1)
+		}
+		a := Nonsymmetric(eig, 1, src)
+		var e mat.Eigen
+		ok := e.Factorize(a, mat.EigenNone)
+		if !ok {
+			t.Fatalf("n=%d: eigendecomposition failed", n)
+		}
+		vals := e.Values(nil)
+		got := make([]float64, n)
+		for i, v := range vals {
+			if math.Abs(imag(v)) > 1e-10 {
+				t.Errorf("n=%d: unexpected complex eigenvalue %v", n, v)
+			}
+			got[i] = real(v)
+		}
+		sort.Float64s(got)
+		if !floats.EqualApprox(got, eig, 1e-10) {
+			t.Errorf("n=%d: unexpected eigenvalues: got %v, want %v", n, got, eig)
+		}
+	}
+}
+
+func checkBandwidth(t *testing.T, name string, a mat.Matrix, kl, ku int) {
+	t.Helper()
+	m, n := a.Dims()
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if (j < i-kl || i+ku < j) && a.At(i, j) != 0 {
+				t.Errorf("%s: non-zero element outside band at (%d,%d)", name, i, j)
+				return
+			}
+		}
+	}
+}
+
+func checkSingularValues(t *testing.T, name string, a mat.Matrix, want []float64) {
+	t.Helper()
+	var svd mat.SVD
+	ok := svd.Factorize(a, mat.SVDNone)
+	if !ok {
+		t.Errorf("%s: SVD failed", name)
+		return
+	}
+	got := svd.Values(nil)
+	if !floats.EqualApprox(got, want, 1e-12) {
+		t.Errorf("%s: unexpected singular values: got %v, want %v", name, got, want)
+	}
+}
+
+func checkEigenvalues(t *testing.T, name string, a mat.Symmetric, want []float64) {
+	t.Helper()
+	var e mat.EigenSym
+	ok := e.Factorize(a, false)
+	if !ok {
+		t.Errorf("%s: eigendecomposition failed", name)
+		return
+	}
+	got := e.Values(nil)
+	if !floats.EqualApprox(got, want, 1e-12) {
+		t.Errorf("%s: unexpected eigenvalues: got %v, want %v", name, got, want)
+	}
+}
+
+func eye(n int) *mat.Dense {
+	a := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, 1)
+	}
+	return a
+}
@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package reverse provides a numeric type and functions for reverse mode
+// automatic differentiation.
+//
+// Operations on Numbers are recorded on a Tape, also known as a Wengert list,
+// which is traversed backwards to compute the derivatives of a result with
+// respect to all of the variables at a cost of a small multiple of the cost
+// of computing the result. This contrasts with the forward mode of package
+// num/dual, which requires one evaluation for each variable.
+//
+// See https://en.wikipedia.org/wiki/Automatic_differentiation for details.
+package reverse // import "gonum.org/v1/gonum/num/reverse"
@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import "math"
+
+// Loop records n iterations of the function step applied to state and
+// returns the final state. At each iteration step is called with the
+// current state in src and params, and must store the next state into dst.
+//
+// Loop uses checkpointing to reduce the memory required for long loops.
+// Instead of recording every iteration on the tape, only the final state is
+// recorded, and the state is stored as a checkpoint every interval
+// iterations. When derivatives are computed, the iterations between
+// consecutive checkpoints are recomputed and recorded on a temporary tape,
+// so that the memory used is proportional to the number of checkpoints and
+// to the number of operations in interval iterations, at the cost of one
+// additional evaluation of the loop. If interval is zero, a default value
+// of ⌈√n⌉ is used.
+//
+// The function step must compute the next state only from the values in
+// src, params and constants, and must not use Numbers recorded on t or
+// another tape, since the Numbers passed to step are recorded on a
+// temporary tape.
+//
+// Loop panics if n or interval is negative.
+func (t *Tape) Loop(state, params []Number, n, interval int, step func(dst, src, params []Number)) []Number {
+	if n < 0 || interval < 0 {
+		panic("reverse: negative loop length or interval")
+	}
+	if interval == 0 {
+		interval = max(1, int(math.Ceil(math.Sqrt(float64(n)))))
+	}
+	if n == 0 {
+		return append([]Number(nil), state...)
+	}
+	l := &loop{
+		inputs:   make([]int, len(state)),
+		params:   make([]int, len(params)),
+		paramVal: make([]float64, len(params)),
+		interval: interval,
+		n:        n,
+		step:     step,
+	}
+	recorded := false
+	for i, x := range state {
+		l.inputs[i] = -1
+		if t.records(x) {
+			l.inputs[i] = x.index
+			recorded = true
+		}
+	}
+	for i, p := range params {
+		l.params[i] = -1
+		if t.records(p) {
+			l.params[i] = p.index
+			recorded = true
+		}
+		l.paramVal[i] = p.Real
+	}
+
+	// Evaluate the loop with constants, storing the checkpoints.
+	src := make([]Number, len(state))
+	dst := make([]Number, len(state))
+	for i, x := range state {
+		src[i] = Number{Real: x.Real}
+	}
+	p := make([]Number, len(params))
+	for i, v := range l.paramVal {
+		p[i] = Number{Real: v}
+	}
+	for k := 0; k < n; k++ {
+		if recorded && k%interval == 0 {
+			c := make([]float64, len(src))
+			for i, x := range src {
+				c[i] = x.Real
+			}
+			l.checkpoints = append(l.checkpoints, c)
+		}
+		for i := range dst {
+			dst[i] = Number{}
+		}
+		step(dst, src, p)
+		for i, x := range dst {
+			src[i] = Number{Real: x.Real}
+		}
+	}
+	if !recorded || len(state) == 0 {
+		return src
+	}
+
+	l.first = len(t.nodes)
+	out := make([]Number, len(src))
+	for i, x := range src {
+		out[i] = t.push(x.Real, -1, 0, -1, 0)
+	}
+	t.loops = append(t.loops, l)
+	return out
+}
+
+// loop is a loop recorded on a Tape by Loop.
+type loop struct {
+	// first is the index of the first output of the loop on the tape.
+	first int
+	// inputs and params are the indices of the initial state and of the
+	// parameters on the tape, or -1 for constants.
+	inputs   []int
+	params   []int
+	paramVal []float64
+
+	interval    int
+	n           int
+	step        func(dst, src, params []Number)
+	checkpoints [][]float64
+}
+
+// last returns the index of the last output of the loop on the tape.
+func (l *loop) last() int {
+	return l.first + len(l.inputs) - 1
+}
+
+// backward propagates the adjoints of the outputs of the loop in adj to its
+// inputs and parameters, recomputing the iterations between checkpoints.
+func (l *loop) backward(adj []float64) {
+	d := len(l.inputs)
+	lambda := make([]float64, d)
+	copy(lambda, adj[l.first:l.first+d])
+	paramAdj := make([]float64, len(l.params))
+
+	var sub Tape
+	for c := len(l.checkpoints) - 1; c >= 0; c-- {
+		sub.Reset()
+		start := sub.Variables(l.checkpoints[c])
+		p := sub.Variables(l.paramVal)
+		x := start
+		for k := c * l.interval; k < min((c+1)*l.interval, l.n); k++ {
+			dst := make([]Number, d)
+			l.step(dst, x, p)
+			x = dst
+		}
+		a := sub.adjoints(lambda, x)
+		for i, s := range start {
+			lambda[i] = a[s.index]
+		}
+		for i, q := range p {
+			paramAdj[i] += a[q.index]
+		}
+	}
+
+	for i, idx := range l.inputs {
+		if idx >= 0 {
+			adj[idx] += lambda[i]
+		}
+	}
+	for i, idx := range l.params {
+		if idx >= 0 {
+			adj[idx] += paramAdj[i]
+		}
+	}
+}
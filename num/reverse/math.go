@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import "gonum.org/v1/gonum/num/dual"
+
+// The values and derivatives of the elementary functions are computed by
+// the corresponding functions of package dual, so that the special cases
+// of the functions and their derivatives agree with forward mode
+// automatic differentiation.
+
+// derivative returns the Number obtained by applying f to x, recording
+// the derivative of f computed with dual numbers.
+func derivative(x Number, f func(dual.Number) dual.Number) Number {
+	d := f(dual.Number{Real: x.Real, Emag: 1})
+	return unary(x, d.Real, d.Emag)
+}
+
+// Add returns the sum of x and y.
+func Add(x, y Number) Number {
+	return binary(x, y, x.Real+y.Real, 1, 1)
+}
+
+// Sub returns the difference of x and y, x-y.
+func Sub(x, y Number) Number {
+	return binary(x, y, x.Real-y.Real, 1, -1)
+}
+
+// Mul returns the product of x and y.
+func Mul(x, y Number) Number {
+	return binary(x, y, x.Real*y.Real, y.Real, x.Real)
+}
+
+// Inv returns the inverse of x.
+func Inv(x Number) Number {
+	return derivative(x, dual.Inv)
+}
+
+// Scale returns x scaled by f.
+func Scale(f float64, x Number) Number {
+	return unary(x, f*x.Real, f)
+}
+
+// Abs returns the absolute value of x.
+func Abs(x Number) Number {
+	return derivative(x, dual.Abs)
+}
+
+// PowReal returns x**p, the base-x exponential of p.
+func PowReal(x Number, p float64) Number {
+	return derivative(x, func(d dual.Number) dual.Number { return dual.PowReal(d, p) })
+}
+
+// Pow returns x**p, the base-x exponential of p.
+func Pow(x, p Number) Number {
+	return Exp(Mul(p, Log(x)))
+}
+
+// Sqrt returns the square root of x.
+func Sqrt(x Number) Number {
+	return derivative(x, dual.Sqrt)
+}
+
+// Exp returns e**x, the base-e exponential of x.
+func Exp(x Number) Number {
+	return derivative(x, dual.Exp)
+}
+
+// Log returns the natural logarithm of x.
+func Log(x Number) Number {
+	return derivative(x, dual.Log)
+}
+
+// Sin returns the sine of x.
+func Sin(x Number) Number {
+	return derivative(x, dual.Sin)
+}
+
+// Cos returns the cosine of x.
+func Cos(x Number) Number {
+	return derivative(x, dual.Cos)
+}
+
+// Tan returns the tangent of x.
+func Tan(x Number) Number {
+	return derivative(x, dual.Tan)
+}
+
+// Asin returns the inverse sine of x.
+func Asin(x Number) Number {
+	return derivative(x, dual.Asin)
+}
+
+// Acos returns the inverse cosine of x.
+func Acos(x Number) Number {
+	return derivative(x, dual.Acos)
+}
+
+// Atan returns the inverse tangent of x.
+func Atan(x Number) Number {
+	return derivative(x, dual.Atan)
+}
+
+// Sinh returns the hyperbolic sine of x.
+func Sinh(x Number) Number {
+	return derivative(x, dual.Sinh)
+}
+
+// Cosh returns the hyperbolic cosine of x.
+func Cosh(x Number) Number {
+	return derivative(x, dual.Cosh)
+}
+
+// Tanh returns the hyperbolic tangent of x.
+func Tanh(x Number) Number {
+	return derivative(x, dual.Tanh)
+}
+
+// Asinh returns the inverse hyperbolic sine of x.
+func Asinh(x Number) Number {
+	return derivative(x, dual.Asinh)
+}
+
+// Acosh returns the inverse hyperbolic cosine of x.
+func Acosh(x Number) Number {
+	return derivative(x, dual.Acosh)
+}
+
+// Atanh returns the inverse hyperbolic tangent of x.
+func Atanh(x Number) Number {
+	return derivative(x, dual.Atanh)
+}
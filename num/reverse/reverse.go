@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import "fmt"
+
+// Number is a float64 precision real number that may be recorded on a Tape.
+// A Number that is not recorded on a Tape, such as Number{Real: 2}, is a
+// constant with respect to differentiation.
+type Number struct {
+	Real float64
+
+	tape  *Tape
+	index int
+}
+
+// Format implements fmt.Formatter.
+func (x Number) Format(fs fmt.State, c rune) {
+	switch c {
+	case 'v', 'e', 'E', 'f', 'F', 'g', 'G':
+		fmt.Fprintf(fs, fmt.FormatString(fs, c), x.Real)
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%[2]v)", c, x)
+	}
+}
+
+// Tape records the operations performed on the Numbers created by its
+// Variable and Variables methods. The zero value of Tape is an empty tape
+// ready to use.
+//
+// A Tape must not be used concurrently and all Numbers combined in an
+// operation must be constants or recorded on the same Tape.
+type Tape struct {
+	nodes []node
+	loops []*loop
+}
+
+// node is a recorded operation with at most two arguments.
+type node struct {
+	// x and y are the indices of the arguments or -1 if there is none,
+	// and dx and dy are the partial derivatives with respect to them.
+	x, y   int
+	dx, dy float64
+}
+
+// Variable returns a Number with value x recorded on the tape as an
+// independent variable.
+func (t *Tape) Variable(x float64) Number {
+	return t.push(x, -1, 0, -1, 0)
+}
+
+// Variables returns a slice of independent variables recorded on the tape
+// with the values in x.
+func (t *Tape) Variables(x []float64) []Number {
+	v := make([]Number, len(x))
+	for i, xi := range x {
+		v[i] = t.Variable(xi)
+	}
+	return v
+}
+
+// Len returns the number of Numbers recorded on the tape.
+func (t *Tape) Len() int {
+	return len(t.nodes)
+}
+
+// Reset clears the tape, retaining the allocated memory. The Numbers
+// previously recorded on the tape must not be used after Reset.
+func (t *Tape) Reset() {
+	t.nodes = t.nodes[:0]
+	t.loops = t.loops[:0]
+}
+
+// Gradient computes the gradient of y with respect to the Numbers in x. If
+// dst is not nil, the result will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. Elements of x that are
+// constants have a zero derivative.
+//
+// Gradient panics if the length of dst and x is not equal or if y or an
+// element of x is recorded on a different tape.
+func (t *Tape) Gradient(dst []float64, y Number, x []Number) []float64 {
+	return t.VJP(dst, []float64{1}, []Number{y}, x)
+}
+
+// VJP computes the vector-Jacobian product vᵀJ, where J is the Jacobian
+// matrix of y with respect to the Numbers in x,
+//
+//	J_{i,j} = ∂y_i/∂x_j.
+//
+// If dst is not nil, the result will be stored in-place into dst and
+// returned, otherwise a new slice will be allocated first. The cost of VJP
+// is independent of the lengths of y and x.
+//
+// VJP panics if the length of dst and x or of v and y is not equal or if an
+// element of y or x is recorded on a different tape.
+func (t *Tape) VJP(dst, v []float64, y, x []Number) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) || len(v) != len(y) {
+		panic(badLength)
+	}
+	adj := t.adjoints(v, y)
+	for j, xj := range x {
+		dst[j] = 0
+		if t.records(xj) {
+			dst[j] = adj[xj.index]
+		}
+	}
+	return dst
+}
+
+// adjoints returns the adjoints of all Numbers recorded on the tape with
+// respect to the function vᵀy.
+func (t *Tape) adjoints(v []float64, y []Number) []float64 {
+	adj := make([]float64, len(t.nodes))
+	last := -1
+	for i, yi := range y {
+		if !t.records(yi) {
+			continue
+		}
+		adj[yi.index] += v[i]
+		last = max(last, yi.index)
+	}
+	t.backward(adj, last)
+	return adj
+}
+
+// records returns whether x is recorded on t. It panics if x is recorded
+// on another tape.
+func (t *Tape) records(x Number) bool {
+	if x.tape == nil {
+		return false
+	}
+	if x.tape != t {
+		panic(mixedTapes)
+	}
+	return true
+}
+
+// backward propagates the adjoints in adj from the node last to the start
+// of the tape.
+func (t *Tape) backward(adj []float64, last int) {
+	l := len(t.loops) - 1
+	for l >= 0 && t.loops[l].first > last {
+		l--
+	}
+	for i := last; i >= 0; i-- {
+		// The adjoints of the outputs of a loop are complete when its last
+		// output is reached, since all of their uses are recorded later.
+		if l >= 0 && i == min(t.loops[l].last(), last) {
+			t.loops[l].backward(adj)
+			l--
+		}
+		a := adj[i]
+		if a == 0 {
+			continue
+		}
+		n := t.nodes[i]
+		if n.x >= 0 {
+			adj[n.x] += a * n.dx
+		}
+		if n.y >= 0 {
+			adj[n.y] += a * n.dy
+		}
+	}
+}
+
+// push records a Number with value v and arguments x and y with the
+// partial derivatives dx and dy.
+func (t *Tape) push(v float64, x int, dx float64, y int, dy float64) Number {
+	t.nodes = append(t.nodes, node{x: x, y: y, dx: dx, dy: dy})
+	return Number{Real: v, tape: t, index: len(t.nodes) - 1}
+}
+
+// unary returns the Number with value v and derivative d with respect to x.
+func unary(x Number, v, d float64) Number {
+	if x.tape == nil {
+		return Number{Real: v}
+	}
+	return x.tape.push(v, x.index, d, -1, 0)
+}
+
+// binary returns the Number with value v and partial derivatives dx and dy
+// with respect to x and y.
+func binary(x, y Number, v, dx, dy float64) Number {
+	switch {
+	case x.tape == nil && y.tape == nil:
+		return Number{Real: v}
+	case y.tape == nil:
+		return x.tape.push(v, x.index, dx, -1, 0)
+	case x.tape == nil:
+		return y.tape.push(v, y.index, dy, -1, 0)
+	case x.tape != y.tape:
+		panic(mixedTapes)
+	}
+	return x.tape.push(v, x.index, dx, y.index, dy)
+}
+
+// Gradient computes the gradient of the multivariate function f at the
+// location x by reverse mode automatic differentiation. If dst is not nil,
+// the result will be stored in-place into dst and returned, otherwise a new
+// slice will be allocated first.
+//
+// Gradient may be used to implement the Grad field of an optimize.Problem
+// from a function written in terms of Numbers.
+//
+// Gradient panics if the length of dst and x is not equal.
+func Gradient(dst []float64, f func(x []Number) Number, x []float64) []float64 {
+	if dst != nil && len(dst) != len(x) {
+		panic(badLength)
+	}
+	var t Tape
+	v := t.Variables(x)
+	return t.Gradient(dst, f(v), v)
+}
+
+// VJP computes the vector-Jacobian product vᵀJ of the vector-valued function
+// f at the location x by reverse mode automatic differentiation, where J is
+// the Jacobian matrix of f at x. The function f must store its value at x
+// into y, which has the length of v. If dst is not nil, the result will be
+// stored in-place into dst and returned, otherwise a new slice will be
+// allocated first.
+//
+// VJP panics if the length of dst and x is not equal.
+func VJP(dst []float64, f func(y, x []Number), x, v []float64) []float64 {
+	if dst != nil && len(dst) != len(x) {
+		panic(badLength)
+	}
+	var t Tape
+	xv := t.Variables(x)
+	y := make([]Number, len(v))
+	f(y, xv)
+	return t.VJP(dst, v, y, xv)
+}
+
+const (
+	badLength  = "reverse: slice length mismatch"
+	mixedTapes = "reverse: numbers recorded on different tapes"
+)
@@ -0,0 +1,330 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/num/dual"
+)
+
+var functionTests = []struct {
+	name    string
+	fn      func(Number) Number
+	dual    func(dual.Number) dual.Number
+	domain  [2]float64
+	special []float64
+}{
+	{name: "Abs", fn: Abs, dual: dual.Abs, domain: [2]float64{-5, 5}, special: []float64{0, math.Copysign(0, -1)}},
+	{name: "Inv", fn: Inv, dual: dual.Inv, domain: [2]float64{-5, 5}, special: []float64{0, math.Inf(1)}},
+	{name: "Sqrt", fn: Sqrt, dual: dual.Sqrt, domain: [2]float64{0, 5}, special: []float64{0, -1}},
+	{name: "Exp", fn: Exp, dual: dual.Exp, domain: [2]float64{-5, 5}},
+	{name: "Log", fn: Log, dual: dual.Log, domain: [2]float64{0, 5}, special: []float64{0, -1, math.Inf(1)}},
+	{name: "Sin", fn: Sin, dual: dual.Sin, domain: [2]float64{-5, 5}, special: []float64{0}},
+	{name: "Cos", fn: Cos, dual: dual.Cos, domain: [2]float64{-5, 5}},
+	{name: "Tan", fn: Tan, dual: dual.Tan, domain: [2]float64{-1.5, 1.5}, special: []float64{0}},
+	{name: "Asin", fn: Asin, dual: dual.Asin, domain: [2]float64{-1, 1}, special: []float64{0, 1, 2}},
+	{name: "Acos", fn: Acos, dual: dual.Acos, domain: [2]float64{-1, 1}, special: []float64{-1, 2}},
+	{name: "Atan", fn: Atan, dual: dual.Atan, domain: [2]float64{-5, 5}, special: []float64{0}},
+	{name: "Sinh", fn: Sinh, dual: dual.Sinh, domain: [2]float64{-5, 5}, special: []float64{0, math.Inf(1)}},
+	{name: "Cosh", fn: Cosh, dual: dual.Cosh, domain: [2]float64{-5, 5}, special: []float64{math.Inf(-1)}},
+	{name: "Tanh", fn: Tanh, dual: dual.Tanh, domain: [2]float64{-5, 5}, special: []float64{0, math.Inf(1)}},
+	{name: "Asinh", fn: Asinh, dual: dual.Asinh, domain: [2]float64{-5, 5}, special: []float64{0}},
+	{name: "Acosh", fn: Acosh, dual: dual.Acosh, domain: [2]float64{1, 5}, special: []float64{1, 0}},
+	{name: "Atanh", fn: Atanh, dual: dual.Atanh, domain: [2]float64{-1, 1}, special: []float64{0, 1}},
+	{
+		name:   "PowReal",
+		fn:     func(x Number) Number { return PowReal(x, 2.5) },
+		dual:   func(x dual.Number) dual.Number { return dual.PowReal(x, 2.5) },
+		domain: [2]float64{0, 5},
+	},
+	{
+		name:   "Pow",
+		fn:     func(x Number) Number { return Pow(x, Number{Real: 1.5}) },
+		dual:   func(x dual.Number) dual.Number { return dual.Pow(x, dual.Number{Real: 1.5}) },
+		domain: [2]float64{0.1, 5},
+	},
+	{
+		name:   "Scale",
+		fn:     func(x Number) Number { return Scale(-3, x) },
+		dual:   func(x dual.Number) dual.Number { return dual.Scale(-3, x) },
+		domain: [2]float64{-5, 5},
+	},
+	{
+		name: "Mul",
+		fn:   func(x Number) Number { return Mul(Sin(x), Exp(x)) },
+		dual: func(x dual.Number) dual.Number { return dual.Mul(dual.Sin(x), dual.Exp(x)) },
+	},
+	{
+		name: "Sub",
+		fn:   func(x Number) Number { return Sub(Cos(x), Mul(x, x)) },
+		dual: func(x dual.Number) dual.Number { return dual.Sub(dual.Cos(x), dual.Mul(x, x)) },
+	},
+	{
+		name: "Add",
+		fn:   func(x Number) Number { return Add(Add(x, x), Number{Real: 2}) },
+		dual: func(x dual.Number) dual.Number { return dual.Add(dual.Add(x, x), dual.Number{Real: 2}) },
+	},
+}
+
+func TestFunctions(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range functionTests {
+		lo, hi := test.domain[0], test.domain[1]
+		if lo == hi {
+			lo, hi = -5, 5
+		}
+		xs := append([]float64(nil), test.special...)
+		for i := 0; i < 20; i++ {
+			xs = append(xs, lo+(hi-lo)*rnd.Float64())
+		}
+		for _, x := range xs {
+			want := test.dual(dual.Number{Real: x, Emag: 1})
+
+			var tape Tape
+			v := tape.Variable(x)
+			y := test.fn(v)
+			got := tape.Gradient(nil, y, []Number{v})[0]
+			if !same(y.Real, want.Real, tol) {
+				t.Errorf("unexpected value of %s(%v): got %v, want %v", test.name, x, y.Real, want.Real)
+			}
+			if !same(got, want.Emag, tol) {
+				t.Errorf("unexpected derivative of %s(%v): got %v, want %v", test.name, x, got, want.Emag)
+			}
+
+			// Constants are not recorded.
+			c := test.fn(Number{Real: x})
+			if c.tape != nil {
+				t.Errorf("constant %s(%v) recorded on a tape", test.name, x)
+			}
+			if !same(c.Real, want.Real, tol) {
+				t.Errorf("unexpected value of constant %s(%v): got %v, want %v", test.name, x, c.Real, want.Real)
+			}
+		}
+	}
+}
+
+func same(a, b, tol float64) bool {
+	return (math.IsNaN(a) && math.IsNaN(b)) || a == b || scalar.EqualWithinAbsOrRel(a, b, tol, tol)
+}
+
+// testFunc is f(x) = Σ_i sin(x_i) x_{i+1} + exp(x_0 / n) log(1 + x_{n-1}^2).
+func testFunc(x []Number) Number {
+	n := len(x)
+	var sum Number
+	for i := 0; i < n-1; i++ {
+		sum = Add(sum, Mul(Sin(x[i]), x[i+1]))
+	}
+	last := Log(Add(Number{Real: 1}, Mul(x[n-1], x[n-1])))
+	return Add(sum, Mul(Exp(Scale(1/float64(n), x[0])), last))
+}
+
+func testFuncDual(x []dual.Number) dual.Number {
+	n := len(x)
+	var sum dual.Number
+	for i := 0; i < n-1; i++ {
+		sum = dual.Add(sum, dual.Mul(dual.Sin(x[i]), x[i+1]))
+	}
+	last := dual.Log(dual.Add(dual.Number{Real: 1}, dual.Mul(x[n-1], x[n-1])))
+	return dual.Add(sum, dual.Mul(dual.Exp(dual.Scale(1/float64(n), x[0])), last))
+}
+
+// testVecFunc is f(x)_i = x_i x_{i+1} + tanh(x_{i+2}) with cyclic indices.
+func testVecFunc(y, x []Number) {
+	n := len(x)
+	for i := range y {
+		y[i] = Add(Mul(x[i%n], x[(i+1)%n]), Tanh(x[(i+2)%n]))
+	}
+}
+
+func testVecFuncDual(y, x []dual.Number) {
+	n := len(x)
+	for i := range y {
+		y[i] = dual.Add(dual.Mul(x[i%n], x[(i+1)%n]), dual.Tanh(x[(i+2)%n]))
+	}
+}
+
+// dualGradient returns the gradient of f at x computed with dual numbers.
+func dualGradient(f func([]dual.Number) dual.Number, x []float64) []float64 {
+	grad := make([]float64, len(x))
+	xd := make([]dual.Number, len(x))
+	for j := range x {
+		for i, v := range x {
+			xd[i] = dual.Number{Real: v}
+		}
+		xd[j].Emag = 1
+		grad[j] = f(xd).Emag
+	}
+	return grad
+}
+
+func TestGradient(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []int{1, 2, 5, 20} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		want := dualGradient(testFuncDual, x)
+		got := Gradient(nil, testFunc, x)
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("unexpected gradient for n=%d: got %v, want %v", n, got, want)
+		}
+
+		// The result is stored in dst and the tape can be reused.
+		var tape Tape
+		dst := make([]float64, n)
+		for k := 0; k < 2; k++ {
+			tape.Reset()
+			v := tape.Variables(x)
+			tape.Gradient(dst, testFunc(v), v)
+			if !floats.EqualApprox(dst, want, tol) {
+				t.Errorf("unexpected gradient for n=%d with reused tape: got %v, want %v", n, dst, want)
+			}
+		}
+	}
+}
+
+func TestVJP(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for _, dims := range []struct{ m, n int }{{1, 1}, {3, 2}, {2, 5}, {6, 6}} {
+		m, n := dims.m, dims.n
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		v := make([]float64, m)
+		for i := range v {
+			v[i] = rnd.NormFloat64()
+		}
+		want := make([]float64, n)
+		xd := make([]dual.Number, n)
+		yd := make([]dual.Number, m)
+		for j := range x {
+			for i, xi := range x {
+				xd[i] = dual.Number{Real: xi}
+			}
+			xd[j].Emag = 1
+			testVecFuncDual(yd, xd)
+			for i, yi := range yd {
+				want[j] += v[i] * yi.Emag
+			}
+		}
+		got := VJP(nil, testVecFunc, x, v)
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("unexpected vector-Jacobian product for m=%d, n=%d: got %v, want %v", m, n, got, want)
+		}
+	}
+}
+
+// loopStep is one explicit Euler step of a damped nonlinear oscillator
+// with parameters p.
+func loopStep(dst, src, p []Number) {
+	const h = 0.01
+	dst[0] = Add(src[0], Scale(h, src[1]))
+	dst[1] = Add(src[1], Scale(h, Sub(Scale(-1, Mul(p[0], Sin(src[0]))), Mul(p[1], src[1]))))
+}
+
+func TestLoop(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	x0 := []float64{1, 0.5}
+	p0 := []float64{2, 0.3}
+	for _, n := range []int{0, 1, 10, 97} {
+		// Record every step on the tape.
+		var want Tape
+		x := want.Variables(x0)
+		p := want.Variables(p0)
+		wantInputs := append(append([]Number(nil), x...), p...)
+		state := x
+		for k := 0; k < n; k++ {
+			dst := make([]Number, 2)
+			loopStep(dst, state, p)
+			state = dst
+		}
+		// Use the outputs in further operations, one of them not the
+		// last output of the loop.
+		wantY := Add(Mul(state[0], state[0]), Scale(3, p[0]))
+		wantGrad := want.Gradient(nil, wantY, wantInputs)
+		wantVJP := want.VJP(nil, []float64{1, -2}, state, wantInputs)
+
+		for _, interval := range []int{0, 1, 7, n, n + 5} {
+			var tape Tape
+			x := tape.Variables(x0)
+			p := tape.Variables(p0)
+			inputs := append(append([]Number(nil), x...), p...)
+			out := tape.Loop(x, p, n, interval, loopStep)
+			if n > 0 && tape.Len() != len(inputs)+len(out) {
+				t.Errorf("n=%d interval=%d: unexpected tape length: got %d, want %d", n, interval, tape.Len(), len(inputs)+len(out))
+			}
+			for i := range out {
+				if !same(out[i].Real, state[i].Real, tol) {
+					t.Errorf("n=%d interval=%d: unexpected final state: got %v, want %v", n, interval, out[i].Real, state[i].Real)
+				}
+			}
+			y := Add(Mul(out[0], out[0]), Scale(3, p[0]))
+			got := tape.Gradient(nil, y, inputs)
+			if !floats.EqualApprox(got, wantGrad, tol) {
+				t.Errorf("n=%d interval=%d: unexpected gradient: got %v, want %v", n, interval, got, wantGrad)
+			}
+			got = tape.VJP(nil, []float64{1, -2}, out, inputs)
+			if !floats.EqualApprox(got, wantVJP, tol) {
+				t.Errorf("n=%d interval=%d: unexpected vector-Jacobian product: got %v, want %v", n, interval, got, wantVJP)
+			}
+		}
+	}
+}
+
+func TestMixedTapes(t *testing.T) {
+	t.Parallel()
+	var t1, t2 Tape
+	x := t1.Variable(1)
+	y := t2.Variable(2)
+	panicked := func(fn func()) (ok bool) {
+		defer func() { ok = recover() != nil }()
+		fn()
+		return false
+	}
+	if !panicked(func() { Add(x, y) }) {
+		t.Errorf("expected panic for operation on different tapes")
+	}
+	if !panicked(func() { t1.Gradient(nil, y, []Number{x}) }) {
+		t.Errorf("expected panic for gradient on a different tape")
+	}
+	// Constants have a zero derivative.
+	c := Number{Real: 3}
+	if got := t1.Gradient(nil, Mul(x, c), []Number{x, c}); !floats.Equal(got, []float64{3, 0}) {
+		t.Errorf("unexpected gradient: got %v, want [3 0]", got)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+	var tape Tape
+	x := tape.Variable(1.5)
+	for _, test := range []struct {
+		format string
+		want   string
+	}{
+		{format: "%v", want: "1.5"},
+		{format: "%.2f", want: "1.50"},
+		{format: "%d", want: "%!d(reverse.Number=1.5)"},
+	} {
+		if got := fmt.Sprintf(test.format, x); got != test.want {
+			t.Errorf("unexpected result for %q: got %q, want %q", test.format, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method   = (*SimulatedAnnealing)(nil)
+	_ Statuser = (*SimulatedAnnealing)(nil)
+)
+
+// SimulatedAnnealing implements the simulated annealing global optimization
+// method with a geometric cooling schedule and adaptive step sizes.
+//
+// SimulatedAnnealing performs a random walk in which a proposal y drawn from
+// a normal distribution around the current location x is accepted with the
+// Metropolis probability
+//
+//	min(1, exp(-(f(y) - f(x))/T)),
+//
+// where T is the temperature. The temperature is multiplied by Cooling after
+// every Steps proposals, and the step sizes of the proposals are adapted so
+// that roughly half of the proposals are accepted, as described in
+//
+//	Corana, A., Marchesi, M., Martini, C., Ridella, S. (1987). Minimizing
+//	multimodal functions of continuous variables with the "simulated
+//	annealing" algorithm. ACM Transactions on Mathematical Software 13(3),
+//	262-280.
+//
+// Each temperature level is a major iteration, reporting the best location
+// found so far. The method converges when the temperature falls below
+// MinTemperature.
+//
+// The random walk is inherently sequential. To make use of concurrent
+// evaluations, Batch proposals are drawn from the current location and
+// evaluated concurrently, and they are then considered in order until one of
+// them is accepted. The proposals following an accepted one are discarded.
+type SimulatedAnnealing struct {
+	// InitTemperature is the initial temperature. If InitTemperature is zero,
+	// it is set to the mean absolute difference between the function values
+	// at the first batch of proposals and at the initial location.
+	InitTemperature float64
+	// MinTemperature is the temperature below which the method converges. If
+	// MinTemperature is zero, it is defaulted to 1e-8 times the initial
+	// temperature.
+	MinTemperature float64
+	// Cooling is the factor by which the temperature is multiplied at each
+	// level. If Cooling is zero, it is defaulted to 0.9. Cooling must be in
+	// (0, 1).
+	Cooling float64
+	// Steps is the number of proposals at each temperature level. If Steps
+	// is zero, it is defaulted to 20*dim.
+	Steps int
+	// Batch is the number of proposals evaluated concurrently. If Batch is
+	// zero, it is defaulted to 1.
+	Batch int
+	// InitStepSize is the initial standard deviation of the proposals. If
+	// InitStepSize is zero, it is defaulted to 1/10 of the distance between
+	// the bounds for variables with finite bounds and to 1 otherwise.
+	InitStepSize float64
+	// Lower and Upper are optional bounds on the variables. If Lower is nil,
+	// the variables are not bounded from below, and if Upper is nil they are
+	// not bounded from above. Otherwise they must have the same length as the
+	// problem dimension, and individual entries may be infinite.
+	Lower, Upper []float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	dim, steps, batch int
+	cooling           float64
+	status            Status
+	rnd               *rand.Rand
+
+	step     []float64 // Step sizes of the proposals.
+	accepted []int     // Accepted proposals at the current level by variable.
+	proposed []int     // Proposals at the current level by variable.
+
+	x, bestX  []float64
+	f, bestF  float64
+	ys        *mat.Dense
+	fy        []float64
+	vars      []int // Variable perturbed by each proposal.
+	temp, min float64
+}
+
+func (sa *SimulatedAnnealing) Status() (Status, error) {
+	return sa.status, nil
+}
+
+func (*SimulatedAnnealing) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (sa *SimulatedAnnealing) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(sa.Lower, sa.Upper, dim)
+	if sa.InitTemperature < 0 || sa.MinTemperature < 0 {
+		panic("optimize: negative annealing temperature")
+	}
+	sa.cooling = sa.Cooling
+	if sa.cooling == 0 {
+		sa.cooling = 0.9
+	}
+	if sa.cooling <= 0 || sa.cooling >= 1 {
+		panic("optimize: annealing cooling factor out of range")
+	}
+	sa.steps = sa.Steps
+	if sa.steps == 0 {
+		sa.steps = 20 * dim
+	}
+	sa.batch = sa.Batch
+	if sa.batch == 0 {
+		sa.batch = 1
+	}
+	if sa.steps < 0 || sa.batch < 0 {
+		panic("optimize: negative annealing steps")
+	}
+	sa.dim = dim
+	sa.status = NotTerminated
+	sa.step = resize(sa.step, dim)
+	for i := range sa.step {
+		sa.step[i] = sa.InitStepSize
+		if sa.step[i] == 0 {
+			sa.step[i] = 1
+			lo, up := lowerBound(sa.Lower, i), upperBound(sa.Upper, i)
+			if !math.IsInf(lo, 0) && !math.IsInf(up, 0) {
+				sa.step[i] = (up - lo) / 10
+			}
+		}
+	}
+	sa.accepted = make([]int, dim)
+	sa.proposed = make([]int, dim)
+	sa.x = resize(sa.x, dim)
+	sa.bestX = resize(sa.bestX, dim)
+	sa.ys = mat.NewDense(sa.batch, dim, nil)
+	sa.fy = resize(sa.fy, sa.batch)
+	sa.vars = make([]int, sa.batch)
+	sa.rnd = newRand(sa.Src)
+	return min(tasks, sa.batch)
+}
+
+func (sa *SimulatedAnnealing) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	p := newPopulationEvaluator(operation, result, tasks)
+	defer p.finish()
+
+	copy(sa.x, tasks[0].X)
+	projectBounds(sa.x, sa.Lower, sa.Upper)
+	xs := mat.NewDense(1, sa.dim, sa.x)
+	f := []float64{0}
+	if !p.evaluate(xs, f) {
+		return
+	}
+	sa.f = f[0]
+	sa.bestF = sa.f
+	copy(sa.bestX, sa.x)
+
+	sa.propose()
+	if !p.evaluate(sa.ys, sa.fy) {
+		return
+	}
+	sa.temp = sa.InitTemperature
+	if sa.temp == 0 {
+		var n int
+		for _, fy := range sa.fy {
+			if d := math.Abs(fy - sa.f); !math.IsNaN(d) && !math.IsInf(d, 0) {
+				sa.temp += d
+				n++
+			}
+		}
+		if n > 0 && sa.temp > 0 {
+			sa.temp /= float64(n)
+		} else {
+			sa.temp = 1
+		}
+	}
+	sa.min = sa.MinTemperature
+	if sa.min == 0 {
+		sa.min = 1e-8 * sa.temp
+	}
+
+	for {
+		for k := 0; k < sa.steps; {
+			k += sa.accept()
+			if k >= sa.steps {
+				break
+			}
+			sa.propose()
+			if !p.evaluate(sa.ys, sa.fy) {
+				return
+			}
+		}
+		if !p.majorIteration(sa.bestX, sa.bestF) {
+			return
+		}
+		sa.adaptSteps()
+		sa.temp *= sa.cooling
+		if sa.temp < sa.min {
+			sa.status = MethodConverge
+			p.methodDone()
+			return
+		}
+		sa.propose()
+		if !p.evaluate(sa.ys, sa.fy) {
+			return
+		}
+	}
+}
+
+// propose draws a batch of proposals from the current location. Each
+// proposal perturbs a single random variable.
+func (sa *SimulatedAnnealing) propose() {
+	for i := range sa.vars {
+		y := sa.ys.RawRowView(i)
+		copy(y, sa.x)
+		j := sa.rnd.IntN(sa.dim)
+		sa.vars[i] = j
+		y[j] += sa.step[j] * sa.rnd.NormFloat64()
+		y[j] = reflectBounds(y[j], lowerBound(sa.Lower, j), upperBound(sa.Upper, j))
+	}
+}
+
+// reflectBounds returns v reflected at the bounds into the interval [lo, up].
+func reflectBounds(v, lo, up float64) float64 {
+	switch {
+	case lo <= v && v <= up:
+		return v
+	case math.IsInf(up, 1):
+		return 2*lo - v
+	case math.IsInf(lo, -1):
+		return 2*up - v
+	}
+	w := up - lo
+	if w == 0 {
+		return lo
+	}
+	t := math.Mod(math.Abs(v-lo), 2*w)
+	if t > w {
+		t = 2*w - t
+	}
+	return lo + t
+}
+
+// accept applies the Metropolis criterion to the evaluated proposals in
+// order until one is accepted, and returns the number of proposals
+// considered.
+func (sa *SimulatedAnnealing) accept() int {
+	for i, fy := range sa.fy {
+		j := sa.vars[i]
+		sa.proposed[j]++
+		if math.IsNaN(fy) {
+			continue
+		}
+		if fy < sa.bestF {
+			sa.bestF = fy
+			copy(sa.bestX, sa.ys.RawRowView(i))
+		}
+		if fy <= sa.f || sa.rnd.Float64() < math.Exp(-(fy-sa.f)/sa.temp) {
+			sa.accepted[j]++
+			sa.f = fy
+			copy(sa.x, sa.ys.RawRowView(i))
+			return i + 1
+		}
+	}
+	return len(sa.fy)
+}
+
+// adaptSteps updates the step sizes based on the acceptance ratios at the
+// current level as proposed by Corana et al.
+func (sa *SimulatedAnnealing) adaptSteps() {
+	const c = 2
+	for j := range sa.step {
+		if sa.proposed[j] > 0 {
+			ratio := float64(sa.accepted[j]) / float64(sa.proposed[j])
+			switch {
+			case ratio > 0.6:
+				sa.step[j] *= 1 + c*(ratio-0.6)/0.4
+			case ratio < 0.4:
+				sa.step[j] /= 1 + c*(0.4-ratio)/0.4
+			}
+			lo, up := lowerBound(sa.Lower, j), upperBound(sa.Upper, j)
+			if w := up - lo; sa.step[j] > w {
+				sa.step[j] = w
+			}
+		}
+		sa.accepted[j] = 0
+		sa.proposed[j] = 0
+	}
+}
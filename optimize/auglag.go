@@ -0,0 +1,408 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+const (
+	defaultAugLagPenalty    = 10
+	defaultAugLagTolerance  = 1e-6
+	defaultAugLagInnerIters = 1000
+	augLagPenaltyGrowth     = 10
+)
+
+var (
+	_ Method             = (*AugmentedLagrangian)(nil)
+	_ Statuser           = (*AugmentedLagrangian)(nil)
+	_ constrainedMethod  = (*AugmentedLagrangian)(nil)
+	_ feasibilityChecker = (*AugmentedLagrangian)(nil)
+)
+
+// AugmentedLagrangian is a method for constrained optimization that solves a
+// sequence of unconstrained subproblems with an unconstrained Method. Each
+// subproblem minimizes the augmented Lagrangian
+//
+//	L_A(x; λ, μ) = f(x) + Σ ψ(c_i(x), λ_i, μ),
+//
+// where for equality constraints
+//
+//	ψ(c, λ, μ) = -λ c + μ/2 c²,
+//
+// and for inequality constraints
+//
+//	ψ(c, λ, μ) = -λ c + μ/2 c²  if c ≤ λ/μ,
+//	           = -λ²/(2μ)        otherwise.
+//
+// After each subproblem the Lagrange multiplier estimates λ are updated if the
+// constraint violation has decreased sufficiently, and otherwise the penalty
+// parameter μ is increased. The tolerances of the subproblems are tightened as
+// the iterations proceed.
+//
+// The subproblems are solved with one concurrent task. If the gradient of the
+// objective function and the Jacobian of the constraints are available they
+// are used to compute the gradient of the augmented Lagrangian, and the
+// subproblem is solved when the infinity norm of the gradient falls below the
+// subproblem tolerance. Otherwise the subproblem is solved when the value of
+// the augmented Lagrangian stops decreasing by more than the tolerance. In
+// that case the Stationarity residual in Result.KKT is NaN, and the method
+// converges when the other residuals are within Tolerance and the last
+// subproblem has been solved to Tolerance.
+//
+// References:
+//   - Nocedal, J., & Wright, S. J. (2006). Numerical Optimization (2nd ed).
+//     Springer. Chapter 17.
+//   - Conn, A. R., Gould, N. I. M., & Toint, P. L. (1991). A globally
+//     convergent augmented Lagrangian algorithm for optimization with general
+//     constraints and simple bounds. SIAM Journal on Numerical Analysis, 28(2),
+//     545-572.
+type AugmentedLagrangian struct {
+	// Method is the unconstrained Method used to minimize the augmented
+	// Lagrangian. If Method is nil, LBFGS is used if the gradient and the
+	// constraint Jacobian are available, and NelderMead otherwise.
+	Method Method
+	// Penalty is the initial value of the penalty parameter μ. If Penalty
+	// is zero, a default value of 10 is used.
+	Penalty float64
+	// Tolerance is the tolerance on the constraint violation and on the
+	// subproblem solution for the convergence of AugmentedLagrangian. If
+	// Tolerance is zero, a default value of 1e-6 is used.
+	Tolerance float64
+	// InnerIterations is the maximum number of major iterations of Method
+	// for each subproblem. If InnerIterations is zero, a default value of
+	// 1000 is used.
+	InnerIterations int
+
+	constrainedProjector
+
+	neq     int       // Number of equality constraints.
+	consOps Operation // Evaluation operations for the constraints.
+	inner   Method    // Method used for the subproblems.
+	useGrad bool      // Whether the subproblems use the gradient.
+	evalOps Operation // Evaluations performed at the outer iterates.
+
+	status Status
+	err    error
+
+	penalty    float64 // Initial value of the penalty parameter.
+	tol        float64 // Tolerance on the constraint violation.
+	innerIters int     // Maximum number of major iterations of a subproblem.
+
+	x      []float64 // Current iterate.
+	lambda []float64 // Lagrange multiplier estimates.
+	coef   []float64 // Multiplier estimates at the last evaluated location.
+	mu     float64   // Penalty parameter.
+}
+
+func (a *AugmentedLagrangian) Status() (Status, error) {
+	return a.status, a.err
+}
+
+// Uses selects the Method used for the subproblems and checks that it is
+// suited to the augmented Lagrangian of the optimization problem.
+func (a *AugmentedLagrangian) Uses(has Available) (uses Available, err error) {
+	grad := has.Grad && (has.Jacobian || !has.Constraints)
+	inner := a.Method
+	if inner == nil {
+		if grad {
+			inner = &LBFGS{}
+		} else {
+			inner = &NelderMead{}
+		}
+	}
+	innerUses, err := inner.Uses(Available{Grad: grad})
+	if err != nil {
+		if err == ErrMissingGrad && has.Grad && has.Constraints {
+			err = ErrMissingJacobian
+		}
+		return Available{}, err
+	}
+	a.inner = inner
+	a.useGrad = innerUses.Grad
+	return Available{
+		Grad:        a.useGrad,
+		Constraints: has.Constraints,
+		Jacobian:    a.useGrad && has.Constraints,
+	}, nil
+}
+
+func (a *AugmentedLagrangian) initConstraints(eq, ineq int) {
+	a.neq = eq
+	a.consOps = NoOperation
+	if eq+ineq > 0 {
+		a.consOps = ConstraintEvaluation | JacobianEvaluation
+	}
+}
+
+func (a *AugmentedLagrangian) feasible(loc *Location) bool {
+	return maxViolation(loc.Constraints, a.neq) <= a.tol
+}
+
+func (a *AugmentedLagrangian) Init(dim, tasks int) int {
+	a.status = NotTerminated
+	a.err = nil
+	if a.inner == nil {
+		a.inner = a.Method
+		if a.inner == nil {
+			a.inner = &NelderMead{}
+		}
+	}
+	a.penalty = a.Penalty
+	if a.penalty == 0 {
+		a.penalty = defaultAugLagPenalty
+	}
+	if a.penalty < 0 {
+		panic("auglag: negative penalty parameter")
+	}
+	a.tol = a.Tolerance
+	if a.tol == 0 {
+		a.tol = defaultAugLagTolerance
+	}
+	a.innerIters = a.InnerIterations
+	if a.innerIters == 0 {
+		a.innerIters = defaultAugLagInnerIters
+	}
+	a.evalOps = FuncEvaluation | a.consOps&ConstraintEvaluation
+	if a.useGrad {
+		a.evalOps |= GradEvaluation | a.consOps
+	}
+	a.x = resize(a.x, dim)
+	return 1
+}
+
+func (a *AugmentedLagrangian) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	a.status, a.err = a.run(&syncTasker{operation: operation, result: result, task: tasks[0]})
+	close(operation)
+}
+
+func (a *AugmentedLagrangian) run(t *syncTasker) (Status, error) {
+	loc := t.task.Location
+	if !t.do(a.evalOps &^ t.task.Op) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if math.IsInf(loc.F, 1) || math.IsNaN(loc.F) {
+		t.finishMethodDone()
+		return Failure, ErrFunc(loc.F)
+	}
+	copy(a.x, loc.X)
+	m := len(loc.Constraints)
+	a.lambda = resize(a.lambda, m)
+	for i := range a.lambda {
+		a.lambda[i] = 0
+	}
+	a.coef = resize(a.coef, m)
+	a.mu = a.penalty
+	omega := math.Max(1/a.mu, a.tol)
+	eta := math.Max(1/math.Pow(a.mu, 0.1), a.tol)
+
+	loc.Multipliers = a.lambda
+	if !t.do(MajorIteration) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	var kkt KKTResidual
+	xPrev := make([]float64, len(a.x))
+	for {
+		copy(xPrev, a.x)
+		if !a.minimizeSubproblem(t, omega) {
+			t.finish()
+			return NotTerminated, nil
+		}
+		if s, ok := a.inner.(Statuser); ok && floats.Equal(xPrev, a.x) {
+			// The subproblem method failed without making any progress.
+			status, err := s.Status()
+			if status == Failure {
+				t.finishMethodDone()
+				return status, err
+			}
+		}
+		solved := omega
+		a.augmented(nil, loc)
+		if a.violation(loc.Constraints) <= eta {
+			// The constraint violation has decreased sufficiently, update
+			// the multipliers and tighten the tolerances.
+			copy(a.lambda, a.coef)
+			eta = math.Max(eta/math.Pow(a.mu, 0.9), a.tol)
+			omega = math.Max(omega/a.mu, a.tol)
+		} else {
+			a.mu *= augLagPenaltyGrowth
+			eta = math.Max(1/math.Pow(a.mu, 0.1), a.tol)
+			omega = math.Max(1/a.mu, a.tol)
+		}
+
+		loc.Multipliers = a.lambda
+		if !t.do(MajorIteration) {
+			t.finish()
+			return NotTerminated, nil
+		}
+		kkt.compute(loc, a.neq)
+		stationary := kkt.Stationarity <= a.tol
+		if math.IsNaN(kkt.Stationarity) {
+			// Stationarity cannot be measured without the gradient, so the
+			// tolerance of the last subproblem is used to decide it instead.
+			stationary = solved <= a.tol
+		}
+		if stationary && kkt.Feasibility <= a.tol && kkt.Complementarity <= a.tol && kkt.DualFeasibility <= a.tol {
+			t.finishMethodDone()
+			return MethodConverge, nil
+		}
+	}
+}
+
+// minimizeSubproblem minimizes the augmented Lagrangian starting from the
+// current iterate with the Method for the subproblems. On return, the current
+// iterate and the location of t hold the approximate minimizer. The returned
+// value is false if the optimization has been terminated by the caller.
+func (a *AugmentedLagrangian) minimizeSubproblem(t *syncTasker, omega float64) bool {
+	loc := t.task.Location
+	dim := len(a.x)
+
+	// Start the subproblem from the current iterate, where the values are
+	// already known.
+	start := &Location{X: make([]float64, dim)}
+	copy(start.X, a.x)
+	op := FuncEvaluation
+	if a.useGrad {
+		start.Gradient = make([]float64, dim)
+		op |= GradEvaluation
+	}
+	start.F = a.augmented(start.Gradient, loc)
+
+	if a.inner.Init(dim, 1) != 1 {
+		panic("auglag: subproblem method must use one task")
+	}
+	operation := make(chan Task, 1)
+	result := make(chan Task, 1)
+	go a.inner.Run(operation, result, []Task{{Op: op, Location: start}})
+
+	converger := &FunctionConverge{Absolute: omega, Iterations: 20}
+	converger.Init(dim)
+	var (
+		iters     int
+		stopped   bool
+		evaluated = a.evalOps
+		ok        = true
+	)
+	stop := func() {
+		result <- Task{Op: PostIteration}
+		close(result)
+		stopped = true
+	}
+	for task := range operation {
+		if stopped {
+			// Only MajorIterations may be sent after the subproblem has
+			// been stopped and they are ignored.
+			continue
+		}
+		switch task.Op {
+		case NoOperation:
+			result <- task
+		case MethodDone:
+			stop()
+		case MajorIteration:
+			copy(a.x, task.X)
+			iters++
+			var done bool
+			if a.useGrad {
+				done = floats.Norm(task.Gradient, math.Inf(1)) <= omega
+			} else {
+				done = converger.Converged(task.Location) != NotTerminated
+			}
+			if done || iters >= a.innerIters {
+				stop()
+				continue
+			}
+			result <- task
+		default:
+			if !task.Op.isEvaluation() || task.Op&HessEvaluation != 0 {
+				panic("auglag: unexpected operation from subproblem method")
+			}
+			var eval Operation
+			if task.Op&FuncEvaluation != 0 {
+				eval |= FuncEvaluation | a.consOps&ConstraintEvaluation
+			}
+			if task.Op&GradEvaluation != 0 {
+				eval |= GradEvaluation | a.consOps
+			}
+			copy(loc.X, task.X)
+			if !t.do(eval) {
+				ok = false
+				stop()
+				continue
+			}
+			evaluated = eval
+			var grad []float64
+			if task.Op&GradEvaluation != 0 {
+				task.Gradient = resize(task.Gradient, dim)
+				grad = task.Gradient
+			}
+			f := a.augmented(grad, loc)
+			if task.Op&FuncEvaluation != 0 {
+				task.F = f
+			}
+			result <- task
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	// Make sure that the location holds all of the values at the minimizer.
+	need := a.evalOps
+	if floats.Equal(loc.X, a.x) {
+		need &^= evaluated
+	}
+	if need != NoOperation {
+		copy(loc.X, a.x)
+		if !t.do(need) {
+			return false
+		}
+	}
+	return true
+}
+
+// augmented returns the value of the augmented Lagrangian at loc and stores
+// its gradient into grad if grad is not nil. The multiplier estimates
+//
+//	λ_i - μ c_i(x)            for equality constraints,
+//	max(λ_i - μ c_i(x), 0)    for inequality constraints,
+//
+// are stored into the coef field.
+func (a *AugmentedLagrangian) augmented(grad []float64, loc *Location) float64 {
+	f := loc.F
+	for i, c := range loc.Constraints {
+		l := a.lambda[i]
+		if i >= a.neq && c > l/a.mu {
+			// The inequality constraint is inactive.
+			f -= l * l / (2 * a.mu)
+			a.coef[i] = 0
+			continue
+		}
+		f += -l*c + a.mu/2*c*c
+		a.coef[i] = l - a.mu*c
+	}
+	if grad != nil {
+		lagrangianGradient(grad, loc.Gradient, loc.Jacobian, a.coef)
+	}
+	return f
+}
+
+// violation returns the measure of the constraint violation used for
+// updating the multipliers.
+func (a *AugmentedLagrangian) violation(c []float64) float64 {
+	var v float64
+	for i, ci := range c {
+		if i < a.neq {
+			v = math.Max(v, math.Abs(ci))
+		} else {
+			v = math.Max(v, math.Abs(math.Min(ci, a.lambda[i]/a.mu)))
+		}
+	}
+	return v
+}
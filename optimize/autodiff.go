@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+)
+
+// DualProblem returns a Problem for the minimization of the function f
+// written in terms of the dual numbers of package num/dual. The gradient
+// of the returned Problem is computed exactly by forward mode automatic
+// differentiation using fd.DualGradient, which evaluates f len(x) times.
+func DualProblem(f func(x []dual.Number) dual.Number) Problem {
+	return Problem{
+		Func: func(x []float64) float64 {
+			xd := make([]dual.Number, len(x))
+			for i, v := range x {
+				xd[i].Real = v
+			}
+			return f(xd).Real
+		},
+		Grad: func(grad, x []float64) {
+			fd.DualGradient(grad, f, x)
+		},
+	}
+}
+
+// HyperDualProblem returns a Problem for the minimization of the function f
+// written in terms of the hyperdual numbers of package num/hyperdual. The
+// gradient and the Hessian of the returned Problem are computed exactly by
+// forward mode automatic differentiation. The gradient requires len(x)
+// evaluations of f and the Hessian is computed using fd.HyperDualHessian,
+// which requires n(n+1)/2 evaluations, where n is the length of x.
+func HyperDualProblem(f func(x []hyperdual.Number) hyperdual.Number) Problem {
+	return Problem{
+		Func: func(x []float64) float64 {
+			xh := make([]hyperdual.Number, len(x))
+			for i, v := range x {
+				xh[i].Real = v
+			}
+			return f(xh).Real
+		},
+		Grad: func(grad, x []float64) {
+			xh := make([]hyperdual.Number, len(x))
+			for i := range x {
+				for j, v := range x {
+					xh[j] = hyperdual.Number{Real: v}
+				}
+				xh[i].E1mag = 1
+				grad[i] = f(xh).E1mag
+			}
+		},
+		Hess: func(hess *mat.SymDense, x []float64) {
+			fd.HyperDualHessian(hess, f, x)
+		},
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/num/hyperdual"
+	"gonum.org/v1/gonum/optimize"
+)
+
+func ExampleHyperDualProblem() {
+	// The Rosenbrock function (1-x)² + 100(y-x²)² written in terms of
+	// hyperdual numbers provides its exact gradient and Hessian.
+	p := optimize.HyperDualProblem(func(x []hyperdual.Number) hyperdual.Number {
+		a := hyperdual.Sub(hyperdual.Number{Real: 1}, x[0])
+		b := hyperdual.Sub(x[1], hyperdual.Mul(x[0], x[0]))
+		return hyperdual.Add(hyperdual.Mul(a, a), hyperdual.Scale(100, hyperdual.Mul(b, b)))
+	})
+
+	result, err := optimize.Minimize(p, []float64{-1.2, 1}, nil, &optimize.Newton{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("result.Status: %v\n", result.Status)
+	fmt.Printf("result.X: %0.4g\n", result.X)
+
+	// Output:
+	// result.Status: GradientThreshold
+	// result.X: [1 1]
+}
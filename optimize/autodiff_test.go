@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/dual"
+	"gonum.org/v1/gonum/num/hyperdual"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+// woodDual is functions.Wood written in terms of dual numbers.
+func woodDual(x []dual.Number) dual.Number {
+	sq := func(v dual.Number) dual.Number { return dual.Mul(v, v) }
+	one := dual.Number{Real: 1}
+	two := dual.Number{Real: 2}
+	f1 := dual.Sub(x[1], sq(x[0]))
+	f2 := dual.Sub(one, x[0])
+	f3 := dual.Sub(x[3], sq(x[2]))
+	f4 := dual.Sub(one, x[2])
+	f5 := dual.Sub(dual.Add(x[1], x[3]), two)
+	f6 := dual.Sub(x[1], x[3])
+	sum := dual.Scale(100, sq(f1))
+	sum = dual.Add(sum, sq(f2))
+	sum = dual.Add(sum, dual.Scale(90, sq(f3)))
+	sum = dual.Add(sum, sq(f4))
+	sum = dual.Add(sum, dual.Scale(10, sq(f5)))
+	return dual.Add(sum, dual.Scale(0.1, sq(f6)))
+}
+
+// woodHyperDual is functions.Wood written in terms of hyperdual numbers.
+func woodHyperDual(x []hyperdual.Number) hyperdual.Number {
+	sq := func(v hyperdual.Number) hyperdual.Number { return hyperdual.Mul(v, v) }
+	one := hyperdual.Number{Real: 1}
+	two := hyperdual.Number{Real: 2}
+	f1 := hyperdual.Sub(x[1], sq(x[0]))
+	f2 := hyperdual.Sub(one, x[0])
+	f3 := hyperdual.Sub(x[3], sq(x[2]))
+	f4 := hyperdual.Sub(one, x[2])
+	f5 := hyperdual.Sub(hyperdual.Add(x[1], x[3]), two)
+	f6 := hyperdual.Sub(x[1], x[3])
+	sum := hyperdual.Scale(100, sq(f1))
+	sum = hyperdual.Add(sum, sq(f2))
+	sum = hyperdual.Add(sum, hyperdual.Scale(90, sq(f3)))
+	sum = hyperdual.Add(sum, sq(f4))
+	sum = hyperdual.Add(sum, hyperdual.Scale(10, sq(f5)))
+	return hyperdual.Add(sum, hyperdual.Scale(0.1, sq(f6)))
+}
+
+func TestAutoDiffProblem(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewPCG(1, 1))
+	var wood functions.Wood
+	pd := DualProblem(woodDual)
+	ph := HyperDualProblem(woodHyperDual)
+	if pd.Hess != nil {
+		t.Errorf("unexpected Hessian for DualProblem")
+	}
+	for i := 0; i < 10; i++ {
+		x := make([]float64, 4)
+		for j := range x {
+			x[j] = 4*rnd.Float64() - 2
+		}
+		want := wood.Func(x)
+		for _, p := range []Problem{pd, ph} {
+			if got := p.Func(x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected function value: got %v, want %v", got, want)
+			}
+		}
+
+		wantGrad := make([]float64, 4)
+		wood.Grad(wantGrad, x)
+		for _, p := range []Problem{pd, ph} {
+			grad := make([]float64, 4)
+			p.Grad(grad, x)
+			if !floats.EqualApprox(grad, wantGrad, tol) {
+				t.Errorf("unexpected gradient: got %v, want %v", grad, wantGrad)
+			}
+		}
+
+		wantHess := mat.NewSymDense(4, nil)
+		wood.Hess(wantHess, x)
+		hess := mat.NewSymDense(4, nil)
+		ph.Hess(hess, x)
+		if !mat.EqualApprox(hess, wantHess, tol) {
+			t.Errorf("unexpected Hessian:\ngot  %v\nwant %v", mat.Formatted(hess), mat.Formatted(wantHess))
+		}
+	}
+}
+
+func TestAutoDiffMinimize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		p      Problem
+		method Method
+	}{
+		{name: "BFGS", p: DualProblem(woodDual), method: &BFGS{}},
+		{name: "Newton", p: HyperDualProblem(woodHyperDual), method: &Newton{}},
+	} {
+		result, err := Minimize(test.p, []float64{-3, -1, -3, -1}, nil, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !floats.EqualApprox(result.X, []float64{1, 1, 1, 1}, 1e-6) {
+			t.Errorf("%s: unexpected minimizer: got %v, want [1 1 1 1]", test.name, result.X)
+		}
+	}
+}
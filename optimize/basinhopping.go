@@ -0,0 +1,360 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	basinHoppingAdaptInterval = 50
+	basinHoppingAcceptRate    = 0.5
+	basinHoppingStepFactor    = 0.9
+)
+
+var _ Method = (*BasinHopping)(nil)
+
+// BasinHopping implements the basin-hopping global optimization method of
+// Wales and Doye.
+//
+// BasinHopping performs a random walk over the local minima of the function.
+// At each iteration the current local minimum x is perturbed by a random
+// displacement drawn uniformly from [-StepSize, StepSize] in every coordinate,
+// and a local minimization is started from the perturbed location. The new
+// local minimum y is accepted with the Metropolis probability
+//
+//	min(1, exp(-(f(y) - f(x))/T)),
+//
+// where T is the Temperature. Every 50 iterations the step size is increased
+// if more than half of the new minima have been accepted and decreased
+// otherwise. Each iteration is a major iteration, reporting the best local
+// minimum found so far.
+//
+// If Hops is larger than one, Hops perturbations of the current minimum are
+// minimized concurrently in each iteration, and the best of the resulting
+// minima is considered for acceptance. The local minimizations use separate
+// Methods returned by Local, and the evaluations run concurrently if
+// Settings.Concurrent is larger than one.
+//
+// Reference:
+//
+//	Wales, D. J., Doye, J. P. K. (1997). Global optimization by
+//	basin-hopping and the lowest energy structures of Lennard-Jones clusters
+//	containing up to 110 atoms. Journal of Physical Chemistry A 101(28),
+//	5111-5116.
+type BasinHopping struct {
+	// Local returns a new Method used for the local minimizations, which
+	// must use a single task. Local is called once for every concurrently
+	// running minimization. If Local is nil, LBFGSB restricted to the bounds
+	// is used if the gradient is available, and NelderMead otherwise.
+	// NelderMead does not respect the bounds.
+	Local func() Method
+	// LocalIterations is the largest number of major iterations of a local
+	// minimization. If LocalIterations is zero, it is defaulted to 1000.
+	LocalIterations int
+	// Hops is the number of local minimizations performed in each iteration.
+	// If Hops is zero, it is defaulted to 1.
+	Hops int
+	// Temperature is the temperature T of the Metropolis criterion. If
+	// Temperature is zero, it is defaulted to 1.
+	Temperature float64
+	// StepSize is the initial largest displacement in each coordinate. If
+	// StepSize is zero, it is defaulted to 0.5.
+	StepSize float64
+	// Lower and Upper are optional bounds on the variables. If Lower is nil,
+	// the variables are not bounded from below, and if Upper is nil they are
+	// not bounded from above. Otherwise they must have the same length as the
+	// problem dimension, and individual entries may be infinite.
+	Lower, Upper []float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	dim        int
+	hops       int
+	iterations int
+	temp, step float64
+	useGrad    bool
+	methods    []Method
+	rnd        *rand.Rand
+
+	x, bestX []float64
+	f, bestF float64
+	starts   *mat.Dense
+	minima   *mat.Dense
+	fs       []float64
+}
+
+func (bh *BasinHopping) Uses(has Available) (uses Available, err error) {
+	if has.Constraints {
+		return Available{}, ErrConstrained
+	}
+	bh.useGrad = has.Grad
+	return bh.local().Uses(has)
+}
+
+// local returns a new Method for a local minimization.
+func (bh *BasinHopping) local() Method {
+	if bh.Local != nil {
+		return bh.Local()
+	}
+	if bh.useGrad {
+		return &LBFGSB{Lower: bh.Lower, Upper: bh.Upper}
+	}
+	return &NelderMead{}
+}
+
+func (bh *BasinHopping) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(bh.Lower, bh.Upper, dim)
+	bh.dim = dim
+	bh.hops = bh.Hops
+	if bh.hops == 0 {
+		bh.hops = 1
+	}
+	bh.iterations = bh.LocalIterations
+	if bh.iterations == 0 {
+		bh.iterations = 1000
+	}
+	if bh.hops < 0 || bh.iterations < 0 {
+		panic("optimize: negative basin-hopping parameter")
+	}
+	bh.temp = bh.Temperature
+	if bh.temp == 0 {
+		bh.temp = 1
+	}
+	bh.step = bh.StepSize
+	if bh.step == 0 {
+		bh.step = 0.5
+	}
+	if bh.temp < 0 || bh.step < 0 {
+		panic("optimize: negative basin-hopping parameter")
+	}
+	tasks = min(tasks, bh.hops)
+	bh.methods = bh.methods[:0]
+	for i := 0; i < tasks; i++ {
+		bh.methods = append(bh.methods, bh.local())
+	}
+	bh.x = resize(bh.x, dim)
+	bh.bestX = resize(bh.bestX, dim)
+	bh.starts = mat.NewDense(bh.hops, dim, nil)
+	bh.minima = mat.NewDense(bh.hops, dim, nil)
+	bh.fs = resize(bh.fs, bh.hops)
+	bh.rnd = newRand(bh.Src)
+	return tasks
+}
+
+func (bh *BasinHopping) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	p := newPopulationEvaluator(operation, result, tasks)
+	defer p.finish()
+
+	start := mat.NewDense(1, bh.dim, nil)
+	copy(start.RawRowView(0), tasks[0].X)
+	projectBounds(start.RawRowView(0), bh.Lower, bh.Upper)
+	minimum := mat.NewDense(1, bh.dim, nil)
+	f := []float64{0}
+	if !bh.minimizeHops(p, start, minimum, f) {
+		return
+	}
+	bh.f = f[0]
+	bh.bestF = bh.f
+	copy(bh.x, minimum.RawRowView(0))
+	copy(bh.bestX, bh.x)
+	if !p.majorIteration(bh.bestX, bh.bestF) {
+		return
+	}
+
+	var accepted int
+	for iter := 1; ; iter++ {
+		for i := 0; i < bh.hops; i++ {
+			x := bh.starts.RawRowView(i)
+			for j, v := range bh.x {
+				x[j] = v + bh.step*(2*bh.rnd.Float64()-1)
+				x[j] = reflectBounds(x[j], lowerBound(bh.Lower, j), upperBound(bh.Upper, j))
+			}
+		}
+		if !bh.minimizeHops(p, bh.starts, bh.minima, bh.fs) {
+			return
+		}
+		if i := bestIndex(bh.fs); i != -1 {
+			fy := bh.fs[i]
+			if fy < bh.bestF {
+				bh.bestF = fy
+				copy(bh.bestX, bh.minima.RawRowView(i))
+			}
+			if fy <= bh.f || bh.rnd.Float64() < math.Exp(-(fy-bh.f)/bh.temp) {
+				bh.f = fy
+				copy(bh.x, bh.minima.RawRowView(i))
+				accepted++
+			}
+		}
+		if iter%basinHoppingAdaptInterval == 0 {
+			if float64(accepted)/basinHoppingAdaptInterval > basinHoppingAcceptRate {
+				bh.step /= basinHoppingStepFactor
+			} else {
+				bh.step *= basinHoppingStepFactor
+			}
+			accepted = 0
+		}
+		if !p.majorIteration(bh.bestX, bh.bestF) {
+			return
+		}
+	}
+}
+
+// minimizeHops performs local minimizations starting from the rows of starts
+// concurrently, and stores the local minima and the function values into
+// minima and fs. It returns false if the optimization has been terminated.
+func (bh *BasinHopping) minimizeHops(p *populationEvaluator, starts, minima *mat.Dense, fs []float64) bool {
+	n, _ := starts.Dims()
+	requests := make(chan Task)
+	finished := make(chan int)
+	stop := make(chan struct{})
+	replies := make([]chan Task, len(bh.methods))
+	for i := range replies {
+		replies[i] = make(chan Task, 1)
+	}
+
+	// eval returns a function evaluating the task for the local
+	// minimization running with the i-th method.
+	eval := func(i int) func(Task) (Task, bool) {
+		return func(task Task) (Task, bool) {
+			task.ID = i
+			select {
+			case requests <- task:
+			case <-stop:
+				return task, false
+			}
+			select {
+			case task = <-replies[i]:
+				return task, true
+			case <-stop:
+				return task, false
+			}
+		}
+	}
+	var next, active int
+	launch := func(i int) {
+		hop := next
+		next++
+		active++
+		go func() {
+			fs[hop] = bh.minimizeLocal(bh.methods[i], starts.RawRowView(hop), minima.RawRowView(hop), p.tasks[i], eval(i))
+			finished <- i
+		}()
+	}
+	for i := 0; i < len(bh.methods) && next < n; i++ {
+		launch(i)
+	}
+
+	result := p.result
+	for active > 0 {
+		select {
+		case task := <-requests:
+			if !p.terminated {
+				p.operation <- task
+			}
+		case task, ok := <-result:
+			switch {
+			case !ok:
+				result = nil
+			case task.Op == PostIteration:
+				p.terminated = true
+				close(stop)
+			default:
+				replies[task.ID] <- task
+			}
+		case i := <-finished:
+			active--
+			if !p.terminated && next < n {
+				launch(i)
+			}
+		}
+	}
+	return !p.terminated
+}
+
+// minimizeLocal minimizes the function with method starting from start, and
+// stores the minimizer into dst and returns the minimum. The evaluations are
+// performed in the location of task by calling eval.
+func (bh *BasinHopping) minimizeLocal(method Method, start, dst []float64, task Task, eval func(Task) (Task, bool)) float64 {
+	dim := len(start)
+	if method.Init(dim, 1) != 1 {
+		panic("optimize: basin-hopping local method must use one task")
+	}
+	loc := newLocation(dim)
+	copy(loc.X, start)
+	copy(dst, start)
+	operation := make(chan Task, 1)
+	result := make(chan Task, 1)
+	go method.Run(operation, result, []Task{{Location: loc}})
+
+	converger := &FunctionConverge{Absolute: 1e-10, Iterations: 20}
+	converger.Init(dim)
+	var (
+		iters   int
+		stopped bool
+	)
+	f := math.NaN()
+	stop := func() {
+		result <- Task{Op: PostIteration}
+		close(result)
+		stopped = true
+	}
+	for t := range operation {
+		if stopped {
+			// Only MajorIterations may be sent after the local minimization
+			// has been stopped and they are ignored.
+			continue
+		}
+		switch t.Op {
+		case NoOperation:
+			result <- t
+		case MethodDone:
+			stop()
+		case MajorIteration:
+			copy(dst, t.X)
+			f = t.F
+			iters++
+			if converger.Converged(t.Location) != NotTerminated || iters >= bh.iterations {
+				stop()
+				continue
+			}
+			result <- t
+		default:
+			if !t.Op.isEvaluation() {
+				panic("optimize: unexpected operation from basin-hopping local method")
+			}
+			task.Op = t.Op
+			copy(task.X, t.X)
+			r, ok := eval(task)
+			if !ok {
+				stop()
+				continue
+			}
+			if t.Op&FuncEvaluation != 0 {
+				t.F = r.F
+			}
+			if t.Op&GradEvaluation != 0 {
+				t.Gradient = resize(t.Gradient, dim)
+				copy(t.Gradient, r.Gradient)
+			}
+			if t.Op&HessEvaluation != 0 {
+				t.Hessian = resizeSymDense(t.Hessian, dim)
+				t.Hessian.CopySym(r.Hessian)
+			}
+			result <- t
+		}
+	}
+	return f
+}
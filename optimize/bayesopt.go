@@ -0,0 +1,442 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// bayesianCandidates is the number of random locations at which the
+	// acquisition function is evaluated to select the starting locations
+	// of its maximization.
+	bayesianCandidates = 1000
+
+	bayesianAcquisitionIterations = 100
+)
+
+var (
+	_ Method = (*BayesianOptimization)(nil)
+
+	_ Acquisition = ExpectedImprovement{}
+	_ Acquisition = ProbabilityOfImprovement{}
+	_ Acquisition = UpperConfidenceBound{}
+)
+
+// BayesianOptimization implements Bayesian optimization of expensive
+// functions within a box.
+//
+// BayesianOptimization models the function with a Gaussian process
+// surrogate, whose Matérn 5/2 covariance hyperparameters are estimated by
+// maximum likelihood after every iteration. The kernel matrix is factorized
+// with mat.Cholesky. The next location to evaluate is the maximizer of an
+// Acquisition function of the predicted mean and standard deviation of the
+// surrogate, which is found by LBFGSB minimizations started from the best
+// of a set of random locations.
+//
+// The first iteration evaluates the initial location and locations sampled
+// by Latin hypercube sampling within the bounds. If Batch is larger than
+// one, each following iteration proposes Batch locations using the kriging
+// believer heuristic, in which the surrogate is updated with its predicted
+// mean at each proposed location before proposing the next. The locations
+// of a batch are evaluated concurrently if Settings.Concurrent is larger
+// than one. Each iteration is a major iteration, reporting the best location
+// found so far.
+//
+// The cost of an iteration grows with the cube of the number of evaluated
+// locations, and BayesianOptimization is intended for functions that can
+// only be evaluated a few hundred times. The number of evaluations should
+// be limited by Settings.FuncEvaluations.
+//
+// References:
+//   - Jones, D. R., Schonlau, M., Welch, W. J. (1998). Efficient global
+//     optimization of expensive black-box functions. Journal of Global
+//     Optimization 13, 455-492.
+//   - Ginsbourger, D., Le Riche, R., Carraro, L. (2010). Kriging is
+//     well-suited to parallelize optimization. Computational Intelligence in
+//     Expensive Optimization Problems, 131-162.
+type BayesianOptimization struct {
+	// Lower and Upper are the bounds of the search domain. They must have
+	// the same length as the problem dimension and hold finite values with
+	// Lower[i] < Upper[i].
+	Lower, Upper []float64
+	// InitialSamples is the number of locations evaluated in the first
+	// iteration, including the initial location. If InitialSamples is zero,
+	// it is defaulted to max(2*dim, 5).
+	InitialSamples int
+	// Acquisition is the acquisition function maximized to propose new
+	// locations. If Acquisition is nil, ExpectedImprovement is used.
+	Acquisition Acquisition
+	// Batch is the number of locations proposed in each iteration. If Batch
+	// is zero, it is defaulted to the number of concurrent tasks, in which
+	// case the result depends on Settings.Concurrent.
+	Batch int
+	// Restarts is the number of maximizations of the acquisition function
+	// for each proposed location. If Restarts is zero, it is defaulted
+	// to 5.
+	Restarts int
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	dim          int
+	batch        int
+	initial      int
+	restarts     int
+	acquisition  Acquisition
+	lower, width []float64
+	rnd          *rand.Rand
+
+	gp     *gaussianProcess
+	us     [][]float64 // Evaluated locations scaled to the unit hypercube.
+	fs     []float64   // Function values at us.
+	bestX  []float64
+	bestF  float64
+	xs     *mat.Dense
+	batchF []float64
+}
+
+func (*BayesianOptimization) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (bo *BayesianOptimization) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	if len(bo.Lower) != dim || len(bo.Upper) != dim {
+		panic("optimize: Bayesian optimization bound length mismatch")
+	}
+	bo.lower = resize(bo.lower, dim)
+	bo.width = resize(bo.width, dim)
+	for i, lo := range bo.Lower {
+		up := bo.Upper[i]
+		if math.IsInf(lo, 0) || math.IsInf(up, 0) || !(lo < up) {
+			panic("optimize: invalid bounds")
+		}
+		bo.lower[i] = lo
+		bo.width[i] = up - lo
+	}
+	bo.dim = dim
+	bo.batch = bo.Batch
+	if bo.batch == 0 {
+		bo.batch = max(tasks, 1)
+	}
+	bo.initial = bo.InitialSamples
+	if bo.initial == 0 {
+		bo.initial = max(2*dim, 5)
+	}
+	bo.restarts = bo.Restarts
+	if bo.restarts == 0 {
+		bo.restarts = 5
+	}
+	if bo.batch < 0 || bo.initial < 0 || bo.restarts < 0 {
+		panic("optimize: negative Bayesian optimization parameter")
+	}
+	bo.acquisition = bo.Acquisition
+	if bo.acquisition == nil {
+		bo.acquisition = ExpectedImprovement{}
+	}
+	bo.rnd = newRand(bo.Src)
+	bo.gp = newGaussianProcess(dim)
+	bo.us = bo.us[:0]
+	bo.fs = bo.fs[:0]
+	bo.bestX = resize(bo.bestX, dim)
+	bo.bestF = math.Inf(1)
+	bo.xs = mat.NewDense(bo.batch, dim, nil)
+	bo.batchF = resize(bo.batchF, bo.batch)
+	return min(tasks, max(bo.initial, bo.batch))
+}
+
+func (bo *BayesianOptimization) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	p := newPopulationEvaluator(operation, result, tasks)
+	defer p.finish()
+
+	xs := bo.initialSamples(tasks[0].X)
+	fs := make([]float64, bo.initial)
+	if !p.evaluate(xs, fs) {
+		return
+	}
+	bo.observe(xs, fs)
+	for p.majorIteration(bo.bestX, bo.bestF) {
+		bo.propose(bo.xs)
+		if !p.evaluate(bo.xs, bo.batchF) {
+			return
+		}
+		bo.observe(bo.xs, bo.batchF)
+	}
+}
+
+// initialSamples returns the locations of the first iteration. The first
+// location is x0 projected onto the bounds, and the others are sampled by
+// Latin hypercube sampling.
+func (bo *BayesianOptimization) initialSamples(x0 []float64) *mat.Dense {
+	n := bo.initial
+	xs := mat.NewDense(n, bo.dim, nil)
+	copy(xs.RawRowView(0), x0)
+	projectBounds(xs.RawRowView(0), bo.Lower, bo.Upper)
+	if n == 1 {
+		return xs
+	}
+	perm := make([]int, n-1)
+	for j := 0; j < bo.dim; j++ {
+		for i := range perm {
+			perm[i] = i
+		}
+		bo.rnd.Shuffle(len(perm), func(a, b int) {
+			perm[a], perm[b] = perm[b], perm[a]
+		})
+		for i, k := range perm {
+			u := (float64(k) + bo.rnd.Float64()) / float64(n-1)
+			xs.Set(i+1, j, bo.lower[j]+u*bo.width[j])
+		}
+	}
+	return xs
+}
+
+// observe adds the evaluated locations in the rows of xs with the function
+// values fs to the surrogate, and updates the best location.
+func (bo *BayesianOptimization) observe(xs *mat.Dense, fs []float64) {
+	for i, f := range fs {
+		x := xs.RawRowView(i)
+		u := make([]float64, bo.dim)
+		for j, v := range x {
+			u[j] = (v - bo.lower[j]) / bo.width[j]
+		}
+		bo.us = append(bo.us, u)
+		bo.fs = append(bo.fs, f)
+		if f < bo.bestF || (math.IsInf(bo.bestF, 1) && !math.IsNaN(f)) {
+			bo.bestF = f
+			copy(bo.bestX, x)
+		}
+	}
+	bo.gp.setData(bo.us, bo.fs)
+	bo.gp.fit()
+}
+
+// propose stores the locations of the next iteration into the rows of xs.
+func (bo *BayesianOptimization) propose(xs *mat.Dense) {
+	n, _ := xs.Dims()
+	u := make([]float64, bo.dim)
+	for i := 0; i < n; i++ {
+		bo.maximizeAcquisition(u)
+		x := xs.RawRowView(i)
+		for j, v := range u {
+			x[j] = bo.lower[j] + v*bo.width[j]
+		}
+		if i < n-1 {
+			// Kriging believer: assume that the function value at the
+			// proposed location equals the predicted mean.
+			mean, _ := bo.gp.predict(nil, nil, u)
+			bo.gp.add(slices.Clone(u), mean)
+		}
+	}
+	bo.gp.setData(bo.us, bo.fs)
+	bo.gp.factorize(bo.gp.theta)
+}
+
+// maximizeAcquisition stores into dst the scaled location that maximizes the
+// acquisition function of the current surrogate.
+func (bo *BayesianOptimization) maximizeAcquisition(dst []float64) {
+	gp := bo.gp
+	best := math.Inf(1)
+	for _, y := range gp.ys {
+		best = math.Min(best, y)
+	}
+	dMean := make([]float64, bo.dim)
+	dStd := make([]float64, bo.dim)
+	acquire := func(grad, u []float64) float64 {
+		if grad == nil {
+			mean, std := gp.predict(nil, nil, u)
+			a, _, _ := bo.acquisition.Acquire(mean, std, best)
+			return a
+		}
+		mean, std := gp.predict(dMean, dStd, u)
+		a, da, ds := bo.acquisition.Acquire(mean, std, best)
+		for j := range grad {
+			grad[j] = da*dMean[j] + ds*dStd[j]
+		}
+		return a
+	}
+
+	// Select the starting locations among random candidates and the
+	// best location found so far.
+	starts := mat.NewDense(bayesianCandidates+1, bo.dim, nil)
+	values := make([]float64, bayesianCandidates+1)
+	order := make([]int, bayesianCandidates+1)
+	for i := range order {
+		u := starts.RawRowView(i)
+		if k := bestIndex(bo.fs); i == 0 && k != -1 {
+			copy(u, bo.us[k])
+		} else {
+			for j := range u {
+				u[j] = bo.rnd.Float64()
+			}
+		}
+		values[i] = acquire(nil, u)
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return -compareNaNLow(values[a], values[b])
+	})
+	copy(dst, starts.RawRowView(order[0]))
+	bestA := values[order[0]]
+
+	lower := make([]float64, bo.dim)
+	upper := make([]float64, bo.dim)
+	for j := range upper {
+		upper[j] = 1
+	}
+	p := Problem{
+		Func: func(u []float64) float64 {
+			return -acquire(nil, u)
+		},
+		Grad: func(grad, u []float64) {
+			acquire(grad, u)
+			for j := range grad {
+				grad[j] = -grad[j]
+			}
+		},
+	}
+	settings := &Settings{
+		MajorIterations: bayesianAcquisitionIterations,
+		Converger:       &FunctionConverge{Absolute: 1e-12, Relative: 1e-10, Iterations: 5},
+	}
+	for _, i := range order[:min(bo.restarts, len(order))] {
+		res, _ := Minimize(p, starts.RawRowView(i), settings, &LBFGSB{Lower: lower, Upper: upper})
+		if res != nil && -res.F > bestA {
+			bestA = -res.F
+			copy(dst, res.X)
+		}
+	}
+	projectBounds(dst, lower, upper)
+}
+
+// compareNaNLow compares a and b ordering NaN values below all others.
+func compareNaNLow(a, b float64) int {
+	switch {
+	case math.IsNaN(a) && math.IsNaN(b):
+		return 0
+	case math.IsNaN(a):
+		return -1
+	case math.IsNaN(b):
+		return 1
+	}
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Acquisition is an acquisition function of BayesianOptimization, which
+// measures the value of evaluating the function at a location given the
+// prediction of the surrogate model.
+type Acquisition interface {
+	// Acquire returns the value of the acquisition function for a
+	// prediction of the function value with the given mean and standard
+	// deviation std, where best is the smallest function value observed so
+	// far, and its partial derivatives with respect to mean and std. The
+	// values are standardized. Larger values of the acquisition function are
+	// preferred.
+	Acquire(mean, std, best float64) (a, dMean, dStd float64)
+}
+
+// ExpectedImprovement is the expected improvement acquisition function
+//
+//	a = E[max(best - ξ - f, 0)] = (best - ξ - μ) Φ(z) + σ φ(z),   z = (best - ξ - μ)/σ,
+//
+// where μ and σ are the predicted mean and standard deviation and Φ and φ
+// are the standard normal distribution and density functions.
+type ExpectedImprovement struct {
+	// Exploration is the margin ξ by which an improvement must exceed the
+	// best value. Larger values favor exploration. Exploration must not be
+	// negative.
+	Exploration float64
+}
+
+func (e ExpectedImprovement) Acquire(mean, std, best float64) (a, dMean, dStd float64) {
+	d := best - e.Exploration - mean
+	if std <= 0 {
+		if d > 0 {
+			return d, -1, 0
+		}
+		return 0, 0, 0
+	}
+	z := d / std
+	cdf, pdf := normalCDF(z), normalPDF(z)
+	return d*cdf + std*pdf, -cdf, pdf
+}
+
+// ProbabilityOfImprovement is the probability of improvement acquisition
+// function
+//
+//	a = P(f < best - ξ) = Φ((best - ξ - μ)/σ),
+//
+// where μ and σ are the predicted mean and standard deviation and Φ is the
+// standard normal distribution function.
+type ProbabilityOfImprovement struct {
+	// Exploration is the margin ξ by which an improvement must exceed the
+	// best value. Larger values favor exploration. Exploration must not be
+	// negative.
+	Exploration float64
+}
+
+func (p ProbabilityOfImprovement) Acquire(mean, std, best float64) (a, dMean, dStd float64) {
+	d := best - p.Exploration - mean
+	if std <= 0 {
+		if d > 0 {
+			return 1, 0, 0
+		}
+		return 0, 0, 0
+	}
+	z := d / std
+	pdf := normalPDF(z)
+	return normalCDF(z), -pdf / std, -pdf * z / std
+}
+
+// UpperConfidenceBound is the confidence bound acquisition function
+//
+//	a = κσ - μ,
+//
+// where μ and σ are the predicted mean and standard deviation. Maximizing a
+// minimizes the lower confidence bound μ - κσ of the function value.
+//
+// Reference:
+//
+//	Srinivas, N., Krause, A., Kakade, S. M., Seeger, M. (2010). Gaussian
+//	process optimization in the bandit setting: no regret and experimental
+//	design. Proceedings of the 27th International Conference on Machine
+//	Learning, 1015-1022.
+type UpperConfidenceBound struct {
+	// Kappa is the weight κ of the standard deviation. Larger values favor
+	// exploration. If Kappa is zero, it is defaulted to 2.
+	Kappa float64
+}
+
+func (u UpperConfidenceBound) Acquire(mean, std, best float64) (a, dMean, dStd float64) {
+	kappa := u.Kappa
+	if kappa == 0 {
+		kappa = 2
+	}
+	return kappa*std - mean, -1, kappa
+}
+
+func normalCDF(z float64) float64 {
+	return math.Erfc(-z/math.Sqrt2) / 2
+}
+
+func normalPDF(z float64) float64 {
+	return math.Exp(-z*z/2) / math.Sqrt(2*math.Pi)
+}
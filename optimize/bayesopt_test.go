@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestGaussianProcessDerivatives(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	const dim, n = 3, 12
+	xs := make([][]float64, n)
+	fs := make([]float64, n)
+	for i := range xs {
+		xs[i] = make([]float64, dim)
+		for j := range xs[i] {
+			xs[i][j] = rnd.Float64()
+		}
+		fs[i] = math.Sin(3*xs[i][0]) + xs[i][1]*xs[i][2] + 0.01*rnd.NormFloat64()
+	}
+	gp := newGaussianProcess(dim)
+	gp.setData(xs, fs)
+	theta := []float64{math.Log(0.3), math.Log(0.7), math.Log(1.2), math.Log(1.5), math.Log(1e-3)}
+
+	grad := make([]float64, len(theta))
+	gp.negLogLikelihood(grad, theta)
+	want := fd.Gradient(nil, func(theta []float64) float64 {
+		return gp.negLogLikelihood(nil, theta)
+	}, theta, &fd.Settings{Formula: fd.Central})
+	if !floats.EqualApprox(grad, want, 1e-5) {
+		t.Errorf("unexpected likelihood gradient: got %v, want %v", grad, want)
+	}
+
+	copy(gp.theta, theta)
+	gp.factorize(gp.theta)
+	dMean := make([]float64, dim)
+	dStd := make([]float64, dim)
+	for range 5 {
+		u := []float64{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+		gp.predict(dMean, dStd, u)
+		wantMean := fd.Gradient(nil, func(u []float64) float64 {
+			mean, _ := gp.predict(nil, nil, u)
+			return mean
+		}, u, &fd.Settings{Formula: fd.Central})
+		wantStd := fd.Gradient(nil, func(u []float64) float64 {
+			_, std := gp.predict(nil, nil, u)
+			return std
+		}, u, &fd.Settings{Formula: fd.Central})
+		if !floats.EqualApprox(dMean, wantMean, 1e-5) {
+			t.Errorf("unexpected mean gradient at %v: got %v, want %v", u, dMean, wantMean)
+		}
+		if !floats.EqualApprox(dStd, wantStd, 1e-5) {
+			t.Errorf("unexpected standard deviation gradient at %v: got %v, want %v", u, dStd, wantStd)
+		}
+	}
+
+	// The prediction interpolates observations with small noise.
+	mean, std := gp.predict(nil, nil, xs[0])
+	if got := gp.mean + gp.scale*mean; math.Abs(got-fs[0]) > 0.05 {
+		t.Errorf("unexpected prediction at observation: got %v, want %v", got, fs[0])
+	}
+	if std > 0.1 {
+		t.Errorf("unexpected standard deviation at observation: got %v", std)
+	}
+}
+
+func TestAcquisitionDerivatives(t *testing.T) {
+	t.Parallel()
+	for _, acq := range []Acquisition{
+		ExpectedImprovement{},
+		ExpectedImprovement{Exploration: 0.1},
+		ProbabilityOfImprovement{Exploration: 0.05},
+		UpperConfidenceBound{},
+		UpperConfidenceBound{Kappa: 0.5},
+	} {
+		for _, test := range []struct{ mean, std float64 }{
+			{mean: -0.5, std: 0.3},
+			{mean: 0.2, std: 1.1},
+			{mean: 1.5, std: 0.2},
+		} {
+			const best = -0.2
+			_, dMean, dStd := acq.Acquire(test.mean, test.std, best)
+			const h = 1e-6
+			a1, _, _ := acq.Acquire(test.mean+h, test.std, best)
+			a0, _, _ := acq.Acquire(test.mean-h, test.std, best)
+			if want := (a1 - a0) / (2 * h); math.Abs(dMean-want) > 1e-6 {
+				t.Errorf("%#v: unexpected mean derivative: got %v, want %v", acq, dMean, want)
+			}
+			a1, _, _ = acq.Acquire(test.mean, test.std+h, best)
+			a0, _, _ = acq.Acquire(test.mean, test.std-h, best)
+			if want := (a1 - a0) / (2 * h); math.Abs(dStd-want) > 1e-6 {
+				t.Errorf("%#v: unexpected standard deviation derivative: got %v, want %v", acq, dStd, want)
+			}
+		}
+	}
+
+	// Expected improvement is positive and at least the certain
+	// improvement.
+	a, _, _ := ExpectedImprovement{}.Acquire(-1, 0.5, 0)
+	if a <= 1 {
+		t.Errorf("unexpected expected improvement: got %v, want more than 1", a)
+	}
+	a, _, _ = ExpectedImprovement{}.Acquire(1, 0, 0)
+	if a != 0 {
+		t.Errorf("unexpected expected improvement without uncertainty: got %v, want 0", a)
+	}
+}
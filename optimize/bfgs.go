@@ -14,6 +14,7 @@ var (
 	_ Method          = (*BFGS)(nil)
 	_ localMethod     = (*BFGS)(nil)
 	_ NextDirectioner = (*BFGS)(nil)
+	_ Snapshotter     = (*BFGS)(nil)
 )
 
 // BFGS implements the Broyden–Fletcher–Goldfarb–Shanno optimization method. It
@@ -36,6 +37,7 @@ type BFGS struct {
 	status Status
 	err    error
 
+	n    int // Dimension of the problem.
 	dim  int
 	x    mat.VecDense // Location of the last major iteration.
 	grad mat.VecDense // Gradient at the last major iteration.
@@ -45,7 +47,8 @@ type BFGS struct {
 
 	invHess *mat.SymDense
 
-	first bool // Indicator of the first iteration.
+	first  bool // Indicator of the first iteration.
+	resume bool // Indicator of a state restored from a snapshot.
 }
 
 func (b *BFGS) Status() (Status, error) {
@@ -59,11 +62,14 @@ func (*BFGS) Uses(has Available) (uses Available, err error) {
 func (b *BFGS) Init(dim, tasks int) int {
 	b.status = NotTerminated
 	b.err = nil
+	b.n = dim
+	b.dim = 0
+	b.resume = false
 	return 1
 }
 
 func (b *BFGS) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
-	b.status, b.err = localOptimizer{}.run(b, b.GradStopThreshold, operation, result, tasks)
+	b.status, b.err = localOptimizer{resume: b.resume}.run(b, b.GradStopThreshold, operation, result, tasks)
 	close(operation)
 }
 
@@ -85,6 +91,13 @@ func (b *BFGS) iterateLocal(loc *Location) (Operation, error) {
 }
 
 func (b *BFGS) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	if b.resume {
+		b.resume = false
+		if b.dim != 0 {
+			// Continue with the restored inverse Hessian estimate.
+			return b.NextDirection(loc, dir)
+		}
+	}
 	dim := len(loc.X)
 	b.dim = dim
 	b.first = true
@@ -190,3 +203,51 @@ func (*BFGS) needs() struct {
 		Hessian  bool
 	}{true, false}
 }
+
+// bfgsState is the encoded state of BFGS.
+type bfgsState struct {
+	Dim     int
+	First   bool
+	X, Grad []float64
+	InvHess []float64
+}
+
+// Snapshot returns an encoding of the state of the method at the last major
+// iteration.
+func (b *BFGS) Snapshot() ([]byte, error) {
+	v := bfgsState{Dim: b.dim, First: b.first}
+	if b.dim != 0 {
+		v.X = mat.Col(nil, 0, &b.x)
+		v.Grad = mat.Col(nil, 0, &b.grad)
+		v.InvHess = b.invHess.RawSymmetric().Data
+	}
+	return encodeState(&v)
+}
+
+// Restore restores the state of the method from a snapshot. Restore must be
+// called after Init.
+func (b *BFGS) Restore(data []byte) error {
+	var v bfgsState
+	err := decodeState(data, &v)
+	if err != nil {
+		return err
+	}
+	if v.Dim != 0 && v.Dim != b.n {
+		return errBadSnapshot
+	}
+	if v.Dim < 0 || (v.Dim != 0 && (len(v.X) != v.Dim || len(v.Grad) != v.Dim || len(v.InvHess) != v.Dim*v.Dim)) {
+		return errBadSnapshot
+	}
+	b.dim = v.Dim
+	b.first = v.First
+	if v.Dim != 0 {
+		b.x.CloneFromVec(mat.NewVecDense(v.Dim, v.X))
+		b.grad.CloneFromVec(mat.NewVecDense(v.Dim, v.Grad))
+		b.invHess = mat.NewSymDense(v.Dim, v.InvHess)
+		b.s.Reset()
+		b.y.Reset()
+		b.tmp.Reset()
+	}
+	b.resume = true
+	return nil
+}
@@ -0,0 +1,826 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method   = (*BOBYQA)(nil)
+	_ Statuser = (*BOBYQA)(nil)
+)
+
+// BOBYQA implements Powell's BOBYQA method for gradient-free minimization
+// subject to optional bounds on the variables,
+//
+//	Lower[i] <= x[i] <= Upper[i].
+//
+// BOBYQA is a trust-region method that minimizes a quadratic model of the
+// function. The model interpolates the function values at a set of
+// InterpolationPoints points, and when a point of the set is replaced by a
+// new one, the model is updated so that the Frobenius norm of the change of
+// its Hessian is least. The trust-region steps are computed by a truncated
+// conjugate gradient method that respects the bounds. When the interpolation
+// points are poorly placed for the model to be accurate, a point far from the
+// best one is moved to improve their geometry.
+//
+// The accuracy of the model is governed by the radius ρ, which is a lower
+// bound on the trust-region radius and the distance between the interpolation
+// points. ρ is decreased from InitialRadius to FinalRadius as the iterations
+// proceed, and the method converges when no further progress is possible with
+// ρ equal to FinalRadius. Each trust-region iteration is a major iteration
+// that reports the best point found so far. All of the evaluated points
+// satisfy the bounds. If ρ is too small relative to the location for the
+// interpolation points to be distinct in floating-point arithmetic, BOBYQA
+// fails with ErrTrustRegionCollapse.
+//
+// Without bounds, BOBYQA is essentially Powell's NEWUOA method. It is
+// typically much more efficient than NelderMead for smooth functions,
+// especially in higher dimensions.
+//
+// References:
+//   - Powell, M. J. D. (2009). The BOBYQA algorithm for bound constrained
+//     optimization without derivatives. Technical Report DAMTP 2009/NA06,
+//     University of Cambridge.
+//   - Powell, M. J. D. (2006). The NEWUOA software for unconstrained
+//     optimization without derivatives. In Large-Scale Nonlinear
+//     Optimization, 255-297. Springer.
+type BOBYQA struct {
+	// Lower and Upper are optional bounds on the variables. If Lower is nil,
+	// the variables are not bounded from below, and if Upper is nil they are
+	// not bounded from above. Otherwise they must have the same length as the
+	// problem dimension, and individual entries may be infinite. The distance
+	// between the lower and the upper bound of each variable must be at least
+	// twice the initial radius.
+	Lower, Upper []float64
+	// InterpolationPoints is the number of interpolation points. It must be
+	// between dim+2 and (dim+1)(dim+2)/2. If InterpolationPoints is zero, it
+	// is defaulted to 2*dim+1.
+	InterpolationPoints int
+	// InitialRadius is the initial value of ρ, which should be about one
+	// tenth of the expected change of the variables. If InitialRadius is
+	// zero, it is defaulted to 0.5, or to half of the smallest distance
+	// between the bounds if that is smaller.
+	InitialRadius float64
+	// FinalRadius is the final value of ρ, which determines the accuracy of
+	// the solution. If FinalRadius is zero, it is defaulted to 1e-8.
+	FinalRadius float64
+
+	status Status
+	err    error
+
+	dim, npt         int
+	rho, rhoEnd      float64
+	delta            float64
+	rhoBeg           float64
+	pts              *mat.Dense // Interpolation points in rows.
+	fs               []float64  // Function values at the interpolation points.
+	kopt             int        // Index of the best interpolation point.
+	stepP, stepM     []float64  // Initial displacements of the coordinates.
+	sl, su           []float64  // Bounds on the step from the best point.
+	xnew, d, hd, tmp []float64
+	work             []float64
+
+	// The quadratic model is
+	//  Q(x) = c + gᵀs + ½ sᵀHs,  s = x - xc.
+	xc []float64
+	c  float64
+	g  []float64
+	h  *mat.SymDense
+
+	// The interpolation conditions are solved in the displacements from xc
+	// divided by scale.
+	scale float64
+	disp  *mat.Dense // Scaled displacements of the interpolation points.
+	kkt   *mat.Dense
+	lu    mat.LU
+	rhs   []float64
+	sol   []float64
+}
+
+func (b *BOBYQA) Status() (Status, error) {
+	return b.status, b.err
+}
+
+func (*BOBYQA) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (b *BOBYQA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(b.Lower, b.Upper, dim)
+	b.dim = dim
+	b.npt = b.InterpolationPoints
+	if b.npt == 0 {
+		b.npt = 2*dim + 1
+	}
+	if b.npt < dim+2 || b.npt > (dim+1)*(dim+2)/2 {
+		panic("optimize: BOBYQA number of interpolation points out of range")
+	}
+	minWidth := math.Inf(1)
+	for i := 0; i < dim; i++ {
+		minWidth = math.Min(minWidth, upperBound(b.Upper, i)-lowerBound(b.Lower, i))
+	}
+	b.rhoBeg = b.InitialRadius
+	if b.rhoBeg == 0 {
+		b.rhoBeg = math.Min(0.5, minWidth/2)
+	}
+	b.rhoEnd = b.FinalRadius
+	if b.rhoEnd == 0 {
+		b.rhoEnd = math.Min(1e-8, b.rhoBeg)
+	}
+	if b.rhoBeg <= 0 || b.rhoEnd <= 0 || b.rhoEnd > b.rhoBeg {
+		panic("optimize: BOBYQA radius out of range")
+	}
+	if minWidth < 2*b.rhoBeg {
+		panic("optimize: BOBYQA initial radius larger than half the distance between bounds")
+	}
+	b.status = NotTerminated
+	b.err = nil
+
+	m := b.npt + dim + 1
+	b.pts = mat.NewDense(b.npt, dim, nil)
+	b.fs = resize(b.fs, b.npt)
+	b.stepP = resize(b.stepP, dim)
+	b.stepM = resize(b.stepM, dim)
+	b.sl = resize(b.sl, dim)
+	b.su = resize(b.su, dim)
+	b.xnew = resize(b.xnew, dim)
+	b.d = resize(b.d, dim)
+	b.hd = resize(b.hd, dim)
+	b.tmp = resize(b.tmp, dim)
+	b.work = resize(b.work, dim)
+	b.xc = resize(b.xc, dim)
+	b.g = resize(b.g, dim)
+	b.h = mat.NewSymDense(dim, nil)
+	b.disp = mat.NewDense(b.npt, dim, nil)
+	b.kkt = mat.NewDense(m, m, nil)
+	b.rhs = resize(b.rhs, m)
+	b.sol = resize(b.sol, m)
+	return 1
+}
+
+func (b *BOBYQA) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	b.status, b.err = b.run(&syncTasker{operation: operation, result: result, task: tasks[0]})
+	close(operation)
+}
+
+func (b *BOBYQA) run(t *syncTasker) (Status, error) {
+	loc := t.task.Location
+	x0 := make([]float64, b.dim)
+	copy(x0, loc.X)
+	b.adjustStart(x0)
+	op := FuncEvaluation
+	if floats.Equal(x0, loc.X) {
+		// The initial function value may have been provided.
+		op &^= t.task.Op
+	}
+	copy(loc.X, x0)
+	if !t.do(op) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if math.IsInf(loc.F, 0) || math.IsNaN(loc.F) {
+		t.finishMethodDone()
+		return Failure, ErrFunc(loc.F)
+	}
+
+	b.rho = b.rhoBeg
+	b.delta = b.rho
+	ok, err := b.initPoints(t, x0, loc.F)
+	if !ok {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if err != nil {
+		t.finishMethodDone()
+		return Failure, err
+	}
+	if !b.majorIteration(t) {
+		t.finish()
+		return NotTerminated, nil
+	}
+
+	for {
+		if !b.factorize() {
+			// The interpolation points have become degenerate. Start again
+			// with new points around the best one.
+			copy(x0, b.pts.RawRowView(b.kopt))
+			ok, err = b.initPoints(t, x0, b.fs[b.kopt])
+			if !ok {
+				t.finish()
+				return NotTerminated, nil
+			}
+			if err != nil {
+				t.finishMethodDone()
+				return Failure, err
+			}
+			continue
+		}
+
+		xopt := b.pts.RawRowView(b.kopt)
+		fopt := b.fs[b.kopt]
+		for i, v := range xopt {
+			b.sl[i] = lowerBound(b.Lower, i) - v
+			b.su[i] = upperBound(b.Upper, i) - v
+		}
+		b.trustRegionStep()
+		dnorm := floats.Norm(b.d, 2)
+		if dnorm < b.rho/2 {
+			// The step is too short to give a reliable reduction. Improve
+			// the model if it is inaccurate, otherwise decrease ρ.
+			b.delta = math.Max(b.delta/10, b.rho)
+			if b.delta <= 1.5*b.rho {
+				b.delta = b.rho
+			}
+			if k := b.farthest(2 * b.rho); k >= 0 {
+				ok, err = b.geometryStep(t, k)
+			} else {
+				ok, err = b.reduceRho(t)
+			}
+			if !ok {
+				t.finish()
+				return NotTerminated, nil
+			}
+			if err != nil || b.status == MethodConverge {
+				t.finishMethodDone()
+				if err != nil {
+					return Failure, err
+				}
+				return MethodConverge, nil
+			}
+			continue
+		}
+
+		floats.AddTo(b.xnew, xopt, b.d)
+		projectBounds(b.xnew, b.Lower, b.Upper)
+		fnew, ok := b.evaluate(t, b.xnew)
+		if !ok {
+			t.finish()
+			return NotTerminated, nil
+		}
+		floats.SubTo(b.d, b.xnew, xopt)
+		pred := -b.modelChange(b.d)
+		ratio := -1.0
+		if pred > 0 && !math.IsNaN(fnew) && !math.IsInf(fnew, 0) {
+			ratio = (fopt - fnew) / pred
+		}
+		switch {
+		case ratio <= 0.1:
+			b.delta = math.Min(b.delta/2, dnorm)
+		case ratio <= 0.7:
+			b.delta = math.Max(b.delta/2, dnorm)
+		default:
+			b.delta = math.Max(b.delta/2, 2*dnorm)
+		}
+		if b.delta <= 1.5*b.rho {
+			b.delta = b.rho
+		}
+		if !math.IsNaN(fnew) && !math.IsInf(fnew, 0) {
+			b.include(b.xnew, fnew)
+		}
+		if !b.majorIteration(t) {
+			t.finish()
+			return NotTerminated, nil
+		}
+		if ratio >= 0.1 {
+			continue
+		}
+
+		// The step has not been successful. Improve the model if it is
+		// inaccurate, otherwise decrease ρ when the trust region cannot be
+		// decreased any further.
+		switch k := b.farthest(2 * b.delta); {
+		case k >= 0:
+			ok, err = b.geometryStep(t, k)
+		case ratio <= 0 && b.delta <= b.rho:
+			ok, err = b.reduceRho(t)
+		default:
+			continue
+		}
+		if !ok {
+			t.finish()
+			return NotTerminated, nil
+		}
+		if err != nil || b.status == MethodConverge {
+			t.finishMethodDone()
+			if err != nil {
+				return Failure, err
+			}
+			return MethodConverge, nil
+		}
+	}
+}
+
+// adjustStart moves the initial location so that it is either on a bound or
+// at a distance of at least the initial radius from it.
+func (b *BOBYQA) adjustStart(x []float64) {
+	projectBounds(x, b.Lower, b.Upper)
+	for i, v := range x {
+		lo, up := lowerBound(b.Lower, i), upperBound(b.Upper, i)
+		switch {
+		case lo < v && v < lo+b.rhoBeg:
+			x[i] = lo + b.rhoBeg
+		case up-b.rhoBeg < v && v < up:
+			x[i] = up - b.rhoBeg
+		}
+	}
+}
+
+// initPoints sets the interpolation points around x, where the function value
+// is f, with the current value of ρ, and builds the initial model. The
+// returned bool is false if the optimization has been terminated.
+func (b *BOBYQA) initPoints(t *syncTasker, x []float64, f float64) (bool, error) {
+	n := b.dim
+	rho := b.rho
+	for i, v := range x {
+		lo, up := lowerBound(b.Lower, i), upperBound(b.Upper, i)
+		if v+rho <= up {
+			b.stepP[i] = rho
+			if v-rho >= lo {
+				b.stepM[i] = -rho
+			} else {
+				b.stepM[i] = math.Min(2*rho, up-v)
+			}
+		} else {
+			b.stepP[i] = -rho
+			b.stepM[i] = -math.Min(2*rho, v-lo)
+		}
+	}
+	b.pts.SetRow(0, x)
+	b.fs[0] = f
+	var i, j int // Coordinates of the points displaced in two coordinates.
+	for k := 1; k < b.npt; k++ {
+		row := b.pts.RawRowView(k)
+		copy(row, x)
+		switch {
+		case k <= n:
+			row[k-1] += b.stepP[k-1]
+		case k <= 2*n:
+			row[k-n-1] += b.stepM[k-n-1]
+		default:
+			if j++; j >= n || k == 2*n+1 {
+				if k > 2*n+1 {
+					i++
+				}
+				j = i + 1
+			}
+			// Use the displacement along each coordinate that gave the
+			// lower function value.
+			for _, l := range [2]int{i, j} {
+				if b.fs[l+1] <= b.fs[l+n+1] {
+					row[l] += b.stepP[l]
+				} else {
+					row[l] += b.stepM[l]
+				}
+			}
+		}
+		fk, ok := b.evaluate(t, row)
+		if !ok {
+			return false, nil
+		}
+		if math.IsNaN(fk) || math.IsInf(fk, 0) {
+			return true, ErrFunc(fk)
+		}
+		b.fs[k] = fk
+	}
+	b.kopt = 0
+	for k, fk := range b.fs {
+		if fk < b.fs[b.kopt] {
+			b.kopt = k
+		}
+	}
+
+	// Build the model of least Frobenius norm of the Hessian that
+	// interpolates the function values.
+	copy(b.xc, x)
+	b.c = 0
+	for i := range b.g {
+		b.g[i] = 0
+	}
+	b.h.Zero()
+	if !b.factorize() {
+		// ρ is too small relative to x for the points to be distinct.
+		return true, ErrTrustRegionCollapse
+	}
+	b.updateModel()
+	b.shiftModel()
+	return true, nil
+}
+
+// evaluate evaluates the function at x. The returned bool is false if the
+// optimization has been terminated.
+func (b *BOBYQA) evaluate(t *syncTasker, x []float64) (float64, bool) {
+	loc := t.task.Location
+	copy(loc.X, x)
+	if !t.do(FuncEvaluation) {
+		return 0, false
+	}
+	return loc.F, true
+}
+
+// majorIteration sends a MajorIteration with the best interpolation point.
+// It returns false if the optimization has been terminated.
+func (b *BOBYQA) majorIteration(t *syncTasker) bool {
+	loc := t.task.Location
+	copy(loc.X, b.pts.RawRowView(b.kopt))
+	loc.F = b.fs[b.kopt]
+	return t.do(MajorIteration)
+}
+
+// reduceRho decreases ρ, or sets the status to MethodConverge if ρ has
+// reached its final value. The returned bool is false if the optimization
+// has been terminated.
+func (b *BOBYQA) reduceRho(t *syncTasker) (bool, error) {
+	// The distances between the points are limited by the floating-point
+	// resolution of the best point.
+	minRho := 10 * machEps * math.Max(1, floats.Norm(b.pts.RawRowView(b.kopt), math.Inf(1)))
+	if b.rho <= b.rhoEnd || b.rho <= minRho {
+		b.status = MethodConverge
+		return b.majorIteration(t), nil
+	}
+	old := b.rho
+	switch ratio := b.rho / b.rhoEnd; {
+	case ratio <= 16:
+		b.rho = b.rhoEnd
+	case ratio <= 250:
+		b.rho = math.Sqrt(ratio) * b.rhoEnd
+	default:
+		b.rho /= 10
+	}
+	b.delta = math.Max(old/2, b.rho)
+	return true, nil
+}
+
+// farthest returns the index of the interpolation point that is farthest
+// from the best point if its distance is larger than dist, and -1 otherwise.
+func (b *BOBYQA) farthest(dist float64) int {
+	xopt := b.pts.RawRowView(b.kopt)
+	k := -1
+	for j := 0; j < b.npt; j++ {
+		if d := floats.Distance(b.pts.RawRowView(j), xopt, 2); d > dist {
+			k = j
+			dist = d
+		}
+	}
+	return k
+}
+
+// modelChange returns Q(xc + d) - Q(xc).
+func (b *BOBYQA) modelChange(d []float64) float64 {
+	hd := mat.NewVecDense(b.dim, b.work)
+	hd.MulVec(b.h, mat.NewVecDense(b.dim, d))
+	return floats.Dot(b.g, d) + floats.Dot(d, b.work)/2
+}
+
+// factorize computes the LU factorization of the matrix of the interpolation
+// conditions
+//
+//	[ A  P ]
+//	[ Pᵀ 0 ],
+//
+// where A_ij = ½ (s_iᵀ s_j)² and the rows of P are [1 s_iᵀ] for the scaled
+// displacements s_i of the interpolation points from the center of the model.
+// factorize returns false if the matrix is nearly singular.
+func (b *BOBYQA) factorize() bool {
+	n, m := b.dim, b.npt
+	b.scale = 0
+	for k := 0; k < m; k++ {
+		row := b.disp.RawRowView(k)
+		floats.SubTo(row, b.pts.RawRowView(k), b.xc)
+		b.scale = math.Max(b.scale, floats.Norm(row, 2))
+	}
+	if b.scale == 0 {
+		return false
+	}
+	b.disp.Scale(1/b.scale, b.disp)
+	b.kkt.Zero()
+	for i := 0; i < m; i++ {
+		si := b.disp.RawRowView(i)
+		for j := 0; j <= i; j++ {
+			v := floats.Dot(si, b.disp.RawRowView(j))
+			b.kkt.Set(i, j, v*v/2)
+			b.kkt.Set(j, i, v*v/2)
+		}
+		b.kkt.Set(i, m, 1)
+		b.kkt.Set(m, i, 1)
+		for l := 0; l < n; l++ {
+			b.kkt.Set(i, m+1+l, si[l])
+			b.kkt.Set(m+1+l, i, si[l])
+		}
+	}
+	b.lu.Factorize(b.kkt)
+	return b.lu.Cond() < 1/(1e4*machEps)
+}
+
+// solve solves the system of the interpolation conditions with the right-hand
+// side b.rhs and stores the solution into b.sol.
+func (b *BOBYQA) solve() {
+	sol := mat.NewVecDense(len(b.sol), b.sol)
+	// The only possible error is a mat.Condition, and the condition number
+	// has been checked by factorize.
+	_ = b.lu.SolveVecTo(sol, false, mat.NewVecDense(len(b.rhs), b.rhs))
+}
+
+// updateModel updates the model so that it interpolates the function values
+// at the interpolation points while the Frobenius norm of the change of the
+// Hessian is least. factorize must have been called after the last change of
+// the interpolation points.
+func (b *BOBYQA) updateModel() {
+	m := b.npt
+	for k := 0; k < m; k++ {
+		floats.SubTo(b.tmp, b.pts.RawRowView(k), b.xc)
+		b.rhs[k] = b.fs[k] - b.c - b.modelChange(b.tmp)
+	}
+	for i := m; i < len(b.rhs); i++ {
+		b.rhs[i] = 0
+	}
+	b.solve()
+	b.c += b.sol[m]
+	floats.AddScaled(b.g, 1/b.scale, b.sol[m+1:])
+	s2 := b.scale * b.scale
+	for k := 0; k < m; k++ {
+		b.h.SymRankOne(b.h, b.sol[k]/s2, mat.NewVecDense(b.dim, b.disp.RawRowView(k)))
+	}
+}
+
+// shiftModel moves the center of the model to the best interpolation point.
+func (b *BOBYQA) shiftModel() {
+	xopt := b.pts.RawRowView(b.kopt)
+	floats.SubTo(b.d, xopt, b.xc)
+	hd := mat.NewVecDense(b.dim, b.hd)
+	hd.MulVec(b.h, mat.NewVecDense(b.dim, b.d))
+	floats.Add(b.g, b.hd)
+	b.c = b.fs[b.kopt]
+	copy(b.xc, xopt)
+}
+
+// lagrangeValues stores into dst the values of the Lagrange functions of the
+// interpolation points at x. factorize must have been called after the last
+// change of the interpolation points.
+func (b *BOBYQA) lagrangeValues(dst, x []float64) {
+	m := b.npt
+	floats.SubTo(b.tmp, x, b.xc)
+	floats.Scale(1/b.scale, b.tmp)
+	for k := 0; k < m; k++ {
+		v := floats.Dot(b.disp.RawRowView(k), b.tmp)
+		b.rhs[k] = v * v / 2
+	}
+	b.rhs[m] = 1
+	copy(b.rhs[m+1:], b.tmp)
+	b.solve()
+	copy(dst, b.sol[:m])
+}
+
+// include replaces an interpolation point by x with the function value f
+// and updates the model. The point to be replaced is chosen so that the
+// interpolation points remain well placed, preferring points far from the
+// best point.
+func (b *BOBYQA) include(x []float64, f float64) {
+	ell := make([]float64, b.npt)
+	b.lagrangeValues(ell, x)
+	xopt := b.pts.RawRowView(b.kopt)
+	improved := f < b.fs[b.kopt]
+	knew := -1
+	var best float64
+	for k, l := range ell {
+		if k == b.kopt && !improved {
+			continue
+		}
+		dist := floats.Distance(b.pts.RawRowView(k), xopt, 2) / b.delta
+		score := math.Abs(l) * math.Max(1, dist*dist)
+		if score > best {
+			knew = k
+			best = score
+		}
+	}
+	if knew < 0 || best < 1e-8 {
+		// The new point would make the interpolation points degenerate.
+		return
+	}
+	b.replace(knew, x, f)
+}
+
+// replace replaces the k-th interpolation point by x with the function value
+// f and updates the model.
+func (b *BOBYQA) replace(k int, x []float64, f float64) {
+	b.pts.SetRow(k, x)
+	b.fs[k] = f
+	if !b.factorize() {
+		// Leave the restart to the main iteration.
+		return
+	}
+	b.updateModel()
+	if f < b.fs[b.kopt] {
+		b.kopt = k
+	}
+	b.shiftModel()
+}
+
+// geometryStep replaces the k-th interpolation point by a point near the
+// best one where the magnitude of the Lagrange function of the k-th point is
+// large. The returned bool is false if the optimization has been terminated.
+func (b *BOBYQA) geometryStep(t *syncTasker, k int) (bool, error) {
+	n, m := b.dim, b.npt
+	xopt := b.pts.RawRowView(b.kopt)
+	dist := floats.Distance(b.pts.RawRowView(k), xopt, 2)
+	radius := math.Max(math.Min(dist/10, b.delta), b.rho)
+
+	// Compute the coefficients of the Lagrange function of the k-th point.
+	// The model is centered at the best point.
+	for i := range b.rhs {
+		b.rhs[i] = 0
+	}
+	b.rhs[k] = 1
+	b.solve()
+	lambda := b.sol[:m]
+	grad := make([]float64, n)
+	floats.ScaleTo(grad, 1/b.scale, b.sol[m+1:])
+	// curv returns the coefficient of alpha² of the Lagrange function at
+	// xopt + alpha*u for a unit vector u.
+	curv := func(u []float64) float64 {
+		var q float64
+		for j := 0; j < m; j++ {
+			v := floats.Dot(b.disp.RawRowView(j), u)
+			q += lambda[j] * v * v
+		}
+		return q / (2 * b.scale * b.scale)
+	}
+
+	bestAlpha := 0.0
+	bestValue := -1.0
+	bestDir := make([]float64, n)
+	u := make([]float64, n)
+	try := func(u []float64) {
+		nrm := floats.Norm(u, 2)
+		if nrm == 0 {
+			return
+		}
+		floats.Scale(1/nrm, u)
+		a1 := floats.Dot(grad, u)
+		a2 := curv(u)
+		// Find the feasible interval [lo, up] of the step along u.
+		lo, up := -radius, radius
+		for i, v := range u {
+			switch {
+			case v > 0:
+				lo = math.Max(lo, b.sl[i]/v)
+				up = math.Min(up, b.su[i]/v)
+			case v < 0:
+				lo = math.Max(lo, b.su[i]/v)
+				up = math.Min(up, b.sl[i]/v)
+			}
+		}
+		cands := [3]float64{lo, up, math.NaN()}
+		if a2 != 0 {
+			if s := -a1 / (2 * a2); lo < s && s < up {
+				cands[2] = s
+			}
+		}
+		for _, alpha := range cands {
+			if math.IsNaN(alpha) {
+				continue
+			}
+			// The value of the Lagrange function at xopt is zero, except
+			// for the best point itself.
+			v := math.Abs(alpha*a1 + alpha*alpha*a2)
+			if v > bestValue {
+				bestValue = v
+				bestAlpha = alpha
+				copy(bestDir, u)
+			}
+		}
+	}
+	for i, v := range xopt {
+		b.sl[i] = lowerBound(b.Lower, i) - v
+		b.su[i] = upperBound(b.Upper, i) - v
+	}
+	for j := 0; j < m; j++ {
+		if j == b.kopt {
+			continue
+		}
+		floats.SubTo(u, b.pts.RawRowView(j), xopt)
+		try(u)
+	}
+	copy(u, grad)
+	try(u)
+
+	floats.AddScaledTo(b.xnew, xopt, bestAlpha, bestDir)
+	projectBounds(b.xnew, b.Lower, b.Upper)
+	f, ok := b.evaluate(t, b.xnew)
+	if !ok {
+		return false, nil
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return true, ErrFunc(f)
+	}
+	b.replace(k, b.xnew, f)
+	return true, nil
+}
+
+// trustRegionStep stores into b.d an approximate minimizer of the model
+// change Q(xc + d) - Q(xc) subject to ‖d‖ ≤ delta and b.sl ≤ d ≤ b.su by the
+// truncated conjugate gradient method. Variables that reach a bound are fixed
+// and the conjugate gradient iteration is restarted with the other variables.
+func (b *BOBYQA) trustRegionStep() {
+	n := b.dim
+	d := b.d
+	for i := range d {
+		d[i] = 0
+	}
+	free := make([]bool, n)
+	gd := make([]float64, n) // Gradient of the model at xc + d.
+	copy(gd, b.g)
+	for i := range free {
+		free[i] = !(b.sl[i] >= 0 && gd[i] >= 0) && !(b.su[i] <= 0 && gd[i] <= 0)
+	}
+	p := make([]float64, n)
+	hpv := mat.NewVecDense(n, b.hd)
+	pv := mat.NewVecDense(n, p)
+	gnorm0 := floats.Norm(gd, 2)
+	delta2 := b.delta * b.delta
+
+Restart:
+	for iter := 0; iter < 2*n; iter++ {
+		var rr float64
+		for i := range p {
+			p[i] = 0
+			if free[i] {
+				p[i] = -gd[i]
+				rr += gd[i] * gd[i]
+			}
+		}
+		for cg := 0; cg < n; cg++ {
+			if rr <= 1e-20*gnorm0*gnorm0 || rr == 0 {
+				return
+			}
+			hpv.MulVec(b.h, pv)
+			curv := floats.Dot(p, b.hd)
+			pp := floats.Dot(p, p)
+			dp := floats.Dot(d, p)
+			dd := floats.Dot(d, d)
+			alphaBall := (-dp + math.Sqrt(math.Max(0, dp*dp+pp*(delta2-dd)))) / pp
+			alpha := alphaBall
+			if curv > 0 {
+				alpha = math.Min(alpha, rr/curv)
+			}
+			bound := -1
+			for i, v := range p {
+				var a float64
+				switch {
+				case v > 0:
+					a = (b.su[i] - d[i]) / v
+				case v < 0:
+					a = (b.sl[i] - d[i]) / v
+				default:
+					continue
+				}
+				if a < alpha {
+					alpha = a
+					bound = i
+				}
+			}
+			alpha = math.Max(alpha, 0)
+			floats.AddScaled(d, alpha, p)
+			floats.AddScaled(gd, alpha, b.hd)
+			if bound >= 0 {
+				// Fix the variable at the bound and restart.
+				if p[bound] > 0 {
+					d[bound] = b.su[bound]
+				} else {
+					d[bound] = b.sl[bound]
+				}
+				free[bound] = false
+				continue Restart
+			}
+			if alpha == alphaBall {
+				return
+			}
+			var rrNew float64
+			for i, v := range gd {
+				if free[i] {
+					rrNew += v * v
+				}
+			}
+			beta := rrNew / rr
+			rr = rrNew
+			for i := range p {
+				if free[i] {
+					p[i] = -gd[i] + beta*p[i]
+				}
+			}
+		}
+		return
+	}
+}
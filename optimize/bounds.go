@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+// gradientProjector is implemented by Methods that minimize over a feasible
+// set smaller than the whole space, such as a box given by bounds on the
+// variables or a set described by general constraints. At a constrained
+// minimum the gradient need not vanish, so the projected gradient is used in
+// place of the gradient when checking for gradient convergence.
+type gradientProjector interface {
+	// projectGradient stores the projected gradient at loc into dst.
+	projectGradient(dst []float64, loc *Location)
+}
+
+// checkBounds panics if the lower and upper bounds are inconsistent with
+// each other or with the problem dimension. A nil slice indicates that the
+// variables are unbounded in that direction.
+func checkBounds(lower, upper []float64, dim int) {
+	if lower != nil && len(lower) != dim {
+		panic("optimize: lower bound length mismatch")
+	}
+	if upper != nil && len(upper) != dim {
+		panic("optimize: upper bound length mismatch")
+	}
+	for i := 0; i < dim; i++ {
+		lo, up := lowerBound(lower, i), upperBound(upper, i)
+		if math.IsNaN(lo) || math.IsNaN(up) || lo > up {
+			panic("optimize: invalid bounds")
+		}
+	}
+}
+
+// lowerBound returns the lower bound of the i-th variable.
+func lowerBound(lower []float64, i int) float64 {
+	if lower == nil {
+		return math.Inf(-1)
+	}
+	return lower[i]
+}
+
+// upperBound returns the upper bound of the i-th variable.
+func upperBound(upper []float64, i int) float64 {
+	if upper == nil {
+		return math.Inf(1)
+	}
+	return upper[i]
+}
+
+// projectBounds projects x onto the box given by lower and upper in place.
+func projectBounds(x, lower, upper []float64) {
+	for i, v := range x {
+		x[i] = math.Min(math.Max(v, lowerBound(lower, i)), upperBound(upper, i))
+	}
+}
+
+// isFeasible returns whether x lies within the box given by lower and upper.
+func isFeasible(x, lower, upper []float64) bool {
+	for i, v := range x {
+		if v < lowerBound(lower, i) || upperBound(upper, i) < v {
+			return false
+		}
+	}
+	return true
+}
+
+// boundedGradient stores into dst the projected gradient
+//
+//	x - P(x - grad),
+//
+// where P is the projection onto the box given by lower and upper. The
+// projected gradient is equal to the gradient for variables away from their
+// bounds, and it is zero at a minimum of a function constrained to the box.
+func boundedGradient(dst, x, grad, lower, upper []float64) {
+	for i, v := range x {
+		p := math.Min(math.Max(v-grad[i], lowerBound(lower, i)), upperBound(upper, i))
+		dst[i] = v - p
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"math/rand/v2"
+)
+
+var _ Snapshotter = (*FunctionConverge)(nil)
+
+// Snapshotter is implemented by types whose internal state can be saved and
+// later restored, so that an optimization run can be resumed after it has
+// been interrupted. Methods implementing Snapshotter can be used with
+// Settings.Checkpoint and Settings.Resume.
+//
+// Minimize calls Snapshot of a Method at a major iteration, while the Method
+// waits for the result of the MajorIteration operation, and calls Restore
+// after Init and before Run.
+type Snapshotter interface {
+	// Snapshot returns an encoding of the internal state.
+	Snapshot() ([]byte, error)
+	// Restore restores the internal state from an encoding returned by
+	// Snapshot of a value with the same configuration.
+	Restore(data []byte) error
+}
+
+// Checkpoint is the state of an optimization run at a major iteration, from
+// which the run can be resumed by passing it in Settings.Resume.
+type Checkpoint struct {
+	// Location is the optimal location at the major iteration. It holds
+	// the location, the function value and the gradient if it is used.
+	Location *Location
+	// Stats are the statistics of the run up to the major iteration.
+	Stats Stats
+	// Method is the state of the Method returned by its Snapshot method.
+	Method []byte
+	// Converger is the state of the Converger if it implements Snapshotter,
+	// and nil otherwise.
+	Converger []byte
+}
+
+// MarshalBinary encodes the checkpoint into a binary form and returns the
+// result. The Location.Hessian and Location.Jacobian fields are not encoded.
+func (c *Checkpoint) MarshalBinary() ([]byte, error) {
+	var v checkpointState
+	if c.Location != nil {
+		v.X = c.Location.X
+		v.F = c.Location.F
+		v.Gradient = c.Location.Gradient
+	}
+	v.Stats = c.Stats
+	v.Method = c.Method
+	v.Converger = c.Converger
+	return encodeState(&v)
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+func (c *Checkpoint) UnmarshalBinary(data []byte) error {
+	var v checkpointState
+	err := decodeState(data, &v)
+	if err != nil {
+		return err
+	}
+	*c = Checkpoint{
+		Location: &Location{
+			X:        v.X,
+			F:        v.F,
+			Gradient: v.Gradient,
+		},
+		Stats:     v.Stats,
+		Method:    v.Method,
+		Converger: v.Converger,
+	}
+	return nil
+}
+
+// checkpointState is the encoded form of a Checkpoint.
+type checkpointState struct {
+	X         []float64
+	F         float64
+	Gradient  []float64
+	Stats     Stats
+	Method    []byte
+	Converger []byte
+}
+
+// newCheckpoint returns a Checkpoint holding a copy of the location loc and
+// the statistics, and the states of method and converger.
+func newCheckpoint(loc *Location, stats *Stats, method Method, converger Converger) (*Checkpoint, error) {
+	c := &Checkpoint{
+		Location: &Location{
+			X:        copySlice(nil, loc.X),
+			F:        loc.F,
+			Gradient: copySlice(nil, loc.Gradient),
+		},
+		Stats: *stats,
+	}
+	var err error
+	c.Method, err = method.(Snapshotter).Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := converger.(Snapshotter); ok {
+		c.Converger, err = s.Snapshot()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// encodeState returns the gob encoding of v.
+func encodeState(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeState decodes the gob encoding in data into v.
+func decodeState(data []byte, v any) error {
+	if len(data) == 0 {
+		return errBadSnapshot
+	}
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+var errBadSnapshot = errors.New("optimize: invalid snapshot")
+
+// sourceState returns the state of src if it implements
+// encoding.BinaryMarshaler, and nil otherwise.
+func sourceState(src rand.Source) ([]byte, error) {
+	if m, ok := src.(encoding.BinaryMarshaler); ok {
+		return m.MarshalBinary()
+	}
+	return nil, nil
+}
+
+// restoreSource restores the state of src from data if data is not empty.
+func restoreSource(src rand.Source, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	u, ok := src.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return errors.New("optimize: cannot restore the state of the random source")
+	}
+	return u.UnmarshalBinary(data)
+}
+
+// functionConvergeState is the encoded state of FunctionConverge.
+type functionConvergeState struct {
+	First bool
+	Best  float64
+	Iter  int
+}
+
+// Snapshot returns an encoding of the state of the convergence test.
+func (fc *FunctionConverge) Snapshot() ([]byte, error) {
+	return encodeState(&functionConvergeState{
+		First: fc.first,
+		Best:  fc.best,
+		Iter:  fc.iter,
+	})
+}
+
+// Restore restores the state of the convergence test.
+func (fc *FunctionConverge) Restore(data []byte) error {
+	var v functionConvergeState
+	err := decodeState(data, &v)
+	if err != nil {
+		return err
+	}
+	fc.first = v.First
+	fc.best = v.Best
+	fc.iter = v.Iter
+	return nil
+}
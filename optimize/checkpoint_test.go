@@ -0,0 +1,219 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestCheckpointResume(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	x0 := []float64{-1.2, 1, -1.2, 1}
+
+	for _, test := range []struct {
+		name      string
+		newMethod func() Method
+	}{
+		{
+			name:      "BFGS",
+			newMethod: func() Method { return &BFGS{} },
+		},
+		{
+			name:      "LBFGS",
+			newMethod: func() Method { return &LBFGS{Store: 3} },
+		},
+		{
+			name:      "NelderMead",
+			newMethod: func() Method { return &NelderMead{} },
+		},
+		{
+			name:      "CmaEsChol",
+			newMethod: func() Method { return &CmaEsChol{Src: rand.NewPCG(1, 1)} },
+		},
+	} {
+		var checkpoints [][]byte
+		settings := &Settings{
+			FuncEvaluations: 2000,
+			Checkpoint: func(c *Checkpoint) error {
+				b, err := c.MarshalBinary()
+				if err != nil {
+					return err
+				}
+				checkpoints = append(checkpoints, b)
+				return nil
+			},
+		}
+		want, err := Minimize(p, x0, settings, test.newMethod())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(checkpoints) < 3 {
+			t.Errorf("%s: too few checkpoints: %d", test.name, len(checkpoints))
+			continue
+		}
+		if len(checkpoints) > want.MajorIterations {
+			t.Errorf("%s: unexpected number of checkpoints: got %d for %d major iterations",
+				test.name, len(checkpoints), want.MajorIterations)
+		}
+
+		for _, i := range []int{0, len(checkpoints) / 2, len(checkpoints) - 1} {
+			var c Checkpoint
+			err := c.UnmarshalBinary(checkpoints[i])
+			if err != nil {
+				t.Fatalf("%s: unexpected error unmarshaling checkpoint: %v", test.name, err)
+			}
+			settings := &Settings{
+				FuncEvaluations: 2000,
+				Resume:          &c,
+			}
+			got, err := Minimize(p, nil, settings, test.newMethod())
+			if err != nil {
+				t.Errorf("%s: unexpected error resuming from checkpoint %d: %v", test.name, i, err)
+				continue
+			}
+			if got.Status != want.Status {
+				t.Errorf("%s: unexpected status resuming from checkpoint %d: got %v, want %v",
+					test.name, i, got.Status, want.Status)
+			}
+			if !floats.Same(got.X, want.X) || got.F != want.F {
+				t.Errorf("%s: resuming from checkpoint %d does not reproduce the run: got %v (%v), want %v (%v)",
+					test.name, i, got.X, got.F, want.X, want.F)
+			}
+			gotStats, wantStats := got.Stats, want.Stats
+			gotStats.Runtime, wantStats.Runtime = 0, 0
+			if gotStats != wantStats {
+				t.Errorf("%s: unexpected stats resuming from checkpoint %d: got %+v, want %+v",
+					test.name, i, gotStats, wantStats)
+			}
+		}
+	}
+}
+
+func TestCheckpointErrors(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	x0 := []float64{-1.2, 1}
+
+	settings := &Settings{Checkpoint: func(*Checkpoint) error { return nil }}
+	_, err := Minimize(p, x0, settings, &GradientDescent{})
+	if err != ErrNotSnapshotter {
+		t.Errorf("unexpected error for a method without snapshots: got %v, want %v", err, ErrNotSnapshotter)
+	}
+
+	errStop := errors.New("stop")
+	var calls int
+	settings = &Settings{
+		Checkpoint: func(*Checkpoint) error {
+			calls++
+			if calls == 3 {
+				return errStop
+			}
+			return nil
+		},
+	}
+	res, err := Minimize(p, x0, settings, &BFGS{})
+	if err != errStop {
+		t.Errorf("unexpected error from the checkpoint hook: got %v, want %v", err, errStop)
+	}
+	if res.Status != Failure {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, Failure)
+	}
+	if res.MajorIterations != 3 {
+		t.Errorf("unexpected number of major iterations: got %d, want 3", res.MajorIterations)
+	}
+
+	settings = &Settings{Resume: &Checkpoint{Location: &Location{X: x0}}}
+	_, err = Minimize(p, nil, settings, &BFGS{})
+	if err == nil {
+		t.Errorf("expected error resuming from an invalid checkpoint")
+	}
+
+	for _, test := range []struct {
+		name  string
+		state nelderMeadState
+	}{
+		{
+			name: "too few vertices",
+			state: nelderMeadState{
+				Vertices: [][]float64{{0, 0}, {1, 0}},
+				Values:   []float64{0, 1, 2},
+				Centroid: []float64{0, 0},
+			},
+		},
+		{
+			name: "vertex length",
+			state: nelderMeadState{
+				Vertices: [][]float64{{0, 0}, {1, 0}, {0}},
+				Values:   []float64{0, 1, 2},
+				Centroid: []float64{0, 0},
+			},
+		},
+		{
+			name: "too few values",
+			state: nelderMeadState{
+				Vertices: [][]float64{{0, 0}, {1, 0}, {0, 1}},
+				Values:   []float64{0, 1},
+				Centroid: []float64{0, 0},
+			},
+		},
+		{
+			name: "centroid length",
+			state: nelderMeadState{
+				Vertices: [][]float64{{0, 0}, {1, 0}, {0, 1}},
+				Values:   []float64{0, 1, 2},
+				Centroid: []float64{0, 0, 0},
+			},
+		},
+		{
+			name:  "values without vertices",
+			state: nelderMeadState{Values: []float64{0, 1, 2}},
+		},
+	} {
+		data, err := encodeState(&test.state)
+		if err != nil {
+			t.Fatalf("unexpected error encoding the state: %v", err)
+		}
+		settings = &Settings{Resume: &Checkpoint{Location: &Location{X: x0, F: p.Func(x0)}, Method: data}}
+		_, err = Minimize(Problem{Func: p.Func}, nil, settings, &NelderMead{})
+		if err != errBadSnapshot {
+			t.Errorf("NelderMead %s: unexpected error resuming from a bad snapshot: got %v, want %v",
+				test.name, err, errBadSnapshot)
+		}
+	}
+
+	// A BFGS snapshot of a problem of another dimension.
+	var snapshot []byte
+	settings = &Settings{
+		Checkpoint: func(c *Checkpoint) error {
+			snapshot = c.Method
+			return nil
+		},
+		MajorIterations: 3,
+	}
+	_, err = Minimize(p, []float64{-1.2, 1, -1.2}, settings, &BFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g := make([]float64, len(x0))
+	p.Grad(g, x0)
+	settings = &Settings{Resume: &Checkpoint{Location: &Location{X: x0, F: p.Func(x0), Gradient: g}, Method: snapshot}}
+	_, err = Minimize(p, nil, settings, &BFGS{})
+	if err != errBadSnapshot {
+		t.Errorf("BFGS: unexpected error resuming from a snapshot of another dimension: got %v, want %v", err, errBadSnapshot)
+	}
+}
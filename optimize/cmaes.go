@@ -14,8 +14,6 @@ import (
 	"gonum.org/v1/gonum/stat/distmv"
 )
 
-var _ Method = (*CmaEsChol)(nil)
-
 // TODO(btracey): If we ever implement the traditional CMA-ES algorithm, provide
 // the base explanation there, and modify this description to just
 // describe the differences.
@@ -113,11 +111,14 @@ type CmaEsChol struct {
 	receivedIdx int
 	operation   chan<- Task
 	updateErr   error
+
+	resume bool // Indicator of a state restored from a snapshot.
 }
 
 var (
-	_ Statuser = (*CmaEsChol)(nil)
-	_ Method   = (*CmaEsChol)(nil)
+	_ Statuser    = (*CmaEsChol)(nil)
+	_ Method      = (*CmaEsChol)(nil)
+	_ Snapshotter = (*CmaEsChol)(nil)
 )
 
 func (cma *CmaEsChol) methodConverged() Status {
@@ -233,6 +234,7 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 	cma.receivedIdx = 0
 	cma.operation = nil
 	cma.updateErr = nil
+	cma.resume = false
 	t := min(tasks, cma.pop)
 	return t
 }
@@ -298,7 +300,10 @@ func (cma *CmaEsChol) findBestAndUpdateTask(task Task) Task {
 }
 
 func (cma *CmaEsChol) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
-	copy(cma.mean, tasks[0].X)
+	if !cma.resume {
+		copy(cma.mean, tasks[0].X)
+	}
+	cma.resume = false
 	cma.operation = operations
 	// Send the initial tasks. We know there are at most as many tasks as elements
 	// of the population.
@@ -466,3 +471,70 @@ func (b bestSorter) Swap(i, j int) {
 	b.F[i], b.F[j] = b.F[j], b.F[i]
 	b.Idx[i], b.Idx[j] = b.Idx[j], b.Idx[i]
 }
+
+// cmaEsCholState is the encoded state of CmaEsChol.
+type cmaEsCholState struct {
+	InvSigma float64
+	PC, PS   []float64
+	Mean     []float64
+	U        []float64 // Upper triangular Cholesky factor in row-major order.
+	BestX    []float64
+	BestF    float64
+	Src      []byte
+}
+
+// Snapshot returns an encoding of the state of the method at the last major
+// iteration. The state of Src is included if Src implements
+// encoding.BinaryMarshaler, otherwise a resumed run samples different
+// locations than an uninterrupted run.
+func (cma *CmaEsChol) Snapshot() ([]byte, error) {
+	var u mat.TriDense
+	cma.chol.UTo(&u)
+	v := cmaEsCholState{
+		InvSigma: cma.invSigma,
+		PC:       cma.pc,
+		PS:       cma.ps,
+		Mean:     cma.mean,
+		U:        make([]float64, cma.dim*cma.dim),
+		BestX:    cma.bestX,
+		BestF:    cma.bestF,
+	}
+	for i := 0; i < cma.dim; i++ {
+		for j := i; j < cma.dim; j++ {
+			v.U[i*cma.dim+j] = u.At(i, j)
+		}
+	}
+	var err error
+	v.Src, err = sourceState(cma.Src)
+	if err != nil {
+		return nil, err
+	}
+	return encodeState(&v)
+}
+
+// Restore restores the state of the method from a snapshot. Restore must be
+// called after Init.
+func (cma *CmaEsChol) Restore(data []byte) error {
+	var v cmaEsCholState
+	err := decodeState(data, &v)
+	if err != nil {
+		return err
+	}
+	dim := cma.dim
+	if len(v.PC) != dim || len(v.PS) != dim || len(v.Mean) != dim || len(v.U) != dim*dim || len(v.BestX) != dim {
+		return errBadSnapshot
+	}
+	err = restoreSource(cma.Src, v.Src)
+	if err != nil {
+		return err
+	}
+	cma.invSigma = v.InvSigma
+	copy(cma.pc, v.PC)
+	copy(cma.ps, v.PS)
+	copy(cma.mean, v.Mean)
+	cma.chol.SetFromU(mat.NewTriDense(dim, mat.Upper, v.U))
+	copy(cma.bestX, v.BestX)
+	cma.bestF = v.BestF
+	cma.resume = true
+	return nil
+}
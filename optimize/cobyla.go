@@ -0,0 +1,707 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	cobylaAlpha = 0.25 // Smallest acceptable height of a simplex vertex relative to ρ.
+	cobylaBeta  = 2.1  // Largest acceptable edge of the simplex relative to ρ.
+	cobylaGamma = 0.5  // Length of a geometry step relative to ρ.
+)
+
+var (
+	_ Method            = (*COBYLA)(nil)
+	_ Statuser          = (*COBYLA)(nil)
+	_ constrainedMethod = (*COBYLA)(nil)
+)
+
+// COBYLA implements Powell's COBYLA method for gradient-free constrained
+// minimization. COBYLA does not use the gradient of the objective function
+// or the Jacobian of the constraints.
+//
+// COBYLA builds linear models of the objective function and of the
+// constraints by interpolation at the dim+1 vertices of a simplex. At each
+// iteration it computes a step from the best vertex within a trust region of
+// radius ρ, which first minimizes the largest violation of the linearized
+// constraints and then minimizes the linearized objective function subject to
+// the linearized constraints relaxed by that violation. The step is accepted
+// on the basis of the merit function
+//
+//	f(x) + σ max_i v_i(x),
+//
+// where v_i is the violation of the i-th constraint and the penalty parameter
+// σ is increased as necessary, and the new point replaces a vertex of the
+// simplex. When the simplex becomes too distorted, a vertex is moved to
+// restore its shape. ρ is decreased from InitialRadius to FinalRadius as the
+// iterations proceed, and the method converges when no further progress is
+// possible with ρ equal to FinalRadius.
+//
+// Each trust-region iteration is a major iteration that reports the best
+// vertex with the multipliers of the linearized constraints as estimates of
+// the Lagrange multipliers. Equality constraints are supported, but COBYLA is
+// mainly intended for inequality constraints. Without constraints, COBYLA
+// minimizes linear models of the function and is much less efficient than
+// BOBYQA.
+//
+// References:
+//   - Powell, M. J. D. (1994). A direct search optimization method that models
+//     the objective and constraint functions by linear interpolation. In
+//     Advances in Optimization and Numerical Analysis, 51-67. Springer.
+type COBYLA struct {
+	// InitialRadius is the initial value of ρ, which should be about one
+	// tenth of the expected change of the variables. If InitialRadius is
+	// zero, it is defaulted to 0.5.
+	InitialRadius float64
+	// FinalRadius is the final value of ρ, which determines the accuracy of
+	// the solution. If FinalRadius is zero, it is defaulted to 1e-8.
+	FinalRadius float64
+
+	neq     int       // Number of equality constraints.
+	ncon    int       // Number of constraints.
+	consOps Operation // Evaluation operations for the constraints.
+
+	status Status
+	err    error
+
+	dim         int
+	rho, rhoEnd float64
+	sigma       float64 // Penalty parameter of the merit function.
+
+	pts    *mat.Dense // Vertices of the simplex in rows.
+	fs     []float64  // Function values at the vertices.
+	cons   *mat.Dense // Constraint values at the vertices in rows.
+	best   int        // Index of the best vertex.
+	others []int      // Indices of the other vertices.
+
+	edges *mat.Dense // Edges of the simplex from the best vertex in rows.
+	inv   *mat.Dense // Inverse of edges.
+	g     []float64  // Gradient of the linear model of the objective.
+	jac   *mat.Dense // Gradients of the linear models of the constraints.
+
+	lambda []float64 // Multiplier estimates of the constraints.
+	d      []float64
+	xnew   []float64
+	qp     dualQP
+	prox   *mat.SymDense
+}
+
+func (c *COBYLA) Status() (Status, error) {
+	return c.status, c.err
+}
+
+func (*COBYLA) Uses(has Available) (uses Available, err error) {
+	return Available{Constraints: has.Constraints}, nil
+}
+
+func (c *COBYLA) initConstraints(eq, ineq int) {
+	c.neq = eq
+	c.ncon = eq + ineq
+	c.consOps = NoOperation
+	if c.ncon > 0 {
+		c.consOps = ConstraintEvaluation
+	}
+}
+
+func (c *COBYLA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	rhoBeg := c.InitialRadius
+	if rhoBeg == 0 {
+		rhoBeg = 0.5
+	}
+	c.rhoEnd = c.FinalRadius
+	if c.rhoEnd == 0 {
+		c.rhoEnd = math.Min(1e-8, rhoBeg)
+	}
+	if rhoBeg <= 0 || c.rhoEnd <= 0 || c.rhoEnd > rhoBeg {
+		panic("optimize: COBYLA radius out of range")
+	}
+	c.rho = rhoBeg
+	c.sigma = 0
+	c.status = NotTerminated
+	c.err = nil
+
+	c.dim = dim
+	c.pts = mat.NewDense(dim+1, dim, nil)
+	c.fs = resize(c.fs, dim+1)
+	c.cons = nil
+	if c.ncon > 0 {
+		c.cons = mat.NewDense(dim+1, c.ncon, nil)
+		c.jac = mat.NewDense(c.ncon, dim, nil)
+	}
+	c.others = c.others[:0]
+	c.edges = mat.NewDense(dim, dim, nil)
+	c.inv = mat.NewDense(dim, dim, nil)
+	c.g = resize(c.g, dim)
+	c.lambda = nil
+	if c.ncon > 0 {
+		c.lambda = resize(c.lambda, c.ncon)
+		for i := range c.lambda {
+			c.lambda[i] = 0
+		}
+	}
+	c.d = resize(c.d, dim)
+	c.xnew = resize(c.xnew, dim)
+	return 1
+}
+
+func (c *COBYLA) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	c.status, c.err = c.run(&syncTasker{operation: operation, result: result, task: tasks[0]})
+	close(operation)
+}
+
+func (c *COBYLA) run(t *syncTasker) (Status, error) {
+	loc := t.task.Location
+	if !t.do((FuncEvaluation | c.consOps) &^ t.task.Op) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if math.IsInf(loc.F, 0) || math.IsNaN(loc.F) {
+		t.finishMethodDone()
+		return Failure, ErrFunc(loc.F)
+	}
+	x0 := make([]float64, c.dim)
+	copy(x0, loc.X)
+	ok, err := c.initSimplex(t, x0, loc.F, loc.Constraints)
+	if !ok {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if err != nil {
+		t.finishMethodDone()
+		return Failure, err
+	}
+	if !c.majorIteration(t) {
+		t.finish()
+		return NotTerminated, nil
+	}
+
+	// reduce is true if the last trust-region step was not successful, so
+	// that ρ should be decreased if the simplex is acceptable.
+	var reduce bool
+	for {
+		c.selectBest()
+		if !c.models() {
+			// The simplex has become degenerate. Start again with a new
+			// simplex around the best vertex.
+			copy(x0, c.pts.RawRowView(c.best))
+			ok, err = c.initSimplex(t, x0, c.fs[c.best], c.vertexCons(c.best))
+			if !ok {
+				t.finish()
+				return NotTerminated, nil
+			}
+			if err != nil {
+				t.finishMethodDone()
+				return Failure, err
+			}
+			continue
+		}
+
+		if j := c.distortedVertex(); j >= 0 {
+			ok, err = c.geometryStep(t, j)
+			if !ok {
+				t.finish()
+				return NotTerminated, nil
+			}
+			if err != nil {
+				t.finishMethodDone()
+				return Failure, err
+			}
+			continue
+		}
+
+		if reduce {
+			reduce = false
+			if c.rho <= c.rhoEnd {
+				if !c.majorIteration(t) {
+					t.finish()
+					return NotTerminated, nil
+				}
+				t.finishMethodDone()
+				return MethodConverge, nil
+			}
+			c.rho /= 2
+			if c.rho <= 1.5*c.rhoEnd {
+				c.rho = c.rhoEnd
+			}
+			continue
+		}
+
+		c.trustRegionStep()
+		if floats.Norm(c.d, 2) < c.rho/2 {
+			reduce = true
+			continue
+		}
+
+		// Increase the penalty parameter if necessary for the step to
+		// decrease the merit function of the linear models.
+		xbest := c.pts.RawRowView(c.best)
+		cbest := c.vertexCons(c.best)
+		viol := maxViolation(cbest, c.neq)
+		predViol := c.predictedViolation(cbest, c.d)
+		predF := floats.Dot(c.g, c.d)
+		if decrease := viol - predViol; decrease > 0 {
+			if barmu := predF / decrease; c.sigma < 1.5*barmu {
+				c.sigma = 2 * barmu
+				if old := c.best; c.selectBest() != old {
+					continue
+				}
+			}
+		}
+		pred := c.sigma*(viol-predViol) - predF
+
+		floats.AddTo(c.xnew, xbest, c.d)
+		fnew, ok := c.evaluate(t, c.xnew)
+		if !ok {
+			t.finish()
+			return NotTerminated, nil
+		}
+		if math.IsInf(fnew, 0) || math.IsNaN(fnew) {
+			t.finishMethodDone()
+			return Failure, ErrFunc(fnew)
+		}
+		cnew := loc.Constraints
+		meritBest := c.merit(c.fs[c.best], cbest)
+		actual := meritBest - c.merit(fnew, cnew)
+		c.include(c.xnew, fnew, cnew, actual > 0)
+		if pred <= 0 || actual <= 0.1*pred {
+			reduce = true
+		}
+		c.selectBest()
+		if !c.majorIteration(t) {
+			t.finish()
+			return NotTerminated, nil
+		}
+	}
+}
+
+// initSimplex sets the vertices of the simplex to x, where the function value
+// is f and the constraint values are cons, and to x displaced by ρ along each
+// coordinate. The returned bool is false if the optimization has been
+// terminated.
+func (c *COBYLA) initSimplex(t *syncTasker, x []float64, f float64, cons []float64) (bool, error) {
+	c.pts.SetRow(0, x)
+	c.fs[0] = f
+	if c.ncon > 0 {
+		c.cons.SetRow(0, cons)
+	}
+	for j := 1; j <= c.dim; j++ {
+		row := c.pts.RawRowView(j)
+		copy(row, x)
+		row[j-1] += c.rho
+		fj, ok := c.evaluate(t, row)
+		if !ok {
+			return false, nil
+		}
+		if math.IsInf(fj, 0) || math.IsNaN(fj) {
+			return true, ErrFunc(fj)
+		}
+		c.fs[j] = fj
+		if c.ncon > 0 {
+			c.cons.SetRow(j, t.task.Location.Constraints)
+		}
+	}
+	c.best = 0
+	return true, nil
+}
+
+// evaluate evaluates the function and the constraints at x. The returned bool
+// is false if the optimization has been terminated.
+func (c *COBYLA) evaluate(t *syncTasker, x []float64) (float64, bool) {
+	loc := t.task.Location
+	copy(loc.X, x)
+	if !t.do(FuncEvaluation | c.consOps) {
+		return 0, false
+	}
+	return loc.F, true
+}
+
+// majorIteration sends a MajorIteration with the best vertex. It returns
+// false if the optimization has been terminated.
+func (c *COBYLA) majorIteration(t *syncTasker) bool {
+	loc := t.task.Location
+	copy(loc.X, c.pts.RawRowView(c.best))
+	loc.F = c.fs[c.best]
+	if c.ncon > 0 {
+		loc.Constraints = resize(loc.Constraints, c.ncon)
+		copy(loc.Constraints, c.cons.RawRowView(c.best))
+	}
+	loc.Multipliers = c.lambda
+	return t.do(MajorIteration)
+}
+
+// vertexCons returns the constraint values at the j-th vertex.
+func (c *COBYLA) vertexCons(j int) []float64 {
+	if c.ncon == 0 {
+		return nil
+	}
+	return c.cons.RawRowView(j)
+}
+
+// merit returns the value of the merit function for the function value f and
+// the constraint values cons.
+func (c *COBYLA) merit(f float64, cons []float64) float64 {
+	viol := maxViolation(cons, c.neq)
+	if viol == 0 {
+		return f
+	}
+	return f + c.sigma*viol
+}
+
+// selectBest sets the best vertex to the one with the least value of the merit
+// function, preferring smaller constraint violations in ties, and returns its
+// index.
+func (c *COBYLA) selectBest() int {
+	phi := c.merit(c.fs[c.best], c.vertexCons(c.best))
+	viol := maxViolation(c.vertexCons(c.best), c.neq)
+	for j := range c.fs {
+		pj := c.merit(c.fs[j], c.vertexCons(j))
+		vj := maxViolation(c.vertexCons(j), c.neq)
+		if pj < phi || (pj == phi && vj < viol) {
+			c.best = j
+			phi = pj
+			viol = vj
+		}
+	}
+	c.others = c.others[:0]
+	for j := range c.fs {
+		if j != c.best {
+			c.others = append(c.others, j)
+		}
+	}
+	return c.best
+}
+
+// models computes the edges of the simplex and their inverse, and the linear
+// models of the objective function and the constraints. It returns false if
+// the simplex is degenerate.
+func (c *COBYLA) models() bool {
+	n := c.dim
+	xbest := c.pts.RawRowView(c.best)
+	for i, j := range c.others {
+		floats.SubTo(c.edges.RawRowView(i), c.pts.RawRowView(j), xbest)
+	}
+	err := c.inv.Inverse(c.edges)
+	if err != nil {
+		return false
+	}
+	// The gradients solve edges * g = f_j - f_best.
+	diff := make([]float64, n)
+	for i, j := range c.others {
+		diff[i] = c.fs[j] - c.fs[c.best]
+	}
+	gv := mat.NewVecDense(n, c.g)
+	gv.MulVec(c.inv, mat.NewVecDense(n, diff))
+	if c.ncon > 0 {
+		cbest := c.cons.RawRowView(c.best)
+		var dc mat.Dense
+		dc.ReuseAs(n, c.ncon)
+		for i, j := range c.others {
+			floats.SubTo(dc.RawRowView(i), c.cons.RawRowView(j), cbest)
+		}
+		c.jac.Mul(dc.T(), c.inv.T())
+	}
+	return true
+}
+
+// distortedVertex returns the index into c.others of a vertex that should be
+// moved to improve the shape of the simplex, or -1 if the simplex is
+// acceptable. The vertex farthest from the best one is chosen if its distance
+// is larger than β ρ, and otherwise the vertex closest to the face of the
+// simplex opposite to it if its distance from the face is smaller than α ρ.
+func (c *COBYLA) distortedVertex() int {
+	far, dmax := -1, cobylaBeta*c.rho
+	near, hmin := -1, cobylaAlpha*c.rho
+	for i := range c.others {
+		if d := floats.Norm(c.edges.RawRowView(i), 2); d > dmax {
+			far, dmax = i, d
+		}
+		if h := 1 / mat.Norm(c.inv.ColView(i), 2); h < hmin {
+			near, hmin = i, h
+		}
+	}
+	if far >= 0 {
+		return far
+	}
+	return near
+}
+
+// geometryStep replaces the vertex with index i into c.others by a point at a
+// distance γ ρ from the best vertex in the direction orthogonal to the face
+// opposite to the vertex. The returned bool is false if the optimization has
+// been terminated.
+func (c *COBYLA) geometryStep(t *syncTasker, i int) (bool, error) {
+	n := c.dim
+	u := c.d
+	for k := 0; k < n; k++ {
+		u[k] = c.inv.At(k, i)
+	}
+	floats.Scale(cobylaGamma*c.rho/floats.Norm(u, 2), u)
+	// Choose the sign of the step that gives the smaller merit function of
+	// the linear models.
+	cbest := c.vertexCons(c.best)
+	plus := floats.Dot(c.g, u) + c.sigma*c.predictedViolation(cbest, u)
+	floats.Scale(-1, u)
+	minus := floats.Dot(c.g, u) + c.sigma*c.predictedViolation(cbest, u)
+	if plus < minus {
+		floats.Scale(-1, u)
+	}
+
+	floats.AddTo(c.xnew, c.pts.RawRowView(c.best), u)
+	f, ok := c.evaluate(t, c.xnew)
+	if !ok {
+		return false, nil
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return true, ErrFunc(f)
+	}
+	c.replace(c.others[i], c.xnew, f, t.task.Location.Constraints)
+	return true, nil
+}
+
+// predictedViolation returns the largest violation of the linear models of the
+// constraints at a step d from the best vertex, whose constraint values are
+// cons.
+func (c *COBYLA) predictedViolation(cons, d []float64) float64 {
+	var v float64
+	for i, ci := range cons {
+		ci += floats.Dot(c.jac.RawRowView(i), d)
+		if i < c.neq {
+			v = math.Max(v, math.Abs(ci))
+		} else {
+			v = math.Max(v, -ci)
+		}
+	}
+	return v
+}
+
+// include replaces a vertex by x with the function value f and constraint
+// values cons. The vertex is chosen so that the volume of the simplex
+// increases the most, preferring vertices far from the best one. If improved
+// is true, x has a smaller merit function than the best vertex, which may
+// then be replaced. Otherwise x is only included if it improves the shape of
+// the simplex.
+func (c *COBYLA) include(x []float64, f float64, cons []float64, improved bool) {
+	// The barycentric coordinates w of d = x - xbest with respect to the
+	// edges of the simplex solve edgesᵀ w = d, and |w_i| is the ratio of the
+	// volumes of the simplex with the i-th vertex replaced by x and of the
+	// current simplex.
+	floats.SubTo(c.d, x, c.pts.RawRowView(c.best))
+	var w mat.VecDense
+	w.MulVec(c.inv.T(), mat.NewVecDense(c.dim, c.d))
+	drop := -1
+	score := 1.0
+	if improved {
+		// The barycentric coordinate of the best vertex.
+		score = math.Abs(1 - floats.Sum(w.RawVector().Data))
+		drop = c.best
+	}
+	for i, j := range c.others {
+		dist := floats.Norm(c.edges.RawRowView(i), 2) / c.rho
+		if s := math.Abs(w.AtVec(i)) * math.Max(1, dist*dist); s > score {
+			drop = j
+			score = s
+		}
+	}
+	if drop < 0 {
+		return
+	}
+	c.replace(drop, x, f, cons)
+}
+
+// replace replaces the j-th vertex by x with the function value f and
+// constraint values cons.
+func (c *COBYLA) replace(j int, x []float64, f float64, cons []float64) {
+	c.pts.SetRow(j, x)
+	c.fs[j] = f
+	if c.ncon > 0 {
+		c.cons.SetRow(j, cons)
+	}
+}
+
+// trustRegionStep stores into c.d the trust-region step from the best vertex.
+// The step first minimizes the largest violation of the linearized
+// constraints subject to ‖d‖ ≤ ρ, and then minimizes the linearized objective
+// function subject to ‖d‖ ≤ ρ and to the linearized constraints relaxed by
+// that violation. The multipliers of the second problem are stored into
+// c.lambda.
+func (c *COBYLA) trustRegionStep() {
+	n := c.dim
+	if c.ncon == 0 {
+		copy(c.d, c.g)
+		if nrm := floats.Norm(c.g, 2); nrm > 0 {
+			floats.Scale(-c.rho/nrm, c.d)
+		}
+		return
+	}
+
+	// Write the linearized constraints as A d ≥ b, with each equality
+	// constraint as two inequalities.
+	cbest := c.cons.RawRowView(c.best)
+	m := c.ncon + c.neq
+	a := mat.NewDense(m, n, nil)
+	b := make([]float64, m)
+	for i, ci := range cbest {
+		a.SetRow(i, c.jac.RawRowView(i))
+		b[i] = -ci
+		if i < c.neq {
+			row := a.RawRowView(c.ncon + i)
+			floats.ScaleTo(row, -1, c.jac.RawRowView(i))
+			b[c.ncon+i] = ci
+		}
+	}
+
+	// Minimize the largest violation t of the linearized constraints with
+	// the variables (d, t) subject to A d + t ≥ b and t ≥ 0.
+	viol := maxViolation(cbest, c.neq)
+	var t float64
+	if viol > 0 {
+		a1 := mat.NewDense(m+1, n+1, nil)
+		a1.Slice(0, m, 0, n).(*mat.Dense).Copy(a)
+		for i := 0; i <= m; i++ {
+			a1.Set(i, n, 1)
+		}
+		b1 := make([]float64, m+1)
+		copy(b1, b)
+		g1 := make([]float64, n+1)
+		g1[n] = 1
+		z := make([]float64, n+1)
+		lambda1 := make([]float64, m+1)
+		// The small weight of t in the quadratic program changes the
+		// gradient of the objective by a relative amount of at most 1e-6.
+		err := c.ballStep(z, lambda1, g1, a1, b1, n, 1e-6/viol)
+		if err != nil {
+			for i := range c.d {
+				c.d[i] = 0
+			}
+			return
+		}
+		copy(c.d, z[:n])
+		t = math.Max(0, math.Min(z[n], viol))
+	}
+
+	// Minimize the linearized objective function subject to the relaxed
+	// constraints.
+	for i := range b {
+		b[i] -= t
+	}
+	d := make([]float64, n)
+	lambda := make([]float64, m)
+	err := c.ballStep(d, lambda, c.g, a, b, n, 0)
+	if err != nil {
+		// Keep the step that reduces the violation.
+		return
+	}
+	copy(c.d, d)
+	for i := range c.lambda {
+		c.lambda[i] = lambda[i]
+		if i < c.neq {
+			c.lambda[i] -= lambda[c.ncon+i]
+		}
+	}
+}
+
+// ballStep stores into z an approximate solution of
+//
+//	minimize    gᵀz
+//	subject to  A z ≥ b,  ‖z[:n]‖ ≤ ρ,
+//
+// and the multipliers of the linear constraints into lambda. The problem is
+// solved as the quadratic program with the objective
+//
+//	gᵀz + ½ μ‖z[:n]‖² + ½ w‖z[n:]‖²,
+//
+// whose solution has the same optimality conditions with the multiplier μ of
+// the ball constraint when w is negligible, by searching for μ such that the
+// ball constraint is nearly active. If the solution lies inside the ball, μ
+// is negligible.
+func (c *COBYLA) ballStep(z, lambda, g []float64, a *mat.Dense, b []float64, n int, w float64) error {
+	dim := len(z)
+	c.prox = resizeSymDense(c.prox, dim)
+	solve := func(mu float64) (float64, error) {
+		c.prox.Zero()
+		for i := 0; i < dim; i++ {
+			if i < n {
+				c.prox.SetSym(i, i, mu)
+			} else {
+				c.prox.SetSym(i, i, w)
+			}
+		}
+		err := c.qp.solve(z, lambda, c.prox, g, a, b, 0)
+		return floats.Norm(z[:n], 2), err
+	}
+	rho := c.rho
+	mu0 := floats.Norm(g, 2) / rho
+	if mu0 == 0 {
+		mu0 = 1 / rho
+	}
+	mu := mu0
+	nrm, err := solve(mu)
+	if err != nil {
+		return err
+	}
+	inBand := func(nrm float64) bool { return 0.9*rho <= nrm && nrm <= rho }
+	if inBand(nrm) {
+		return nil
+	}
+
+	// Bracket μ with lo giving a step outside the ball and hi a step inside.
+	var lo, hi float64
+	if nrm > rho {
+		lo = mu
+		for nrm > rho {
+			mu *= 4
+			if nrm, err = solve(mu); err != nil {
+				return err
+			}
+		}
+		hi = mu
+	} else {
+		hi = mu
+		for nrm < 0.9*rho {
+			if mu < 1e-12*mu0 {
+				// The solution lies inside the ball.
+				return nil
+			}
+			mu /= 4
+			if nrm, err = solve(mu); err != nil {
+				return err
+			}
+			if nrm <= rho {
+				hi = mu
+			}
+		}
+		if nrm <= rho {
+			return nil
+		}
+		lo = mu
+	}
+	for i := 0; i < 60; i++ {
+		mu = math.Sqrt(lo * hi)
+		if nrm, err = solve(mu); err != nil {
+			return err
+		}
+		if inBand(nrm) {
+			return nil
+		}
+		if nrm > rho {
+			lo = mu
+		} else {
+			hi = mu
+		}
+	}
+	_, err = solve(hi)
+	return err
+}
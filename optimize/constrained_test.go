@@ -0,0 +1,289 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+type constrainedTest struct {
+	name string
+	p    Problem
+	x    []float64
+
+	want   []float64 // Expected minimizer.
+	lambda []float64 // Expected Lagrange multipliers, if non-nil.
+	tol    float64   // Tolerance on the minimizer and the multipliers.
+}
+
+func constrainedTests() []constrainedTest {
+	return []constrainedTest{
+		{
+			// minimize x₀ + x₁ subject to x₀² + x₁² = 2.
+			name: "Circle",
+			p: Problem{
+				Func: func(x []float64) float64 { return x[0] + x[1] },
+				Grad: func(grad, x []float64) {
+					grad[0] = 1
+					grad[1] = 1
+				},
+				Constraints: &Constraints{
+					Equality: 1,
+					Func: func(c, x []float64) {
+						c[0] = x[0]*x[0] + x[1]*x[1] - 2
+					},
+					Jacobian: func(jac *mat.Dense, x []float64) {
+						jac.Set(0, 0, 2*x[0])
+						jac.Set(0, 1, 2*x[1])
+					},
+				},
+			},
+			x:      []float64{-2, 0.5},
+			want:   []float64{-1, -1},
+			lambda: []float64{-0.5},
+			tol:    1e-6,
+		},
+		{
+			// minimize (x₀-2)² + (x₁-1)² subject to x₀ - 2x₁ + 1 = 0 and
+			// x₀²/4 + x₁² ≤ 1.
+			name: "EllipseLine",
+			p: Problem{
+				Func: func(x []float64) float64 {
+					return (x[0]-2)*(x[0]-2) + (x[1]-1)*(x[1]-1)
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = 2 * (x[0] - 2)
+					grad[1] = 2 * (x[1] - 1)
+				},
+				Constraints: &Constraints{
+					Equality:   1,
+					Inequality: 1,
+					Func: func(c, x []float64) {
+						c[0] = x[0] - 2*x[1] + 1
+						c[1] = 1 - x[0]*x[0]/4 - x[1]*x[1]
+					},
+					Jacobian: func(jac *mat.Dense, x []float64) {
+						jac.Set(0, 0, 1)
+						jac.Set(0, 1, -2)
+						jac.Set(1, 0, -x[0]/2)
+						jac.Set(1, 1, -2*x[1])
+					},
+				},
+			},
+			x:    []float64{2, 2},
+			want: []float64{(math.Sqrt(7) - 1) / 2, (math.Sqrt(7) + 1) / 4},
+			tol:  1e-6,
+		},
+		{
+			// minimize (x₀-1)² + (x₁-1)² subject to x₀ + x₁ ≤ 3, which is
+			// inactive at the minimum.
+			name: "Inactive",
+			p: Problem{
+				Func: func(x []float64) float64 {
+					return (x[0]-1)*(x[0]-1) + (x[1]-1)*(x[1]-1)
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = 2 * (x[0] - 1)
+					grad[1] = 2 * (x[1] - 1)
+				},
+				Constraints: &Constraints{
+					Inequality: 1,
+					Func: func(c, x []float64) {
+						c[0] = 3 - x[0] - x[1]
+					},
+					Jacobian: func(jac *mat.Dense, x []float64) {
+						jac.Set(0, 0, -1)
+						jac.Set(0, 1, -1)
+					},
+				},
+			},
+			x:      []float64{3, -2},
+			want:   []float64{1, 1},
+			lambda: []float64{0},
+			tol:    1e-6,
+		},
+		{
+			// Rosenbrock function constrained to the unit disk.
+			name: "RosenbrockDisk",
+			p: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+				Constraints: &Constraints{
+					Inequality: 1,
+					Func: func(c, x []float64) {
+						c[0] = 1 - x[0]*x[0] - x[1]*x[1]
+					},
+					Jacobian: func(jac *mat.Dense, x []float64) {
+						jac.Set(0, 0, -2*x[0])
+						jac.Set(0, 1, -2*x[1])
+					},
+				},
+			},
+			x:    []float64{0, 0},
+			want: []float64{0.7864151510, 0.6176983165},
+			tol:  1e-5,
+		},
+		{
+			// Problem 71 from Hock and Schittkowski.
+			name: "HS071",
+			p: Problem{
+				Func: func(x []float64) float64 {
+					return x[0]*x[3]*(x[0]+x[1]+x[2]) + x[2]
+				},
+				Grad: func(grad, x []float64) {
+					grad[0] = x[3]*(x[0]+x[1]+x[2]) + x[0]*x[3]
+					grad[1] = x[0] * x[3]
+					grad[2] = x[0]*x[3] + 1
+					grad[3] = x[0] * (x[0] + x[1] + x[2])
+				},
+				Constraints: &Constraints{
+					Equality:   1,
+					Inequality: 9,
+					Func: func(c, x []float64) {
+						c[0] = floats.Dot(x, x) - 40
+						c[1] = x[0]*x[1]*x[2]*x[3] - 25
+						for i, v := range x {
+							c[2+i] = v - 1
+							c[6+i] = 5 - v
+						}
+					},
+					Jacobian: func(jac *mat.Dense, x []float64) {
+						jac.Zero()
+						for i, v := range x {
+							jac.Set(0, i, 2*v)
+							jac.Set(2+i, i, 1)
+							jac.Set(6+i, i, -1)
+						}
+						jac.Set(1, 0, x[1]*x[2]*x[3])
+						jac.Set(1, 1, x[0]*x[2]*x[3])
+						jac.Set(1, 2, x[0]*x[1]*x[3])
+						jac.Set(1, 3, x[0]*x[1]*x[2])
+					},
+				},
+			},
+			x:    []float64{1, 5, 5, 1},
+			want: []float64{1, 4.742999637, 3.821149984, 1.379408291},
+			tol:  1e-5,
+		},
+	}
+}
+
+func TestSQP(t *testing.T) {
+	t.Parallel()
+	for _, test := range constrainedTests() {
+		testConstrained(t, test, &SQP{})
+	}
+}
+
+func TestAugmentedLagrangian(t *testing.T) {
+	t.Parallel()
+	for _, test := range constrainedTests() {
+		testConstrained(t, test, &AugmentedLagrangian{})
+	}
+}
+
+func TestAugmentedLagrangianGradientFree(t *testing.T) {
+	t.Parallel()
+	for _, test := range constrainedTests()[:3] {
+		test.p.Grad = nil
+		test.lambda = nil
+		test.tol = 1e-3
+		testConstrained(t, test, &AugmentedLagrangian{Tolerance: 1e-4})
+	}
+}
+
+func TestConstrainedConvergenceFeasible(t *testing.T) {
+	t.Parallel()
+	// minimize x₀² + x₁² subject to x₀ + x₁ = 1, starting from the
+	// unconstrained minimizer. The gradient of the Lagrangian at the first
+	// iterates is below the threshold, but they are not feasible.
+	p := Problem{
+		Func: func(x []float64) float64 {
+			return x[0]*x[0] + x[1]*x[1]
+		},
+		Grad: func(grad, x []float64) {
+			grad[0] = 2 * x[0]
+			grad[1] = 2 * x[1]
+		},
+		Constraints: &Constraints{
+			Equality: 1,
+			Func: func(c, x []float64) {
+				c[0] = x[0] + x[1] - 1
+			},
+			Jacobian: func(jac *mat.Dense, x []float64) {
+				jac.Set(0, 0, 1)
+				jac.Set(0, 1, 1)
+			},
+		},
+	}
+	for _, method := range []Method{&SQP{}, &AugmentedLagrangian{}} {
+		settings := &Settings{GradientThreshold: 1}
+		result, err := Minimize(p, []float64{0, 0}, settings, method)
+		if err != nil {
+			t.Errorf("%T: unexpected error: %v", method, err)
+			continue
+		}
+		if result.Status != GradientThreshold && result.Status != MethodConverge {
+			t.Errorf("%T: unexpected status: %v", method, result.Status)
+		}
+		if result.KKT.Feasibility > 1e-6 {
+			t.Errorf("%T: converged at an infeasible point %v: violation %v", method, result.X, result.KKT.Feasibility)
+		}
+	}
+}
+
+func testConstrained(t *testing.T, test constrainedTest, method Method) {
+	t.Helper()
+	result, err := Minimize(test.p, test.x, nil, method)
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", test.name, err)
+		return
+	}
+	if result.Status != MethodConverge {
+		t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, MethodConverge)
+	}
+	if !floats.EqualApprox(result.X, test.want, test.tol) {
+		t.Errorf("%s: unexpected minimizer: got %v, want %v", test.name, result.X, test.want)
+	}
+	if result.KKT == nil {
+		t.Errorf("%s: missing KKT residuals", test.name)
+		return
+	}
+	if result.KKT.Feasibility > test.tol {
+		t.Errorf("%s: constraints not satisfied: violation %v", test.name, result.KKT.Feasibility)
+	}
+	if test.p.Grad != nil && !(result.KKT.max() <= test.tol) {
+		t.Errorf("%s: KKT residuals too large: %+v", test.name, *result.KKT)
+	}
+	if test.p.Grad == nil && !math.IsNaN(result.KKT.Stationarity) {
+		t.Errorf("%s: stationarity reported without a gradient: %v", test.name, result.KKT.Stationarity)
+	}
+	if test.lambda != nil && !floats.EqualApprox(result.Multipliers, test.lambda, test.tol) {
+		t.Errorf("%s: unexpected multipliers: got %v, want %v", test.name, result.Multipliers, test.lambda)
+	}
+	if result.ConstraintEvaluations == 0 {
+		t.Errorf("%s: constraint evaluations not counted", test.name)
+	}
+}
+
+func TestConstrainedUses(t *testing.T) {
+	t.Parallel()
+	has := Available{Grad: true, Hess: true, Constraints: true, Jacobian: true}
+	for _, method := range []Method{&BFGS{}, &LBFGS{}, &NelderMead{}, &Newton{}, &CmaEsChol{}} {
+		_, err := method.Uses(has)
+		if err != ErrConstrained {
+			t.Errorf("%T: unexpected error for constrained problem: got %v, want %v", method, err, ErrConstrained)
+		}
+	}
+	_, err := (&SQP{}).Uses(Available{Grad: true, Constraints: true})
+	if err != ErrMissingJacobian {
+		t.Errorf("SQP: unexpected error without Jacobian: got %v, want %v", err, ErrMissingJacobian)
+	}
+}
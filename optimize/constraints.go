@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Constraints describes the nonlinear constraints
+//
+//	c_i(x) = 0,  i = 0, ..., Equality-1,
+//	c_i(x) ≥ 0,  i = Equality, ..., Equality+Inequality-1,
+//
+// of a constrained optimization problem.
+//
+// The Lagrange multipliers λ of a constrained minimum are defined with
+// respect to the Lagrangian
+//
+//	L(x, λ) = f(x) - λᵀc(x),
+//
+// so that the multipliers of the inequality constraints are non-negative.
+type Constraints struct {
+	// Equality and Inequality are the number of equality and inequality
+	// constraints respectively.
+	Equality   int
+	Inequality int
+
+	// Func evaluates the constraint functions at x and stores the result in
+	// c which will have length Equality+Inequality. The equality constraints
+	// are stored first. Func must not modify x.
+	Func func(c, x []float64)
+
+	// Jacobian evaluates the Jacobian of the constraint functions at x and
+	// stores the result in-place in jac which will have Equality+Inequality
+	// rows and len(x) columns. Jacobian must not modify x.
+	Jacobian func(jac *mat.Dense, x []float64)
+}
+
+// KKTResidual holds the residuals of the Karush-Kuhn-Tucker first-order
+// optimality conditions of a constrained optimization problem. All of the
+// residuals are zero at a constrained local minimum.
+type KKTResidual struct {
+	// Stationarity is the infinity norm of the gradient of the Lagrangian,
+	//  ∇f(x) - J(x)ᵀλ.
+	// Stationarity is NaN if the gradient or the Jacobian is not known.
+	Stationarity float64
+	// Feasibility is the largest violation of a constraint.
+	Feasibility float64
+	// Complementarity is the largest magnitude of λ_i*c_i(x) over the
+	// inequality constraints.
+	Complementarity float64
+	// DualFeasibility is the largest violation of the condition λ_i ≥ 0
+	// over the inequality constraints.
+	DualFeasibility float64
+}
+
+// compute computes the KKT residuals at loc, which must hold the constraint
+// values and the Lagrange multipliers. neq is the number of equality
+// constraints.
+func (k *KKTResidual) compute(loc *Location, neq int) {
+	c := loc.Constraints
+	lambda := loc.Multipliers
+	k.Feasibility = maxViolation(c, neq)
+	k.Complementarity = 0
+	k.DualFeasibility = 0
+	for i := neq; i < len(c); i++ {
+		k.Complementarity = math.Max(k.Complementarity, math.Abs(lambda[i]*c[i]))
+		k.DualFeasibility = math.Max(k.DualFeasibility, -lambda[i])
+	}
+	if loc.Gradient == nil || (loc.Jacobian == nil && len(c) != 0) {
+		k.Stationarity = math.NaN()
+		return
+	}
+	grad := make([]float64, len(loc.Gradient))
+	lagrangianGradient(grad, loc.Gradient, loc.Jacobian, lambda)
+	k.Stationarity = floats.Norm(grad, math.Inf(1))
+}
+
+// max returns the largest of the KKT residuals.
+func (k *KKTResidual) max() float64 {
+	return math.Max(math.Max(k.Stationarity, k.Feasibility), math.Max(k.Complementarity, k.DualFeasibility))
+}
+
+// lagrangianGradient stores into dst the gradient of the Lagrangian
+//
+//	grad - jacᵀλ.
+func lagrangianGradient(dst, grad []float64, jac *mat.Dense, lambda []float64) {
+	copy(dst, grad)
+	if len(lambda) == 0 {
+		return
+	}
+	d := mat.NewVecDense(len(dst), dst)
+	d.MulVec(jac.T(), mat.NewVecDense(len(lambda), lambda))
+	floats.SubTo(dst, grad, dst)
+}
+
+// maxViolation returns the largest violation of the constraints with values
+// c, the first neq of which are equality constraints.
+func maxViolation(c []float64, neq int) float64 {
+	var v float64
+	for i, ci := range c {
+		if i < neq {
+			v = math.Max(v, math.Abs(ci))
+		} else {
+			v = math.Max(v, -ci)
+		}
+	}
+	return v
+}
+
+// sumViolation returns the sum of the violations of the constraints with
+// values c, the first neq of which are equality constraints.
+func sumViolation(c []float64, neq int) float64 {
+	var v float64
+	for i, ci := range c {
+		if i < neq {
+			v += math.Abs(ci)
+		} else {
+			v += math.Max(0, -ci)
+		}
+	}
+	return v
+}
+
+// constrainedMethod is implemented by Methods that support constrained
+// optimization. initConstraints is called with the number of equality and
+// inequality constraints of the Problem before Init.
+type constrainedMethod interface {
+	initConstraints(eq, ineq int)
+}
+
+// feasibilityChecker is implemented by constrained Methods that have a
+// tolerance on the constraint violation. The convergence criteria given by
+// Settings are only checked at locations where feasible returns true, since
+// a stationary point of the Lagrangian that violates the constraints is not
+// a solution.
+type feasibilityChecker interface {
+	// feasible returns whether the constraint violation at loc is within
+	// the tolerance of the Method.
+	feasible(loc *Location) bool
+}
+
+// constrainedProjector implements gradientProjector for constrained Methods
+// by using the gradient of the Lagrangian at locations that carry Lagrange
+// multipliers.
+type constrainedProjector struct{}
+
+func (constrainedProjector) projectGradient(dst []float64, loc *Location) {
+	if loc.Multipliers == nil || (loc.Jacobian == nil && len(loc.Multipliers) != 0) {
+		copy(dst, loc.Gradient)
+		return
+	}
+	lagrangianGradient(dst, loc.Gradient, loc.Jacobian, loc.Multipliers)
+}
+
+// syncTasker sends the operations of a sequential Method one at a time and
+// waits for their results. It allows such Methods to express their control
+// flow directly rather than as a state machine.
+type syncTasker struct {
+	operation chan<- Task
+	result    <-chan Task
+	task      Task
+}
+
+// do sends op on the location of the task and waits for its result. do
+// returns false if the optimization has been terminated by the caller.
+func (t *syncTasker) do(op Operation) bool {
+	t.task.Op = op
+	t.operation <- t.task
+	r := <-t.result
+	if r.Op == PostIteration {
+		return false
+	}
+	t.task = r
+	return true
+}
+
+// finish completes the channel operations after the caller has terminated
+// the optimization.
+func (t *syncTasker) finish() {
+	localOptimizer{}.finish(t.operation, t.result)
+}
+
+// finishMethodDone signals that the Method is done and completes the channel
+// operations.
+func (t *syncTasker) finishMethodDone() {
+	localOptimizer{}.finishMethodDone(t.operation, t.result, t.task)
+}
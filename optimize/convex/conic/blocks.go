@@ -0,0 +1,438 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conic
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// scalingOp is a linear map derived from the Nesterov-Todd scaling W of a
+// cone.
+type scalingOp int
+
+const (
+	opW     scalingOp = iota // W
+	opWInv                   // W⁻¹
+	opWInvT                  // W⁻ᵀ
+	opWTW                    // WᵀW
+)
+
+// block is a cone of the product K. The methods operate on the elements of
+// a vector that belong to the cone.
+//
+// The Nesterov-Todd scaling W of a primal point x and a dual point s in the
+// interior of the cone satisfies
+//
+//	λ = W s = W⁻ᵀ x,
+//
+// where λ is the scaled point.
+type block interface {
+	// dim returns the number of elements of the block.
+	dim() int
+	// degree returns the degree of the barrier of the cone.
+	degree() int
+	// identity stores the identity element e of the cone into dst.
+	identity(dst []float64)
+	// scale computes the scaling at x and s and stores λ into lambda. It
+	// returns false if x or s is not in the interior of the cone.
+	scale(lambda, x, s []float64) bool
+	// apply stores op applied to v into dst.
+	apply(dst, v []float64, op scalingOp)
+	// product stores the Jordan product u ∘ v into dst.
+	product(dst, u, v []float64)
+	// divide stores the solution of λ ∘ dst = r into dst, where λ is the
+	// scaled point computed by the last call to scale.
+	divide(dst, r []float64)
+	// maxStep returns the largest α such that x + α d is in the cone, or
+	// +Inf if there is no such bound, for x in the interior of the cone.
+	maxStep(x, d []float64) float64
+}
+
+// newBlocks returns the blocks of the cone k.
+func newBlocks(k Cone) []block {
+	var blocks []block
+	if k.Linear > 0 {
+		blocks = append(blocks, &linearBlock{n: k.Linear, w: make([]float64, k.Linear), lambda: make([]float64, k.Linear)})
+	}
+	for _, q := range k.SOC {
+		blocks = append(blocks, &socBlock{n: q, v: make([]float64, q), jv: make([]float64, q), lambda: make([]float64, q)})
+	}
+	for _, s := range k.PSD {
+		blocks = append(blocks, &psdBlock{k: s, lambda: make([]float64, s), xm: mat.NewSymDense(s, nil), sm: mat.NewSymDense(s, nil)})
+	}
+	return blocks
+}
+
+// linearBlock is the non-negative orthant with the scaling W = diag(w),
+// w = √(x/s).
+type linearBlock struct {
+	n      int
+	w      []float64
+	lambda []float64
+}
+
+func (b *linearBlock) dim() int    { return b.n }
+func (b *linearBlock) degree() int { return b.n }
+
+func (b *linearBlock) identity(dst []float64) {
+	for i := range dst {
+		dst[i] = 1
+	}
+}
+
+func (b *linearBlock) scale(lambda, x, s []float64) bool {
+	for i := range x {
+		if !(x[i] > 0 && s[i] > 0) {
+			return false
+		}
+		b.w[i] = math.Sqrt(x[i] / s[i])
+		lambda[i] = math.Sqrt(x[i] * s[i])
+	}
+	copy(b.lambda, lambda)
+	return true
+}
+
+func (b *linearBlock) apply(dst, v []float64, op scalingOp) {
+	for i, w := range b.w {
+		switch op {
+		case opW:
+			dst[i] = w * v[i]
+		case opWInv, opWInvT:
+			dst[i] = v[i] / w
+		case opWTW:
+			dst[i] = w * w * v[i]
+		}
+	}
+}
+
+func (b *linearBlock) product(dst, u, v []float64) {
+	for i := range dst {
+		dst[i] = u[i] * v[i]
+	}
+}
+
+func (b *linearBlock) divide(dst, r []float64) {
+	for i, l := range b.lambda {
+		dst[i] = r[i] / l
+	}
+}
+
+func (b *linearBlock) maxStep(x, d []float64) float64 {
+	alpha := math.Inf(1)
+	for i, v := range d {
+		if v < 0 {
+			alpha = math.Min(alpha, x[i]/-v)
+		}
+	}
+	return alpha
+}
+
+// socBlock is a second-order cone with the scaling W = β(2vvᵀ - J), where
+// J = diag(1, -1, ..., -1).
+type socBlock struct {
+	n      int
+	beta   float64
+	v, jv  []float64 // v and J v.
+	lambda []float64
+}
+
+func (b *socBlock) dim() int    { return b.n }
+func (b *socBlock) degree() int { return 1 }
+
+func (b *socBlock) identity(dst []float64) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	dst[0] = 1
+}
+
+// jnorm returns √(uᵀJu), or zero if u is not in the interior of the cone.
+func jnorm(u []float64) float64 {
+	if u[0] <= 0 {
+		return 0
+	}
+	t := floats.Norm(u[1:], 2)
+	d := (u[0] - t) * (u[0] + t)
+	if d <= 0 {
+		return 0
+	}
+	return math.Sqrt(d)
+}
+
+func (b *socBlock) scale(lambda, x, s []float64) bool {
+	a, c := jnorm(x), jnorm(s)
+	if a == 0 || c == 0 {
+		return false
+	}
+	b.beta = math.Sqrt(a / c)
+	// The scaling point w̄ = (x̄ + J s̄) / 2γ of the normalized points
+	// x̄ = x/a and s̄ = s/c satisfies w̄ᵀJw̄ = 1.
+	gamma := math.Sqrt((1 + floats.Dot(x, s)/(a*c)) / 2)
+	w := b.v
+	w[0] = (x[0]/a + s[0]/c) / (2 * gamma)
+	for i := 1; i < b.n; i++ {
+		w[i] = (x[i]/a - s[i]/c) / (2 * gamma)
+	}
+	f := 1 / math.Sqrt(2*(w[0]+1))
+	w[0] += 1
+	floats.Scale(f, w)
+	copy(b.jv, w)
+	floats.Scale(-1, b.jv[1:])
+	b.apply(lambda, s, opW)
+	copy(b.lambda, lambda)
+	return true
+}
+
+func (b *socBlock) apply(dst, v []float64, op scalingOp) {
+	switch op {
+	case opW:
+		// W v = β(2v(vᵀu) - Ju).
+		f := 2 * floats.Dot(b.v, v)
+		for i := range dst {
+			dst[i] = b.beta * (f*b.v[i] + v[i])
+		}
+		dst[0] -= 2 * b.beta * v[0]
+	case opWInv, opWInvT:
+		// W⁻¹ u = (2Jv(Jv)ᵀu - Ju)/β.
+		f := 2 * floats.Dot(b.jv, v)
+		for i := range dst {
+			dst[i] = (f*b.jv[i] + v[i]) / b.beta
+		}
+		dst[0] -= 2 * v[0] / b.beta
+	case opWTW:
+		tmp := make([]float64, b.n)
+		b.apply(tmp, v, opW)
+		b.apply(dst, tmp, opW)
+	}
+}
+
+func (b *socBlock) product(dst, u, v []float64) {
+	d0 := floats.Dot(u, v)
+	for i := 1; i < b.n; i++ {
+		dst[i] = u[0]*v[i] + v[0]*u[i]
+	}
+	dst[0] = d0
+}
+
+func (b *socBlock) divide(dst, r []float64) {
+	l := b.lambda
+	det := (l[0] - floats.Norm(l[1:], 2)) * (l[0] + floats.Norm(l[1:], 2))
+	x0 := (l[0]*r[0] - floats.Dot(l[1:], r[1:])) / det
+	for i := 1; i < b.n; i++ {
+		dst[i] = (r[i] - x0*l[i]) / l[0]
+	}
+	dst[0] = x0
+}
+
+func (b *socBlock) maxStep(x, d []float64) float64 {
+	// Find the smallest positive root of
+	//  (x₀ + αd₀)² - ‖x₁ + αd₁‖² = aα² + 2bα + c.
+	qa := d[0]*d[0] - floats.Dot(d[1:], d[1:])
+	qb := x[0]*d[0] - floats.Dot(x[1:], d[1:])
+	qc := (x[0] - floats.Norm(x[1:], 2)) * (x[0] + floats.Norm(x[1:], 2))
+	alpha := math.Inf(1)
+	if d[0] < 0 {
+		alpha = x[0] / -d[0]
+	}
+	if qa == 0 {
+		if qb < 0 {
+			alpha = math.Min(alpha, qc/(-2*qb))
+		}
+		return alpha
+	}
+	disc := qb*qb - qa*qc
+	if disc < 0 {
+		return alpha
+	}
+	q := -(qb + math.Copysign(math.Sqrt(disc), qb))
+	for _, r := range []float64{q / qa, qc / q} {
+		if r > 0 {
+			alpha = math.Min(alpha, r)
+		}
+	}
+	return alpha
+}
+
+// psdBlock is a cone of positive semidefinite matrices with the scaling
+// W(U) = RᵀUR, where R is computed from the Cholesky factors of X and S so
+// that RᵀSR = R⁻¹XR⁻ᵀ = diag(λ).
+type psdBlock struct {
+	k       int
+	r, rinv mat.Dense
+	lambda  []float64
+
+	xm, sm *mat.SymDense
+	chol   mat.Cholesky
+	svd    mat.SVD
+}
+
+func (b *psdBlock) dim() int    { return b.k * (b.k + 1) / 2 }
+func (b *psdBlock) degree() int { return b.k }
+
+func (b *psdBlock) identity(dst []float64) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	var idx int
+	for i := 0; i < b.k; i++ {
+		dst[idx] = 1
+		idx += b.k - i
+	}
+}
+
+func (b *psdBlock) scale(lambda, x, s []float64) bool {
+	k := b.k
+	SMat(b.xm, x)
+	SMat(b.sm, s)
+	var l1, l2 mat.TriDense
+	if !b.chol.Factorize(b.xm) {
+		return false
+	}
+	b.chol.LTo(&l1)
+	if !b.chol.Factorize(b.sm) {
+		return false
+	}
+	b.chol.LTo(&l2)
+
+	// With the singular value decomposition L₂ᵀL₁ = U diag(λ) Vᵀ,
+	// R = L₁ V diag(λ)^(-1/2) and R⁻¹ = diag(λ)^(-1/2) Uᵀ L₂ᵀ.
+	var m mat.Dense
+	m.Mul(l2.T(), &l1)
+	if !b.svd.Factorize(&m, mat.SVDFull) {
+		return false
+	}
+	b.svd.Values(b.lambda)
+	var u, v mat.Dense
+	b.svd.UTo(&u)
+	b.svd.VTo(&v)
+	for j, l := range b.lambda {
+		if !(l > 0) {
+			return false
+		}
+		f := 1 / math.Sqrt(l)
+		for i := 0; i < k; i++ {
+			v.Set(i, j, v.At(i, j)*f)
+			u.Set(i, j, u.At(i, j)*f)
+		}
+	}
+	b.r.Reset()
+	b.r.Mul(&l1, &v)
+	b.rinv.Reset()
+	b.rinv.Mul(u.T(), l2.T())
+
+	for i := range lambda {
+		lambda[i] = 0
+	}
+	var idx int
+	for i := 0; i < k; i++ {
+		lambda[idx] = b.lambda[i]
+		idx += k - i
+	}
+	return true
+}
+
+func (b *psdBlock) apply(dst, v []float64, op scalingOp) {
+	SMat(b.xm, v)
+	var t, u mat.Dense
+	switch op {
+	case opW:
+		t.Mul(b.r.T(), b.xm)
+		u.Mul(&t, &b.r)
+	case opWInv:
+		t.Mul(b.rinv.T(), b.xm)
+		u.Mul(&t, &b.rinv)
+	case opWInvT:
+		t.Mul(&b.rinv, b.xm)
+		u.Mul(&t, b.rinv.T())
+	case opWTW:
+		t.Mul(b.r.T(), b.xm)
+		u.Mul(&t, &b.r)
+		t.Mul(&b.r, &u)
+		u.Mul(&t, b.r.T())
+	}
+	svecDense(dst, &u)
+}
+
+// svecDense stores the SVec representation of the symmetric part of the
+// square matrix a into dst.
+func svecDense(dst []float64, a *mat.Dense) {
+	k, _ := a.Dims()
+	var idx int
+	for i := 0; i < k; i++ {
+		dst[idx] = a.At(i, i)
+		idx++
+		for j := i + 1; j < k; j++ {
+			dst[idx] = (a.At(i, j) + a.At(j, i)) / math.Sqrt2
+			idx++
+		}
+	}
+}
+
+func (b *psdBlock) product(dst, u, v []float64) {
+	SMat(b.xm, u)
+	SMat(b.sm, v)
+	// u ∘ v = (UV + VU)/2 is the symmetric part of UV.
+	var m mat.Dense
+	m.Mul(b.xm, b.sm)
+	svecDense(dst, &m)
+}
+
+func (b *psdBlock) divide(dst, r []float64) {
+	// Solve (ΛX + XΛ)/2 = R elementwise.
+	var idx int
+	for i := 0; i < b.k; i++ {
+		for j := i; j < b.k; j++ {
+			dst[idx] = 2 * r[idx] / (b.lambda[i] + b.lambda[j])
+			idx++
+		}
+	}
+}
+
+func (b *psdBlock) maxStep(x, d []float64) float64 {
+	// With X = LLᵀ, X + αD is positive semidefinite for α ≤ -1/λmin
+	// where λmin is the smallest eigenvalue of L⁻¹DL⁻ᵀ.
+	SMat(b.xm, x)
+	SMat(b.sm, d)
+	if !b.chol.Factorize(b.xm) {
+		return 0
+	}
+	var l mat.TriDense
+	b.chol.LTo(&l)
+	var t mat.Dense
+	err := t.Solve(&l, b.sm)
+	if err != nil && !isCondition(err) {
+		return 0
+	}
+	var m mat.Dense
+	err = m.Solve(&l, t.T())
+	if err != nil && !isCondition(err) {
+		return 0
+	}
+	sym := mat.NewSymDense(b.k, nil)
+	for i := 0; i < b.k; i++ {
+		for j := i; j < b.k; j++ {
+			sym.SetSym(i, j, (m.At(i, j)+m.At(j, i))/2)
+		}
+	}
+	var eig mat.EigenSym
+	if !eig.Factorize(sym, false) {
+		return 0
+	}
+	lmin := eig.Values(nil)[0]
+	if lmin >= 0 {
+		return math.Inf(1)
+	}
+	return -1 / lmin
+}
+
+// isCondition returns whether err is a mat.Condition error, which is
+// returned along with a valid solution of an ill-conditioned system.
+func isCondition(err error) bool {
+	_, ok := err.(mat.Condition)
+	return ok
+}
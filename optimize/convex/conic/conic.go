@@ -0,0 +1,209 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conic
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrInfeasible signifies that the constraints cannot be satisfied.
+	ErrInfeasible = errors.New("conic: problem is infeasible")
+	// ErrUnbounded signifies that the objective is not bounded below on
+	// the feasible set.
+	ErrUnbounded = errors.New("conic: problem is unbounded")
+	// ErrIterationLimit signifies that the maximum number of iterations
+	// was reached before a solution was found.
+	ErrIterationLimit = errors.New("conic: iteration limit reached")
+	// ErrLinSolve signifies that the Newton system could not be solved.
+	ErrLinSolve = errors.New("conic: linear solve failure")
+)
+
+const badShape = "conic: size mismatch"
+
+// Cone describes the product
+//
+//	K = ℝ₊^Linear × Q^SOC[0] × ... × S₊^PSD[0] × ...
+//
+// of the non-negative orthant, the second-order cones
+//
+//	Q^q = {(t, u) ∈ ℝ × ℝ^(q-1) : ‖u‖₂ ≤ t}
+//
+// and the cones S₊^k of positive semidefinite k×k matrices. A point of K is
+// stored in a vector holding the components of the orthant, followed by the
+// components of each second-order cone and the SVec representation of each
+// semidefinite block. The k×k semidefinite blocks take k(k+1)/2 elements.
+type Cone struct {
+	Linear int
+	SOC    []int
+	PSD    []int
+}
+
+// Dim returns the number of elements of a vector in the cone.
+func (k Cone) Dim() int {
+	if k.Linear < 0 {
+		panic("conic: negative cone dimension")
+	}
+	n := k.Linear
+	for _, q := range k.SOC {
+		if q < 1 {
+			panic("conic: invalid cone dimension")
+		}
+		n += q
+	}
+	for _, s := range k.PSD {
+		if s < 1 {
+			panic("conic: invalid cone dimension")
+		}
+		n += s * (s + 1) / 2
+	}
+	return n
+}
+
+// Problem is a conic program in the standard form
+//
+//	minimize   cᵀx
+//	subject to A x = b,
+//	           x ∈ K,
+//
+// with the dual problem
+//
+//	maximize   bᵀy
+//	subject to Aᵀy + s = c,
+//	           s ∈ K.
+//
+// Linear matrix inequalities C - Σ yᵢ Aᵢ ⪰ 0 in the variables y are
+// expressed by the dual problem, where the rows of A hold SVec(Aᵢ).
+type Problem struct {
+	C    []float64
+	A    mat.Matrix
+	B    []float64
+	Cone Cone
+}
+
+// Settings holds the settings of InteriorPoint.
+type Settings struct {
+	// Tolerance is the relative tolerance of the primal and dual
+	// residuals and of the duality gap. If Tolerance is zero, a default
+	// value of 1e-8 is used.
+	Tolerance float64
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, a default value of 100 is used.
+	MaxIterations int
+}
+
+// Result holds the solution of a conic program.
+type Result struct {
+	// X is the solution of the primal problem, and Y and S the solution
+	// of the dual problem.
+	X, Y, S []float64
+
+	// PrimalObjective is cᵀx and DualObjective is bᵀy. For a primal
+	// feasible x and a dual feasible (y, s), the duality gap
+	//
+	//	Gap = cᵀx - bᵀy = xᵀs ≥ 0
+	//
+	// bounds the distance of both objective values from the optimal
+	// value. PrimalResidual is ‖Ax - b‖∞ and DualResidual is
+	// ‖Aᵀy + s - c‖∞.
+	PrimalObjective float64
+	DualObjective   float64
+	Gap             float64
+	PrimalResidual  float64
+	DualResidual    float64
+
+	// Iterations is the number of iterations.
+	Iterations int
+
+	// Certificate proves that the problem has no solution. If the solver
+	// returns ErrInfeasible, Certificate is a vector y with bᵀy > 0 and
+	// -Aᵀy ∈ K. If the solver returns ErrUnbounded, Certificate is a ray
+	// x ∈ K with Ax = 0 and cᵀx < 0. Otherwise Certificate is nil.
+	Certificate []float64
+}
+
+// SVec stores the upper triangle of the symmetric matrix a into dst row by
+// row, scaling the off-diagonal elements by √2 so that
+//
+//	SVec(a)ᵀ SVec(b) = tr(a b),
+//
+// and returns dst. If dst is nil, a new slice is allocated, otherwise the
+// length of dst must be k(k+1)/2 where k is the order of a.
+func SVec(dst []float64, a mat.Symmetric) []float64 {
+	k := a.SymmetricDim()
+	if dst == nil {
+		dst = make([]float64, k*(k+1)/2)
+	}
+	if len(dst) != k*(k+1)/2 {
+		panic(badShape)
+	}
+	var idx int
+	for i := 0; i < k; i++ {
+		dst[idx] = a.At(i, i)
+		idx++
+		for j := i + 1; j < k; j++ {
+			dst[idx] = math.Sqrt2 * a.At(i, j)
+			idx++
+		}
+	}
+	return dst
+}
+
+// SMat stores the symmetric matrix represented by the SVec vector v into dst
+// and returns dst. If dst is nil, a new matrix is allocated, otherwise its
+// order must match the length of v.
+func SMat(dst *mat.SymDense, v []float64) *mat.SymDense {
+	k := svecOrder(len(v))
+	if dst == nil {
+		dst = mat.NewSymDense(k, nil)
+	}
+	if dst.SymmetricDim() != k {
+		panic(badShape)
+	}
+	var idx int
+	for i := 0; i < k; i++ {
+		dst.SetSym(i, i, v[idx])
+		idx++
+		for j := i + 1; j < k; j++ {
+			dst.SetSym(i, j, v[idx]/math.Sqrt2)
+			idx++
+		}
+	}
+	return dst
+}
+
+// svecOrder returns the order of the matrix represented by an SVec vector of
+// length n.
+func svecOrder(n int) int {
+	k := int(math.Round((math.Sqrt(float64(8*n+1)) - 1) / 2))
+	if k*(k+1)/2 != n || k == 0 {
+		panic(badShape)
+	}
+	return k
+}
+
+// problemDims checks the consistency of p and returns the number of
+// constraints and variables.
+func problemDims(p Problem) (m, n int) {
+	n = p.Cone.Dim()
+	if n == 0 {
+		panic("conic: zero number of variables")
+	}
+	if len(p.C) != n {
+		panic(badShape)
+	}
+	if p.A == nil {
+		panic("conic: zero number of constraints")
+	}
+	var an int
+	m, an = p.A.Dims()
+	if an != n || len(p.B) != m {
+		panic(badShape)
+	}
+	return m, n
+}
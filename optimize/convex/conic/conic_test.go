@@ -0,0 +1,429 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conic
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func TestSVec(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for k := 1; k <= 5; k++ {
+		a := randomSym(rnd, k)
+		b := randomSym(rnd, k)
+		va := SVec(nil, a)
+		if len(va) != k*(k+1)/2 {
+			t.Errorf("unexpected length for k=%d: got %d, want %d", k, len(va), k*(k+1)/2)
+		}
+		var ab mat.Dense
+		ab.Mul(a, b)
+		if got, want := floats.Dot(va, SVec(nil, b)), mat.Trace(&ab); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected inner product for k=%d: got %v, want %v", k, got, want)
+		}
+		if got := SMat(nil, va); !mat.EqualApprox(got, a, 1e-14) {
+			t.Errorf("round trip mismatch for k=%d", k)
+		}
+	}
+}
+
+func TestScaling(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	cones := []Cone{
+		{Linear: 4},
+		{SOC: []int{1}},
+		{SOC: []int{5}},
+		{PSD: []int{1}},
+		{PSD: []int{4}},
+	}
+	for _, k := range cones {
+		for trial := 0; trial < 10; trial++ {
+			bl := newBlocks(k)[0]
+			n := bl.dim()
+			x := randomInterior(rnd, k)
+			s := randomInterior(rnd, k)
+			lambda := make([]float64, n)
+			if !bl.scale(lambda, x, s) {
+				t.Fatalf("%+v: scaling failed for interior points", k)
+			}
+			// λ = W s = W⁻ᵀ x.
+			ws := make([]float64, n)
+			bl.apply(ws, s, opW)
+			wx := make([]float64, n)
+			bl.apply(wx, x, opWInvT)
+			if !floats.EqualApprox(ws, lambda, 1e-10) || !floats.EqualApprox(wx, lambda, 1e-10) {
+				t.Errorf("%+v: scaling mismatch: W s = %v, W⁻ᵀ x = %v, λ = %v", k, ws, wx, lambda)
+			}
+			// W⁻¹ W v = v and W⁻ᵀ WᵀW v = W v.
+			v := make([]float64, n)
+			for i := range v {
+				v[i] = rnd.NormFloat64()
+			}
+			bl.apply(ws, v, opW)
+			bl.apply(wx, ws, opWInv)
+			if !floats.EqualApprox(wx, v, 1e-10) {
+				t.Errorf("%+v: W⁻¹ W v mismatch: got %v, want %v", k, wx, v)
+			}
+			wtw := make([]float64, n)
+			bl.apply(wtw, v, opWTW)
+			bl.apply(wx, wtw, opWInvT)
+			if !floats.EqualApprox(wx, ws, 1e-10) {
+				t.Errorf("%+v: W⁻ᵀ WᵀW v mismatch: got %v, want %v", k, wx, ws)
+			}
+			// λ ∘ (λ \ v) = v.
+			bl.divide(wx, v)
+			bl.product(ws, lambda, wx)
+			if !floats.EqualApprox(ws, v, 1e-10) {
+				t.Errorf("%+v: division mismatch: got %v, want %v", k, ws, v)
+			}
+			// The step to the boundary.
+			for i := range v {
+				v[i] = rnd.NormFloat64()
+			}
+			alpha := bl.maxStep(x, v)
+			if math.IsInf(alpha, 1) {
+				continue
+			}
+			y := make([]float64, n)
+			floats.AddScaledTo(y, x, 0.999*alpha, v)
+			if !inCone(k, y, 0) {
+				t.Errorf("%+v: point inside the step is not in the cone", k)
+			}
+			floats.AddScaledTo(y, x, 1.001*alpha, v)
+			if inCone(k, y, 0) {
+				t.Errorf("%+v: point beyond the step is in the cone", k)
+			}
+		}
+	}
+}
+
+func TestInteriorPointKnown(t *testing.T) {
+	t.Parallel()
+	// Minimum eigenvalue: minimize tr(CX) subject to tr(X) = 1, X ⪰ 0.
+	c := mat.NewSymDense(3, []float64{2, 1, 0, 1, 3, 1, 0, 1, 4})
+	p := Problem{
+		C:    SVec(nil, c),
+		A:    mat.NewDense(1, 6, SVec(nil, mat.NewDiagDense(3, []float64{1, 1, 1}))),
+		B:    []float64{1},
+		Cone: Cone{PSD: []int{3}},
+	}
+	checkOptimal(t, "minimum eigenvalue", p, 3-math.Sqrt(3), 1e-7)
+
+	// Lovász theta function of the 5-cycle, maximize ⟨J, X⟩ subject to
+	// tr(X) = 1, X_ij = 0 for the edges ij and X ⪰ 0, equals √5.
+	const k = 5
+	jm := mat.NewSymDense(k, nil)
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			jm.SetSym(i, j, -1)
+		}
+	}
+	a := mat.NewDense(k+1, k*(k+1)/2, nil)
+	a.SetRow(0, SVec(nil, mat.NewDiagDense(k, []float64{1, 1, 1, 1, 1})))
+	for i := 0; i < k; i++ {
+		e := mat.NewSymDense(k, nil)
+		e.SetSym(i, (i+1)%k, 1)
+		a.SetRow(i+1, SVec(nil, e))
+	}
+	p = Problem{
+		C:    SVec(nil, jm),
+		A:    a,
+		B:    []float64{1, 0, 0, 0, 0, 0},
+		Cone: Cone{PSD: []int{k}},
+	}
+	checkOptimal(t, "Lovász theta", p, -math.Sqrt(5), 1e-7)
+
+	// Distance from (1, 2) to the line x₁ + x₂ = 1, minimize t subject
+	// to ‖x - (1, 2)‖ ≤ t.
+	p = Problem{
+		C:    []float64{1, 0, 0},
+		A:    mat.NewDense(1, 3, []float64{0, 1, 1}),
+		B:    []float64{1 - 3},
+		Cone: Cone{SOC: []int{3}},
+	}
+	checkOptimal(t, "distance", p, math.Sqrt2, 1e-7)
+
+	// Infeasible: x ≥ 0 with x₁ + x₂ = -1.
+	p = Problem{
+		C:    []float64{1, 1},
+		A:    mat.NewDense(1, 2, []float64{1, 1}),
+		B:    []float64{-1},
+		Cone: Cone{Linear: 2},
+	}
+	res, err := InteriorPoint(p, nil)
+	if err != ErrInfeasible {
+		t.Errorf("unexpected error for infeasible problem: got %v, want %v", err, ErrInfeasible)
+	} else {
+		checkCertificate(t, "infeasible", p, res, err)
+	}
+
+	// Unbounded: minimize -t subject to t = u₁ and (t, u) ∈ Q.
+	p = Problem{
+		C:    []float64{-1, 0, 0},
+		A:    mat.NewDense(1, 3, []float64{1, -1, 0}),
+		B:    []float64{0},
+		Cone: Cone{SOC: []int{3}},
+	}
+	res, err = InteriorPoint(p, nil)
+	if err != ErrUnbounded {
+		t.Errorf("unexpected error for unbounded problem: got %v, want %v", err, ErrUnbounded)
+	} else {
+		checkCertificate(t, "unbounded", p, res, err)
+	}
+}
+
+func TestInteriorPointLinear(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for k := 0; k < 200; k++ {
+		n := rnd.IntN(10) + 1
+		m := rnd.IntN(n) + 1
+		a := mat.NewDense(m, n, nil)
+		b := make([]float64, m)
+		c := make([]float64, n)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+			b[i] = rnd.NormFloat64()
+		}
+		for j := range c {
+			c[j] = rnd.NormFloat64()
+		}
+		want, errLP := lp.DualSimplex(lp.Problem{C: c, A: a, B: b}, nil)
+		p := Problem{C: c, A: a, B: b, Cone: Cone{Linear: n}}
+		res, err := InteriorPoint(p, nil)
+		switch errLP {
+		case nil:
+			if err != nil {
+				t.Errorf("case %d: unexpected error: %v", k, err)
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(res.PrimalObjective, want.F, 1e-6, 1e-6) {
+				t.Errorf("case %d: optimal value mismatch: got %v, want %v", k, res.PrimalObjective, want.F)
+			}
+		case lp.ErrInfeasible:
+			if err != ErrInfeasible {
+				t.Errorf("case %d: unexpected error: got %v, want %v", k, err, ErrInfeasible)
+			}
+		case lp.ErrUnbounded:
+			if err != ErrUnbounded {
+				t.Errorf("case %d: unexpected error: got %v, want %v", k, err, ErrUnbounded)
+			}
+		}
+		checkCertificate(t, fmt.Sprintf("case %d", k), p, res, err)
+	}
+}
+
+func TestInteriorPointRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 4))
+	for k := 0; k < 100; k++ {
+		cone := Cone{Linear: rnd.IntN(4)}
+		for i := rnd.IntN(3); i > 0; i-- {
+			cone.SOC = append(cone.SOC, rnd.IntN(5)+1)
+		}
+		for i := rnd.IntN(3); i > 0; i-- {
+			cone.PSD = append(cone.PSD, rnd.IntN(4)+1)
+		}
+		n := cone.Dim()
+		if n == 0 {
+			continue
+		}
+		m := rnd.IntN(n) + 1
+		// Construct a problem with strictly feasible primal and dual
+		// problems, which therefore has an optimal solution.
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		x0 := randomInterior(rnd, cone)
+		s0 := randomInterior(rnd, cone)
+		y0 := make([]float64, m)
+		for i := range y0 {
+			y0[i] = rnd.NormFloat64()
+		}
+		b := make([]float64, m)
+		mat.NewVecDense(m, b).MulVec(a, mat.NewVecDense(n, x0))
+		c := make([]float64, n)
+		mat.NewVecDense(n, c).MulVec(a.T(), mat.NewVecDense(m, y0))
+		floats.Add(c, s0)
+
+		p := Problem{C: c, A: a, B: b, Cone: cone}
+		res, err := InteriorPoint(p, nil)
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", k, err)
+			continue
+		}
+		checkCertificate(t, fmt.Sprintf("case %d (%+v)", k, cone), p, res, err)
+	}
+}
+
+func TestInteriorPointIterationLimit(t *testing.T) {
+	t.Parallel()
+	c := mat.NewSymDense(3, []float64{2, 1, 0, 1, 3, 1, 0, 1, 4})
+	p := Problem{
+		C:    SVec(nil, c),
+		A:    mat.NewDense(1, 6, SVec(nil, mat.NewDiagDense(3, []float64{1, 1, 1}))),
+		B:    []float64{1},
+		Cone: Cone{PSD: []int{3}},
+	}
+	res, err := InteriorPoint(p, &Settings{MaxIterations: 2})
+	if err != ErrIterationLimit {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrIterationLimit)
+	}
+	if res.Iterations != 2 {
+		t.Errorf("unexpected number of iterations: got %d, want 2", res.Iterations)
+	}
+}
+
+// checkOptimal checks that p is solved with the optimal value want.
+func checkOptimal(t *testing.T, name string, p Problem, want, tol float64) {
+	t.Helper()
+	res, err := InteriorPoint(p, nil)
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", name, err)
+		return
+	}
+	if !scalar.EqualWithinAbsOrRel(res.PrimalObjective, want, tol, tol) {
+		t.Errorf("%s: unexpected primal objective: got %v, want %v", name, res.PrimalObjective, want)
+	}
+	if !scalar.EqualWithinAbsOrRel(res.DualObjective, want, tol, tol) {
+		t.Errorf("%s: unexpected dual objective: got %v, want %v", name, res.DualObjective, want)
+	}
+	checkCertificate(t, name, p, res, err)
+}
+
+// checkCertificate checks the optimality conditions of the result of
+// InteriorPoint or the validity of the certificate returned with err.
+func checkCertificate(t *testing.T, name string, p Problem, res *Result, err error) {
+	t.Helper()
+	const tol = 1e-6
+	m, n := problemDims(p)
+	switch err {
+	case nil:
+		scale := 1 + floats.Norm(res.X, math.Inf(1)) + floats.Norm(res.S, math.Inf(1))
+		if res.PrimalResidual > tol*scale || res.DualResidual > tol*scale {
+			t.Errorf("%s: large residuals: primal %v, dual %v", name, res.PrimalResidual, res.DualResidual)
+		}
+		if res.Gap < -tol*(1+math.Abs(res.PrimalObjective)) || res.Gap > tol*(1+math.Abs(res.PrimalObjective)) {
+			t.Errorf("%s: large duality gap: %v", name, res.Gap)
+		}
+		if !inCone(p.Cone, res.X, tol*scale) || !inCone(p.Cone, res.S, tol*scale) {
+			t.Errorf("%s: solution not in the cone", name)
+		}
+	case ErrInfeasible:
+		y := res.Certificate
+		if by := floats.Dot(p.B, y); by <= 0 {
+			t.Errorf("%s: invalid Farkas certificate: bᵀy = %v", name, by)
+		}
+		aty := make([]float64, n)
+		mat.NewVecDense(n, aty).MulVec(p.A.T(), mat.NewVecDense(m, y))
+		floats.Scale(-1, aty)
+		if !inCone(p.Cone, aty, tol) {
+			t.Errorf("%s: invalid Farkas certificate: -Aᵀy = %v not in the cone", name, aty)
+		}
+	case ErrUnbounded:
+		x := res.Certificate
+		if cx := floats.Dot(p.C, x); cx >= 0 {
+			t.Errorf("%s: invalid ray: cᵀx = %v", name, cx)
+		}
+		ax := make([]float64, m)
+		mat.NewVecDense(m, ax).MulVec(p.A, mat.NewVecDense(n, x))
+		if floats.Norm(ax, math.Inf(1)) > tol {
+			t.Errorf("%s: invalid ray: Ax = %v", name, ax)
+		}
+		if !inCone(p.Cone, x, tol) {
+			t.Errorf("%s: invalid ray: x = %v not in the cone", name, x)
+		}
+	}
+}
+
+// inCone returns whether x is in the cone k within the tolerance tol.
+func inCone(k Cone, x []float64, tol float64) bool {
+	off := 0
+	for j := 0; j < k.Linear; j++ {
+		if x[j] < -tol {
+			return false
+		}
+	}
+	off += k.Linear
+	for _, q := range k.SOC {
+		u := x[off : off+q]
+		if floats.Norm(u[1:], 2) > u[0]+tol {
+			return false
+		}
+		off += q
+	}
+	for _, s := range k.PSD {
+		d := s * (s + 1) / 2
+		var eig mat.EigenSym
+		if !eig.Factorize(SMat(nil, x[off:off+d]), false) {
+			return false
+		}
+		if eig.Values(nil)[0] < -tol {
+			return false
+		}
+		off += d
+	}
+	return true
+}
+
+// randomInterior returns a random point in the interior of the cone k.
+func randomInterior(rnd *rand.Rand, k Cone) []float64 {
+	x := make([]float64, k.Dim())
+	off := 0
+	for j := 0; j < k.Linear; j++ {
+		x[j] = 0.1 + rnd.Float64()
+	}
+	off += k.Linear
+	for _, q := range k.SOC {
+		u := x[off : off+q]
+		for i := 1; i < q; i++ {
+			u[i] = rnd.NormFloat64()
+		}
+		u[0] = floats.Norm(u[1:], 2) + 0.1 + rnd.Float64()
+		off += q
+	}
+	for _, s := range k.PSD {
+		d := s * (s + 1) / 2
+		l := mat.NewDense(s, s, nil)
+		for i := 0; i < s; i++ {
+			for j := 0; j < s; j++ {
+				l.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		a := mat.NewSymDense(s, nil)
+		a.SymOuterK(1, l)
+		for i := 0; i < s; i++ {
+			a.SetSym(i, i, a.At(i, i)+0.1)
+		}
+		SVec(x[off:off+d], a)
+		off += d
+	}
+	return x
+}
+
+// randomSym returns a random symmetric k×k matrix.
+func randomSym(rnd *rand.Rand, k int) *mat.SymDense {
+	a := mat.NewSymDense(k, nil)
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
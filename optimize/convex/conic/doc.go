@@ -0,0 +1,9 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package conic implements routines to solve conic programming problems
+// over products of the non-negative orthant, second-order cones and cones
+// of positive semidefinite matrices, which include linear, second-order
+// cone and semidefinite programs.
+package conic // import "gonum.org/v1/gonum/optimize/convex/conic"
@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conic_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/conic"
+)
+
+func ExampleInteriorPoint() {
+	// Find the smallest eigenvalue of a symmetric matrix C as the optimal
+	// value of the semidefinite program
+	//  minimize tr(CX) subject to tr(X) = 1, X ⪰ 0.
+	c := mat.NewSymDense(3, []float64{
+		2, 1, 0,
+		1, 3, 1,
+		0, 1, 4,
+	})
+	id := mat.NewDiagDense(3, []float64{1, 1, 1})
+	p := conic.Problem{
+		C:    conic.SVec(nil, c),
+		A:    mat.NewDense(1, 6, conic.SVec(nil, id)),
+		B:    []float64{1},
+		Cone: conic.Cone{PSD: []int{3}},
+	}
+	res, err := conic.InteriorPoint(p, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("λmin = %.6f\n", res.PrimalObjective)
+	fmt.Println("certified:", math.Abs(res.Gap) < 1e-6)
+
+	// The solution is the projection onto the eigenvector.
+	x := conic.SMat(nil, res.X)
+	fmt.Printf("X = %.4f\n", mat.Formatted(x, mat.Prefix("    ")))
+
+	// Output:
+	// λmin = 1.267949
+	// certified: true
+	// X = ⎡ 0.6220  -0.4553   0.1667⎤
+	//     ⎢-0.4553   0.3333  -0.1220⎥
+	//     ⎣ 0.1667  -0.1220   0.0447⎦
+}
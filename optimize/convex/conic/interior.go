@@ -0,0 +1,502 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conic
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultTol     = 1e-8
+	defaultMaxIter = 100
+
+	// stepFactor is the fraction of the step to the boundary of the cone
+	// taken by InteriorPoint.
+	stepFactor = 0.99
+
+	// maxRefine is the maximum number of iterative refinement steps for
+	// the solution of the Newton system.
+	maxRefine = 3
+)
+
+// InteriorPoint solves the conic program p by the homogeneous primal-dual
+// interior-point method with Nesterov-Todd scaling and Mehrotra's
+// predictor-corrector steps.
+//
+// The solution is found as the solution of the homogeneous self-dual
+// embedding of the primal and dual problems, which detects infeasible and
+// unbounded problems, for which InteriorPoint returns ErrInfeasible or
+// ErrUnbounded together with a Result holding a certificate. A problem that
+// is both primal and dual infeasible is reported as infeasible. Problems
+// that are feasible but have no interior, or whose optimal value is not
+// attained, may require many iterations or be reported as infeasible or
+// unbounded.
+//
+// The search directions are computed from the normal equations, which are
+// formed densely and solved by a Cholesky factorization with iterative
+// refinement. The semidefinite blocks are scaled using the Cholesky factors
+// of the primal and dual matrices, and the step lengths are found from the
+// eigenvalues of the scaled directions. The cost of an iteration is dominated by forming the
+// normal equations and grows with the number of constraints times the cube
+// of the order of the semidefinite blocks, and with the cube of the number
+// of constraints.
+//
+// The returned Result reports the duality gap and the residuals, which
+// certify the accuracy of the solution. If settings is nil, the default
+// settings are used.
+//
+// References:
+//   - Andersen, E. D., Roos, C., & Terlaky, T. (2003). On implementing a
+//     primal-dual interior-point method for conic quadratic optimization.
+//     Mathematical Programming, 95(2), 249-277.
+//   - Nesterov, Y. E., & Todd, M. J. (1998). Primal-dual interior-point
+//     methods for self-scaled cones. SIAM Journal on Optimization, 8(2),
+//     324-364.
+//   - Vandenberghe, L. (2010). The CVXOPT linear and quadratic cone program
+//     solvers. Technical report, UCLA.
+func InteriorPoint(p Problem, settings *Settings) (*Result, error) {
+	m, n := problemDims(p)
+	tol := defaultTol
+	maxIter := defaultMaxIter
+	if settings != nil {
+		if settings.Tolerance < 0 || settings.MaxIterations < 0 {
+			panic("conic: negative setting")
+		}
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		if settings.MaxIterations != 0 {
+			maxIter = settings.MaxIterations
+		}
+	}
+
+	a := mat.DenseCopyOf(p.A)
+	h := newHomogeneous(a, p.B, p.C, p.Cone)
+	iter, err := h.run(tol, maxIter)
+	var ray []float64
+	if err == ErrUnbounded {
+		// The problem is dual infeasible, so it is unbounded if it is
+		// feasible, which is checked with a zero objective.
+		ray = make([]float64, n)
+		copy(ray, h.x)
+		h = newHomogeneous(a, p.B, make([]float64, n), p.Cone)
+		var it int
+		it, err = h.run(tol, maxIter-iter)
+		iter += it
+		if err == nil {
+			err = ErrUnbounded
+		}
+	}
+
+	res := &Result{Iterations: iter}
+	switch err {
+	case ErrInfeasible:
+		res.Certificate = make([]float64, m)
+		copy(res.Certificate, h.y)
+		if norm := floats.Norm(res.Certificate, math.Inf(1)); norm > 0 {
+			floats.Scale(1/norm, res.Certificate)
+		}
+		res.PrimalObjective = math.NaN()
+		res.DualObjective = math.NaN()
+		return res, err
+	case ErrUnbounded:
+		res.Certificate = ray
+		if norm := floats.Norm(ray, math.Inf(1)); norm > 0 {
+			floats.Scale(1/norm, ray)
+		}
+		res.PrimalObjective = math.Inf(-1)
+		res.DualObjective = math.NaN()
+		return res, err
+	case ErrLinSolve:
+		return res, err
+	}
+	res.X = make([]float64, n)
+	res.Y = make([]float64, m)
+	res.S = make([]float64, n)
+	floats.ScaleTo(res.X, 1/h.tau, h.x)
+	floats.ScaleTo(res.Y, 1/h.tau, h.y)
+	floats.ScaleTo(res.S, 1/h.tau, h.s)
+	res.PrimalObjective = floats.Dot(p.C, res.X)
+	res.DualObjective = floats.Dot(p.B, res.Y)
+	res.Gap = res.PrimalObjective - res.DualObjective
+
+	r := make([]float64, m)
+	mat.NewVecDense(m, r).MulVec(a, mat.NewVecDense(n, res.X))
+	floats.Sub(r, p.B)
+	res.PrimalResidual = floats.Norm(r, math.Inf(1))
+	rd := make([]float64, n)
+	mat.NewVecDense(n, rd).MulVec(a.T(), mat.NewVecDense(m, res.Y))
+	floats.Add(rd, res.S)
+	floats.Sub(rd, p.C)
+	res.DualResidual = floats.Norm(rd, math.Inf(1))
+	return res, err
+}
+
+type hsdStatus int
+
+const (
+	hsdRunning hsdStatus = iota
+	hsdOptimal
+	hsdPrimalInfeasible
+	hsdDualInfeasible
+)
+
+// homogeneous holds the iterates of the homogeneous algorithm for the conic
+// program
+//
+//	minimize cᵀx subject to A x = b, x ∈ K,
+//
+// which finds a solution of the homogeneous self-dual system
+//
+//	A x - b τ = 0,
+//	Aᵀy + s - c τ = 0,
+//	-cᵀx + bᵀy - κ = 0,
+//	x, s ∈ K, τ, κ ≥ 0.
+//
+// A solution with τ > 0 gives the optimal solution x/τ, y/τ and s/τ, and a
+// solution with κ > 0 a certificate of infeasibility.
+type homogeneous struct {
+	a      *mat.Dense
+	b, c   []float64
+	m, n   int
+	blocks []block
+	nu     int // Degree of the barrier of K.
+
+	x, y, s    []float64
+	tau, kappa float64
+	lambda     []float64
+
+	// Norms of the residuals at the initial point.
+	rp0, rd0, mu0 float64
+
+	rp, rd []float64
+	rg, mu float64
+
+	normal mat.SymDense
+	chol   mat.Cholesky
+	p, q   []float64
+	u, v   []float64
+	rhs    []float64
+	tmp    []float64
+	rc, vc []float64
+	dx, dy []float64
+	ds     []float64
+	wdx    []float64
+	wds    []float64
+
+	// Work space for symSolve.
+	res, dv  []float64
+	du, zero []float64
+	work     []float64
+}
+
+func newHomogeneous(a *mat.Dense, b, c []float64, k Cone) *homogeneous {
+	m, n := a.Dims()
+	h := &homogeneous{
+		a: a, b: b, c: c, m: m, n: n,
+		blocks: newBlocks(k),
+		x:      make([]float64, n), y: make([]float64, m), s: make([]float64, n),
+		tau: 1, kappa: 1,
+		lambda: make([]float64, n),
+		rp:     make([]float64, m), rd: make([]float64, n),
+		p: make([]float64, n), q: make([]float64, m),
+		u: make([]float64, n), v: make([]float64, m),
+		rhs: make([]float64, m), tmp: make([]float64, n),
+		rc: make([]float64, n), vc: make([]float64, n),
+		dx: make([]float64, n), dy: make([]float64, m), ds: make([]float64, n),
+		wdx: make([]float64, n), wds: make([]float64, n),
+		res: make([]float64, m), dv: make([]float64, m),
+		du: make([]float64, n), zero: make([]float64, n),
+		work: make([]float64, n),
+	}
+	var off int
+	for _, bl := range h.blocks {
+		d := bl.dim()
+		bl.identity(h.x[off : off+d])
+		bl.identity(h.s[off : off+d])
+		h.nu += bl.degree()
+		off += d
+	}
+	h.residuals()
+	h.rp0 = math.Max(1, floats.Norm(h.rp, 2))
+	h.rd0 = math.Max(1, floats.Norm(h.rd, 2))
+	h.mu0 = h.mu
+	return h
+}
+
+// each calls fn for each block with the offset of its elements.
+func (h *homogeneous) each(fn func(bl block, lo, hi int)) {
+	var off int
+	for _, bl := range h.blocks {
+		d := bl.dim()
+		fn(bl, off, off+d)
+		off += d
+	}
+}
+
+// apply stores op applied blockwise to v into dst.
+func (h *homogeneous) apply(dst, v []float64, op scalingOp) {
+	h.each(func(bl block, lo, hi int) {
+		bl.apply(dst[lo:hi], v[lo:hi], op)
+	})
+}
+
+// residuals computes the residuals of the homogeneous system and the
+// complementarity gap at the current point.
+func (h *homogeneous) residuals() {
+	// rp = bτ - Ax.
+	rp := mat.NewVecDense(h.m, h.rp)
+	rp.MulVec(h.a, mat.NewVecDense(h.n, h.x))
+	for i, v := range h.rp {
+		h.rp[i] = h.b[i]*h.tau - v
+	}
+	// rd = cτ - Aᵀy - s.
+	rd := mat.NewVecDense(h.n, h.rd)
+	rd.MulVec(h.a.T(), mat.NewVecDense(h.m, h.y))
+	for j, v := range h.rd {
+		h.rd[j] = h.c[j]*h.tau - v - h.s[j]
+	}
+	// rg = cᵀx - bᵀy + κ.
+	h.rg = floats.Dot(h.c, h.x) - floats.Dot(h.b, h.y) + h.kappa
+	h.mu = (floats.Dot(h.x, h.s) + h.tau*h.kappa) / float64(h.nu+1)
+}
+
+// status returns the status of the algorithm at the current point.
+func (h *homogeneous) status(tol float64) hsdStatus {
+	rhoP := floats.Norm(h.rp, 2) / h.rp0
+	rhoD := floats.Norm(h.rd, 2) / h.rd0
+	cx := floats.Dot(h.c, h.x)
+	by := floats.Dot(h.b, h.y)
+	rhoA := math.Abs(cx-by) / (h.tau + math.Abs(by))
+	if rhoP <= tol && rhoD <= tol && rhoA <= tol {
+		return hsdOptimal
+	}
+	// The problem is primal infeasible if y is a Farkas certificate,
+	// -Aᵀy ∈ K and bᵀy > 0, where Aᵀy + s = cτ - rd with s ∈ K.
+	if by > 0 {
+		var res float64
+		for j, c := range h.c {
+			res = math.Max(res, math.Abs(c*h.tau-h.rd[j]))
+		}
+		if res <= tol*by {
+			return hsdPrimalInfeasible
+		}
+	}
+	// The problem is dual infeasible if x is a ray, Ax = 0 and cᵀx < 0,
+	// where Ax = bτ - rp.
+	if cx < 0 {
+		var res float64
+		for i, b := range h.b {
+			res = math.Max(res, math.Abs(b*h.tau-h.rp[i]))
+		}
+		if res <= -tol*cx {
+			return hsdDualInfeasible
+		}
+	}
+	// Stop if the iterates approach the complementary solution with τ = 0
+	// without a sufficiently accurate certificate.
+	if h.mu/h.mu0 <= tol && h.tau <= tol*math.Min(1, h.kappa) {
+		if by > -cx {
+			return hsdPrimalInfeasible
+		}
+		return hsdDualInfeasible
+	}
+	return hsdRunning
+}
+
+// run iterates until the homogeneous system is solved or maxIter iterations
+// are performed. It returns the number of iterations and ErrInfeasible if the
+// problem is primal infeasible or ErrUnbounded if it is dual infeasible.
+func (h *homogeneous) run(tol float64, maxIter int) (int, error) {
+	for iter := 0; ; iter++ {
+		switch h.status(tol) {
+		case hsdOptimal:
+			return iter, nil
+		case hsdPrimalInfeasible:
+			return iter, ErrInfeasible
+		case hsdDualInfeasible:
+			return iter, ErrUnbounded
+		}
+		if iter >= maxIter {
+			return iter, ErrIterationLimit
+		}
+		if err := h.iterate(); err != nil {
+			return iter, err
+		}
+	}
+}
+
+// iterate performs a predictor-corrector step.
+func (h *homogeneous) iterate() error {
+	// Compute the scaling and form and factorize the normal matrix
+	// A WᵀW Aᵀ, adding regularization if it is not positive definite.
+	ok := true
+	h.each(func(bl block, lo, hi int) {
+		ok = ok && bl.scale(h.lambda[lo:hi], h.x[lo:hi], h.s[lo:hi])
+	})
+	if !ok {
+		return ErrLinSolve
+	}
+	g := mat.NewDense(h.m, h.n, nil)
+	for i := 0; i < h.m; i++ {
+		h.apply(g.RawRowView(i), h.a.RawRowView(i), opWTW)
+	}
+	h.normal.Reset()
+	h.normal.ReuseAsSym(h.m)
+	var maxDiag float64
+	for i := 0; i < h.m; i++ {
+		gi := g.RawRowView(i)
+		for j := i; j < h.m; j++ {
+			h.normal.SetSym(i, j, floats.Dot(gi, h.a.RawRowView(j)))
+		}
+		maxDiag = math.Max(maxDiag, h.normal.At(i, i))
+	}
+	if math.IsNaN(maxDiag) || math.IsInf(maxDiag, 1) {
+		return ErrLinSolve
+	}
+	reg := 0.0
+	for !h.chol.Factorize(&h.normal) {
+		if reg == 0 {
+			reg = 1e-14 * math.Max(1, maxDiag)
+		} else {
+			reg *= 100
+		}
+		if reg > math.Max(1, maxDiag) {
+			return ErrLinSolve
+		}
+		for i := 0; i < h.m; i++ {
+			h.normal.SetSym(i, i, h.normal.At(i, i)+reg)
+		}
+	}
+
+	// The direction (p, q) is independent of the right-hand side.
+	h.symSolve(h.p, h.q, h.c, h.b)
+
+	var dtau, dkappa float64
+	gamma := 0.0
+	for corrector := 0; corrector < 2; corrector++ {
+		eta := 1 - gamma
+		// The linearized complementarity condition is
+		//  λ ∘ (W ds + W⁻ᵀ dx) = γμe - λ ∘ λ,
+		// with the second order term of the predictor in the corrector.
+		h.each(func(bl block, lo, hi int) {
+			bl.product(h.rc[lo:hi], h.lambda[lo:hi], h.lambda[lo:hi])
+			bl.identity(h.tmp[lo:hi])
+		})
+		for j := range h.rc {
+			h.rc[j] = gamma*h.mu*h.tmp[j] - h.rc[j]
+		}
+		rhattk := gamma*h.mu - h.tau*h.kappa
+		if corrector == 1 {
+			h.apply(h.wdx, h.dx, opWInvT)
+			h.apply(h.wds, h.ds, opW)
+			h.each(func(bl block, lo, hi int) {
+				bl.product(h.tmp[lo:hi], h.wdx[lo:hi], h.wds[lo:hi])
+			})
+			floats.Sub(h.rc, h.tmp)
+			rhattk -= dtau * dkappa
+		}
+		h.each(func(bl block, lo, hi int) {
+			bl.divide(h.vc[lo:hi], h.rc[lo:hi])
+		})
+		h.apply(h.tmp, h.vc, opWInv)
+		for j := range h.tmp {
+			h.tmp[j] = eta*h.rd[j] - h.tmp[j]
+		}
+		for i := range h.rhs {
+			h.rhs[i] = eta * h.rp[i]
+		}
+		h.symSolve(h.u, h.v, h.tmp, h.rhs)
+
+		rhatg := eta * h.rg
+		dtau = (rhatg + rhattk/h.tau - (-floats.Dot(h.c, h.u) + floats.Dot(h.b, h.v))) /
+			(h.kappa/h.tau + (-floats.Dot(h.c, h.p) + floats.Dot(h.b, h.q)))
+		floats.AddScaledTo(h.dx, h.u, dtau, h.p)
+		floats.AddScaledTo(h.dy, h.v, dtau, h.q)
+		// ds = ηrd + c dτ - Aᵀdy is computed from the dual residual
+		// rather than the complementarity condition, which loses
+		// accuracy when W is badly conditioned.
+		mat.NewVecDense(h.n, h.ds).MulVec(h.a.T(), mat.NewVecDense(h.m, h.dy))
+		for j, v := range h.ds {
+			h.ds[j] = eta*h.rd[j] + h.c[j]*dtau - v
+		}
+		dkappa = (rhattk - h.kappa*dtau) / h.tau
+
+		alpha := h.step(dtau, dkappa, 1)
+		gamma = (1 - alpha) * (1 - alpha) * math.Min(0.1, 1-alpha)
+	}
+
+	alpha := h.step(dtau, dkappa, stepFactor)
+	floats.AddScaled(h.x, alpha, h.dx)
+	floats.AddScaled(h.y, alpha, h.dy)
+	floats.AddScaled(h.s, alpha, h.ds)
+	h.tau += alpha * dtau
+	h.kappa += alpha * dkappa
+	h.residuals()
+	return nil
+}
+
+// symSolve solves the system
+//
+//	-H u + Aᵀv = r1,
+//	 A u       = r2,
+//
+// where H = (WᵀW)⁻¹, by the normal equations A WᵀW Aᵀ v = r2 + A WᵀW r1.
+// The solution is improved by iterative refinement since the normal matrix
+// may be regularized and is badly conditioned close to the solution.
+func (h *homogeneous) symSolve(u, v, r1, r2 []float64) {
+	h.normalSolve(u, v, r1, r2)
+	for i := 0; i < maxRefine; i++ {
+		// The first equation holds exactly by construction of u, so only
+		// the residual of the second is corrected.
+		mat.NewVecDense(h.m, h.res).MulVec(h.a, mat.NewVecDense(h.n, u))
+		floats.SubTo(h.res, r2, h.res)
+		if floats.Norm(h.res, math.Inf(1)) <= 1e-14*(1+floats.Norm(r2, math.Inf(1))) {
+			return
+		}
+		for j := range h.zero {
+			h.zero[j] = 0
+		}
+		h.normalSolve(h.du, h.dv, h.zero, h.res)
+		floats.Add(u, h.du)
+		floats.Add(v, h.dv)
+	}
+}
+
+// normalSolve solves the system in symSolve using the factorized normal
+// matrix.
+func (h *homogeneous) normalSolve(u, v, r1, r2 []float64) {
+	h.apply(u, r1, opWTW)
+	vv := mat.NewVecDense(h.m, v)
+	vv.MulVec(h.a, mat.NewVecDense(h.n, u))
+	floats.Add(v, r2)
+	// The error is ignored because the matrix was successfully factorized
+	// and a large condition number is expected close to the solution.
+	_ = h.chol.SolveVecTo(vv, vv)
+	t := h.work
+	mat.NewVecDense(h.n, t).MulVec(h.a.T(), vv)
+	floats.Sub(t, r1)
+	h.apply(u, t, opWTW)
+}
+
+// step returns the largest step length not greater than one that keeps x,
+// s, τ and κ in the interior of their cones, multiplied by factor.
+func (h *homogeneous) step(dtau, dkappa, factor float64) float64 {
+	alpha := math.Inf(1)
+	h.each(func(bl block, lo, hi int) {
+		alpha = math.Min(alpha, bl.maxStep(h.x[lo:hi], h.dx[lo:hi]))
+		alpha = math.Min(alpha, bl.maxStep(h.s[lo:hi], h.ds[lo:hi]))
+	})
+	if dtau < 0 {
+		alpha = math.Min(alpha, h.tau/-dtau)
+	}
+	if dkappa < 0 {
+		alpha = math.Min(alpha, h.kappa/-dkappa)
+	}
+	return math.Min(1, factor*alpha)
+}
@@ -0,0 +1,570 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultDualSimplexTol = 1e-9
+
+	// pivotTol is the smallest magnitude of a pivot element accepted in
+	// the ratio test.
+	pivotTol = 1e-7
+	// refactorPeriod is the number of basis updates after which the basis
+	// matrix is factorized anew.
+	refactorPeriod = 50
+)
+
+// DualSimplex solves the linear program p by the bounded revised dual simplex
+// method.
+//
+// Unlike Simplex, DualSimplex works directly with the bounds of the variables
+// and with inequality constraints. Each constraint aᵢᵀx is represented by a
+// logical variable rᵢ = aᵢᵀx bounded by the right-hand side, and the method
+// starts from the basis formed by the logical variables or from the basis
+// given in settings. If the initial basis is not dual feasible, a dual
+// feasible basis is found by solving an auxiliary problem in which all
+// variables are bounded by the recession cone of the feasible set
+// intersected with the unit box. If the auxiliary problem has a negative
+// optimal value, its solution is a direction of unbounded descent and
+// DualSimplex returns ErrUnbounded when the problem is feasible and
+// ErrInfeasible otherwise. The phase two iterations keep the basis dual
+// feasible and choose the leaving variable with the largest primal
+// infeasibility and the entering variable by Harris' two-pass ratio test.
+// Boxed variables are moved between their bounds whenever that restores dual
+// feasibility. The basis matrix is represented by its LU factorization and a
+// product of eta matrices.
+//
+// DualSimplex returns a basic solution, that is, a vertex of the feasible
+// set, together with its basis which can be used to warm start the solution
+// of a problem with modified bounds. If settings is nil, the default settings
+// are used.
+//
+// References:
+//   - Koberstein, A. (2005). The dual simplex method, techniques for a fast
+//     and stable implementation. PhD thesis, Universität Paderborn.
+//   - Harris, P. M. J. (1973). Pivot selection methods of the Devex LP code.
+//     Mathematical Programming 5(1), 1-28.
+func DualSimplex(p Problem, settings *Settings) (*Result, error) {
+	m, n := problemDims(p)
+	tol := defaultDualSimplexTol
+	maxIter := 100 * (m + n)
+	if settings != nil {
+		if settings.Tolerance < 0 || settings.MaxIterations < 0 {
+			panic("lp: negative setting")
+		}
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		if settings.MaxIterations != 0 {
+			maxIter = settings.MaxIterations
+		}
+	}
+
+	ds := newDualSimplex(p, m, n, tol, maxIter)
+	res := &Result{
+		X:           make([]float64, n),
+		Dual:        make([]float64, m),
+		ReducedCost: make([]float64, n),
+	}
+
+	var initial []int
+	if settings != nil {
+		initial = settings.InitialBasis
+	}
+	ds.setBasis(initial)
+
+	var err error
+	if ds.dualFeasible() {
+		err = ds.solve(ds.lo, ds.hi, ds.c)
+	} else {
+		err = ds.phaseOne(n, m)
+	}
+	res.Iterations = ds.iter
+	switch err {
+	case nil, ErrIterationLimit:
+		copy(res.X, ds.x[:n])
+		copy(res.Dual, ds.y)
+		copy(res.ReducedCost, ds.d[:n])
+		res.F = p.objective(res.X)
+		res.Basis = make([]int, m)
+		copy(res.Basis, ds.basis)
+	case ErrInfeasible:
+		res.F = math.NaN()
+		res.Certificate = ds.farkas()
+	case ErrUnbounded:
+		res.F = math.Inf(-1)
+		res.Certificate = ds.ray
+	default:
+		res.F = math.NaN()
+	}
+	return res, err
+}
+
+// phaseOne finds a dual feasible basis by solving the auxiliary problem
+// with the recession cone of the feasible set intersected with the unit box,
+// and continues with phase two if the problem is dual feasible.
+func (ds *dualSimplex) phaseOne(n, m int) error {
+	auxLo := make([]float64, n+m)
+	auxHi := make([]float64, n+m)
+	for j := range auxLo {
+		if math.IsInf(ds.lo[j], -1) {
+			auxLo[j] = -1
+		}
+		if math.IsInf(ds.hi[j], 1) {
+			auxHi[j] = 1
+		}
+	}
+	err := ds.solve(auxLo, auxHi, ds.c)
+	if err == nil {
+		var obj float64
+		for j := 0; j < n; j++ {
+			obj += ds.c[j] * ds.x[j]
+		}
+		if obj < -ds.tol*(1+floats.Norm(ds.c[:n], math.Inf(1))) {
+			// The problem is dual infeasible, so it is unbounded if it
+			// is feasible.
+			ds.ray = make([]float64, n)
+			copy(ds.ray, ds.x[:n])
+			floats.Scale(1/floats.Norm(ds.ray, math.Inf(1)), ds.ray)
+			err = ds.solve(ds.lo, ds.hi, ds.feasibilityCosts())
+			if err == nil {
+				return ErrUnbounded
+			}
+			return err
+		}
+		err = ds.solve(ds.lo, ds.hi, ds.c)
+	}
+	return err
+}
+
+// nonbasicStatus is the position of a nonbasic variable.
+type nonbasicStatus int
+
+const (
+	atLower nonbasicStatus = iota
+	atUpper
+	atZero // Free nonbasic variable.
+	basic
+)
+
+// dualSimplex holds the state of the dual simplex method for the problem
+//
+//	minimize   cᵀx
+//	subject to A x - r = 0,
+//	           l ≤ (x, r) ≤ u,
+//
+// where the logical variables r follow the structural variables x.
+type dualSimplex struct {
+	m, n    int // Number of constraints and of structural variables.
+	a       *mat.Dense
+	lo, hi  []float64
+	c       []float64
+	tol     float64
+	maxIter int
+	iter    int
+
+	basis  []int
+	status []nonbasicStatus
+	x      []float64 // Values of all variables.
+	y, d   []float64 // Dual values and reduced costs.
+
+	lu     mat.LU
+	bmat   *mat.Dense
+	etas   []eta
+	colBuf []float64
+	rho    []float64
+	alpha  []float64 // Pivot row.
+	rhs    []float64
+	sign   float64   // Direction of the infeasibility of the leaving row.
+	ray    []float64 // Direction of unbounded descent.
+}
+
+// eta is the eta matrix of a basis update, the identity with column r
+// replaced by col.
+type eta struct {
+	r   int
+	col []float64
+}
+
+func newDualSimplex(p Problem, m, n int, tol float64, maxIter int) *dualSimplex {
+	ds := &dualSimplex{
+		m: m, n: n,
+		lo:      make([]float64, n+m),
+		hi:      make([]float64, n+m),
+		c:       make([]float64, n+m),
+		tol:     tol,
+		maxIter: maxIter,
+		basis:   make([]int, m),
+		status:  make([]nonbasicStatus, n+m),
+		x:       make([]float64, n+m),
+		y:       make([]float64, m),
+		d:       make([]float64, n+m),
+		colBuf:  make([]float64, m),
+		rho:     make([]float64, m),
+		alpha:   make([]float64, n+m),
+		rhs:     make([]float64, m),
+	}
+	if m > 0 {
+		ds.a = mat.DenseCopyOf(p.A)
+		ds.bmat = mat.NewDense(m, m, nil)
+	}
+	copy(ds.c, p.C)
+	for j := 0; j < n; j++ {
+		ds.lo[j], ds.hi[j] = p.lower(j), p.upper(j)
+	}
+	for i := 0; i < m; i++ {
+		ds.lo[n+i], ds.hi[n+i] = p.rowBounds(i)
+	}
+	return ds
+}
+
+// setBasis sets the initial basis to the given basic variables, or to the
+// logical variables if basis is nil or singular.
+func (ds *dualSimplex) setBasis(basis []int) {
+	if basis != nil {
+		if len(basis) != ds.m {
+			panic(badShape)
+		}
+		for _, j := range basis {
+			if j < 0 || ds.n+ds.m <= j || ds.status[j] == basic {
+				panic("lp: invalid basis")
+			}
+			ds.status[j] = basic
+		}
+		copy(ds.basis, basis)
+		if ds.m == 0 || ds.factorize() == nil {
+			return
+		}
+		for j := range ds.status {
+			ds.status[j] = atLower
+		}
+	}
+	for i := range ds.basis {
+		ds.basis[i] = ds.n + i
+		ds.status[ds.n+i] = basic
+	}
+}
+
+// dualFeasible returns whether the current basis is dual feasible for the
+// costs and the bounds of the problem when the nonbasic boxed variables are
+// placed at the appropriate bound.
+func (ds *dualSimplex) dualFeasible() bool {
+	if ds.m > 0 {
+		if ds.factorize() != nil {
+			return false
+		}
+	}
+	ds.computeDual(ds.c)
+	for j, st := range ds.status {
+		if st == basic {
+			continue
+		}
+		d := ds.d[j]
+		loInf, hiInf := math.IsInf(ds.lo[j], -1), math.IsInf(ds.hi[j], 1)
+		if (hiInf && d < -ds.tol) || (loInf && d > ds.tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// column stores the j-th column of [A -I] into dst.
+func (ds *dualSimplex) column(dst []float64, j int) {
+	if j < ds.n {
+		mat.Col(dst, j, ds.a)
+		return
+	}
+	for i := range dst {
+		dst[i] = 0
+	}
+	dst[j-ds.n] = -1
+}
+
+// factorize computes the LU factorization of the basis matrix and discards
+// the eta matrices.
+func (ds *dualSimplex) factorize() error {
+	for r, j := range ds.basis {
+		ds.column(ds.colBuf, j)
+		ds.bmat.SetCol(r, ds.colBuf)
+	}
+	ds.lu.Factorize(ds.bmat)
+	ds.etas = ds.etas[:0]
+	if math.IsInf(ds.lu.Cond(), 1) {
+		return ErrSingular
+	}
+	return nil
+}
+
+// ftran solves B dst = b in place.
+func (ds *dualSimplex) ftran(b []float64) {
+	v := mat.NewVecDense(ds.m, b)
+	// The condition of the basis is checked when it is factorized.
+	_ = ds.lu.SolveVecTo(v, false, v)
+	for _, e := range ds.etas {
+		t := b[e.r] / e.col[e.r]
+		for i, a := range e.col {
+			b[i] -= a * t
+		}
+		b[e.r] = t
+	}
+}
+
+// btran solves Bᵀ dst = b in place.
+func (ds *dualSimplex) btran(b []float64) {
+	for k := len(ds.etas) - 1; k >= 0; k-- {
+		e := ds.etas[k]
+		t := b[e.r]
+		for i, a := range e.col {
+			if i != e.r {
+				t -= a * b[i]
+			}
+		}
+		b[e.r] = t / e.col[e.r]
+	}
+	v := mat.NewVecDense(ds.m, b)
+	_ = ds.lu.SolveVecTo(v, true, v)
+}
+
+// place sets the status and the value of the nonbasic variable j to the
+// bound that makes its reduced cost dual feasible.
+func (ds *dualSimplex) place(j int, lo, hi float64) {
+	loInf, hiInf := math.IsInf(lo, -1), math.IsInf(hi, 1)
+	switch {
+	case loInf && hiInf:
+		ds.status[j], ds.x[j] = atZero, 0
+	case hiInf || (!loInf && ds.d[j] >= 0):
+		ds.status[j], ds.x[j] = atLower, lo
+	default:
+		ds.status[j], ds.x[j] = atUpper, hi
+	}
+}
+
+// computeDual computes the dual values and the reduced costs of the current
+// basis for the costs c.
+func (ds *dualSimplex) computeDual(c []float64) {
+	for r, j := range ds.basis {
+		ds.y[r] = c[j]
+	}
+	if ds.m > 0 {
+		ds.btran(ds.y)
+	}
+	ds.pricedRow(ds.d, ds.y)
+	for j := range ds.d {
+		ds.d[j] = c[j] - ds.d[j]
+	}
+}
+
+// pricedRow computes the row vᵀ[A -I] into dst.
+func (ds *dualSimplex) pricedRow(dst, v []float64) {
+	if ds.m == 0 {
+		for j := range dst {
+			dst[j] = 0
+		}
+		return
+	}
+	mat.NewVecDense(ds.n, dst[:ds.n]).MulVec(ds.a.T(), mat.NewVecDense(ds.m, v))
+	for i, vi := range v {
+		dst[ds.n+i] = -vi
+	}
+}
+
+// computePrimal computes the values of the basic variables from the values
+// of the nonbasic variables.
+func (ds *dualSimplex) computePrimal() {
+	if ds.m == 0 {
+		return
+	}
+	for i := range ds.rhs {
+		ds.rhs[i] = 0
+	}
+	for j := 0; j < ds.n; j++ {
+		if ds.status[j] == basic || ds.x[j] == 0 {
+			continue
+		}
+		for i := 0; i < ds.m; i++ {
+			ds.rhs[i] -= ds.a.At(i, j) * ds.x[j]
+		}
+	}
+	for i := 0; i < ds.m; i++ {
+		if ds.status[ds.n+i] != basic {
+			ds.rhs[i] += ds.x[ds.n+i]
+		}
+	}
+	ds.ftran(ds.rhs)
+	for r, j := range ds.basis {
+		ds.x[j] = ds.rhs[r]
+	}
+}
+
+// solve runs the phase two iterations of the dual simplex method with the
+// bounds lo and hi and the costs c, starting from the current basis.
+func (ds *dualSimplex) solve(lo, hi, c []float64) error {
+	m := ds.m
+	if m > 0 {
+		if err := ds.factorize(); err != nil {
+			return err
+		}
+	}
+	ds.computeDual(c)
+	for j, s := range ds.status {
+		if s != basic {
+			ds.place(j, lo[j], hi[j])
+		}
+	}
+	for {
+		// Restore the dual feasibility of boxed variables.
+		for j, s := range ds.status {
+			switch s {
+			case atLower:
+				if ds.d[j] < -ds.tol && !math.IsInf(hi[j], 1) {
+					ds.status[j], ds.x[j] = atUpper, hi[j]
+				}
+			case atUpper:
+				if ds.d[j] > ds.tol && !math.IsInf(lo[j], -1) {
+					ds.status[j], ds.x[j] = atLower, lo[j]
+				}
+			}
+		}
+		ds.computePrimal()
+
+		// Choose the leaving variable with the largest infeasibility.
+		r := -1
+		var delta float64
+		for i, j := range ds.basis {
+			v := ds.x[j]
+			var viol float64
+			if v < lo[j]-ds.tol*math.Max(1, math.Abs(lo[j])) {
+				viol = v - lo[j]
+			} else if v > hi[j]+ds.tol*math.Max(1, math.Abs(hi[j])) {
+				viol = v - hi[j]
+			}
+			if math.Abs(viol) > math.Abs(delta) {
+				r, delta = i, viol
+			}
+		}
+		if r < 0 {
+			return nil
+		}
+		if ds.iter == ds.maxIter {
+			return ErrIterationLimit
+		}
+		ds.iter++
+		s := math.Copysign(1, delta)
+
+		// Compute the pivot row and choose the entering variable by
+		// Harris' two-pass ratio test.
+		for i := range ds.rho {
+			ds.rho[i] = 0
+		}
+		ds.rho[r] = 1
+		ds.btran(ds.rho)
+		ds.pricedRow(ds.alpha, ds.rho)
+		thetaMax := math.Inf(1)
+		for j, st := range ds.status {
+			slack, ok := ds.candidate(j, st, s, lo[j], hi[j])
+			if ok {
+				thetaMax = math.Min(thetaMax, (slack+ds.tol)/math.Abs(ds.alpha[j]))
+			}
+		}
+		if math.IsInf(thetaMax, 1) {
+			ds.sign = s
+			return ErrInfeasible
+		}
+		q := -1
+		var best float64
+		for j, st := range ds.status {
+			slack, ok := ds.candidate(j, st, s, lo[j], hi[j])
+			if ok && slack/math.Abs(ds.alpha[j]) <= thetaMax && math.Abs(ds.alpha[j]) > best {
+				q, best = j, math.Abs(ds.alpha[j])
+			}
+		}
+
+		// Update the basis.
+		col := make([]float64, m)
+		ds.column(col, q)
+		ds.ftran(col)
+		if math.Abs(col[r]-ds.alpha[q]) > 1e-6*(1+math.Abs(col[r])) && len(ds.etas) > 0 {
+			// The pivot row and column disagree, so factorize the
+			// basis anew and repeat the iteration.
+			if err := ds.factorize(); err != nil {
+				return err
+			}
+			ds.computeDual(c)
+			continue
+		}
+		p := ds.basis[r]
+		if s < 0 {
+			ds.status[p], ds.x[p] = atLower, lo[p]
+		} else {
+			ds.status[p], ds.x[p] = atUpper, hi[p]
+		}
+		ds.basis[r] = q
+		ds.status[q] = basic
+		ds.etas = append(ds.etas, eta{r: r, col: col})
+		if len(ds.etas) == refactorPeriod {
+			if err := ds.factorize(); err != nil {
+				return err
+			}
+		}
+		ds.computeDual(c)
+	}
+}
+
+// feasibilityCosts returns random costs for which the current basis is dual
+// feasible with the nonbasic variables at their finite bounds. The method
+// cycles easily with zero costs since then all reduced costs vanish, and the
+// costs do not affect whether a feasible point is found.
+func (ds *dualSimplex) feasibilityCosts() []float64 {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	c := make([]float64, len(ds.c))
+	for j, st := range ds.status {
+		if st == basic {
+			continue
+		}
+		switch {
+		case !math.IsInf(ds.lo[j], -1):
+			c[j] = 1 + rnd.Float64()
+		case !math.IsInf(ds.hi[j], 1):
+			c[j] = -1 - rnd.Float64()
+		}
+	}
+	return c
+}
+
+// candidate returns whether the nonbasic variable j is a candidate to enter
+// the basis in the ratio test for a leaving variable moving in the
+// direction s, and its dual slack.
+func (ds *dualSimplex) candidate(j int, st nonbasicStatus, s, lo, hi float64) (slack float64, ok bool) {
+	a := s * ds.alpha[j]
+	if st == basic || lo == hi || math.Abs(a) <= pivotTol {
+		return 0, false
+	}
+	switch st {
+	case atLower:
+		return ds.d[j], a > 0
+	case atUpper:
+		return -ds.d[j], a < 0
+	default:
+		return math.Abs(ds.d[j]), true
+	}
+}
+
+// farkas returns the certificate of infeasibility for the row that could
+// not be made feasible.
+func (ds *dualSimplex) farkas() []float64 {
+	y := make([]float64, ds.m)
+	floats.ScaleTo(y, ds.sign, ds.rho)
+	if norm := floats.Norm(y, math.Inf(1)); norm > 0 {
+		floats.Scale(1/norm, y)
+	}
+	return y
+}
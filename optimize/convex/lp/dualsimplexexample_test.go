@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+func ExampleDualSimplex() {
+	// Maximize 3x + 2y subject to
+	//  x + y ≤ 4,
+	//  x + 3y ≤ 7,
+	//  0 ≤ x ≤ 3, y ≥ 0.
+	p := lp.Problem{
+		C:         []float64{-3, -2},
+		A:         mat.NewDense(2, 2, []float64{1, 1, 1, 3}),
+		B:         []float64{4, 7},
+		Relations: []lp.Relation{lp.LessEqual, lp.LessEqual},
+		Upper:     []float64{3, math.Inf(1)},
+	}
+	res, err := lp.DualSimplex(p, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("opt: %v\n", -res.F)
+	fmt.Printf("x: %v\n", res.X)
+	fmt.Printf("dual values: %v\n", res.Dual)
+	fmt.Printf("reduced costs: %v\n", res.ReducedCost)
+	// Output:
+	// opt: 11
+	// x: [3 1]
+	// dual values: [-2 0]
+	// reduced costs: [-1 0]
+}
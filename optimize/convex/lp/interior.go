@@ -0,0 +1,406 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultInteriorTol     = 1e-8
+	defaultInteriorMaxIter = 200
+
+	// stepFactor is the fraction of the step to the boundary of the
+	// positive orthant taken by InteriorPoint.
+	stepFactor = 0.99995
+)
+
+// InteriorPoint solves the linear program p by the homogeneous primal-dual
+// interior-point method with Mehrotra's predictor-corrector steps.
+//
+// The problem is converted into the standard form by shifting the variables
+// with finite bounds, splitting free variables and adding slack variables,
+// and the solution is found as the solution of the homogeneous self-dual
+// embedding of the standard form LP. The embedding detects infeasible and
+// unbounded problems, for which InteriorPoint returns ErrInfeasible or
+// ErrUnbounded together with a Result holding a certificate. A problem that
+// is both primal and dual infeasible is reported as infeasible. The search
+// directions are computed from the normal equations which are solved by a
+// dense Cholesky factorization, so the cost of an iteration grows with the
+// cube of the number of constraints and finite upper bounds, while the
+// number of iterations depends only weakly on the size of the problem.
+//
+// InteriorPoint returns the solution in the interior of the optimal face. If
+// the problem has multiple solutions, X is not necessarily a vertex. If
+// settings is nil, the default settings are used.
+//
+// References:
+//   - Andersen, E. D., & Andersen, K. D. (2000). The MOSEK interior point
+//     optimizer for linear programming: an implementation of the homogeneous
+//     algorithm. In High Performance Optimization (pp. 197-232). Springer.
+//   - Mehrotra, S. (1992). On the implementation of a primal-dual interior
+//     point method. SIAM Journal on Optimization 2(4), 575-601.
+func InteriorPoint(p Problem, settings *Settings) (*Result, error) {
+	m, n := problemDims(p)
+	tol := defaultInteriorTol
+	maxIter := defaultInteriorMaxIter
+	if settings != nil {
+		if settings.Tolerance < 0 || settings.MaxIterations < 0 {
+			panic("lp: negative setting")
+		}
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		if settings.MaxIterations != 0 {
+			maxIter = settings.MaxIterations
+		}
+	}
+
+	sf := newStandardForm(p, m, n)
+	h := newHomogeneous(sf.a, sf.b, sf.c)
+	iter, err := h.run(tol, maxIter)
+	var ray []float64
+	if err == ErrUnbounded {
+		// The problem is dual infeasible, so it is unbounded if it is
+		// feasible, which is checked with a zero objective.
+		ray = make([]float64, len(h.x))
+		copy(ray, h.x)
+		h = newHomogeneous(sf.a, sf.b, make([]float64, len(sf.c)))
+		var it int
+		it, err = h.run(tol, maxIter-iter)
+		iter += it
+		if err == nil {
+			err = ErrUnbounded
+		}
+	}
+
+	res := &Result{
+		X:           make([]float64, n),
+		Dual:        make([]float64, m),
+		ReducedCost: make([]float64, n),
+		Iterations:  iter,
+	}
+	switch err {
+	case ErrInfeasible:
+		res.Certificate = make([]float64, m)
+		copy(res.Certificate, h.y)
+		if norm := floats.Norm(res.Certificate, math.Inf(1)); norm > 0 {
+			floats.Scale(1/norm, res.Certificate)
+		}
+		res.F = math.NaN()
+		return res, err
+	case ErrUnbounded:
+		res.Certificate = make([]float64, n)
+		sf.point(res.Certificate, ray, true)
+		if norm := floats.Norm(res.Certificate, math.Inf(1)); norm > 0 {
+			floats.Scale(1/norm, res.Certificate)
+		}
+		res.F = math.Inf(-1)
+		return res, err
+	}
+	xs := make([]float64, len(h.x))
+	floats.ScaleTo(xs, 1/h.tau, h.x)
+	sf.point(res.X, xs, false)
+	floats.ScaleTo(res.Dual, 1/h.tau, h.y[:m])
+	p.reducedCosts(res.ReducedCost, res.Dual)
+	res.F = p.objective(res.X)
+	return res, err
+}
+
+type hsdStatus int
+
+const (
+	hsdRunning hsdStatus = iota
+	hsdOptimal
+	hsdPrimalInfeasible
+	hsdDualInfeasible
+)
+
+// homogeneous holds the iterates of the homogeneous algorithm for the
+// standard form LP
+//
+//	minimize cᵀx subject to A x = b, x ≥ 0,
+//
+// which finds a solution of the homogeneous self-dual system
+//
+//	A x - b τ = 0,
+//	Aᵀy + z - c τ = 0,
+//	-cᵀx + bᵀy - κ = 0,
+//	x, z, τ, κ ≥ 0.
+//
+// A solution with τ > 0 gives the optimal solution x/τ and y/τ, and a
+// solution with κ > 0 a certificate of infeasibility.
+type homogeneous struct {
+	a    *mat.Dense
+	b, c []float64
+	m, n int
+
+	x, y, z    []float64
+	tau, kappa float64
+
+	// Norms of the residuals at the initial point.
+	rp0, rd0, rg0, mu0 float64
+
+	rp, rd []float64
+	rg, mu float64
+
+	dinv     []float64
+	ad       mat.Dense
+	normal   mat.SymDense
+	chol     mat.Cholesky
+	p, q     []float64
+	u, v     []float64
+	rhs, tmp []float64
+	dx, dy   []float64
+	dz       []float64
+	rhatxs   []float64
+}
+
+func newHomogeneous(a *mat.Dense, b, c []float64) *homogeneous {
+	m, n := a.Dims()
+	h := &homogeneous{
+		a: a, b: b, c: c, m: m, n: n,
+		x: make([]float64, n), y: make([]float64, m), z: make([]float64, n),
+		tau: 1, kappa: 1,
+		rp: make([]float64, m), rd: make([]float64, n),
+		dinv: make([]float64, n),
+		p:    make([]float64, n), q: make([]float64, m),
+		u: make([]float64, n), v: make([]float64, m),
+		rhs: make([]float64, m), tmp: make([]float64, n),
+		dx: make([]float64, n), dy: make([]float64, m), dz: make([]float64, n),
+		rhatxs: make([]float64, n),
+	}
+	for i := range h.x {
+		h.x[i] = 1
+		h.z[i] = 1
+	}
+	h.residuals()
+	h.rp0 = math.Max(1, floats.Norm(h.rp, 2))
+	h.rd0 = math.Max(1, floats.Norm(h.rd, 2))
+	h.rg0 = math.Max(1, math.Abs(h.rg))
+	h.mu0 = h.mu
+	return h
+}
+
+// residuals computes the residuals of the homogeneous system and the
+// complementarity gap at the current point.
+func (h *homogeneous) residuals() {
+	// rp = bτ - Ax.
+	rp := mat.NewVecDense(h.m, h.rp)
+	rp.MulVec(h.a, mat.NewVecDense(h.n, h.x))
+	for i, v := range h.rp {
+		h.rp[i] = h.b[i]*h.tau - v
+	}
+	// rd = cτ - Aᵀy - z.
+	rd := mat.NewVecDense(h.n, h.rd)
+	rd.MulVec(h.a.T(), mat.NewVecDense(h.m, h.y))
+	for j, v := range h.rd {
+		h.rd[j] = h.c[j]*h.tau - v - h.z[j]
+	}
+	// rg = cᵀx - bᵀy + κ.
+	h.rg = floats.Dot(h.c, h.x) - floats.Dot(h.b, h.y) + h.kappa
+	h.mu = (floats.Dot(h.x, h.z) + h.tau*h.kappa) / float64(h.n+1)
+}
+
+// status returns the status of the algorithm at the current point.
+func (h *homogeneous) status(tol float64) hsdStatus {
+	rhoP := floats.Norm(h.rp, 2) / h.rp0
+	rhoD := floats.Norm(h.rd, 2) / h.rd0
+	by := floats.Dot(h.b, h.y)
+	rhoA := math.Abs(floats.Dot(h.c, h.x)-by) / (h.tau + math.Abs(by))
+	if rhoP <= tol && rhoD <= tol && rhoA <= tol {
+		return hsdOptimal
+	}
+	// The problem is primal infeasible if y is a Farkas certificate,
+	// Aᵀy ≤ 0 and bᵀy > 0, where Aᵀy + z = cτ - rd with z ≥ 0.
+	if by > 0 {
+		var res float64
+		for j, c := range h.c {
+			res = math.Max(res, math.Abs(c*h.tau-h.rd[j]))
+		}
+		if res <= tol*by {
+			return hsdPrimalInfeasible
+		}
+	}
+	// The problem is dual infeasible if x is a ray, Ax = 0 and cᵀx < 0,
+	// where Ax = bτ - rp.
+	if cx := floats.Dot(h.c, h.x); cx < 0 {
+		var res float64
+		for i, b := range h.b {
+			res = math.Max(res, math.Abs(b*h.tau-h.rp[i]))
+		}
+		if res <= -tol*cx {
+			return hsdDualInfeasible
+		}
+	}
+	// Stop if the iterates approach the complementary solution with τ = 0
+	// without a sufficiently accurate certificate.
+	if h.mu/h.mu0 <= tol && h.tau <= tol*math.Min(1, h.kappa) {
+		if by > -floats.Dot(h.c, h.x) {
+			return hsdPrimalInfeasible
+		}
+		return hsdDualInfeasible
+	}
+	return hsdRunning
+}
+
+// run iterates until the homogeneous system is solved or maxIter iterations
+// are performed. It returns the number of iterations and ErrInfeasible if the
+// LP is primal infeasible or ErrUnbounded if it is dual infeasible.
+func (h *homogeneous) run(tol float64, maxIter int) (int, error) {
+	for iter := 0; ; iter++ {
+		switch h.status(tol) {
+		case hsdOptimal:
+			return iter, nil
+		case hsdPrimalInfeasible:
+			return iter, ErrInfeasible
+		case hsdDualInfeasible:
+			return iter, ErrUnbounded
+		}
+		if iter >= maxIter {
+			return iter, ErrIterationLimit
+		}
+		if err := h.iterate(); err != nil {
+			return iter, err
+		}
+	}
+}
+
+// iterate performs a predictor-corrector step.
+func (h *homogeneous) iterate() error {
+	// Form and factorize the normal matrix A D⁻¹ Aᵀ, where D = Z X⁻¹,
+	// adding regularization if it is not positive definite.
+	for j, x := range h.x {
+		h.dinv[j] = x / h.z[j]
+	}
+	h.ad.Reset()
+	h.ad.CloneFrom(h.a)
+	for j, d := range h.dinv {
+		s := math.Sqrt(d)
+		for i := 0; i < h.m; i++ {
+			h.ad.Set(i, j, h.ad.At(i, j)*s)
+		}
+	}
+	h.normal.Reset()
+	h.normal.SymOuterK(1, &h.ad)
+	var maxDiag float64
+	for i := 0; i < h.m; i++ {
+		maxDiag = math.Max(maxDiag, h.normal.At(i, i))
+	}
+	if math.IsNaN(maxDiag) || math.IsInf(maxDiag, 1) {
+		return ErrLinSolve
+	}
+	reg := 0.0
+	for !h.chol.Factorize(&h.normal) {
+		if reg == 0 {
+			reg = 1e-14 * math.Max(1, maxDiag)
+		} else {
+			reg *= 100
+		}
+		if reg > math.Max(1, maxDiag) {
+			return ErrLinSolve
+		}
+		for i := 0; i < h.m; i++ {
+			h.normal.SetSym(i, i, h.normal.At(i, i)+reg)
+		}
+	}
+
+	// The direction (p, q) is independent of the right-hand side.
+	h.symSolve(h.p, h.q, h.c, h.b)
+
+	var dtau, dkappa float64
+	gamma := 0.0
+	for corrector := 0; corrector < 2; corrector++ {
+		eta := 1 - gamma
+		for j := range h.rhatxs {
+			h.rhatxs[j] = gamma*h.mu - h.x[j]*h.z[j]
+		}
+		rhattk := gamma*h.mu - h.tau*h.kappa
+		if corrector == 1 {
+			for j := range h.rhatxs {
+				h.rhatxs[j] -= h.dx[j] * h.dz[j]
+			}
+			rhattk -= dtau * dkappa
+		}
+		for j := range h.tmp {
+			h.tmp[j] = eta*h.rd[j] - h.rhatxs[j]/h.x[j]
+		}
+		for i := range h.rhs {
+			h.rhs[i] = eta * h.rp[i]
+		}
+		h.symSolve(h.u, h.v, h.tmp, h.rhs)
+
+		rhatg := eta * h.rg
+		dtau = (rhatg + rhattk/h.tau - (-floats.Dot(h.c, h.u) + floats.Dot(h.b, h.v))) /
+			(h.kappa/h.tau + (-floats.Dot(h.c, h.p) + floats.Dot(h.b, h.q)))
+		floats.AddScaledTo(h.dx, h.u, dtau, h.p)
+		floats.AddScaledTo(h.dy, h.v, dtau, h.q)
+		for j := range h.dz {
+			h.dz[j] = (h.rhatxs[j] - h.z[j]*h.dx[j]) / h.x[j]
+		}
+		dkappa = (rhattk - h.kappa*dtau) / h.tau
+
+		alpha := h.step(dtau, dkappa, 1)
+		gamma = (1 - alpha) * (1 - alpha) * math.Min(0.1, 1-alpha)
+	}
+
+	alpha := h.step(dtau, dkappa, stepFactor)
+	floats.AddScaled(h.x, alpha, h.dx)
+	floats.AddScaled(h.y, alpha, h.dy)
+	floats.AddScaled(h.z, alpha, h.dz)
+	h.tau += alpha * dtau
+	h.kappa += alpha * dkappa
+	h.residuals()
+	return nil
+}
+
+// symSolve solves the system
+//
+//	-D u + Aᵀv = r1,
+//	 A u       = r2,
+//
+// by the normal equations A D⁻¹ Aᵀ v = r2 + A D⁻¹ r1.
+func (h *homogeneous) symSolve(u, v, r1, r2 []float64) {
+	for j := range u {
+		u[j] = h.dinv[j] * r1[j]
+	}
+	vv := mat.NewVecDense(h.m, v)
+	vv.MulVec(h.a, mat.NewVecDense(h.n, u))
+	floats.Add(v, r2)
+	// The error is ignored because the matrix was successfully factorized
+	// and a large condition number is expected close to the solution.
+	_ = h.chol.SolveVecTo(vv, vv)
+	uu := mat.NewVecDense(h.n, u)
+	uu.MulVec(h.a.T(), vv)
+	for j := range u {
+		u[j] = h.dinv[j] * (u[j] - r1[j])
+	}
+}
+
+// step returns the largest step length not greater than one that keeps x,
+// z, τ and κ positive, multiplied by factor.
+func (h *homogeneous) step(dtau, dkappa, factor float64) float64 {
+	alpha := 1.0
+	for j, d := range h.dx {
+		if d < 0 {
+			alpha = math.Min(alpha, factor*h.x[j]/-d)
+		}
+	}
+	for j, d := range h.dz {
+		if d < 0 {
+			alpha = math.Min(alpha, factor*h.z[j]/-d)
+		}
+	}
+	if dtau < 0 {
+		alpha = math.Min(alpha, factor*h.tau/-dtau)
+	}
+	if dkappa < 0 {
+		alpha = math.Min(alpha, factor*h.kappa/-dkappa)
+	}
+	return alpha
+}
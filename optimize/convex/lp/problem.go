@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrIterationLimit signifies that the maximum number of iterations was
+// reached before a solution was found.
+var ErrIterationLimit = errors.New("lp: iteration limit reached")
+
+// Relation is the relation between the two sides of a linear constraint.
+type Relation int
+
+const (
+	// Equal is the constraint aᵢᵀx = bᵢ.
+	Equal Relation = iota
+	// LessEqual is the constraint aᵢᵀx ≤ bᵢ.
+	LessEqual
+	// GreaterEqual is the constraint aᵢᵀx ≥ bᵢ.
+	GreaterEqual
+)
+
+// Problem is a linear program in general form
+//
+//	minimize   cᵀx
+//	subject to aᵢᵀx ≤ bᵢ, aᵢᵀx = bᵢ or aᵢᵀx ≥ bᵢ, i = 0, ..., m-1,
+//	           l ≤ x ≤ u,
+//
+// where aᵢᵀ is the i-th row of A.
+type Problem struct {
+	// C holds the coefficients of the objective function.
+	C []float64
+	// A holds the coefficients of the constraints. A has len(C) columns.
+	// A may be nil if there are no constraints.
+	A mat.Matrix
+	// B holds the right-hand sides of the constraints.
+	B []float64
+	// Relations holds the relation of each constraint. If Relations is
+	// nil, all constraints are equality constraints.
+	Relations []Relation
+	// Lower and Upper hold the bounds on the variables. The bounds may be
+	// infinite. If Lower is nil, the lower bounds are zero. If Upper is
+	// nil, the upper bounds are +∞. With the default bounds and
+	// relations, Problem is a linear program in the standard form accepted
+	// by Simplex.
+	Lower, Upper []float64
+}
+
+// Settings holds the settings of the linear programming solvers.
+type Settings struct {
+	// Tolerance is the relative tolerance of the primal and dual
+	// feasibility and of the optimality. If Tolerance is zero, a default
+	// value of 1e-8 is used by InteriorPoint and of 1e-9 by DualSimplex.
+	Tolerance float64
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, a default value of 200 is used by InteriorPoint and of
+	// 100*(m+n) by DualSimplex, where m and n are the numbers of
+	// constraints and variables.
+	MaxIterations int
+	// InitialBasis holds the basic variables of the basis from which
+	// DualSimplex starts, in the numbering of Result.Basis. The basis is
+	// typically the basis of a solution of a problem that differs from
+	// the current problem only in the bounds. If InitialBasis is nil or
+	// the basis is singular, DualSimplex starts from the basis formed by
+	// the constraints. InitialBasis is ignored by InteriorPoint.
+	InitialBasis []int
+}
+
+// Result holds the solution of a linear program.
+type Result struct {
+	// X is the solution.
+	X []float64
+	// F is the optimal value of the objective function cᵀX.
+	F float64
+	// Dual holds the dual values y of the constraints, and ReducedCost
+	// holds the reduced costs d of the variables, such that
+	//
+	//	c = Aᵀy + d.
+	//
+	// At the solution, the dual values of ≤ constraints are non-positive,
+	// the dual values of ≥ constraints are non-negative, and the reduced
+	// costs are non-negative for variables at their lower bound,
+	// non-positive for variables at their upper bound and zero otherwise.
+	Dual        []float64
+	ReducedCost []float64
+	// Iterations is the number of iterations.
+	Iterations int
+	// Basis holds the m basic variables of the solution found by
+	// DualSimplex. The variables are numbered so that j < n is the j-th
+	// variable of the problem and n+i is the value aᵢᵀx of the i-th
+	// constraint. Basis is nil for InteriorPoint.
+	Basis []int
+
+	// Certificate proves that the problem has no solution. If the solver
+	// returns ErrUnbounded, Certificate is a primal ray d of length n
+	// with cᵀd < 0 along which the objective decreases without bound, that
+	// is, every point x + td with t ≥ 0 satisfies the constraints if x
+	// does. If the solver returns ErrInfeasible, Certificate is a Farkas
+	// vector y of length m such that
+	//
+	//	min yᵀs > max (Aᵀy)ᵀx,
+	//
+	// where s ranges over the right-hand sides permitted by the
+	// constraints and x ranges over the bounds, which shows that no x
+	// satisfies both. Otherwise Certificate is nil.
+	Certificate []float64
+}
+
+// problemDims checks the consistency of p and returns the number of
+// constraints and variables.
+func problemDims(p Problem) (m, n int) {
+	n = len(p.C)
+	if n == 0 {
+		panic("lp: zero number of variables")
+	}
+	if p.A != nil {
+		var an int
+		m, an = p.A.Dims()
+		if an != n {
+			panic(badShape)
+		}
+	}
+	if len(p.B) != m {
+		panic(badShape)
+	}
+	if p.Relations != nil && len(p.Relations) != m {
+		panic(badShape)
+	}
+	if p.Lower != nil && len(p.Lower) != n {
+		panic(badShape)
+	}
+	if p.Upper != nil && len(p.Upper) != n {
+		panic(badShape)
+	}
+	for j := 0; j < n; j++ {
+		if !(p.lower(j) <= p.upper(j)) || math.IsInf(p.lower(j), 1) || math.IsInf(p.upper(j), -1) {
+			panic("lp: invalid bounds")
+		}
+	}
+	return m, n
+}
+
+func (p Problem) lower(j int) float64 {
+	if p.Lower == nil {
+		return 0
+	}
+	return p.Lower[j]
+}
+
+func (p Problem) upper(j int) float64 {
+	if p.Upper == nil {
+		return math.Inf(1)
+	}
+	return p.Upper[j]
+}
+
+func (p Problem) relation(i int) Relation {
+	if p.Relations == nil {
+		return Equal
+	}
+	return p.Relations[i]
+}
+
+// rowBounds returns the range of the values of aᵢᵀx permitted by the i-th
+// constraint.
+func (p Problem) rowBounds(i int) (lo, hi float64) {
+	switch p.relation(i) {
+	case Equal:
+		return p.B[i], p.B[i]
+	case LessEqual:
+		return math.Inf(-1), p.B[i]
+	case GreaterEqual:
+		return p.B[i], math.Inf(1)
+	default:
+		panic("lp: invalid relation")
+	}
+}
+
+// reducedCosts computes the reduced costs c - Aᵀy into dst.
+func (p Problem) reducedCosts(dst, y []float64) {
+	copy(dst, p.C)
+	if p.A == nil {
+		return
+	}
+	m, n := p.A.Dims()
+	for i := 0; i < m; i++ {
+		if y[i] == 0 {
+			continue
+		}
+		for j := 0; j < n; j++ {
+			dst[j] -= y[i] * p.A.At(i, j)
+		}
+	}
+}
+
+// objective returns cᵀx.
+func (p Problem) objective(x []float64) float64 {
+	return floats.Dot(p.C, x)
+}
@@ -0,0 +1,373 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+var solvers = []struct {
+	name  string
+	solve func(Problem, *Settings) (*Result, error)
+}{
+	{name: "InteriorPoint", solve: InteriorPoint},
+	{name: "DualSimplex", solve: DualSimplex},
+}
+
+func TestSolversKnown(t *testing.T) {
+	t.Parallel()
+	// minimize -3x - 2y subject to x + y ≤ 4, x + 3y ≤ 7, 0 ≤ x ≤ 3, y ≥ 0.
+	p := Problem{
+		C:         []float64{-3, -2},
+		A:         mat.NewDense(2, 2, []float64{1, 1, 1, 3}),
+		B:         []float64{4, 7},
+		Relations: []Relation{LessEqual, LessEqual},
+		Upper:     []float64{3, math.Inf(1)},
+	}
+	for _, s := range solvers {
+		res, err := s.solve(p, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", s.name, err)
+			continue
+		}
+		if !floats.EqualApprox(res.X, []float64{3, 1}, 1e-7) {
+			t.Errorf("%s: unexpected solution: got %v, want [3 1]", s.name, res.X)
+		}
+		if !scalar.EqualWithinAbs(res.F, -11, 1e-7) {
+			t.Errorf("%s: unexpected optimal value: got %v, want -11", s.name, res.F)
+		}
+		if !floats.EqualApprox(res.Dual, []float64{-2, 0}, 1e-7) {
+			t.Errorf("%s: unexpected dual values: got %v, want [-2 0]", s.name, res.Dual)
+		}
+		if !floats.EqualApprox(res.ReducedCost, []float64{-1, 0}, 1e-7) {
+			t.Errorf("%s: unexpected reduced costs: got %v, want [-1 0]", s.name, res.ReducedCost)
+		}
+	}
+
+	// Infeasible: x + y ≥ 5 with x, y ∈ [0, 2].
+	p = Problem{
+		C:         []float64{1, 1},
+		A:         mat.NewDense(1, 2, []float64{1, 1}),
+		B:         []float64{5},
+		Relations: []Relation{GreaterEqual},
+		Upper:     []float64{2, 2},
+	}
+	for _, s := range solvers {
+		res, err := s.solve(p, nil)
+		if err != ErrInfeasible {
+			t.Errorf("%s: unexpected error for infeasible problem: got %v, want %v", s.name, err, ErrInfeasible)
+			continue
+		}
+		checkCertificate(t, s.name, p, res, err, 1e-8)
+	}
+
+	// Unbounded: minimize -x - y subject to x - y = 1, x, y ≥ 0.
+	p = Problem{
+		C: []float64{-1, -1},
+		A: mat.NewDense(1, 2, []float64{1, -1}),
+		B: []float64{1},
+	}
+	for _, s := range solvers {
+		res, err := s.solve(p, nil)
+		if err != ErrUnbounded {
+			t.Errorf("%s: unexpected error for unbounded problem: got %v, want %v", s.name, err, ErrUnbounded)
+			continue
+		}
+		checkCertificate(t, s.name, p, res, err, 1e-8)
+	}
+
+	// Box constrained without constraints.
+	p = Problem{
+		C:     []float64{1, -2, 0},
+		Lower: []float64{-1, -1, math.Inf(-1)},
+		Upper: []float64{1, 4, math.Inf(1)},
+	}
+	for _, s := range solvers {
+		res, err := s.solve(p, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", s.name, err)
+			continue
+		}
+		if !scalar.EqualWithinAbs(res.F, -9, 1e-7) {
+			t.Errorf("%s: unexpected optimal value: got %v, want -9", s.name, res.F)
+		}
+	}
+}
+
+func TestSolversStandardForm(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for k := 0; k < 500; k++ {
+		n := rnd.IntN(10) + 2
+		m := rnd.IntN(n-1) + 1
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		b := make([]float64, m)
+		for i := range b {
+			b[i] = rnd.NormFloat64()
+		}
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+		want, _, errSimplex := Simplex(c, a, b, convergenceTol, nil)
+		if errSimplex != nil && errSimplex != ErrInfeasible && errSimplex != ErrUnbounded {
+			continue
+		}
+		p := Problem{C: c, A: a, B: b}
+		for _, s := range solvers {
+			name := fmt.Sprintf("%s (case %d)", s.name, k)
+			res, err := s.solve(p, nil)
+			if err != errSimplex {
+				t.Errorf("%s: error mismatch with Simplex: got %v, want %v", name, err, errSimplex)
+				continue
+			}
+			if err == nil && !scalar.EqualWithinAbsOrRel(res.F, want, 1e-6, 1e-6) {
+				t.Errorf("%s: optimal value mismatch with Simplex: got %v, want %v", name, res.F, want)
+			}
+			checkCertificate(t, name, p, res, err, 1e-6)
+		}
+	}
+}
+
+func TestSolversGeneral(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	for k := 0; k < 500; k++ {
+		p := randomProblem(rnd, rnd.IntN(10)+1, rnd.IntN(8))
+		ipm, errIPM := InteriorPoint(p, nil)
+		ds, errDS := DualSimplex(p, nil)
+		if errIPM != errDS {
+			t.Errorf("case %d: error mismatch: InteriorPoint %v, DualSimplex %v", k, errIPM, errDS)
+			continue
+		}
+		if errIPM == nil && !scalar.EqualWithinAbsOrRel(ipm.F, ds.F, 1e-6, 1e-6) {
+			t.Errorf("case %d: optimal value mismatch: InteriorPoint %v, DualSimplex %v", k, ipm.F, ds.F)
+		}
+		checkCertificate(t, fmt.Sprintf("InteriorPoint (case %d)", k), p, ipm, errIPM, 1e-6)
+		checkCertificate(t, fmt.Sprintf("DualSimplex (case %d)", k), p, ds, errDS, 1e-8)
+	}
+}
+
+func TestDualSimplexIterationLimit(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for k := 0; ; k++ {
+		if k == 100 {
+			t.Fatal("no problem requiring more than one iteration found")
+		}
+		p := randomProblem(rnd, 10, 8)
+		_, err := DualSimplex(p, nil)
+		if err != nil {
+			continue
+		}
+		res, err := DualSimplex(p, &Settings{MaxIterations: 1})
+		if err != ErrIterationLimit {
+			// The slack basis may already be optimal.
+			continue
+		}
+		if res.Iterations != 1 {
+			t.Errorf("unexpected number of iterations: got %d, want 1", res.Iterations)
+		}
+		break
+	}
+}
+
+// randomProblem returns a random linear program with n variables and m
+// constraints with random bounds and relations.
+func randomProblem(rnd *rand.Rand, n, m int) Problem {
+	p := Problem{
+		C:         make([]float64, n),
+		B:         make([]float64, m),
+		Relations: make([]Relation, m),
+		Lower:     make([]float64, n),
+		Upper:     make([]float64, n),
+	}
+	for j := range p.C {
+		p.C[j] = rnd.NormFloat64()
+		l := rnd.NormFloat64()
+		u := l + 2*rnd.Float64()
+		switch rnd.IntN(5) {
+		case 0:
+			l = math.Inf(-1)
+		case 1:
+			u = math.Inf(1)
+		case 2:
+			l, u = math.Inf(-1), math.Inf(1)
+		case 3:
+			if rnd.IntN(4) == 0 {
+				u = l
+			}
+		}
+		p.Lower[j], p.Upper[j] = l, u
+	}
+	if m > 0 {
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if rnd.Float64() < 0.7 {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			p.B[i] = rnd.NormFloat64()
+			p.Relations[i] = Relation(rnd.IntN(3))
+		}
+		p.A = a
+	}
+	return p
+}
+
+// checkCertificate checks the optimality conditions of the result of a
+// solver or the validity of the certificate returned with err.
+func checkCertificate(t *testing.T, name string, p Problem, res *Result, err error, tol float64) {
+	t.Helper()
+	m, n := problemDims(p)
+	ax := make([]float64, m)
+	switch err {
+	case nil:
+		for j, x := range res.X {
+			if x < p.lower(j)-tol || x > p.upper(j)+tol {
+				t.Errorf("%s: bound %d violated: %v not in [%v, %v]", name, j, x, p.lower(j), p.upper(j))
+			}
+		}
+		if m > 0 {
+			mat.NewVecDense(m, ax).MulVec(p.A, mat.NewVecDense(n, res.X))
+		}
+		scale := 1 + floats.Norm(res.X, math.Inf(1))
+		for i := 0; i < m; i++ {
+			lo, hi := p.rowBounds(i)
+			if ax[i] < lo-tol*scale || ax[i] > hi+tol*scale {
+				t.Errorf("%s: constraint %d violated: %v not in [%v, %v]", name, i, ax[i], lo, hi)
+			}
+			y := res.Dual[i]
+			if (p.relation(i) == LessEqual && y > tol) || (p.relation(i) == GreaterEqual && y < -tol) {
+				t.Errorf("%s: dual value %d has wrong sign: %v", name, i, y)
+			}
+			if math.Abs(y*(ax[i]-p.B[i])) > tol*scale {
+				t.Errorf("%s: complementarity of constraint %d violated", name, i)
+			}
+		}
+		d := make([]float64, n)
+		p.reducedCosts(d, res.Dual)
+		if !floats.EqualApprox(d, res.ReducedCost, 1e-10*(1+floats.Norm(d, math.Inf(1)))) {
+			t.Errorf("%s: reduced costs mismatch: got %v, want %v", name, res.ReducedCost, d)
+		}
+		scale += math.Abs(res.F)
+		for j, dj := range res.ReducedCost {
+			x := res.X[j]
+			if (dj > tol && dj*(x-p.lower(j)) > tol*scale) || (dj < -tol && -dj*(p.upper(j)-x) > tol*scale) {
+				t.Errorf("%s: complementarity of variable %d violated: x = %v, d = %v", name, j, x, dj)
+			}
+		}
+	case ErrUnbounded:
+		ray := res.Certificate
+		if len(ray) != n {
+			t.Errorf("%s: unexpected ray length %d", name, len(ray))
+			return
+		}
+		if floats.Dot(p.C, ray) >= -tol {
+			t.Errorf("%s: objective not decreasing along ray: %v", name, floats.Dot(p.C, ray))
+		}
+		for j, v := range ray {
+			if (v < -tol && !math.IsInf(p.lower(j), -1)) || (v > tol && !math.IsInf(p.upper(j), 1)) {
+				t.Errorf("%s: ray leaves bound %d: %v", name, j, v)
+			}
+		}
+		if m > 0 {
+			mat.NewVecDense(m, ax).MulVec(p.A, mat.NewVecDense(n, ray))
+		}
+		for i, v := range ax {
+			lo, hi := p.rowBounds(i)
+			if (v < -tol && !math.IsInf(lo, -1)) || (v > tol && !math.IsInf(hi, 1)) {
+				t.Errorf("%s: ray leaves constraint %d: %v", name, i, v)
+			}
+		}
+	case ErrInfeasible:
+		y := res.Certificate
+		if len(y) != m {
+			t.Errorf("%s: unexpected certificate length %d", name, len(y))
+			return
+		}
+		// min yᵀs over the permitted right-hand sides. Coefficients
+		// of infinite bounds must vanish.
+		var minYS float64
+		for i, yi := range y {
+			lo, hi := p.rowBounds(i)
+			minYS += boundTerm(yi, lo, hi, tol)
+		}
+		// max (Aᵀy)ᵀx over the bounds.
+		aty := make([]float64, n)
+		if m > 0 {
+			mat.NewVecDense(n, aty).MulVec(p.A.T(), mat.NewVecDense(m, y))
+		}
+		var maxATYX float64
+		for j, g := range aty {
+			maxATYX -= boundTerm(-g, p.lower(j), p.upper(j), tol)
+		}
+		if !(minYS > maxATYX) {
+			t.Errorf("%s: invalid Farkas certificate: min yᵀs = %v, max (Aᵀy)ᵀx = %v", name, minYS, maxATYX)
+		}
+	}
+}
+
+// boundTerm returns min v*s over s in [lo, hi], where v multiplying an
+// infinite bound is treated as zero if |v| ≤ tol.
+func boundTerm(v, lo, hi, tol float64) float64 {
+	switch {
+	case v > 0 && !math.IsInf(lo, -1):
+		return v * lo
+	case v < 0 && !math.IsInf(hi, 1):
+		return v * hi
+	case math.Abs(v) <= tol:
+		return 0
+	}
+	return math.Inf(-1)
+}
+
+func TestDualSimplexWarmStart(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 4))
+	var tested int
+	for k := 0; k < 200; k++ {
+		p := randomProblem(rnd, 12, 8)
+		res, err := DualSimplex(p, nil)
+		if err != nil {
+			continue
+		}
+		// Cut off the solution by tightening the bounds of a variable
+		// as in branch and bound.
+		j := rnd.IntN(len(p.C))
+		if rnd.IntN(2) == 0 {
+			p.Upper[j] = math.Max(p.Lower[j], res.X[j]-0.5)
+		} else {
+			p.Lower[j] = math.Min(p.Upper[j], res.X[j]+0.5)
+		}
+		cold, errCold := DualSimplex(p, nil)
+		warm, errWarm := DualSimplex(p, &Settings{InitialBasis: res.Basis})
+		if errCold != errWarm {
+			t.Errorf("case %d: error mismatch: cold %v, warm %v", k, errCold, errWarm)
+			continue
+		}
+		checkCertificate(t, fmt.Sprintf("warm start (case %d)", k), p, warm, errWarm, 1e-8)
+		if errCold == nil && !scalar.EqualWithinAbsOrRel(cold.F, warm.F, 1e-8, 1e-8) {
+			t.Errorf("case %d: optimal value mismatch: cold %v, warm %v", k, cold.F, warm.F)
+		}
+		tested++
+	}
+	if tested == 0 {
+		t.Error("no problems tested")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// varKind is the kind of the transformation of a variable of a general LP
+// into non-negative variables.
+type varKind int
+
+const (
+	fixedVar varKind = iota // x = l.
+	lowerVar                // x = l + x', x' ≥ 0.
+	upperVar                // x = u - x', x' ≥ 0.
+	freeVar                 // x = x' - x'', x', x'' ≥ 0.
+	boxedVar                // x = l + x', x' + w = u - l, x', w ≥ 0.
+)
+
+// standardForm is a general LP converted into the standard form
+//
+//	minimize   cᵀx
+//	subject to A x = b, x ≥ 0.
+//
+// The columns of A are the transformed variables of the general LP, followed
+// by the slack variables of the inequality constraints and the slack
+// variables w of the upper bounds of boxed variables. The rows of A are the
+// constraints of the general LP followed by the upper bounds of boxed
+// variables.
+type standardForm struct {
+	p    Problem
+	a    *mat.Dense
+	b, c []float64
+
+	kind []varKind
+	col  []int // Column of the first transformed variable of each variable.
+}
+
+func newStandardForm(p Problem, m, n int) *standardForm {
+	sf := &standardForm{
+		p:    p,
+		kind: make([]varKind, n),
+		col:  make([]int, n),
+	}
+	// Count the columns and the rows.
+	var cols, boxed int
+	for j := 0; j < n; j++ {
+		l, u := p.lower(j), p.upper(j)
+		loInf, upInf := math.IsInf(l, -1), math.IsInf(u, 1)
+		sf.col[j] = cols
+		switch {
+		case l == u:
+			sf.kind[j] = fixedVar
+			continue
+		case !loInf && upInf:
+			sf.kind[j] = lowerVar
+		case loInf && !upInf:
+			sf.kind[j] = upperVar
+		case loInf && upInf:
+			sf.kind[j] = freeVar
+			cols++
+		default:
+			sf.kind[j] = boxedVar
+			boxed++
+		}
+		cols++
+	}
+	var slacks int
+	for i := 0; i < m; i++ {
+		if p.relation(i) != Equal {
+			slacks++
+		}
+	}
+	rows := m + boxed
+	ncols := cols + slacks + boxed
+	// The normal equations require at least one row and the
+	// standard form at least one column.
+	sf.a = mat.NewDense(max(rows, 1), max(ncols, 1), nil)
+	sf.b = make([]float64, max(rows, 1))
+	sf.c = make([]float64, max(ncols, 1))
+
+	copy(sf.b, p.B)
+	row := m
+	for j := 0; j < n; j++ {
+		l, u := p.lower(j), p.upper(j)
+		k := sf.col[j]
+		var sign, shift float64
+		switch sf.kind[j] {
+		case fixedVar:
+			shift = l
+		case lowerVar:
+			sign, shift = 1, l
+		case upperVar:
+			sign, shift = -1, u
+		case freeVar:
+			sign = 1
+		case boxedVar:
+			sign, shift = 1, l
+			w := cols + slacks + row - m
+			sf.a.Set(row, k, 1)
+			sf.a.Set(row, w, 1)
+			sf.b[row] = u - l
+			row++
+		}
+		switch sf.kind[j] {
+		case fixedVar:
+		case freeVar:
+			sf.c[k], sf.c[k+1] = p.C[j], -p.C[j]
+		default:
+			sf.c[k] = sign * p.C[j]
+		}
+		for i := 0; i < m; i++ {
+			aij := p.A.At(i, j)
+			if aij == 0 {
+				continue
+			}
+			if shift != 0 {
+				sf.b[i] -= aij * shift
+			}
+			if sf.kind[j] == fixedVar {
+				continue
+			}
+			sf.a.Set(i, k, sign*aij)
+			if sf.kind[j] == freeVar {
+				sf.a.Set(i, k+1, -aij)
+			}
+		}
+	}
+	s := cols
+	for i := 0; i < m; i++ {
+		switch p.relation(i) {
+		case LessEqual:
+			sf.a.Set(i, s, 1)
+			s++
+		case GreaterEqual:
+			sf.a.Set(i, s, -1)
+			s++
+		}
+	}
+	return sf
+}
+
+// point converts the transformed variables xs into the variables of the
+// general LP and stores them into dst. If ray is true, xs is interpreted as a
+// direction and the bounds are not added.
+func (sf *standardForm) point(dst, xs []float64, ray bool) {
+	for j := range dst {
+		k := sf.col[j]
+		var shift float64
+		if !ray {
+			switch sf.kind[j] {
+			case fixedVar, lowerVar, boxedVar:
+				shift = sf.p.lower(j)
+			case upperVar:
+				shift = sf.p.upper(j)
+			}
+		}
+		switch sf.kind[j] {
+		case fixedVar:
+			dst[j] = shift
+		case lowerVar, boxedVar:
+			dst[j] = shift + xs[k]
+		case upperVar:
+			dst[j] = shift - xs[k]
+		case freeVar:
+			dst[j] = xs[k] - xs[k+1]
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package milp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+const (
+	// minCutFraction is the smallest distance of the value of a basic
+	// integer variable from an integer for which a cut is generated.
+	minCutFraction = 0.01
+	// maxCutDynamism is the largest ratio of the magnitudes of the
+	// coefficients of an accepted cut.
+	maxCutDynamism = 1e6
+	// minCutViolation is the smallest violation of an accepted cut by
+	// the current solution.
+	minCutViolation = 1e-6
+)
+
+// cut is the inequality aᵀx ≥ b.
+type cut struct {
+	a []float64
+	b float64
+}
+
+// gomoryCuts returns the Gomory mixed-integer cuts derived from the rows of
+// the optimal simplex tableau of the relaxation p for the basic integer
+// variables with fractional values.
+//
+// With the constraints written as A x - r = 0 and the nonbasic variables
+// shifted to tⱼ = xⱼ - lⱼ ≥ 0 or tⱼ = uⱼ - xⱼ ≥ 0, the row of the basic
+// variable xₚ is
+//
+//	xₚ + Σ aⱼ tⱼ = β,
+//
+// and with f₀ the fractional part of β and fⱼ that of aⱼ the cut is
+//
+//	Σ_{j integer} min(fⱼ/f₀, (1-fⱼ)/(1-f₀)) tⱼ + Σ_{j continuous} max(aⱼ/f₀, -aⱼ/(1-f₀)) tⱼ ≥ 1.
+//
+// The constraint values rᵢ are treated as continuous.
+func gomoryCuts(p lp.Problem, integer []bool, res *lp.Result, tol float64) []cut {
+	if p.A == nil {
+		return nil
+	}
+	m, n := p.A.Dims()
+	if m == 0 {
+		return nil
+	}
+
+	// Values and bounds of the structural and logical variables.
+	ax := make([]float64, m)
+	mat.NewVecDense(m, ax).MulVec(p.A, mat.NewVecDense(n, res.X))
+	value := append(append([]float64(nil), res.X...), ax...)
+	lo := make([]float64, n+m)
+	hi := make([]float64, n+m)
+	copy(lo, p.Lower)
+	copy(hi, p.Upper)
+	for i := 0; i < m; i++ {
+		rel := lp.Equal
+		if p.Relations != nil {
+			rel = p.Relations[i]
+		}
+		lo[n+i], hi[n+i] = p.B[i], p.B[i]
+		switch rel {
+		case lp.LessEqual:
+			lo[n+i] = math.Inf(-1)
+		case lp.GreaterEqual:
+			hi[n+i] = math.Inf(1)
+		}
+	}
+	isBasic := make([]bool, n+m)
+	for _, j := range res.Basis {
+		isBasic[j] = true
+	}
+	column := func(dst []float64, j int) {
+		if j < n {
+			mat.Col(dst, j, p.A)
+			return
+		}
+		for i := range dst {
+			dst[i] = 0
+		}
+		dst[j-n] = -1
+	}
+
+	// Factorize the basis matrix.
+	bmat := mat.NewDense(m, m, nil)
+	col := make([]float64, m)
+	for r, j := range res.Basis {
+		column(col, j)
+		bmat.SetCol(r, col)
+	}
+	var lu mat.LU
+	lu.Factorize(bmat)
+	if lu.Cond() > 1/tol {
+		return nil
+	}
+
+	var cuts []cut
+	rho := make([]float64, m)
+	alpha := make([]float64, n+m)
+	coef := make([]float64, n+m)
+	rhoVec := mat.NewVecDense(m, rho)
+	for r, p0 := range res.Basis {
+		if p0 >= n || !integer[p0] {
+			continue
+		}
+		beta := value[p0]
+		f0 := beta - math.Floor(beta)
+		if f0 < minCutFraction || f0 > 1-minCutFraction {
+			continue
+		}
+
+		// Compute the tableau row αᵀ = e_rᵀ B⁻¹ [A -I].
+		for i := range rho {
+			rho[i] = 0
+		}
+		rho[r] = 1
+		if err := lu.SolveVecTo(rhoVec, true, rhoVec); err != nil {
+			continue
+		}
+		mat.NewVecDense(n, alpha[:n]).MulVec(p.A.T(), rhoVec)
+		for i, v := range rho {
+			alpha[n+i] = -v
+		}
+
+		// Compute the cut in the shifted nonbasic variables and
+		// transform it back to the structural variables.
+		valid := true
+		rhs := 1.0
+		for j := range coef {
+			coef[j] = 0
+			if isBasic[j] || lo[j] == hi[j] || alpha[j] == 0 {
+				continue
+			}
+			atLower := !math.IsInf(lo[j], -1) &&
+				(math.IsInf(hi[j], 1) || value[j]-lo[j] <= hi[j]-value[j])
+			atUpper := !atLower && !math.IsInf(hi[j], 1)
+			if !atLower && !atUpper {
+				// A free nonbasic variable cannot be shifted.
+				valid = false
+				break
+			}
+			a := alpha[j]
+			if atUpper {
+				a = -a
+			}
+			var c float64
+			if j < n && integer[j] {
+				fj := a - math.Floor(a)
+				c = math.Min(fj/f0, (1-fj)/(1-f0))
+			} else {
+				c = math.Max(a/f0, -a/(1-f0))
+			}
+			if atLower {
+				coef[j] = c
+				rhs += c * lo[j]
+			} else {
+				coef[j] = -c
+				rhs -= c * hi[j]
+			}
+		}
+		if !valid {
+			continue
+		}
+		g := make([]float64, n)
+		copy(g, coef[:n])
+		for i := 0; i < m; i++ {
+			if c := coef[n+i]; c != 0 {
+				for j := 0; j < n; j++ {
+					g[j] += c * p.A.At(i, j)
+				}
+			}
+		}
+		if ct, ok := safeCut(g, rhs, res.X, lo[:n], hi[:n]); ok {
+			cuts = append(cuts, ct)
+		}
+	}
+	return cuts
+}
+
+// safeCut cleans the coefficients of the cut gᵀx ≥ rhs and returns it if
+// it is numerically safe and violated by x. Tiny coefficients are removed
+// using the bounds lo and hi of the variables.
+func safeCut(g []float64, rhs float64, x, lo, hi []float64) (cut, bool) {
+	var maxAbs float64
+	for _, v := range g {
+		maxAbs = math.Max(maxAbs, math.Abs(v))
+	}
+	if maxAbs == 0 || math.IsNaN(maxAbs) || math.IsInf(maxAbs, 0) || math.IsInf(rhs, 0) {
+		return cut{}, false
+	}
+	minAbs := maxAbs
+	for j, v := range g {
+		if v == 0 {
+			continue
+		}
+		if math.Abs(v) < 1e-12*maxAbs {
+			// Remove the term using the bound that maximizes it.
+			bound := hi[j]
+			if v < 0 {
+				bound = lo[j]
+			}
+			if math.IsInf(bound, 0) {
+				return cut{}, false
+			}
+			rhs -= v * bound
+			g[j] = 0
+			continue
+		}
+		minAbs = math.Min(minAbs, math.Abs(v))
+	}
+	if maxAbs > maxCutDynamism*minAbs {
+		return cut{}, false
+	}
+	// Relax the cut slightly against rounding errors.
+	rhs -= 1e-9 * math.Max(1, math.Abs(rhs))
+	var gx float64
+	for j, v := range g {
+		gx += v * x[j]
+	}
+	if rhs-gx < minCutViolation*math.Max(1, maxAbs) {
+		return cut{}, false
+	}
+	return cut{a: g, b: rhs}, true
+}
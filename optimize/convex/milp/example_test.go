@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package milp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+	"gonum.org/v1/gonum/optimize/convex/milp"
+)
+
+func ExampleSolve() {
+	// Maximize 5x + 4y subject to
+	//  6x + 4y ≤ 24,
+	//   x + 2y ≤ 6,
+	//  x, y ≥ 0 and integer.
+	p := milp.Problem{
+		Problem: lp.Problem{
+			C:         []float64{-5, -4},
+			A:         mat.NewDense(2, 2, []float64{6, 4, 1, 2}),
+			B:         []float64{24, 6},
+			Relations: []lp.Relation{lp.LessEqual, lp.LessEqual},
+		},
+		Integer: []bool{true, true},
+	}
+	res, err := milp.Solve(p, &milp.Settings{GomoryRounds: 2})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("opt: %v\n", -res.F)
+	fmt.Printf("x: %v\n", res.X)
+	fmt.Println("status:", res.Status)
+	// Output:
+	// opt: 20
+	// x: [4 0]
+	// status: Success
+}
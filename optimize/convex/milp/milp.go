@@ -0,0 +1,677 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package milp
+
+import (
+	"container/heap"
+	"math"
+	"sync"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+const (
+	defaultIntegerTolerance = 1e-6
+	defaultAbsoluteGap      = 1e-9
+	defaultRelativeGap      = 1e-6
+
+	// minCutImprovement is the smallest relative improvement of the root
+	// bound for which another round of cuts is generated.
+	minCutImprovement = 1e-4
+)
+
+// Problem is a mixed-integer linear program
+//
+//	minimize   cᵀx
+//	subject to aᵢᵀx ≤ bᵢ, aᵢᵀx = bᵢ or aᵢᵀx ≥ bᵢ, i = 0, ..., m-1,
+//	           l ≤ x ≤ u,
+//	           xⱼ integer if Integer[j] is true.
+type Problem struct {
+	lp.Problem
+
+	// Integer reports whether each variable is restricted to integer
+	// values. Integer must have the same length as C.
+	Integer []bool
+}
+
+// NodeSelection is the rule for choosing the next node of the
+// branch-and-bound tree to be evaluated.
+type NodeSelection int
+
+const (
+	// BestBound chooses the node with the smallest lower bound, which
+	// minimizes the number of evaluated nodes needed to prove optimality.
+	BestBound NodeSelection = iota
+	// DepthFirst chooses the most recently created node, which finds
+	// integer solutions quickly and keeps the number of open nodes small.
+	DepthFirst
+	// Hybrid uses DepthFirst until an integer solution is found and
+	// BestBound afterwards.
+	Hybrid
+)
+
+// Branching is the rule for choosing the variable on which a node is
+// branched.
+type Branching int
+
+const (
+	// MostFractional branches on the variable with the fractional part
+	// closest to one half.
+	MostFractional Branching = iota
+	// FirstFractional branches on the fractional variable with the
+	// smallest index.
+	FirstFractional
+	// PseudoCost branches on the variable with the largest product of the
+	// estimated increases of the objective in the two child nodes. The
+	// estimates are the average increases per unit change of the variable
+	// observed in earlier branchings.
+	PseudoCost
+)
+
+// Settings holds the settings of Solve. See the field comments for default
+// values.
+type Settings struct {
+	// NodeSelection is the rule for choosing the next node.
+	NodeSelection NodeSelection
+	// Branching is the rule for choosing the branching variable.
+	Branching Branching
+
+	// GomoryRounds is the number of rounds of Gomory mixed-integer cuts
+	// added at the root node. If GomoryRounds is zero, no cuts are added.
+	GomoryRounds int
+
+	// IntegerTolerance is the largest distance of an integer variable from
+	// an integer for which it is considered integer. If IntegerTolerance
+	// is zero, a default value of 1e-6 is used.
+	IntegerTolerance float64
+
+	// AbsoluteGap and RelativeGap stop the search when the objective value
+	// F of the best integer solution and the lower bound on the optimal
+	// value satisfy F - bound ≤ max(AbsoluteGap, RelativeGap*|F|). If
+	// they are zero, default values of 1e-9 and 1e-6 are used.
+	AbsoluteGap float64
+	RelativeGap float64
+
+	// NodeLimit is the maximum number of evaluated nodes. IterationLimit
+	// status is returned if the number of nodes equals or exceeds this
+	// value. If it equals zero, this setting has no effect.
+	NodeLimit int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If it
+	// equals zero, this setting has no effect.
+	Runtime time.Duration
+
+	// Concurrent is the number of nodes whose linear programs are solved
+	// concurrently. If Concurrent is zero or one, the nodes are evaluated
+	// serially.
+	Concurrent int
+
+	// Incumbent is called with the solution and the objective value each
+	// time a better integer solution is found. Incumbent must not modify
+	// or retain x.
+	Incumbent func(x []float64, f float64)
+
+	// LP holds the settings of the linear programs solved by
+	// lp.DualSimplex. The InitialBasis field is ignored. If LP is nil, the
+	// default settings are used.
+	LP *lp.Settings
+}
+
+// Result holds the result of Solve.
+type Result struct {
+	// X is the best integer solution found, or nil if no integer
+	// solution was found.
+	X []float64
+	// F is the objective value at X, or +∞ if X is nil.
+	F float64
+	// Bound is a lower bound on the optimal objective value.
+	Bound float64
+
+	// Nodes is the number of evaluated nodes of the search tree.
+	Nodes int
+	// LPIterations is the total number of dual simplex iterations.
+	LPIterations int
+	// Cuts is the number of cuts added at the root node.
+	Cuts int
+	// Runtime is the duration of the run.
+	Runtime time.Duration
+
+	Status optimize.Status
+}
+
+// Solve solves the mixed-integer linear program p by branch and bound.
+//
+// The linear programming relaxation of each node of the search tree is
+// solved by lp.DualSimplex. A node is branched on a variable xⱼ with a
+// fractional value v into the children with xⱼ ≤ ⌊v⌋ and xⱼ ≥ ⌈v⌉, and
+// the children are warm started from the optimal basis of their parent,
+// which stays dual feasible after the change of the bounds. If
+// settings.GomoryRounds is positive, the relaxation at the root node is
+// tightened by Gomory mixed-integer cuts before branching, which are valid
+// for the whole tree.
+//
+// Solve returns lp.ErrInfeasible if p has no integer solution and
+// lp.ErrUnbounded if the relaxation of p is unbounded, in which case p is
+// either unbounded or infeasible. The status of the result is Success if the
+// search was completed. If a limit is reached, Solve returns the best
+// solution found so far with the corresponding status. If settings is nil,
+// the default settings are used.
+//
+// References:
+//   - Wolsey, L. A. (1998). Integer Programming. Wiley.
+//   - Achterberg, T., Koch, T., & Martin, A. (2005). Branching rules
+//     revisited. Operations Research Letters 33(1), 42-54.
+//   - Cornuéjols, G. (2008). Valid inequalities for mixed integer linear
+//     programs. Mathematical Programming 112(1), 3-44.
+func Solve(p Problem, settings *Settings) (*Result, error) {
+	start := time.Now()
+	n := len(p.C)
+	if len(p.Integer) != n {
+		panic("milp: size mismatch")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	s := *settings
+	if s.IntegerTolerance == 0 {
+		s.IntegerTolerance = defaultIntegerTolerance
+	}
+	if s.AbsoluteGap == 0 {
+		s.AbsoluteGap = defaultAbsoluteGap
+	}
+	if s.RelativeGap == 0 {
+		s.RelativeGap = defaultRelativeGap
+	}
+	if s.IntegerTolerance < 0 || s.AbsoluteGap < 0 || s.RelativeGap < 0 || s.GomoryRounds < 0 {
+		panic("milp: negative setting")
+	}
+
+	b := &brancher{
+		settings: s,
+		integer:  p.Integer,
+		prob:     p.Problem,
+		lower:    make([]float64, n),
+		upper:    make([]float64, n),
+		f:        math.Inf(1),
+		bound:    math.Inf(1),
+		pseudo:   make([][2]pseudoCost, n),
+	}
+	if s.LP != nil {
+		b.lpSettings = *s.LP
+	}
+	for j := 0; j < n; j++ {
+		l, u := math.Inf(-1), math.Inf(1)
+		if p.Lower == nil {
+			l = 0
+		} else {
+			l = p.Lower[j]
+		}
+		if p.Upper != nil {
+			u = p.Upper[j]
+		}
+		if p.Integer[j] {
+			l = math.Ceil(l - s.IntegerTolerance)
+			u = math.Floor(u + s.IntegerTolerance)
+		}
+		b.lower[j], b.upper[j] = l, u
+	}
+	result := &Result{F: math.Inf(1)}
+	finish := func(err error) (*Result, error) {
+		result.X = b.x
+		result.F = b.f
+		result.Bound = math.Min(b.bound, b.f)
+		result.Nodes = b.nodes
+		result.LPIterations = b.iterations
+		result.Runtime = time.Since(start)
+		return result, err
+	}
+	for j := 0; j < n; j++ {
+		if b.lower[j] > b.upper[j] {
+			result.Status = optimize.Success
+			return finish(lp.ErrInfeasible)
+		}
+	}
+
+	root, err := b.root()
+	result.Cuts = b.cuts
+	switch err {
+	case nil:
+	case lp.ErrInfeasible, lp.ErrUnbounded:
+		result.Status = optimize.Success
+		return finish(err)
+	default:
+		result.Status = optimize.Failure
+		return finish(err)
+	}
+	b.queue = &nodeQueue{less: b.less}
+	if b.fractional(root.X) < 0 {
+		b.incumbent(root.X)
+	} else {
+		b.branch(&node{lower: b.lower, upper: b.upper, bound: root.F, res: root})
+	}
+
+	concurrent := max(s.Concurrent, 1)
+	var batch []*node
+	for b.queue.Len() > 0 {
+		if s.Runtime > 0 && time.Since(start) >= s.Runtime {
+			result.Status = optimize.RuntimeLimit
+			break
+		}
+		if s.NodeLimit > 0 && b.nodes >= s.NodeLimit {
+			result.Status = optimize.IterationLimit
+			break
+		}
+		batch = batch[:0]
+		for b.queue.Len() > 0 && len(batch) < concurrent {
+			nd := heap.Pop(b.queue).(*node)
+			if b.prune(nd.bound) {
+				continue
+			}
+			if s.NodeLimit > 0 && b.nodes+len(batch) >= s.NodeLimit {
+				heap.Push(b.queue, nd)
+				break
+			}
+			batch = append(batch, nd)
+		}
+		b.evaluate(batch)
+		for _, nd := range batch {
+			if err := b.process(nd); err != nil {
+				b.pushBack(batch)
+				result.Status = optimize.Failure
+				return finish(err)
+			}
+		}
+	}
+	b.pushBack(nil)
+	if result.Status == optimize.NotTerminated {
+		result.Status = optimize.Success
+	}
+	if b.x == nil && result.Status == optimize.Success {
+		return finish(lp.ErrInfeasible)
+	}
+	return finish(nil)
+}
+
+// pseudoCost holds the observed objective increases per unit change of a
+// variable in the down or up direction.
+type pseudoCost struct {
+	sum   float64
+	count int
+}
+
+// brancher holds the state of the branch-and-bound search.
+type brancher struct {
+	settings   Settings
+	lpSettings lp.Settings
+	integer    []bool
+	prob       lp.Problem // Problem with the root cuts.
+	lower      []float64
+	upper      []float64
+
+	queue *nodeQueue
+	seq   int
+
+	x     []float64 // Incumbent.
+	f     float64   // Objective value of the incumbent.
+	bound float64   // Smallest bound of the nodes pruned by the gap.
+
+	pseudo     [][2]pseudoCost
+	nodes      int
+	iterations int
+	cuts       int
+}
+
+// node is a node of the search tree.
+type node struct {
+	lower, upper []float64
+	basis        []int
+	bound        float64 // Lower bound of the objective at the node.
+	depth        int
+	seq          int
+
+	// Branching that created the node.
+	branchVar int
+	up        bool
+	frac      float64
+
+	res *lp.Result
+	err error
+}
+
+// less reports whether the node a is to be evaluated before b.
+func (b *brancher) less(a, c *node) bool {
+	sel := b.settings.NodeSelection
+	if sel == Hybrid {
+		sel = DepthFirst
+		if b.x != nil {
+			sel = BestBound
+		}
+	}
+	switch sel {
+	case BestBound:
+		if a.bound != c.bound {
+			return a.bound < c.bound
+		}
+	case DepthFirst:
+	default:
+		panic("milp: invalid node selection")
+	}
+	return a.seq > c.seq
+}
+
+// prune returns whether a node with the given lower bound cannot improve
+// the incumbent by more than the gap tolerance, and records the bound of the
+// pruned node.
+func (b *brancher) prune(bound float64) bool {
+	if b.x == nil {
+		return false
+	}
+	gap := math.Max(b.settings.AbsoluteGap, b.settings.RelativeGap*math.Abs(b.f))
+	if bound < b.f-gap {
+		return false
+	}
+	b.bound = math.Min(b.bound, bound)
+	return true
+}
+
+// pushBack records the bounds of the open nodes and of the nodes in batch
+// that were not processed when the search ended.
+func (b *brancher) pushBack(batch []*node) {
+	for _, nd := range batch {
+		b.bound = math.Min(b.bound, nd.bound)
+	}
+	for _, nd := range b.queue.nodes {
+		b.bound = math.Min(b.bound, nd.bound)
+	}
+}
+
+// problem returns the linear program of the node with the given bounds.
+func (b *brancher) problem(lower, upper []float64) lp.Problem {
+	p := b.prob
+	p.Lower = lower
+	p.Upper = upper
+	return p
+}
+
+// solve solves the linear program with the given bounds warm started from
+// basis.
+func (b *brancher) solve(lower, upper []float64, basis []int) (*lp.Result, error) {
+	settings := b.lpSettings
+	settings.InitialBasis = basis
+	return lp.DualSimplex(b.problem(lower, upper), &settings)
+}
+
+// root solves the relaxation at the root node and tightens it by cuts if
+// requested.
+func (b *brancher) root() (*lp.Result, error) {
+	res, err := b.solve(b.lower, b.upper, nil)
+	b.nodes++
+	b.iterations += res.Iterations
+	if err != nil {
+		return nil, err
+	}
+	for round := 0; round < b.settings.GomoryRounds; round++ {
+		if b.fractional(res.X) < 0 {
+			break
+		}
+		cuts := gomoryCuts(b.problem(b.lower, b.upper), b.integer, res, b.settings.IntegerTolerance)
+		if len(cuts) == 0 {
+			break
+		}
+		basis := b.addCuts(cuts, res.Basis)
+		prev := res.F
+		res, err = b.solve(b.lower, b.upper, basis)
+		b.iterations += res.Iterations
+		if err != nil {
+			return nil, err
+		}
+		if res.F-prev <= minCutImprovement*math.Max(1, math.Abs(prev)) {
+			break
+		}
+	}
+	return res, nil
+}
+
+// addCuts appends the cuts to the constraints and returns the basis extended
+// by the logical variables of the cuts.
+func (b *brancher) addCuts(cuts []cut, basis []int) []int {
+	n := len(b.prob.C)
+	m := len(b.prob.B)
+	a := mat.NewDense(m+len(cuts), n, nil)
+	if m > 0 {
+		a.Slice(0, m, 0, n).(*mat.Dense).Copy(b.prob.A)
+	}
+	rhs := make([]float64, m, m+len(cuts))
+	copy(rhs, b.prob.B)
+	rel := make([]lp.Relation, m, m+len(cuts))
+	for i := range rel {
+		rel[i] = lp.Equal
+		if b.prob.Relations != nil {
+			rel[i] = b.prob.Relations[i]
+		}
+	}
+	basis = append(basis[:m:m], make([]int, 0, len(cuts))...)
+	for k, c := range cuts {
+		a.SetRow(m+k, c.a)
+		rhs = append(rhs, c.b)
+		rel = append(rel, lp.GreaterEqual)
+		basis = append(basis, n+m+k)
+	}
+	b.prob.A = a
+	b.prob.B = rhs
+	b.prob.Relations = rel
+	b.cuts += len(cuts)
+	return basis
+}
+
+// evaluate solves the linear programs of the nodes in batch.
+func (b *brancher) evaluate(batch []*node) {
+	if len(batch) == 1 {
+		nd := batch[0]
+		nd.res, nd.err = b.solve(nd.lower, nd.upper, nd.basis)
+		return
+	}
+	var wg sync.WaitGroup
+	for _, nd := range batch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nd.res, nd.err = b.solve(nd.lower, nd.upper, nd.basis)
+		}()
+	}
+	wg.Wait()
+}
+
+// process updates the search with the solution of the linear program of the
+// node and branches the node if needed.
+func (b *brancher) process(nd *node) error {
+	b.nodes++
+	b.iterations += nd.res.Iterations
+	switch nd.err {
+	case nil:
+	case lp.ErrInfeasible:
+		return nil
+	default:
+		return nd.err
+	}
+	res := nd.res
+	if nd.branchVar >= 0 {
+		dist := nd.frac
+		if nd.up {
+			dist = 1 - nd.frac
+		}
+		pc := &b.pseudo[nd.branchVar][boolIndex(nd.up)]
+		pc.sum += math.Max(res.F-nd.bound, 0) / dist
+		pc.count++
+	}
+	if b.prune(res.F) {
+		return nil
+	}
+	if b.fractional(res.X) < 0 {
+		b.incumbent(res.X)
+		return nil
+	}
+	nd.bound = res.F
+	b.branch(nd)
+	return nil
+}
+
+// incumbent records x as the incumbent if it is better than the current one.
+func (b *brancher) incumbent(x []float64) {
+	x = append([]float64(nil), x...)
+	for j, isInt := range b.integer {
+		if isInt {
+			x[j] = math.Round(x[j])
+		}
+	}
+	f := floats.Dot(b.prob.C, x)
+	if f >= b.f {
+		return
+	}
+	b.x, b.f = x, f
+	if b.settings.NodeSelection == Hybrid {
+		// The order of the nodes changes with the first incumbent.
+		heap.Init(b.queue)
+	}
+	if b.settings.Incumbent != nil {
+		b.settings.Incumbent(x, f)
+	}
+}
+
+// fractional returns the index of the branching variable for x chosen by
+// the branching rule, or -1 if x is integer.
+func (b *brancher) fractional(x []float64) int {
+	tol := b.settings.IntegerTolerance
+	best := -1
+	var bestScore float64
+	var avg [2]float64
+	if b.settings.Branching == PseudoCost {
+		avg = b.averagePseudoCost()
+	}
+	for j, isInt := range b.integer {
+		if !isInt {
+			continue
+		}
+		f := x[j] - math.Floor(x[j])
+		if f <= tol || f >= 1-tol {
+			continue
+		}
+		var score float64
+		switch b.settings.Branching {
+		case MostFractional:
+			score = math.Min(f, 1-f)
+		case FirstFractional:
+			return j
+		case PseudoCost:
+			down := b.pseudoEstimate(j, false, avg) * f
+			up := b.pseudoEstimate(j, true, avg) * (1 - f)
+			const eps = 1e-6
+			score = math.Max(down, eps) * math.Max(up, eps)
+		default:
+			panic("milp: invalid branching rule")
+		}
+		if best < 0 || score > bestScore {
+			best, bestScore = j, score
+		}
+	}
+	return best
+}
+
+// averagePseudoCost returns the average pseudo-costs over all variables
+// in the down and up direction, or one if no pseudo-costs were observed.
+func (b *brancher) averagePseudoCost() [2]float64 {
+	var avg [2]float64
+	for dir := range avg {
+		var sum float64
+		var count int
+		for _, pc := range b.pseudo {
+			if pc[dir].count > 0 {
+				sum += pc[dir].sum / float64(pc[dir].count)
+				count++
+			}
+		}
+		avg[dir] = 1
+		if count > 0 {
+			avg[dir] = sum / float64(count)
+		}
+	}
+	return avg
+}
+
+// pseudoEstimate returns the pseudo-cost of variable j in the given
+// direction, using avg for variables without observations.
+func (b *brancher) pseudoEstimate(j int, up bool, avg [2]float64) float64 {
+	pc := b.pseudo[j][boolIndex(up)]
+	if pc.count == 0 {
+		return avg[boolIndex(up)]
+	}
+	return pc.sum / float64(pc.count)
+}
+
+// branch creates the children of nd.
+func (b *brancher) branch(nd *node) {
+	res := nd.res
+	j := b.fractional(res.X)
+	v := res.X[j]
+	f := v - math.Floor(v)
+
+	downUpper := append([]float64(nil), nd.upper...)
+	downUpper[j] = math.Floor(v)
+	down := &node{
+		lower: nd.lower, upper: downUpper,
+		branchVar: j, frac: f,
+	}
+	upLower := append([]float64(nil), nd.lower...)
+	upLower[j] = math.Ceil(v)
+	up := &node{
+		lower: upLower, upper: nd.upper,
+		branchVar: j, up: true, frac: f,
+	}
+	// The child in the direction of the nearest integer is created last
+	// so that it is evaluated first by depth-first search.
+	children := [2]*node{up, down}
+	if f > 0.5 {
+		children = [2]*node{down, up}
+	}
+	for _, c := range children {
+		c.basis = res.Basis
+		c.bound = res.F
+		c.depth = nd.depth + 1
+		b.seq++
+		c.seq = b.seq
+		heap.Push(b.queue, c)
+	}
+	nd.res = nil
+}
+
+func boolIndex(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// nodeQueue is a priority queue of nodes.
+type nodeQueue struct {
+	nodes []*node
+	less  func(a, b *node) bool
+}
+
+func (q *nodeQueue) Len() int           { return len(q.nodes) }
+func (q *nodeQueue) Less(i, j int) bool { return q.less(q.nodes[i], q.nodes[j]) }
+func (q *nodeQueue) Swap(i, j int)      { q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i] }
+func (q *nodeQueue) Push(x any)         { q.nodes = append(q.nodes, x.(*node)) }
+func (q *nodeQueue) Pop() any {
+	n := len(q.nodes)
+	nd := q.nodes[n-1]
+	q.nodes[n-1] = nil
+	q.nodes = q.nodes[:n-1]
+	return nd
+}
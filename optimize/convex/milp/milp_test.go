@@ -0,0 +1,324 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package milp
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+var settingsTests = []struct {
+	name     string
+	settings *Settings
+}{
+	{name: "default", settings: nil},
+	{name: "DepthFirst", settings: &Settings{NodeSelection: DepthFirst}},
+	{name: "Hybrid", settings: &Settings{NodeSelection: Hybrid}},
+	{name: "FirstFractional", settings: &Settings{Branching: FirstFractional}},
+	{name: "PseudoCost", settings: &Settings{Branching: PseudoCost}},
+	{name: "Gomory", settings: &Settings{GomoryRounds: 5}},
+	{name: "Concurrent", settings: &Settings{Concurrent: 4, Branching: PseudoCost}},
+}
+
+// randomMILP returns a random mixed-integer program with bounded integer
+// variables and continuous variables in [-2, 2].
+func randomMILP(rnd *rand.Rand, n, m int) Problem {
+	p := Problem{
+		Problem: lp.Problem{
+			C:         make([]float64, n),
+			A:         mat.NewDense(m, n, nil),
+			B:         make([]float64, m),
+			Relations: make([]lp.Relation, m),
+			Lower:     make([]float64, n),
+			Upper:     make([]float64, n),
+		},
+		Integer: make([]bool, n),
+	}
+	for j := 0; j < n; j++ {
+		p.C[j] = rnd.NormFloat64()
+		p.Integer[j] = rnd.IntN(4) != 0
+		if p.Integer[j] {
+			p.Lower[j] = float64(-rnd.IntN(3))
+			p.Upper[j] = p.Lower[j] + float64(rnd.IntN(4)+1)
+		} else {
+			p.Lower[j], p.Upper[j] = -2, 2
+		}
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			p.A.(*mat.Dense).Set(i, j, math.Round(4*rnd.NormFloat64())/2)
+		}
+		p.B[i] = 2 * rnd.NormFloat64()
+		p.Relations[i] = lp.Relation(rnd.IntN(3))
+		if p.Relations[i] == lp.Equal && rnd.IntN(2) == 0 {
+			p.Relations[i] = lp.LessEqual
+		}
+	}
+	return p
+}
+
+// bruteForce solves p by enumerating the integer variables and solving the
+// linear program in the continuous variables.
+func bruteForce(p Problem) (float64, error) {
+	n := len(p.C)
+	best := math.Inf(1)
+	lower := make([]float64, n)
+	upper := make([]float64, n)
+	var enumerate func(j int)
+	enumerate = func(j int) {
+		if j == n {
+			q := p.Problem
+			q.Lower, q.Upper = lower, upper
+			res, err := lp.DualSimplex(q, nil)
+			if err == nil {
+				best = math.Min(best, res.F)
+			}
+			return
+		}
+		if !p.Integer[j] {
+			lower[j], upper[j] = p.Lower[j], p.Upper[j]
+			enumerate(j + 1)
+			return
+		}
+		for v := p.Lower[j]; v <= p.Upper[j]; v++ {
+			lower[j], upper[j] = v, v
+			enumerate(j + 1)
+		}
+	}
+	enumerate(0)
+	if math.IsInf(best, 1) {
+		return best, lp.ErrInfeasible
+	}
+	return best, nil
+}
+
+func TestSolveRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for k := 0; k < 100; k++ {
+		p := randomMILP(rnd, rnd.IntN(5)+2, rnd.IntN(4)+1)
+		want, wantErr := bruteForce(p)
+		for _, test := range settingsTests {
+			name := fmt.Sprintf("%s (case %d)", test.name, k)
+			res, err := Solve(p, test.settings)
+			if err != wantErr {
+				t.Errorf("%s: unexpected error: got %v, want %v", name, err, wantErr)
+				continue
+			}
+			if res.Status != optimize.Success {
+				t.Errorf("%s: unexpected status: %v", name, res.Status)
+			}
+			if err != nil {
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(res.F, want, 1e-6, 1e-6) {
+				t.Errorf("%s: unexpected optimal value: got %v, want %v", name, res.F, want)
+			}
+			if res.Bound > res.F+1e-9 || res.F-res.Bound > 1e-6*math.Max(1, math.Abs(res.F)) {
+				t.Errorf("%s: unexpected bound %v for optimal value %v", name, res.Bound, res.F)
+			}
+			checkFeasible(t, name, p, res.X)
+		}
+	}
+}
+
+func checkFeasible(t *testing.T, name string, p Problem, x []float64) {
+	t.Helper()
+	const tol = 1e-6
+	for j, v := range x {
+		if v < p.Lower[j]-tol || v > p.Upper[j]+tol {
+			t.Errorf("%s: bound %d violated: %v", name, j, v)
+		}
+		if p.Integer[j] && v != math.Round(v) {
+			t.Errorf("%s: variable %d not integer: %v", name, j, v)
+		}
+	}
+	m, n := p.A.Dims()
+	ax := make([]float64, m)
+	mat.NewVecDense(m, ax).MulVec(p.A, mat.NewVecDense(n, x))
+	for i, v := range ax {
+		var ok bool
+		switch p.Relations[i] {
+		case lp.Equal:
+			ok = math.Abs(v-p.B[i]) <= tol
+		case lp.LessEqual:
+			ok = v <= p.B[i]+tol
+		case lp.GreaterEqual:
+			ok = v >= p.B[i]-tol
+		}
+		if !ok {
+			t.Errorf("%s: constraint %d violated", name, i)
+		}
+	}
+}
+
+// knapsack returns a 0-1 knapsack problem written as a minimization.
+func knapsack() Problem {
+	values := []float64{15, 10, 9, 5, 8, 12, 7, 14, 6, 11}
+	weights := []float64{1, 5, 3, 4, 2, 6, 3, 7, 2, 5}
+	n := len(values)
+	p := Problem{
+		Problem: lp.Problem{
+			C:         make([]float64, n),
+			A:         mat.NewDense(1, n, weights),
+			B:         []float64{15},
+			Relations: []lp.Relation{lp.LessEqual},
+			Upper:     make([]float64, n),
+		},
+		Integer: make([]bool, n),
+	}
+	for j := range values {
+		p.C[j] = -values[j]
+		p.Upper[j] = 1
+		p.Integer[j] = true
+	}
+	return p
+}
+
+func TestSolveKnapsack(t *testing.T) {
+	t.Parallel()
+	// The optimal value was found by enumerating all subsets.
+	const want = -52
+	for _, test := range settingsTests {
+		var incumbents []float64
+		s := Settings{}
+		if test.settings != nil {
+			s = *test.settings
+		}
+		s.Incumbent = func(x []float64, f float64) {
+			incumbents = append(incumbents, f)
+		}
+		res, err := Solve(knapsack(), &s)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if res.F != want {
+			t.Errorf("%s: unexpected optimal value: got %v, want %v", test.name, res.F, want)
+		}
+		if len(incumbents) == 0 || incumbents[len(incumbents)-1] != res.F {
+			t.Errorf("%s: incumbent callback not called with the solution: %v", test.name, incumbents)
+		}
+		for i := 1; i < len(incumbents); i++ {
+			if incumbents[i] >= incumbents[i-1] {
+				t.Errorf("%s: incumbents not improving: %v", test.name, incumbents)
+				break
+			}
+		}
+	}
+}
+
+func TestSolveInfeasible(t *testing.T) {
+	t.Parallel()
+	// 2x - 2y = 1 has no integer solution although its relaxation is
+	// feasible.
+	p := Problem{
+		Problem: lp.Problem{
+			C:     []float64{1, 1},
+			A:     mat.NewDense(1, 2, []float64{2, -2}),
+			B:     []float64{1},
+			Upper: []float64{10, 10},
+		},
+		Integer: []bool{true, true},
+	}
+	for _, test := range settingsTests {
+		res, err := Solve(p, test.settings)
+		if err != lp.ErrInfeasible {
+			t.Errorf("%s: unexpected error: got %v, want %v", test.name, err, lp.ErrInfeasible)
+		}
+		if res.X != nil {
+			t.Errorf("%s: unexpected solution %v", test.name, res.X)
+		}
+	}
+
+	// Unbounded relaxation.
+	p.C = []float64{-1, 0}
+	p.Upper = nil
+	p.B = []float64{0}
+	_, err := Solve(p, nil)
+	if err != lp.ErrUnbounded {
+		t.Errorf("unexpected error for unbounded problem: got %v, want %v", err, lp.ErrUnbounded)
+	}
+}
+
+func TestSolveLimits(t *testing.T) {
+	t.Parallel()
+	p := knapsack()
+	res, err := Solve(p, &Settings{NodeLimit: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Status != optimize.IterationLimit {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, optimize.IterationLimit)
+	}
+	if res.Nodes > 3 {
+		t.Errorf("node limit exceeded: %d nodes", res.Nodes)
+	}
+	full, err := Solve(p, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Bound > full.F {
+		t.Errorf("bound %v exceeds optimal value %v", res.Bound, full.F)
+	}
+
+	// A large relative gap accepts the first solution within it.
+	res, err = Solve(p, &Settings{RelativeGap: 0.5, NodeSelection: DepthFirst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.F-res.Bound > 0.5*math.Abs(res.F) || res.Bound > full.F {
+		t.Errorf("gap not respected: F = %v, bound = %v, optimum %v", res.F, res.Bound, full.F)
+	}
+	if res.Nodes > full.Nodes {
+		t.Errorf("more nodes with a larger gap: %d > %d", res.Nodes, full.Nodes)
+	}
+}
+
+func TestGomoryCuts(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	var improved int
+	for k := 0; k < 200; k++ {
+		p := randomMILP(rnd, rnd.IntN(5)+2, rnd.IntN(4)+1)
+		root, err := lp.DualSimplex(p.Problem, nil)
+		if err != nil {
+			continue
+		}
+		want, err := bruteForce(p)
+		cuts := gomoryCuts(p.Problem, p.Integer, root, defaultIntegerTolerance)
+		for _, c := range cuts {
+			if floats.Dot(c.a, root.X) >= c.b {
+				t.Errorf("case %d: cut not violated by the relaxed solution", k)
+			}
+		}
+		if err != nil {
+			continue
+		}
+		// The cuts must not cut off the integer optimum.
+		res, err := Solve(p, &Settings{GomoryRounds: 1})
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", k, err)
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(res.F, want, 1e-6, 1e-6) {
+			t.Errorf("case %d: unexpected optimal value with cuts: got %v, want %v", k, res.F, want)
+		}
+		if res.Cuts > 0 {
+			improved++
+		}
+	}
+	if improved == 0 {
+		t.Error("no cuts generated")
+	}
+}
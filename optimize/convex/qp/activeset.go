@@ -0,0 +1,562 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+// ActiveSet solves the convex quadratic program p by the dual active-set
+// method of Goldfarb and Idnani. The method is suited to small dense
+// problems and computes accurate solutions and multipliers.
+//
+// The dual method starts from the unconstrained minimum and adds violated
+// constraints, including the bounds, to the active set one at a time, so it
+// does not need a feasible starting point. If Q is singular, the problem is
+// solved by a sequence of strictly convex proximal-point subproblems
+//
+//	minimize ½ xᵀQx + cᵀx + ½ρ‖x - xₖ‖²,
+//
+// each started with the active set of the previous one, after the
+// feasibility of the constraints and the recession directions of the problem
+// have been checked with lp.DualSimplex.
+//
+// If the problem has no solution, ActiveSet returns ErrInfeasible or
+// ErrUnbounded, and if Q is not positive semidefinite, it returns
+// ErrNotConvex. If the iteration limit is reached, the current iterate is
+// returned along with ErrIterationLimit.
+//
+// References:
+//   - Goldfarb, D., & Idnani, A. (1983). A numerically stable dual method for
+//     solving strictly convex quadratic programs. Mathematical Programming,
+//     27(1), 1-33.
+//   - Rockafellar, R. T. (1976). Monotone operators and the proximal point
+//     algorithm. SIAM Journal on Control and Optimization, 14(5), 877-898.
+func ActiveSet(p Problem, settings *Settings) (*Result, error) {
+	m, n := problemDims(p)
+	tol := 1e-9
+	var maxIter int
+	var warm *Result
+	if settings != nil {
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		maxIter = settings.MaxIterations
+		warm = settings.WarmStart
+	}
+	as := newActiveSet(p, m, n, tol)
+	if maxIter == 0 {
+		maxIter = 100 * (len(as.cons) + n)
+	}
+	as.maxIter = maxIter
+	if warm != nil {
+		as.preferWarm(warm, m, n)
+	}
+
+	q := denseQ(p, n)
+	var err error
+	if as.factorize(q) && as.chol.Cond() < maxCond {
+		err = as.solve(p.C)
+	} else {
+		err = as.proximal(p, q, warm)
+	}
+
+	res := &Result{
+		X:          as.x,
+		Iterations: as.iter,
+	}
+	switch err {
+	case nil, ErrIterationLimit:
+		res.F = objective(p, as.x)
+		res.Dual = make([]float64, m)
+		res.ReducedCost = make([]float64, n)
+		for k, c := range as.cons {
+			v := c.sign * as.lambda[k]
+			if c.row >= 0 {
+				res.Dual[c.row] += v
+			} else {
+				res.ReducedCost[c.col] += v
+			}
+		}
+	}
+	return res, err
+}
+
+// maxCond is the largest condition number of Q for which the problem is
+// solved directly instead of by proximal-point iterations.
+const maxCond = 1e12
+
+// proxScale is the weight of the proximal term relative to the largest
+// eigenvalue of Q.
+const proxScale = 1e-6
+
+// constraint is a constraint aᵀx = b or aᵀx ≥ b of the active-set method.
+// It is the row-th general constraint or a bound of the col-th variable,
+// multiplied by sign.
+type constraint struct {
+	a     []float64
+	b     float64
+	eq    bool
+	norm  float64
+	row   int
+	col   int
+	sign  float64
+	prior bool // Whether the constraint is added before the others.
+}
+
+// activeSet is the dual active-set method of Goldfarb and Idnani for the
+// problem
+//
+//	minimize   ½ xᵀGx + gᵀx
+//	subject to aₖᵀx = bₖ or aₖᵀx ≥ bₖ for all constraints k,
+//
+// where G is positive definite. At the solution
+//
+//	Gx + g = Σ λₖ aₖ,
+//
+// with λₖ ≥ 0 for the inequality constraints.
+type activeSet struct {
+	n    int
+	cons []constraint
+	chol mat.Cholesky
+	l    mat.TriDense // Cholesky factor of G.
+	tol  float64
+
+	x      []float64
+	lambda []float64
+
+	active   []int     // Indices of the active constraints.
+	u        []float64 // Multipliers of the active constraints.
+	isActive []bool
+
+	iter    int
+	maxIter int
+}
+
+func newActiveSet(p Problem, m, n int, tol float64) *activeSet {
+	as := &activeSet{
+		n:   n,
+		tol: tol,
+		x:   make([]float64, n),
+	}
+	add := func(a []float64, b float64, eq bool, row, col int, sign float64) {
+		as.cons = append(as.cons, constraint{
+			a:    a,
+			b:    b,
+			eq:   eq,
+			norm: floats.Norm(a, 2),
+			row:  row,
+			col:  col,
+			sign: sign,
+		})
+	}
+	for i := 0; i < m; i++ {
+		a := make([]float64, n)
+		for j := range a {
+			a[j] = p.A.At(i, j)
+		}
+		lo, hi := rowBounds(p, i)
+		switch {
+		case lo == hi:
+			add(a, lo, true, i, -1, 1)
+		case !math.IsInf(lo, -1):
+			add(a, lo, false, i, -1, 1)
+		default:
+			floats.Scale(-1, a)
+			add(a, -hi, false, i, -1, -1)
+		}
+	}
+	for j := 0; j < n; j++ {
+		l, u := lower(p, j), upper(p, j)
+		if l == u {
+			a := make([]float64, n)
+			a[j] = 1
+			add(a, l, true, -1, j, 1)
+			continue
+		}
+		if !math.IsInf(l, -1) {
+			a := make([]float64, n)
+			a[j] = 1
+			add(a, l, false, -1, j, 1)
+		}
+		if !math.IsInf(u, 1) {
+			a := make([]float64, n)
+			a[j] = -1
+			add(a, -u, false, -1, j, -1)
+		}
+	}
+	as.lambda = make([]float64, len(as.cons))
+	as.isActive = make([]bool, len(as.cons))
+	return as
+}
+
+// preferWarm marks the constraints with nonzero multipliers in warm so that
+// they are added to the active set first.
+func (as *activeSet) preferWarm(warm *Result, m, n int) {
+	if len(warm.Dual) != m || len(warm.ReducedCost) != n {
+		panic(badShape)
+	}
+	for k := range as.cons {
+		c := &as.cons[k]
+		var v float64
+		if c.row >= 0 {
+			v = warm.Dual[c.row]
+		} else {
+			v = warm.ReducedCost[c.col]
+		}
+		c.prior = c.eq || c.sign*v > 0
+	}
+}
+
+// proximal solves the problem with a positive semidefinite singular q by
+// proximal-point iterations.
+func (as *activeSet) proximal(p Problem, q *mat.SymDense, warm *Result) error {
+	var eig mat.EigenSym
+	if !eig.Factorize(q, true) {
+		return ErrNotConvex
+	}
+	vals := eig.Values(nil)
+	lmax := math.Max(math.Abs(vals[0]), math.Abs(vals[len(vals)-1]))
+	if vals[0] < -1e-10*math.Max(1, lmax) {
+		return ErrNotConvex
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+	// Check the constraints and the recession directions, since the
+	// proximal-point iterations do not terminate if the problem has no
+	// solution.
+	feas := p.Problem
+	feas.C = make([]float64, as.n)
+	res, err := lp.DualSimplex(feas, nil)
+	if err != nil {
+		if err == lp.ErrInfeasible {
+			return ErrInfeasible
+		}
+		return err
+	}
+	if as.unbounded(p, vals, &vecs, lmax) {
+		copy(as.x, res.X)
+		return ErrUnbounded
+	}
+
+	rho := proxScale * math.Max(1, lmax)
+	g := mat.NewSymDense(as.n, nil)
+	g.CopySym(q)
+	for j := 0; j < as.n; j++ {
+		g.SetSym(j, j, g.At(j, j)+rho)
+	}
+	if !as.factorize(g) {
+		return ErrNotConvex
+	}
+	center := make([]float64, as.n)
+	if warm != nil {
+		if len(warm.X) != as.n {
+			panic(badShape)
+		}
+		copy(center, warm.X)
+	}
+	lin := make([]float64, as.n)
+	for {
+		if as.iter >= as.maxIter {
+			return ErrIterationLimit
+		}
+		as.iter++
+		floats.AddScaledTo(lin, p.C, -rho, center)
+		err := as.solve(lin)
+		if err != nil {
+			return err
+		}
+		if floats.Distance(as.x, center, math.Inf(1)) <= as.tol*(1+floats.Norm(as.x, math.Inf(1))) {
+			return nil
+		}
+		copy(center, as.x)
+		// Start the next subproblem with the current active set.
+		for k := range as.cons {
+			as.cons[k].prior = as.cons[k].eq || as.lambda[k] != 0
+		}
+	}
+}
+
+// unbounded returns whether the problem has a recession direction d in the
+// null space of Q with cᵀd < 0. The null space is spanned by the eigenvectors
+// in vecs with small eigenvalues vals.
+func (as *activeSet) unbounded(p Problem, vals []float64, vecs *mat.Dense, lmax float64) bool {
+	var null []int
+	for k, v := range vals {
+		if v <= 1e-10*math.Max(1, lmax) {
+			null = append(null, k)
+		}
+	}
+	if len(null) == 0 {
+		return false
+	}
+	// Find the recession direction d = V w, |w| ≤ 1, with the most negative
+	// cᵀd.
+	nn := len(null)
+	c := make([]float64, nn)
+	lower := make([]float64, nn)
+	upper := make([]float64, nn)
+	for k, col := range null {
+		c[k] = floats.Dot(p.C, mat.Col(nil, col, vecs))
+		lower[k], upper[k] = -1, 1
+	}
+	var rows [][]float64
+	var rel []lp.Relation
+	for _, con := range as.cons {
+		a := make([]float64, nn)
+		for k, col := range null {
+			a[k] = floats.Dot(con.a, mat.Col(nil, col, vecs))
+		}
+		rows = append(rows, a)
+		if con.eq {
+			rel = append(rel, lp.Equal)
+		} else {
+			rel = append(rel, lp.GreaterEqual)
+		}
+	}
+	prob := lp.Problem{
+		C:         c,
+		B:         make([]float64, len(rows)),
+		Relations: rel,
+		Lower:     lower,
+		Upper:     upper,
+	}
+	if len(rows) > 0 {
+		a := mat.NewDense(len(rows), nn, nil)
+		for i, r := range rows {
+			a.SetRow(i, r)
+		}
+		prob.A = a
+	}
+	res, err := lp.DualSimplex(prob, nil)
+	if err != nil {
+		// The zero direction is feasible, so the recession problem always
+		// has a solution unless it failed numerically.
+		return false
+	}
+	return res.F < -math.Sqrt(as.tol)*math.Max(1, floats.Norm(p.C, math.Inf(1)))
+}
+
+// factorize computes the Cholesky factorization of g and returns whether g
+// is positive definite.
+func (as *activeSet) factorize(g *mat.SymDense) bool {
+	if !as.chol.Factorize(g) {
+		return false
+	}
+	as.chol.LTo(&as.l)
+	return true
+}
+
+// solve minimizes ½ xᵀGx + gᵀx subject to the constraints, where G has been
+// factorized into as.chol. Constraints marked as prior are added before the
+// others.
+func (as *activeSet) solve(g []float64) error {
+	n := as.n
+	xv := mat.NewVecDense(n, as.x)
+	err := as.chol.SolveVecTo(xv, mat.NewVecDense(n, g))
+	if err != nil {
+		return ErrNotConvex
+	}
+	floats.Scale(-1, as.x)
+	as.active = as.active[:0]
+	as.u = as.u[:0]
+	for k := range as.lambda {
+		as.lambda[k] = 0
+		as.isActive[k] = false
+	}
+	if len(as.cons) == 0 {
+		return nil
+	}
+
+	np := make([]float64, n)
+	z := make([]float64, n)
+	ginvNp := make([]float64, n)
+	for {
+		k, sign := as.violated()
+		if k < 0 {
+			break
+		}
+		if as.iter >= as.maxIter {
+			return ErrIterationLimit
+		}
+		c := as.cons[k]
+		floats.ScaleTo(np, sign, c.a)
+		bp := sign * c.b
+		uplus := append(as.u, 0)
+		for {
+			as.iter++
+			// Compute the step direction z in the primal space and the
+			// negative step direction r in the dual space.
+			r, err := as.directions(z, ginvNp, np)
+			if err != nil {
+				return err
+			}
+
+			// Find the largest step that keeps the multipliers of the
+			// active inequality constraints non-negative.
+			t1 := math.Inf(1)
+			l := -1
+			for j, a := range as.active {
+				if as.cons[a].eq || r[j] <= 0 {
+					continue
+				}
+				if t := uplus[j] / r[j]; t < t1 {
+					t1 = t
+					l = j
+				}
+			}
+			// Find the step that satisfies the constraint k.
+			t2 := math.Inf(1)
+			if floats.Norm(z, math.Inf(1)) > 1e-12*floats.Norm(ginvNp, math.Inf(1)) {
+				t2 = -(floats.Dot(np, as.x) - bp) / floats.Dot(z, np)
+			}
+			if math.IsInf(t1, 1) && math.IsInf(t2, 1) {
+				return ErrInfeasible
+			}
+			t := math.Min(t1, t2)
+			if !math.IsInf(t2, 1) {
+				floats.AddScaled(as.x, t, z)
+			}
+			for j := range r {
+				uplus[j] -= t * r[j]
+			}
+			uplus[len(uplus)-1] += t
+			if t2 <= t1 {
+				// Full step, add the constraint to the active set.
+				as.active = append(as.active, k)
+				as.isActive[k] = true
+				as.u = uplus
+				if sign < 0 {
+					// An equality constraint violated from above is
+					// added with the opposite orientation.
+					as.cons[k].sign, as.cons[k].a, as.cons[k].b = -c.sign, np, bp
+					np = make([]float64, n)
+				}
+				break
+			}
+			if as.iter >= as.maxIter {
+				return ErrIterationLimit
+			}
+			// Partial step, drop the blocking constraint.
+			as.isActive[as.active[l]] = false
+			as.active = append(as.active[:l], as.active[l+1:]...)
+			uplus = append(uplus[:l], uplus[l+1:]...)
+			as.u = uplus[:len(uplus)-1]
+		}
+	}
+	for j, k := range as.active {
+		as.lambda[k] = as.u[j]
+	}
+	return nil
+}
+
+// violated returns the next constraint to be added to the active set and
+// its orientation, or -1 if all constraints are satisfied. Equality
+// constraints are chosen first, then the prior constraints and then the
+// most violated inequality constraint.
+func (as *activeSet) violated() (k int, sign float64) {
+	k = -1
+	var worst float64
+	var prior bool
+	xnorm := floats.Norm(as.x, math.Inf(1))
+	for i, c := range as.cons {
+		if as.isActive[i] {
+			continue
+		}
+		s := floats.Dot(c.a, as.x) - c.b
+		tol := as.tol * math.Max(1, math.Max(math.Abs(c.b), c.norm*xnorm))
+		if c.eq {
+			if math.Abs(s) > tol {
+				if s > 0 {
+					return i, -1
+				}
+				return i, 1
+			}
+			continue
+		}
+		if s >= -tol {
+			continue
+		}
+		v := s / c.norm
+		switch {
+		case c.prior && !prior:
+			k, worst, prior = i, v, true
+		case c.prior == prior && v < worst:
+			k, worst = i, v
+		}
+	}
+	return k, 1
+}
+
+// directions computes the primal step direction z = H n and returns the dual
+// step direction r = N* n, where N is the matrix of active constraint normals,
+//
+//	N* = (Nᵀ G⁻¹ N)⁻¹ Nᵀ G⁻¹,
+//	H  = G⁻¹ (I - N N*).
+//
+// With G = L Lᵀ and the QR factorization L⁻¹ N = [Q₁ Q₂] [R; 0], the
+// directions are computed as r = R⁻¹ Q₁ᵀ L⁻¹ n and z = L⁻ᵀ Q₂ Q₂ᵀ L⁻¹ n to
+// avoid forming Nᵀ G⁻¹ N. ginvN is used to store G⁻¹ n.
+func (as *activeSet) directions(z, ginvN, n []float64) ([]float64, error) {
+	dim := len(z)
+	var w mat.VecDense
+	err := w.SolveVec(&as.l, mat.NewVecDense(dim, n))
+	if err != nil && !isCondition(err) {
+		return nil, ErrNotConvex
+	}
+	gv := mat.NewVecDense(dim, ginvN)
+	err = gv.SolveVec(as.l.T(), &w)
+	if err != nil && !isCondition(err) {
+		return nil, ErrNotConvex
+	}
+	na := len(as.active)
+	if na == 0 {
+		copy(z, ginvN)
+		return nil, nil
+	}
+	nmat := mat.NewDense(dim, na, nil)
+	for j, k := range as.active {
+		nmat.SetCol(j, as.cons[k].a)
+	}
+	var b mat.Dense
+	err = b.Solve(&as.l, nmat)
+	if err != nil && !isCondition(err) {
+		return nil, ErrNotConvex
+	}
+	var qr mat.QR
+	qr.Factorize(&b)
+	r := mat.NewVecDense(na, nil)
+	err = qr.SolveVecTo(r, false, &w)
+	if err != nil {
+		return nil, ErrInfeasible
+	}
+	zv := mat.NewVecDense(dim, z)
+	if na < dim {
+		var q mat.Dense
+		qr.QTo(&q)
+		q2 := q.Slice(0, dim, na, dim)
+		var s mat.VecDense
+		s.MulVec(q2.T(), &w)
+		zv.MulVec(q2, &s)
+		err = zv.SolveVec(as.l.T(), zv)
+		if err != nil && !isCondition(err) {
+			return nil, ErrNotConvex
+		}
+	} else {
+		zv.Zero()
+	}
+	return r.RawVector().Data, nil
+}
+
+// isCondition returns whether err is a mat.Condition error, which is
+// returned along with a valid solution of an ill-conditioned system.
+func isCondition(err error) bool {
+	_, ok := err.(mat.Condition)
+	return ok
+}
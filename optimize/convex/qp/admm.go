@@ -0,0 +1,494 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	admmSigma      = 1e-6 // Proximal regularization of x.
+	admmAlpha      = 1.6  // Relaxation parameter.
+	admmEqualScale = 1e3  // Scale of the penalty of equality constraints.
+	admmCheck      = 5    // Number of iterations between termination checks.
+	admmAdapt      = 25   // Number of iterations between updates of the penalty.
+	admmMinRho     = 1e-6
+	admmMaxRho     = 1e6
+)
+
+// ADMM solves the convex quadratic program p by the alternating direction
+// method of multipliers in the form used by OSQP. The method is suited to
+// larger problems where moderate accuracy is sufficient, since every
+// iteration only requires a solve with a matrix that is factorized once
+// per update of the penalty parameter.
+//
+// The constraints and the bounds are written as l ≤ Ãx ≤ u and each
+// iteration solves
+//
+//	(Q + σI + ρÃᵀÃ) x̃ = σx - c + Ãᵀ(ρz - y),
+//
+// followed by a relaxed projection of Ãx̃ onto [l, u] and an update of the
+// multipliers. The penalty parameter ρ is adapted to balance the primal and
+// dual residuals. The iterations stop when the residuals are within
+// settings.Tolerance relative to the size of the problem data. The solution
+// is then polished by solving the equality constrained problem defined by
+// the constraints that the multipliers mark as active, and the polished
+// solution is returned if it is more accurate. ADMM converges slowly on
+// badly scaled problems, for which ActiveSet may be more suitable.
+//
+// If the difference of successive iterates certifies that the problem has no
+// solution, ADMM returns ErrInfeasible or ErrUnbounded. If the constraints
+// are infeasible and the objective is also unbounded along a recession
+// direction, either error may be returned. If the iteration limit is
+// reached, the current iterate is returned along with ErrIterationLimit.
+//
+// References:
+//   - Stellato, B., Banjac, G., Goulart, P., Bemporad, A., & Boyd, S. (2020).
+//     OSQP: an operator splitting solver for quadratic programs. Mathematical
+//     Programming Computation, 12(4), 637-672.
+//   - Banjac, G., Goulart, P., Stellato, B., & Boyd, S. (2019). Infeasibility
+//     detection in the alternating direction method of multipliers for convex
+//     optimization. Journal of Optimization Theory and Applications, 183(2),
+//     490-519.
+func ADMM(p Problem, settings *Settings) (*Result, error) {
+	m, n := problemDims(p)
+	tol := 1e-6
+	maxIter := 10000
+	rho := 0.1
+	var warm *Result
+	if settings != nil {
+		if settings.Tolerance != 0 {
+			tol = settings.Tolerance
+		}
+		if settings.MaxIterations != 0 {
+			maxIter = settings.MaxIterations
+		}
+		if settings.Rho != 0 {
+			rho = settings.Rho
+		}
+		warm = settings.WarmStart
+	}
+	ad := newADMM(p, m, n, tol)
+	if warm != nil {
+		ad.warmStart(warm)
+	}
+	ad.setRho(rho)
+	err := ad.factorize()
+	if err == nil {
+		err = ad.iterate(maxIter)
+	}
+
+	res := &Result{
+		X:          ad.x,
+		Iterations: ad.iter,
+	}
+	switch err {
+	case nil, ErrIterationLimit:
+		res.F = objective(p, ad.x)
+		res.Dual = make([]float64, m)
+		res.ReducedCost = make([]float64, n)
+		for i := 0; i < m; i++ {
+			res.Dual[i] = -ad.y[i]
+		}
+		for k, j := range ad.bnd {
+			res.ReducedCost[j] = -ad.y[m+k]
+		}
+	}
+	return res, err
+}
+
+// admm holds the state of the ADMM iterations for the problem
+//
+//	minimize   ½ xᵀQx + cᵀx
+//	subject to l ≤ Ãx ≤ u,
+//
+// where the rows of Ã are the rows of A followed by the unit rows of the
+// variables with a finite bound. The multipliers y satisfy
+//
+//	Qx + c + Ãᵀy = 0
+//
+// at the solution.
+type admm struct {
+	m, n int
+	q    *mat.SymDense
+	a    *mat.Dense
+	c    []float64
+	bnd  []int // Variables with a finite bound.
+	l, u []float64
+	tol  float64
+
+	rho  float64
+	rhos []float64 // Penalty of each row of Ã.
+	chol mat.Cholesky
+
+	x, z, y []float64
+	iter    int
+}
+
+func newADMM(p Problem, m, n int, tol float64) *admm {
+	ad := &admm{
+		m:   m,
+		n:   n,
+		q:   denseQ(p, n),
+		a:   denseA(p, m),
+		c:   p.C,
+		tol: tol,
+	}
+	for i := 0; i < m; i++ {
+		lo, hi := rowBounds(p, i)
+		ad.l = append(ad.l, lo)
+		ad.u = append(ad.u, hi)
+	}
+	for j := 0; j < n; j++ {
+		lo, hi := lower(p, j), upper(p, j)
+		if math.IsInf(lo, -1) && math.IsInf(hi, 1) {
+			continue
+		}
+		ad.bnd = append(ad.bnd, j)
+		ad.l = append(ad.l, lo)
+		ad.u = append(ad.u, hi)
+	}
+	rows := len(ad.l)
+	ad.rhos = make([]float64, rows)
+	ad.x = make([]float64, n)
+	ad.z = make([]float64, rows)
+	ad.y = make([]float64, rows)
+	return ad
+}
+
+// warmStart initializes the iterates from the solution of a similar problem.
+func (ad *admm) warmStart(warm *Result) {
+	if len(warm.X) != ad.n || len(warm.Dual) != ad.m || len(warm.ReducedCost) != ad.n {
+		panic(badShape)
+	}
+	copy(ad.x, warm.X)
+	for i := 0; i < ad.m; i++ {
+		ad.y[i] = -warm.Dual[i]
+	}
+	for k, j := range ad.bnd {
+		ad.y[ad.m+k] = -warm.ReducedCost[j]
+	}
+	ad.mulA(ad.z, ad.x)
+	ad.project(ad.z)
+}
+
+// setRho sets the penalty parameter of the rows of Ã.
+func (ad *admm) setRho(rho float64) {
+	ad.rho = rho
+	for i := range ad.rhos {
+		ad.rhos[i] = rho
+		if ad.l[i] == ad.u[i] {
+			ad.rhos[i] = admmEqualScale * rho
+		}
+	}
+}
+
+// factorize factorizes Q + σI + Ãᵀ diag(ρ) Ã.
+func (ad *admm) factorize() error {
+	n := ad.n
+	k := mat.NewSymDense(n, nil)
+	k.CopySym(ad.q)
+	for j := 0; j < n; j++ {
+		k.SetSym(j, j, k.At(j, j)+admmSigma)
+	}
+	for i := 0; i < ad.m; i++ {
+		k.SymRankOne(k, ad.rhos[i], ad.a.RowView(i))
+	}
+	for r, j := range ad.bnd {
+		k.SetSym(j, j, k.At(j, j)+ad.rhos[ad.m+r])
+	}
+	if !ad.chol.Factorize(k) {
+		return ErrNotConvex
+	}
+	return nil
+}
+
+// mulA computes dst = Ãx.
+func (ad *admm) mulA(dst, x []float64) {
+	if ad.m > 0 {
+		mat.NewVecDense(ad.m, dst[:ad.m]).MulVec(ad.a, mat.NewVecDense(ad.n, x))
+	}
+	for k, j := range ad.bnd {
+		dst[ad.m+k] = x[j]
+	}
+}
+
+// mulAT computes dst = Ãᵀv.
+func (ad *admm) mulAT(dst, v []float64) {
+	for j := range dst {
+		dst[j] = 0
+	}
+	if ad.m > 0 {
+		mat.NewVecDense(ad.n, dst).MulVec(ad.a.T(), mat.NewVecDense(ad.m, v[:ad.m]))
+	}
+	for k, j := range ad.bnd {
+		dst[j] += v[ad.m+k]
+	}
+}
+
+// mulQ computes dst = Qx.
+func (ad *admm) mulQ(dst, x []float64) {
+	mat.NewVecDense(ad.n, dst).MulVec(ad.q, mat.NewVecDense(ad.n, x))
+}
+
+// project projects v onto [l, u].
+func (ad *admm) project(v []float64) {
+	for i := range v {
+		v[i] = math.Min(math.Max(v[i], ad.l[i]), ad.u[i])
+	}
+}
+
+func (ad *admm) iterate(maxIter int) error {
+	n, rows := ad.n, len(ad.l)
+	var (
+		rhs  = make([]float64, n)
+		tmp  = make([]float64, rows)
+		xt   = make([]float64, n)
+		zt   = make([]float64, rows)
+		dx   = make([]float64, n)
+		dy   = make([]float64, rows)
+		ax   = make([]float64, rows)
+		qx   = make([]float64, n)
+		aty  = make([]float64, n)
+		xvec = mat.NewVecDense(n, xt)
+	)
+	for ad.iter < maxIter {
+		ad.iter++
+		// Solve the equality constrained subproblem for x̃ and z̃.
+		for i := range tmp {
+			tmp[i] = ad.rhos[i]*ad.z[i] - ad.y[i]
+		}
+		ad.mulAT(rhs, tmp)
+		for j := range rhs {
+			rhs[j] += admmSigma*ad.x[j] - ad.c[j]
+		}
+		err := ad.chol.SolveVecTo(xvec, mat.NewVecDense(n, rhs))
+		if err != nil {
+			return ErrNotConvex
+		}
+		ad.mulA(zt, xt)
+
+		// Relax the iterates, project z and update the multipliers.
+		for j := range xt {
+			xn := admmAlpha*xt[j] + (1-admmAlpha)*ad.x[j]
+			dx[j] = xn - ad.x[j]
+			ad.x[j] = xn
+		}
+		for i := range zt {
+			zr := admmAlpha*zt[i] + (1-admmAlpha)*ad.z[i]
+			zn := math.Min(math.Max(zr+ad.y[i]/ad.rhos[i], ad.l[i]), ad.u[i])
+			dy[i] = ad.rhos[i] * (zr - zn)
+			ad.y[i] += dy[i]
+			ad.z[i] = zn
+		}
+
+		if ad.iter%admmCheck != 0 && ad.iter != maxIter {
+			continue
+		}
+		rp, rd, normP, normD := ad.residuals(ad.x, ad.z, ad.y, ax, qx, aty)
+		if rp <= ad.tol*(1+normP) && rd <= ad.tol*(1+normD) {
+			ad.polish(rp, rd)
+			return nil
+		}
+		if ad.primalInfeasible(dy, aty) {
+			return ErrInfeasible
+		}
+		if ad.dualInfeasible(dx, qx, tmp) {
+			return ErrUnbounded
+		}
+
+		if ad.iter%admmAdapt == 0 {
+			// Balance the relative primal and dual residuals.
+			ratio := math.Sqrt((rp / math.Max(normP, 1e-10)) / math.Max(rd/math.Max(normD, 1e-10), 1e-300))
+			rho := math.Min(math.Max(ad.rho*ratio, admmMinRho), admmMaxRho)
+			if rho > 5*ad.rho || rho < ad.rho/5 {
+				ad.setRho(rho)
+				if err := ad.factorize(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return ErrIterationLimit
+}
+
+// residuals returns the infinity norms of the primal residual Ãx - z and
+// the dual residual Qx + c + Ãᵀy, and the norms used to scale them. ax, qx and
+// aty are used as temporary storage.
+func (ad *admm) residuals(x, z, y, ax, qx, aty []float64) (rp, rd, normP, normD float64) {
+	ad.mulA(ax, x)
+	ad.mulQ(qx, x)
+	ad.mulAT(aty, y)
+	inf := math.Inf(1)
+	rp = floats.Distance(ax, z, inf)
+	normP = math.Max(floats.Norm(ax, inf), floats.Norm(z, inf))
+	for j := range qx {
+		rd = math.Max(rd, math.Abs(qx[j]+ad.c[j]+aty[j]))
+	}
+	normD = math.Max(floats.Norm(qx, inf), math.Max(floats.Norm(aty, inf), floats.Norm(ad.c, inf)))
+	return rp, rd, normP, normD
+}
+
+// polish improves the accuracy of the solution by guessing the active
+// constraints from the multipliers and solving the equality constrained
+// problem
+//
+//	minimize   ½ xᵀQx + cᵀx
+//	subject to Ãᵢx = lᵢ or Ãᵢx = uᵢ for the active rows i.
+//
+// The polished solution replaces the iterate if the signs of its multipliers
+// are consistent and its residuals are not larger than rp and rd.
+func (ad *admm) polish(rp, rd float64) {
+	n, rows := ad.n, len(ad.l)
+	var act []int
+	var bound []float64
+	for i := 0; i < rows; i++ {
+		switch {
+		case ad.l[i] == ad.u[i], ad.z[i]-ad.l[i] < -ad.y[i]:
+			act = append(act, i)
+			bound = append(bound, ad.l[i])
+		case ad.u[i]-ad.z[i] < ad.y[i]:
+			act = append(act, i)
+			bound = append(bound, ad.u[i])
+		}
+	}
+	// Form the KKT matrix of the equality constrained problem and solve
+	// its regularized version with iterative refinement.
+	na := len(act)
+	k := mat.NewDense(n+na, n+na, nil)
+	k.Slice(0, n, 0, n).(*mat.Dense).Copy(ad.q)
+	for r, i := range act {
+		if i >= ad.m {
+			j := ad.bnd[i-ad.m]
+			k.Set(n+r, j, 1)
+			k.Set(j, n+r, 1)
+			continue
+		}
+		for j, v := range ad.a.RawRowView(i) {
+			k.Set(n+r, j, v)
+			k.Set(j, n+r, v)
+		}
+	}
+	reg := mat.NewDense(n+na, n+na, nil)
+	reg.Copy(k)
+	for j := 0; j < n+na; j++ {
+		if j < n {
+			reg.Set(j, j, reg.At(j, j)+admmSigma)
+		} else {
+			reg.Set(j, j, reg.At(j, j)-admmSigma)
+		}
+	}
+	var lu mat.LU
+	lu.Factorize(reg)
+	rhs := mat.NewVecDense(n+na, nil)
+	for j := 0; j < n; j++ {
+		rhs.SetVec(j, -ad.c[j])
+	}
+	for r, v := range bound {
+		rhs.SetVec(n+r, v)
+	}
+	sol := mat.NewVecDense(n+na, nil)
+	var res, step mat.VecDense
+	for it := 0; it < 5; it++ {
+		res.MulVec(k, sol)
+		res.SubVec(rhs, &res)
+		if err := step.SolveVec(&lu, &res); err != nil && !isCondition(err) {
+			return
+		}
+		sol.AddVec(sol, &step)
+	}
+
+	x := make([]float64, n)
+	copy(x, sol.RawVector().Data[:n])
+	y := make([]float64, rows)
+	for r, i := range act {
+		v := sol.AtVec(n + r)
+		if ad.l[i] != ad.u[i] && ad.y[i]*v < 0 {
+			// The multiplier changed its sign, so the guessed active set
+			// is wrong.
+			return
+		}
+		y[i] = v
+	}
+	z := make([]float64, rows)
+	ad.mulA(z, x)
+	ad.project(z)
+	ax := make([]float64, rows)
+	qx := make([]float64, n)
+	aty := make([]float64, n)
+	prp, prd, _, _ := ad.residuals(x, z, y, ax, qx, aty)
+	if prp > rp || prd > rd {
+		return
+	}
+	ad.x, ad.z, ad.y = x, z, y
+}
+
+// primalInfeasible returns whether the multiplier step dy certifies that the
+// constraints are infeasible, that is whether Ãᵀdy = 0 and
+// uᵀmax(dy, 0) + lᵀmin(dy, 0) < 0. work is used as temporary storage.
+func (ad *admm) primalInfeasible(dy, work []float64) bool {
+	norm := floats.Norm(dy, math.Inf(1))
+	if norm == 0 {
+		return false
+	}
+	eps := ad.tol * norm
+	var s float64
+	for i, v := range dy {
+		switch {
+		case v > 0:
+			if math.IsInf(ad.u[i], 1) {
+				if v > eps {
+					return false
+				}
+				continue
+			}
+			s += ad.u[i] * v
+		case v < 0:
+			if math.IsInf(ad.l[i], -1) {
+				if v < -eps {
+					return false
+				}
+				continue
+			}
+			s += ad.l[i] * v
+		}
+	}
+	if s > -eps {
+		return false
+	}
+	ad.mulAT(work, dy)
+	return floats.Norm(work, math.Inf(1)) <= eps
+}
+
+// dualInfeasible returns whether the primal step dx certifies that the
+// objective is unbounded, that is whether Qdx = 0, cᵀdx < 0 and Ãdx is a
+// recession direction of [l, u]. work is used as temporary storage.
+func (ad *admm) dualInfeasible(dx, qdx, work []float64) bool {
+	norm := floats.Norm(dx, math.Inf(1))
+	if norm == 0 {
+		return false
+	}
+	eps := ad.tol * norm
+	if floats.Dot(ad.c, dx) > -eps {
+		return false
+	}
+	ad.mulQ(qdx, dx)
+	if floats.Norm(qdx, math.Inf(1)) > eps {
+		return false
+	}
+	ad.mulA(work, dx)
+	for i, v := range work {
+		if !math.IsInf(ad.u[i], 1) && v > eps {
+			return false
+		}
+		if !math.IsInf(ad.l[i], -1) && v < -eps {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package qp implements routines to solve convex quadratic programming
+// problems.
+package qp // import "gonum.org/v1/gonum/optimize/convex/qp"
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+	"gonum.org/v1/gonum/optimize/convex/qp"
+)
+
+func ExampleActiveSet() {
+	// Find the minimum variance portfolio of three assets with an
+	// expected return of at least 0.1 and no short positions.
+	cov := mat.NewSymDense(3, []float64{
+		0.04, 0.006, 0.002,
+		0.006, 0.09, 0.009,
+		0.002, 0.009, 0.01,
+	})
+	p := qp.Problem{
+		Q: cov,
+		Problem: lp.Problem{
+			C:         []float64{0, 0, 0},
+			A:         mat.NewDense(2, 3, []float64{1, 1, 1, 0.12, 0.15, 0.05}),
+			B:         []float64{1, 0.1},
+			Relations: []lp.Relation{lp.Equal, lp.GreaterEqual},
+		},
+	}
+	res, err := qp.ActiveSet(p, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("weights: %.4f\n", res.X)
+	fmt.Printf("variance: %.6f\n", 2*res.F)
+	fmt.Printf("multipliers: %.4f\n", res.Dual)
+
+	// Solve the problem again with a higher expected return, starting
+	// from the previous solution.
+	p.B[1] = 0.11
+	res, err = qp.ADMM(p, &qp.Settings{WarmStart: res, Tolerance: 1e-8})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("weights: %.4f\n", res.X)
+
+	// Output:
+	// weights: [0.4231 0.2038 0.3731]
+	// variance: 0.015327
+	// multipliers: [-0.0025 0.1783]
+	// weights: [0.4893 0.2575 0.2532]
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+var (
+	// ErrInfeasible signifies that the constraints cannot be satisfied.
+	ErrInfeasible = errors.New("qp: problem is infeasible")
+	// ErrUnbounded signifies that the objective is not bounded below on
+	// the feasible set.
+	ErrUnbounded = errors.New("qp: problem is unbounded")
+	// ErrNotConvex signifies that Q is not positive semidefinite.
+	ErrNotConvex = errors.New("qp: problem is not convex")
+	// ErrIterationLimit signifies that the maximum number of iterations
+	// was reached before a solution was found.
+	ErrIterationLimit = errors.New("qp: iteration limit reached")
+)
+
+const badShape = "qp: size mismatch"
+
+// Problem is a convex quadratic program
+//
+//	minimize   ½ xᵀQx + cᵀx
+//	subject to aᵢᵀx ≤ bᵢ, aᵢᵀx = bᵢ or aᵢᵀx ≥ bᵢ, i = 0, ..., m-1,
+//	           l ≤ x ≤ u,
+//
+// where Q is positive semidefinite. The linear part of the problem is
+// described by the embedded lp.Problem, see its documentation for the
+// default bounds and relations.
+type Problem struct {
+	// Q is the matrix of the quadratic term. Q may be nil, in which case
+	// the problem is a linear program.
+	Q mat.Symmetric
+
+	lp.Problem
+}
+
+// Settings holds the settings of the quadratic programming solvers.
+type Settings struct {
+	// Tolerance is the relative tolerance of the primal and dual
+	// feasibility. If Tolerance is zero, a default value of 1e-9 is used
+	// by ActiveSet and of 1e-6 by ADMM.
+	Tolerance float64
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, a default value of 100*(m+n) is used by ActiveSet, where m is
+	// the number of constraints and finite bounds and n is the number of
+	// variables, and of 10000 by ADMM.
+	MaxIterations int
+
+	// WarmStart holds the solution of a similar problem, for example with
+	// a different c or b, from which the solver starts. ActiveSet adds the
+	// constraints with nonzero multipliers in WarmStart first, and ADMM
+	// starts from X and the multipliers in WarmStart. If WarmStart is nil,
+	// the solvers start from scratch.
+	WarmStart *Result
+
+	// Rho is the initial penalty parameter of ADMM, which is adapted during
+	// the iterations. If Rho is zero, a default value of 0.1 is used.
+	Rho float64
+}
+
+// Result holds the solution of a quadratic program.
+type Result struct {
+	// X is the solution.
+	X []float64
+	// F is the optimal value of the objective function.
+	F float64
+	// Dual holds the multipliers y of the constraints, and ReducedCost
+	// holds the multipliers d of the bounds, such that
+	//
+	//	Qx + c = Aᵀy + d.
+	//
+	// The signs of the multipliers follow lp.Result: the multipliers of
+	// ≤ constraints are non-positive, those of ≥ constraints are
+	// non-negative, and d is non-negative at a lower bound, non-positive
+	// at an upper bound and zero otherwise.
+	Dual        []float64
+	ReducedCost []float64
+	// Iterations is the number of iterations.
+	Iterations int
+}
+
+// problemDims checks the consistency of p and returns the number of
+// constraints and variables.
+func problemDims(p Problem) (m, n int) {
+	n = len(p.C)
+	if n == 0 {
+		panic("qp: zero number of variables")
+	}
+	if p.Q != nil && p.Q.SymmetricDim() != n {
+		panic(badShape)
+	}
+	if p.A != nil {
+		var an int
+		m, an = p.A.Dims()
+		if an != n {
+			panic(badShape)
+		}
+	}
+	if len(p.B) != m {
+		panic(badShape)
+	}
+	if p.Relations != nil && len(p.Relations) != m {
+		panic(badShape)
+	}
+	if p.Lower != nil && len(p.Lower) != n {
+		panic(badShape)
+	}
+	if p.Upper != nil && len(p.Upper) != n {
+		panic(badShape)
+	}
+	for j := 0; j < n; j++ {
+		l, u := lower(p, j), upper(p, j)
+		if !(l <= u) || math.IsInf(l, 1) || math.IsInf(u, -1) {
+			panic("qp: invalid bounds")
+		}
+	}
+	return m, n
+}
+
+func lower(p Problem, j int) float64 {
+	if p.Lower == nil {
+		return 0
+	}
+	return p.Lower[j]
+}
+
+func upper(p Problem, j int) float64 {
+	if p.Upper == nil {
+		return math.Inf(1)
+	}
+	return p.Upper[j]
+}
+
+// rowBounds returns the range of the values of aᵢᵀx permitted by the i-th
+// constraint.
+func rowBounds(p Problem, i int) (lo, hi float64) {
+	rel := lp.Equal
+	if p.Relations != nil {
+		rel = p.Relations[i]
+	}
+	switch rel {
+	case lp.Equal:
+		return p.B[i], p.B[i]
+	case lp.LessEqual:
+		return math.Inf(-1), p.B[i]
+	case lp.GreaterEqual:
+		return p.B[i], math.Inf(1)
+	default:
+		panic("qp: invalid relation")
+	}
+}
+
+// objective returns ½ xᵀQx + cᵀx.
+func objective(p Problem, x []float64) float64 {
+	f := floats.Dot(p.C, x)
+	if p.Q != nil {
+		xv := mat.NewVecDense(len(x), x)
+		f += 0.5 * mat.Inner(xv, p.Q, xv)
+	}
+	return f
+}
+
+// denseQ returns Q as a dense symmetric matrix, or the zero matrix if Q is
+// nil.
+func denseQ(p Problem, n int) *mat.SymDense {
+	q := mat.NewSymDense(n, nil)
+	if p.Q != nil {
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				q.SetSym(i, j, p.Q.At(i, j))
+			}
+		}
+	}
+	return q
+}
+
+// denseA returns A as a dense matrix, or nil if there are no constraints.
+func denseA(p Problem, m int) *mat.Dense {
+	if m == 0 {
+		return nil
+	}
+	return mat.DenseCopyOf(p.A)
+}
@@ -0,0 +1,388 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package qp
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/lp"
+)
+
+var solvers = []struct {
+	name  string
+	solve func(Problem, *Settings) (*Result, error)
+	tol   float64
+}{
+	{name: "ActiveSet", solve: ActiveSet, tol: 1e-8},
+	{name: "ADMM", solve: ADMM, tol: 1e-4},
+}
+
+func TestSolversKnown(t *testing.T) {
+	t.Parallel()
+	// minimize (x-1)² + (y-2.5)² subject to
+	//  x - 2y ≥ -2, -x - 2y ≥ -6, -x + 2y ≥ -2, x, y ≥ 0.
+	p := Problem{
+		Q: mat.NewSymDense(2, []float64{2, 0, 0, 2}),
+		Problem: lp.Problem{
+			C:         []float64{-2, -5},
+			A:         mat.NewDense(3, 2, []float64{1, -2, -1, -2, -1, 2}),
+			B:         []float64{-2, -6, -2},
+			Relations: []lp.Relation{lp.GreaterEqual, lp.GreaterEqual, lp.GreaterEqual},
+		},
+	}
+	for _, s := range solvers {
+		res, err := s.solve(p, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", s.name, err)
+			continue
+		}
+		if !floats.EqualApprox(res.X, []float64{1.4, 1.7}, s.tol) {
+			t.Errorf("%s: unexpected solution: got %v, want [1.4 1.7]", s.name, res.X)
+		}
+		if !scalar.EqualWithinAbs(res.F, -6.45, s.tol) {
+			t.Errorf("%s: unexpected optimal value: got %v, want -6.45", s.name, res.F)
+		}
+		if !floats.EqualApprox(res.Dual, []float64{0.8, 0, 0}, s.tol) {
+			t.Errorf("%s: unexpected multipliers: got %v, want [0.8 0 0]", s.name, res.Dual)
+		}
+		if !floats.EqualApprox(res.ReducedCost, []float64{0, 0}, s.tol) {
+			t.Errorf("%s: unexpected bound multipliers: got %v, want [0 0]", s.name, res.ReducedCost)
+		}
+	}
+
+	// Infeasible: x + y ≥ 5 with x, y ∈ [0, 2].
+	p = Problem{
+		Q: mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+		Problem: lp.Problem{
+			C:         []float64{1, 1},
+			A:         mat.NewDense(1, 2, []float64{1, 1}),
+			B:         []float64{5},
+			Relations: []lp.Relation{lp.GreaterEqual},
+			Upper:     []float64{2, 2},
+		},
+	}
+	for _, s := range solvers {
+		_, err := s.solve(p, nil)
+		if err != ErrInfeasible {
+			t.Errorf("%s: unexpected error for infeasible problem: got %v, want %v", s.name, err, ErrInfeasible)
+		}
+	}
+
+	// Unbounded: minimize (x-y)² - x - y with x - y ≤ 1 and x, y ≥ 0.
+	p = Problem{
+		Q: mat.NewSymDense(2, []float64{2, -2, -2, 2}),
+		Problem: lp.Problem{
+			C:         []float64{-1, -1},
+			A:         mat.NewDense(1, 2, []float64{1, -1}),
+			B:         []float64{1},
+			Relations: []lp.Relation{lp.LessEqual},
+		},
+	}
+	for _, s := range solvers {
+		_, err := s.solve(p, nil)
+		if err != ErrUnbounded {
+			t.Errorf("%s: unexpected error for unbounded problem: got %v, want %v", s.name, err, ErrUnbounded)
+		}
+	}
+
+	// Not convex.
+	p = Problem{
+		Q: mat.NewSymDense(2, []float64{1, 0, 0, -1}),
+		Problem: lp.Problem{
+			C:     []float64{0, 0},
+			Lower: []float64{-1, -1},
+			Upper: []float64{1, 1},
+		},
+	}
+	_, err := ActiveSet(p, nil)
+	if err != ErrNotConvex {
+		t.Errorf("ActiveSet: unexpected error for nonconvex problem: got %v, want %v", err, ErrNotConvex)
+	}
+}
+
+func TestActiveSetLinear(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for k := 0; k < 200; k++ {
+		p := randomProblem(rnd, rnd.IntN(8)+1, rnd.IntN(6), 0)
+		want, errLP := lp.DualSimplex(p.Problem, nil)
+		got, err := ActiveSet(p, nil)
+		if !sameError(err, errLP) {
+			t.Errorf("case %d: error mismatch: ActiveSet %v, DualSimplex %v", k, err, errLP)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(got.F, want.F, 1e-7, 1e-7) {
+			t.Errorf("case %d: optimal value mismatch: ActiveSet %v, DualSimplex %v", k, got.F, want.F)
+		}
+		checkKKT(t, fmt.Sprintf("ActiveSet (case %d)", k), p, got, 1e-7)
+	}
+}
+
+func TestActiveSetSingular(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 5))
+	for k := 0; k < 300; k++ {
+		n := rnd.IntN(10) + 2
+		p := randomProblem(rnd, n, rnd.IntN(8), rnd.IntN(n-1)+1)
+		res, err := ActiveSet(p, nil)
+		switch err {
+		case nil:
+			checkKKT(t, fmt.Sprintf("case %d", k), p, res, 1e-7)
+		case ErrInfeasible, ErrUnbounded:
+			// The recession directions and the feasibility of the
+			// constraints are checked with lp.DualSimplex.
+		default:
+			t.Errorf("case %d: unexpected error: %v", k, err)
+		}
+	}
+}
+
+func TestSolversRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	var tested int
+	for k := 0; k < 300; k++ {
+		n := rnd.IntN(10) + 1
+		p := randomProblem(rnd, n, rnd.IntN(8), n)
+		as, errAS := ActiveSet(p, nil)
+		if errAS == nil && badlyScaled(as) {
+			// ADMM converges slowly on problems with large solutions or
+			// multipliers.
+			continue
+		}
+		ad, errAD := ADMM(p, nil)
+		if errAS != errAD {
+			t.Errorf("case %d: error mismatch: ActiveSet %v, ADMM %v", k, errAS, errAD)
+			continue
+		}
+		tested++
+		if errAS != nil {
+			continue
+		}
+		checkKKT(t, fmt.Sprintf("ActiveSet (case %d)", k), p, as, 1e-7)
+		checkKKT(t, fmt.Sprintf("ADMM (case %d)", k), p, ad, 1e-4)
+		if !scalar.EqualWithinAbsOrRel(as.F, ad.F, 1e-5, 1e-5) {
+			t.Errorf("case %d: optimal value mismatch: ActiveSet %v, ADMM %v", k, as.F, ad.F)
+		}
+	}
+	if tested < 250 {
+		t.Errorf("too few problems tested: %d", tested)
+	}
+}
+
+// badlyScaled returns whether the solution or the multipliers in res are
+// large.
+func badlyScaled(res *Result) bool {
+	inf := math.Inf(1)
+	return math.Max(floats.Norm(res.X, inf), math.Max(floats.Norm(res.Dual, inf), floats.Norm(res.ReducedCost, inf))) > 1e3
+}
+
+func TestSolversWarmStart(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 3))
+	for _, s := range solvers {
+		var cold, warm, tested int
+		for k := 0; k < 100; k++ {
+			n := rnd.IntN(10) + 5
+			p := randomProblem(rnd, n, rnd.IntN(8), n)
+			res, err := s.solve(p, nil)
+			if err != nil || badlyScaled(res) {
+				continue
+			}
+			// Perturb the linear term as in a sequence of related
+			// problems.
+			for j := range p.C {
+				p.C[j] += 1e-3 * rnd.NormFloat64()
+			}
+			resCold, errCold := s.solve(p, nil)
+			resWarm, errWarm := s.solve(p, &Settings{WarmStart: res})
+			if errCold != nil || errWarm != nil {
+				t.Errorf("%s: case %d: unexpected error: cold %v, warm %v", s.name, k, errCold, errWarm)
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(resCold.F, resWarm.F, s.tol, s.tol) {
+				t.Errorf("%s: case %d: optimal value mismatch: cold %v, warm %v", s.name, k, resCold.F, resWarm.F)
+			}
+			cold += resCold.Iterations
+			warm += resWarm.Iterations
+			tested++
+		}
+		if tested == 0 {
+			t.Errorf("%s: no problems tested", s.name)
+		}
+		if warm >= cold {
+			t.Errorf("%s: warm start did not reduce the number of iterations: cold %d, warm %d", s.name, cold, warm)
+		}
+	}
+}
+
+func TestSolversIterationLimit(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 4))
+	p := randomProblem(rnd, 10, 6, 10)
+	for _, s := range solvers {
+		res, err := s.solve(p, &Settings{MaxIterations: 1})
+		if err != ErrIterationLimit {
+			t.Errorf("%s: unexpected error: got %v, want %v", s.name, err, ErrIterationLimit)
+			continue
+		}
+		if res.Iterations != 1 {
+			t.Errorf("%s: unexpected number of iterations: got %d, want 1", s.name, res.Iterations)
+		}
+	}
+}
+
+// sameError returns whether the qp error err corresponds to the lp error
+// errLP.
+func sameError(err, errLP error) bool {
+	switch errLP {
+	case nil:
+		return err == nil
+	case lp.ErrInfeasible:
+		return err == ErrInfeasible
+	case lp.ErrUnbounded:
+		return err == ErrUnbounded
+	}
+	return false
+}
+
+// randomProblem returns a random quadratic program with n variables and m
+// constraints with random bounds and relations, where Q has the given rank.
+// If the rank is n, the identity is added to Q so that it is well
+// conditioned.
+func randomProblem(rnd *rand.Rand, n, m, rank int) Problem {
+	p := Problem{
+		Problem: lp.Problem{
+			C:         make([]float64, n),
+			B:         make([]float64, m),
+			Relations: make([]lp.Relation, m),
+			Lower:     make([]float64, n),
+			Upper:     make([]float64, n),
+		},
+	}
+	if rank > 0 {
+		l := mat.NewDense(n, rank, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < rank; j++ {
+				l.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		q := mat.NewSymDense(n, nil)
+		q.SymOuterK(1, l)
+		if rank == n {
+			for i := 0; i < n; i++ {
+				q.SetSym(i, i, q.At(i, i)+1)
+			}
+		}
+		p.Q = q
+	}
+	for j := range p.C {
+		p.C[j] = rnd.NormFloat64()
+		l := rnd.NormFloat64()
+		u := l + 2*rnd.Float64()
+		switch rnd.IntN(5) {
+		case 0:
+			l = math.Inf(-1)
+		case 1:
+			u = math.Inf(1)
+		case 2:
+			l, u = math.Inf(-1), math.Inf(1)
+		case 3:
+			if rnd.IntN(4) == 0 {
+				u = l
+			}
+		}
+		p.Lower[j], p.Upper[j] = l, u
+	}
+	if m > 0 {
+		a := mat.NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if rnd.Float64() < 0.7 {
+					a.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			p.B[i] = rnd.NormFloat64()
+			p.Relations[i] = lp.Relation(rnd.IntN(3))
+		}
+		p.A = a
+	}
+	return p
+}
+
+// checkKKT checks the optimality conditions of the result of a solver.
+func checkKKT(t *testing.T, name string, p Problem, res *Result, tol float64) {
+	t.Helper()
+	m, n := problemDims(p)
+	x := res.X
+	scale := 1 + floats.Norm(x, math.Inf(1))
+	// Primal feasibility.
+	for j := 0; j < n; j++ {
+		if x[j] < lower(p, j)-tol*scale || x[j] > upper(p, j)+tol*scale {
+			t.Errorf("%s: bound %d violated: %v not in [%v, %v]", name, j, x[j], lower(p, j), upper(p, j))
+		}
+	}
+	ax := make([]float64, m)
+	for i := 0; i < m; i++ {
+		ax[i] = floats.Dot(mat.Row(nil, i, p.A), x)
+		lo, hi := rowBounds(p, i)
+		if ax[i] < lo-tol*scale || ax[i] > hi+tol*scale {
+			t.Errorf("%s: constraint %d violated: %v not in [%v, %v]", name, i, ax[i], lo, hi)
+		}
+	}
+	// Stationarity Qx + c = Aᵀy + d.
+	r := make([]float64, n)
+	copy(r, p.C)
+	if p.Q != nil {
+		var qx mat.VecDense
+		qx.MulVec(p.Q, mat.NewVecDense(n, x))
+		floats.Add(r, qx.RawVector().Data)
+	}
+	for i := 0; i < m; i++ {
+		floats.AddScaled(r, -res.Dual[i], mat.Row(nil, i, p.A))
+	}
+	floats.Sub(r, res.ReducedCost)
+	dscale := 1 + floats.Norm(res.Dual, math.Inf(1)) + floats.Norm(res.ReducedCost, math.Inf(1))
+	if floats.Norm(r, math.Inf(1)) > tol*dscale*scale {
+		t.Errorf("%s: stationarity violated: residual %v", name, r)
+	}
+	// Signs and complementarity of the multipliers.
+	ctol := tol * dscale * scale
+	for i := 0; i < m; i++ {
+		lo, hi := rowBounds(p, i)
+		checkMultiplier(t, name, "constraint", i, res.Dual[i], ax[i], lo, hi, ctol)
+	}
+	for j := 0; j < n; j++ {
+		checkMultiplier(t, name, "bound", j, res.ReducedCost[j], x[j], lower(p, j), upper(p, j), ctol)
+	}
+}
+
+// checkMultiplier checks the sign and the complementarity of the multiplier
+// y of the constraint lo ≤ v ≤ hi.
+func checkMultiplier(t *testing.T, name, kind string, i int, y, v, lo, hi, tol float64) {
+	t.Helper()
+	if lo == hi {
+		return
+	}
+	switch {
+	case y > tol:
+		if math.IsInf(lo, -1) || y*(v-lo) > tol {
+			t.Errorf("%s: %s %d: multiplier %v inconsistent with value %v in [%v, %v]", name, kind, i, y, v, lo, hi)
+		}
+	case y < -tol:
+		if math.IsInf(hi, 1) || -y*(hi-v) > tol {
+			t.Errorf("%s: %s %d: multiplier %v inconsistent with value %v in [%v, %v]", name, kind, i, y, v, lo, hi)
+		}
+	}
+}
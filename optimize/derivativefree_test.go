@@ -0,0 +1,265 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestPowell(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	for _, list := range [][]unconstrainedTest{gradFreeTests, gradientDescentTests} {
+		for _, test := range list {
+			// The line searches along the coordinate directions reach
+			// points where HelicalValley is not defined.
+			if test.name != "HelicalValley" {
+				tests = append(tests, test)
+			}
+		}
+	}
+	// Disable the convergence test of Powell so that the tests terminate
+	// with FunctionConvergence.
+	testLocal(t, tests, &Powell{Tolerance: math.NaN()})
+}
+
+func TestDerivativeFree(t *testing.T) {
+	t.Parallel()
+	rosenbrock := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	wood := Problem{Func: functions.Wood{}.Func}
+	beale := Problem{Func: functions.Beale{}.Func}
+	for _, test := range []struct {
+		name   string
+		p      Problem
+		x      []float64
+		method Method
+		want   []float64
+		tol    float64
+	}{
+		{
+			name:   "PowellRosenbrock",
+			p:      rosenbrock,
+			x:      []float64{-1.2, 1},
+			method: &Powell{},
+			want:   []float64{1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "PowellWood",
+			p:      wood,
+			x:      []float64{-3, -1, -3, -1},
+			method: &Powell{},
+			want:   []float64{1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "PowellBeale",
+			p:      beale,
+			x:      []float64{1, 1},
+			method: &Powell{},
+			want:   []float64{3, 0.5},
+			tol:    1e-6,
+		},
+		{
+			name:   "BOBYQARosenbrock",
+			p:      rosenbrock,
+			x:      []float64{-1.2, 1},
+			method: &BOBYQA{},
+			want:   []float64{1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "BOBYQARosenbrock8",
+			p:      rosenbrock,
+			x:      []float64{-1.2, 1, -1.2, 1, -1.2, 1, -1.2, 1},
+			method: &BOBYQA{},
+			want:   []float64{1, 1, 1, 1, 1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "BOBYQAWood",
+			p:      wood,
+			x:      []float64{-3, -1, -3, -1},
+			method: &BOBYQA{},
+			want:   []float64{1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "BOBYQAWoodMinPoints",
+			p:      wood,
+			x:      []float64{-3, -1, -3, -1},
+			method: &BOBYQA{InterpolationPoints: 6},
+			want:   []float64{1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "BOBYQAWoodMaxPoints",
+			p:      wood,
+			x:      []float64{-3, -1, -3, -1},
+			method: &BOBYQA{InterpolationPoints: 15},
+			want:   []float64{1, 1, 1, 1},
+			tol:    1e-6,
+		},
+		{
+			name:   "BOBYQABeale",
+			p:      beale,
+			x:      []float64{1, 1},
+			method: &BOBYQA{},
+			want:   []float64{3, 0.5},
+			tol:    1e-6,
+		},
+		{
+			name: "COBYLAQuadratic",
+			p: Problem{
+				Func: func(x []float64) float64 {
+					return (x[0]-1)*(x[0]-1) + 2*(x[1]+2)*(x[1]+2) + x[0]*x[1]
+				},
+			},
+			x:      []float64{0, 0},
+			method: &COBYLA{},
+			want:   []float64{16.0 / 7, -18.0 / 7},
+			tol:    1e-4,
+		},
+	} {
+		// The methods must terminate by their own convergence criteria.
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			FuncEvaluations: 100000,
+		}
+		result, err := Minimize(test.p, test.x, settings, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, MethodConverge)
+		}
+		if !floats.EqualApprox(result.X, test.want, test.tol) {
+			t.Errorf("%s: unexpected minimizer: got %v, want %v", test.name, result.X, test.want)
+		}
+		if f := test.p.Func(result.X); f != result.F {
+			t.Errorf("%s: function value at the minimizer %v not equal to the returned value %v", test.name, f, result.F)
+		}
+	}
+}
+
+func TestBOBYQATrustRegionCollapse(t *testing.T) {
+	t.Parallel()
+	// The initial interpolation points are not distinct in floating-point
+	// arithmetic, since ρ is below the spacing of the floats near x.
+	p := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	_, err := Minimize(p, []float64{1e12, 1e12}, nil, &BOBYQA{InitialRadius: 1e-6, FinalRadius: 1e-8})
+	if err != ErrTrustRegionCollapse {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrTrustRegionCollapse)
+	}
+}
+
+func TestBOBYQABounds(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name         string
+		f            func([]float64) float64
+		grad         func([]float64, []float64)
+		x            []float64
+		lower, upper []float64
+	}{
+		{
+			name:  "Rosenbrock",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			grad:  functions.ExtendedRosenbrock{}.Grad,
+			x:     []float64{-1.2, 1, -1.2, 1},
+			lower: []float64{-2, -2, -2, -2},
+			upper: []float64{0.5, 2, 2, 2},
+		},
+		{
+			name:  "RosenbrockOneSided",
+			f:     functions.ExtendedRosenbrock{}.Func,
+			grad:  functions.ExtendedRosenbrock{}.Grad,
+			x:     []float64{-1.2, 1.5},
+			lower: []float64{math.Inf(-1), 1.5},
+		},
+		{
+			name:  "WoodStartOnBound",
+			f:     functions.Wood{}.Func,
+			grad:  functions.Wood{}.Grad,
+			x:     []float64{-1, 0, -1, 0},
+			lower: []float64{-1, -1, -1, -1},
+			upper: []float64{0, 0, 0, 0},
+		},
+	} {
+		var outside bool
+		p := Problem{
+			Func: func(x []float64) float64 {
+				for i, v := range x {
+					if v < lowerBound(test.lower, i) || upperBound(test.upper, i) < v {
+						outside = true
+					}
+				}
+				return test.f(x)
+			},
+		}
+		result, err := Minimize(p, test.x, nil, &BOBYQA{Lower: test.lower, Upper: test.upper})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if outside {
+			t.Errorf("%s: function evaluated outside of the bounds", test.name)
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, MethodConverge)
+		}
+		// Compare with the minimizer found using the gradient.
+		want, err := Minimize(Problem{Func: test.f, Grad: test.grad}, test.x, nil, &LBFGSB{Lower: test.lower, Upper: test.upper})
+		if err != nil {
+			t.Fatalf("%s: unexpected error from LBFGSB: %v", test.name, err)
+		}
+		if !floats.EqualApprox(result.X, want.X, 1e-5) {
+			t.Errorf("%s: unexpected minimizer: got %v, want %v", test.name, result.X, want.X)
+		}
+	}
+}
+
+func TestCOBYLA(t *testing.T) {
+	t.Parallel()
+	for _, test := range constrainedTests() {
+		test.p.Grad = nil
+		test.p.Constraints.Jacobian = nil
+		testConstrained(t, test, &COBYLA{})
+	}
+}
+
+func TestDerivativeFreeEvaluationLimit(t *testing.T) {
+	t.Parallel()
+	rosenbrock := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+	constrained := constrainedTests()[3].p
+	constrained.Grad = nil
+	for _, test := range []struct {
+		p      Problem
+		method Method
+	}{
+		{p: rosenbrock, method: &Powell{}},
+		{p: rosenbrock, method: &BOBYQA{}},
+		{p: rosenbrock, method: &COBYLA{}},
+		{p: constrained, method: &COBYLA{}},
+	} {
+		const limit = 50
+		result, err := Minimize(test.p, []float64{-1.2, 1}, &Settings{FuncEvaluations: limit}, test.method)
+		if err != nil {
+			t.Errorf("%T: unexpected error: %v", test.method, err)
+			continue
+		}
+		if result.Status != FunctionEvaluationLimit {
+			t.Errorf("%T: unexpected status: got %v, want %v", test.method, result.Status, FunctionEvaluationLimit)
+		}
+		if result.FuncEvaluations != limit {
+			t.Errorf("%T: unexpected number of evaluations: got %d, want %d", test.method, result.FuncEvaluations, limit)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method   = (*DifferentialEvolution)(nil)
+	_ Statuser = (*DifferentialEvolution)(nil)
+)
+
+// DifferentialEvolution implements the differential evolution global
+// optimization method of Storn and Price with the DE/rand/1/bin strategy.
+//
+// DifferentialEvolution maintains a population of locations. In each
+// generation, a trial location is formed for every member x_i of the
+// population by mutating a random member x_{r1} with the scaled difference
+// of two other random members,
+//
+//	v = x_{r1} + F (x_{r2} - x_{r3}),
+//
+// and then taking each coordinate from v with probability CR and from x_i
+// otherwise. The trial location replaces x_i if its function value is not
+// larger. All trial locations of a generation are evaluated concurrently if
+// Settings.Concurrent is larger than one. Each generation is a major
+// iteration, reporting the best location found so far.
+//
+// The initial population contains the initial location. The other members
+// are sampled uniformly for variables with finite lower and upper bounds, and
+// from a normal distribution around the initial location otherwise.
+//
+// Reference:
+//
+//	Storn, R., Price, K. (1997). Differential evolution – a simple and
+//	efficient heuristic for global optimization over continuous spaces.
+//	Journal of Global Optimization 11, 341-359.
+type DifferentialEvolution struct {
+	// Population is the size of the population. If Population is zero, it is
+	// defaulted to max(10*dim, 5). Population must be at least 4.
+	Population int
+	// Mutation is the differential weight F. If Mutation is zero, it is
+	// defaulted to 0.8. Mutation must be in (0, 2].
+	Mutation float64
+	// Crossover is the crossover probability CR. If Crossover is zero, it is
+	// defaulted to 0.9. Crossover must be in (0, 1].
+	Crossover float64
+	// InitStepSize is the standard deviation of the initial population for
+	// variables without finite bounds. If InitStepSize is zero, it is
+	// defaulted to 1.
+	InitStepSize float64
+	// Lower and Upper are optional bounds on the variables. If Lower is nil,
+	// the variables are not bounded from below, and if Upper is nil they are
+	// not bounded from above. Otherwise they must have the same length as the
+	// problem dimension, and individual entries may be infinite.
+	Lower, Upper []float64
+	// Tolerance sets the threshold for stopping when the population has
+	// converged. The method converges when the standard deviation of the
+	// function values of the population is less than Tolerance·(1 + |mean|).
+	// If Tolerance is zero, it is defaulted to 1e-12, and if it is NaN the
+	// criterion is not used.
+	Tolerance float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	dim, pop     int
+	mutation, cr float64
+	tol          float64
+	status       Status
+	xs, trials   *mat.Dense
+	fs, trialF   []float64
+	bestX        []float64
+	bestF        float64
+	rnd          *rand.Rand
+	lower, upper []float64
+	initStepSize float64
+}
+
+func (de *DifferentialEvolution) Status() (Status, error) {
+	return de.status, nil
+}
+
+func (*DifferentialEvolution) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (de *DifferentialEvolution) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(de.Lower, de.Upper, dim)
+	de.dim = dim
+	de.pop = de.Population
+	if de.pop == 0 {
+		de.pop = max(10*dim, 5)
+	}
+	if de.pop < 4 {
+		panic("optimize: differential evolution population smaller than 4")
+	}
+	de.mutation = de.Mutation
+	if de.mutation == 0 {
+		de.mutation = 0.8
+	}
+	if de.mutation < 0 || de.mutation > 2 {
+		panic("optimize: differential evolution mutation out of range")
+	}
+	de.cr = de.Crossover
+	if de.cr == 0 {
+		de.cr = 0.9
+	}
+	if de.cr < 0 || de.cr > 1 {
+		panic("optimize: differential evolution crossover out of range")
+	}
+	de.initStepSize = de.InitStepSize
+	if de.initStepSize == 0 {
+		de.initStepSize = 1
+	}
+	de.tol = de.Tolerance
+	if de.tol == 0 {
+		de.tol = 1e-12
+	}
+	de.lower, de.upper = de.Lower, de.Upper
+	de.status = NotTerminated
+	de.xs = mat.NewDense(de.pop, dim, nil)
+	de.trials = mat.NewDense(de.pop, dim, nil)
+	de.fs = resize(de.fs, de.pop)
+	de.trialF = resize(de.trialF, de.pop)
+	de.bestX = resize(de.bestX, dim)
+	de.bestF = math.Inf(1)
+	de.rnd = newRand(de.Src)
+	return min(tasks, de.pop)
+}
+
+func (de *DifferentialEvolution) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	p := newPopulationEvaluator(operation, result, tasks)
+	initPopulation(de.xs, tasks[0].X, de.lower, de.upper, de.initStepSize, de.rnd)
+	if !p.evaluate(de.xs, de.fs) {
+		p.finish()
+		return
+	}
+	de.updateBest(de.xs, de.fs)
+	for p.majorIteration(de.bestX, de.bestF) {
+		if populationConverged(de.fs, de.tol) {
+			de.status = MethodConverge
+			p.methodDone()
+			break
+		}
+		for i := 0; i < de.pop; i++ {
+			de.trial(de.trials.RawRowView(i), i)
+		}
+		if !p.evaluate(de.trials, de.trialF) {
+			break
+		}
+		de.updateBest(de.trials, de.trialF)
+		for i, f := range de.trialF {
+			// Replace the member if the trial is not worse. NaN values of
+			// the member are always replaced.
+			if f <= de.fs[i] || math.IsNaN(de.fs[i]) {
+				de.fs[i] = f
+				copy(de.xs.RawRowView(i), de.trials.RawRowView(i))
+			}
+		}
+	}
+	p.finish()
+}
+
+// trial stores into dst the trial location for the i-th member of the
+// population.
+func (de *DifferentialEvolution) trial(dst []float64, i int) {
+	// Choose three distinct members different from i.
+	var r [3]int
+	for k := range r {
+	Choose:
+		for {
+			r[k] = de.rnd.IntN(de.pop)
+			if r[k] == i {
+				continue
+			}
+			for _, prev := range r[:k] {
+				if r[k] == prev {
+					continue Choose
+				}
+			}
+			break
+		}
+	}
+	x := de.xs.RawRowView(i)
+	x1 := de.xs.RawRowView(r[0])
+	x2 := de.xs.RawRowView(r[1])
+	x3 := de.xs.RawRowView(r[2])
+	// At least one coordinate is taken from the mutant.
+	jr := de.rnd.IntN(de.dim)
+	for j := range dst {
+		if j != jr && de.rnd.Float64() >= de.cr {
+			dst[j] = x[j]
+			continue
+		}
+		v := x1[j] + de.mutation*(x2[j]-x3[j])
+		// Move coordinates that violate a bound halfway between the member
+		// and the bound.
+		if lo := lowerBound(de.lower, j); v < lo {
+			v = (x[j] + lo) / 2
+		}
+		if up := upperBound(de.upper, j); v > up {
+			v = (x[j] + up) / 2
+		}
+		dst[j] = v
+	}
+}
+
+// updateBest updates the best location found so far.
+func (de *DifferentialEvolution) updateBest(xs *mat.Dense, fs []float64) {
+	if i := bestIndex(fs); i != -1 && (fs[i] < de.bestF || math.IsInf(de.bestF, 1)) {
+		de.bestF = fs[i]
+		copy(de.bestX, xs.RawRowView(i))
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method      = (*Dogleg)(nil)
+	_ localMethod = (*Dogleg)(nil)
+)
+
+// Dogleg implements the dogleg trust-region method for Hessian-based
+// unconstrained minimization.
+//
+// At each iteration Dogleg approximately minimizes the quadratic model
+//
+//	m(p) = f_k + ∇f_kᵀp + ½pᵀH_kp
+//
+// within a trust region |p| ≤ Δ_k along the piecewise linear path from the
+// origin to the minimizer of the model along the steepest descent direction
+// (the Cauchy point) and then to the Newton step -H_k⁻¹∇f_k. The step is
+// accepted if the actual reduction of f is a sufficient fraction of the
+// reduction predicted by the model, and the radius Δ_k is updated based on
+// the agreement between the two.
+//
+// The dogleg path requires a positive definite Hessian. If the Hessian is not
+// positive definite, successively larger multiples of the identity are added
+// to it as in Newton, and the path is formed using the modified matrix.
+// Subspace2D makes better use of negative curvature.
+//
+// If steps keep being rejected until the radius Δ_k is negligible relative to
+// the current location, Dogleg fails with ErrTrustRegionCollapse.
+//
+// See Section 4.1 of Nocedal, Wright (2006), 2nd edition, for details.
+type Dogleg struct {
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is zero, it is defaulted to 1.
+	InitialRadius float64
+	// MaxRadius is the largest allowed radius of the trust region. If
+	// MaxRadius is zero, the radius is not bounded.
+	MaxRadius float64
+	// AcceptRatio is the smallest ratio between the actual and the predicted
+	// reduction for which a step is accepted. AcceptRatio must be in
+	// [0, 1/4). If AcceptRatio is zero, it is defaulted to 0.1.
+	AcceptRatio float64
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	tr   trustRegion
+	hess *mat.SymDense // Storage for the modified Hessian.
+	chol mat.Cholesky
+	pb   []float64 // Newton step.
+	pu   []float64 // Cauchy point.
+}
+
+func (d *Dogleg) Status() (Status, error) {
+	return d.status, d.err
+}
+
+func (*Dogleg) Uses(has Available) (uses Available, err error) {
+	return has.hessian()
+}
+
+func (d *Dogleg) Init(dim, tasks int) int {
+	d.status = NotTerminated
+	d.err = nil
+	return 1
+}
+
+func (d *Dogleg) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	d.status, d.err = localOptimizer{}.run(d, d.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+// TrustRegionStats returns the statistics of the current optimization run.
+func (d *Dogleg) TrustRegionStats() TrustRegionStats {
+	return d.tr.stats
+}
+
+func (d *Dogleg) initLocal(loc *Location) (Operation, error) {
+	dim := len(loc.X)
+	d.pb = resize(d.pb, dim)
+	d.pu = resize(d.pu, dim)
+	d.tr.solver = d
+	d.tr.useHess = true
+	return d.tr.init(loc, d.InitialRadius, d.MaxRadius, d.AcceptRatio)
+}
+
+func (d *Dogleg) iterateLocal(loc *Location) (Operation, error) {
+	return d.tr.iterate(loc)
+}
+
+func (d *Dogleg) start(p, g []float64, hess *mat.SymDense, radius float64) ([]float64, float64) {
+	n := len(g)
+	gv := mat.NewVecDense(n, g)
+	if !d.newtonStep(hess, gv) {
+		cauchyPoint(p, g, mat.Inner(gv, hess, gv), radius)
+		return nil, quadModel(g, hess, p)
+	}
+	if floats.Norm(d.pb, 2) <= radius {
+		copy(p, d.pb)
+		return nil, quadModel(g, hess, p)
+	}
+
+	// The unconstrained minimizer along the steepest descent direction.
+	gnorm := floats.Norm(g, 2)
+	floats.ScaleTo(d.pu, -gnorm*gnorm/mat.Inner(gv, d.hess, gv), g)
+	punorm := floats.Norm(d.pu, 2)
+	if punorm >= radius {
+		floats.ScaleTo(p, radius/punorm, d.pu)
+		return nil, quadModel(g, hess, p)
+	}
+	// Find the intersection of the segment from pu to pb with the boundary.
+	floats.SubTo(p, d.pb, d.pu)
+	_, tau := boundaryStep(d.pu, p, radius)
+	tau = math.Min(tau, 1)
+	floats.Scale(tau, p)
+	floats.Add(p, d.pu)
+	return nil, quadModel(g, hess, p)
+}
+
+// newtonStep stores the Newton step for the modified Hessian in pb. The
+// Hessian is modified by adding successively larger multiples of the identity
+// until it is positive definite as in Newton, and the modified matrix is stored
+// in d.hess. newtonStep returns false if the modification failed.
+func (d *Dogleg) newtonStep(hess *mat.SymDense, g *mat.VecDense) bool {
+	dim := hess.SymmetricDim()
+	minA := hess.At(0, 0)
+	for i := 1; i < dim; i++ {
+		minA = math.Min(minA, hess.At(i, i))
+	}
+	var tau float64
+	if minA <= 0 {
+		tau = -minA + 0.001
+	}
+	d.hess = resizeSymDense(d.hess, dim)
+	d.hess.CopySym(hess)
+	pb := mat.NewVecDense(dim, d.pb)
+	for k := 0; k < maxNewtonModifications; k++ {
+		if tau != 0 {
+			for i := 0; i < dim; i++ {
+				d.hess.SetSym(i, i, hess.At(i, i)+tau)
+			}
+		}
+		if d.chol.Factorize(d.hess) && d.chol.SolveVecTo(pb, g) == nil {
+			pb.ScaleVec(-1, pb)
+			return true
+		}
+		tau = math.Max(5*tau, 0.001)
+	}
+	return false
+}
+
+func (*Dogleg) next([]float64) ([]float64, float64) {
+	panic("optimize: unexpected Hessian-vector product")
+}
+
+func (*Dogleg) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, true}
+}
+
+// cauchyPoint stores into p the minimizer of the quadratic model along the
+// steepest descent direction within the trust region.
+func cauchyPoint(p, g []float64, gHg, radius float64) {
+	gnorm := floats.Norm(g, 2)
+	tau := 1.0
+	if gHg > 0 {
+		tau = math.Min(gnorm*gnorm*gnorm/(radius*gHg), 1)
+	}
+	floats.ScaleTo(p, -tau*radius/gnorm, g)
+}
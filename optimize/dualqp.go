@@ -0,0 +1,221 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	errQPNotConvex  = errors.New("optimize: quadratic subproblem is not strictly convex")
+	errQPInfeasible = errors.New("optimize: quadratic subproblem is infeasible")
+	errQPIterations = errors.New("optimize: quadratic subproblem failed to converge")
+)
+
+// dualQP solves the strictly convex quadratic program
+//
+//	minimize    ½ xᵀ G x + gᵀ x
+//	subject to  a_iᵀ x = b_i,  i < neq,
+//	            a_iᵀ x ≥ b_i,  i ≥ neq,
+//
+// where a_i is the i-th row of A, by the dual active-set method of Goldfarb
+// and Idnani. The solution is stored into x and the Lagrange multipliers
+// into lambda, so that at the solution
+//
+//	G x + g = Aᵀ λ,
+//
+// with λ_i ≥ 0 for the inequality constraints.
+//
+// The dual method starts from the unconstrained minimum and adds violated
+// constraints to the active set one at a time, so it does not need a feasible
+// starting point and it detects infeasibility of the constraints.
+//
+// References:
+//   - Goldfarb, D., & Idnani, A. (1983). A numerically stable dual method for
+//     solving strictly convex quadratic programs. Mathematical Programming,
+//     27(1), 1-33.
+type dualQP struct {
+	chol mat.Cholesky
+
+	active []int     // Indices of the active constraints.
+	sign   []float64 // Orientation of the constraints, ±1.
+	u      []float64 // Multipliers of the active constraints.
+}
+
+func (q *dualQP) solve(x, lambda []float64, G *mat.SymDense, g []float64, A *mat.Dense, b []float64, neq int) error {
+	n := len(x)
+	m := len(b)
+	if !q.chol.Factorize(G) {
+		return errQPNotConvex
+	}
+
+	// Start from the unconstrained minimum.
+	xv := mat.NewVecDense(n, x)
+	err := q.chol.SolveVecTo(xv, mat.NewVecDense(n, g))
+	if err != nil {
+		return errQPNotConvex
+	}
+	floats.Scale(-1, x)
+
+	q.active = q.active[:0]
+	q.u = q.u[:0]
+	q.sign = resize(q.sign, m)
+	for i := range q.sign {
+		q.sign[i] = 1
+	}
+	for i := range lambda {
+		lambda[i] = 0
+	}
+	if m == 0 {
+		return nil
+	}
+
+	np := make([]float64, n)
+	z := make([]float64, n)
+	ginvNp := make([]float64, n)
+	isActive := make([]bool, m)
+	maxIter := 10 * (m + n)
+	for iter := 0; ; iter++ {
+		if iter == maxIter {
+			return errQPIterations
+		}
+		// Select the next violated constraint, equality constraints first.
+		p := -1
+		worst := 0.0
+		for i := 0; i < m; i++ {
+			if isActive[i] {
+				continue
+			}
+			a := A.RawRowView(i)
+			s := floats.Dot(a, x) - b[i]
+			tol := 1e-13 * math.Max(1, math.Max(math.Abs(b[i]), floats.Norm(a, math.Inf(1))*floats.Norm(x, math.Inf(1))))
+			if i < neq {
+				if math.Abs(s) > tol {
+					p = i
+					q.sign[i] = 1
+					if s > 0 {
+						q.sign[i] = -1
+					}
+					break
+				}
+				continue
+			}
+			if s < -tol && s/floats.Norm(a, 2) < worst {
+				p = i
+				worst = s / floats.Norm(a, 2)
+			}
+		}
+		if p < 0 {
+			break
+		}
+
+		floats.ScaleTo(np, q.sign[p], A.RawRowView(p))
+		bp := q.sign[p] * b[p]
+		uplus := append(q.u, 0)
+		for {
+			// Compute the step direction z in the primal space and the
+			// negative step direction r in the dual space.
+			r, err := q.directions(z, ginvNp, np, A)
+			if err != nil {
+				return err
+			}
+
+			// Find the largest step that keeps the multipliers of the
+			// active inequality constraints non-negative.
+			t1 := math.Inf(1)
+			l := -1
+			for j, k := range q.active {
+				if k < neq || r[j] <= 0 {
+					continue
+				}
+				if t := uplus[j] / r[j]; t < t1 {
+					t1 = t
+					l = j
+				}
+			}
+			// Find the step that satisfies the constraint p.
+			t2 := math.Inf(1)
+			if floats.Norm(z, math.Inf(1)) > 1e-12*floats.Norm(ginvNp, math.Inf(1)) {
+				t2 = -(floats.Dot(np, x) - bp) / floats.Dot(z, np)
+			}
+			if math.IsInf(t1, 1) && math.IsInf(t2, 1) {
+				return errQPInfeasible
+			}
+			t := math.Min(t1, t2)
+			if !math.IsInf(t2, 1) {
+				floats.AddScaled(x, t, z)
+			}
+			for j := range r {
+				uplus[j] -= t * r[j]
+			}
+			uplus[len(uplus)-1] += t
+			if t2 <= t1 {
+				// Full step, add the constraint to the active set.
+				q.active = append(q.active, p)
+				isActive[p] = true
+				q.u = uplus
+				break
+			}
+			// Partial step, drop the blocking constraint.
+			isActive[q.active[l]] = false
+			q.active = append(q.active[:l], q.active[l+1:]...)
+			uplus = append(uplus[:l], uplus[l+1:]...)
+			q.u = uplus[:len(uplus)-1]
+		}
+	}
+	for j, k := range q.active {
+		lambda[k] = q.sign[k] * q.u[j]
+	}
+	return nil
+}
+
+// directions computes the primal step direction z = H n and returns the dual
+// step direction r = N* n, where N is the matrix of active constraint normals,
+//
+//	N* = (Nᵀ G⁻¹ N)⁻¹ Nᵀ G⁻¹,
+//	H  = G⁻¹ (I - N N*).
+//
+// ginvN is used to store G⁻¹ n.
+func (q *dualQP) directions(z, ginvN, n []float64, A *mat.Dense) ([]float64, error) {
+	dim := len(z)
+	err := q.chol.SolveVecTo(mat.NewVecDense(dim, ginvN), mat.NewVecDense(dim, n))
+	if err != nil {
+		return nil, errQPNotConvex
+	}
+	copy(z, ginvN)
+	na := len(q.active)
+	if na == 0 {
+		return nil, nil
+	}
+	nmat := mat.NewDense(dim, na, nil)
+	for j, k := range q.active {
+		for i, v := range A.RawRowView(k) {
+			nmat.Set(i, j, q.sign[k]*v)
+		}
+	}
+	var ginvNmat mat.Dense
+	err = q.chol.SolveTo(&ginvNmat, nmat)
+	if err != nil {
+		return nil, errQPNotConvex
+	}
+	var m mat.Dense
+	m.Mul(nmat.T(), &ginvNmat)
+	rhs := mat.NewVecDense(na, nil)
+	rhs.MulVec(ginvNmat.T(), mat.NewVecDense(dim, n))
+	r := mat.NewVecDense(na, nil)
+	err = r.SolveVec(&m, rhs)
+	if err != nil {
+		return nil, errQPInfeasible
+	}
+	zv := mat.NewVecDense(dim, z)
+	var tmp mat.VecDense
+	tmp.MulVec(&ginvNmat, r)
+	zv.SubVec(zv, &tmp)
+	return r.RawVector().Data, nil
+}
@@ -32,6 +32,11 @@ var (
 	// lies out of allowed bounds.
 	ErrLinesearcherBound = errors.New("linesearch: step out of bounds")
 
+	// ErrTrustRegionCollapse signifies that a trust-region method cannot make
+	// further progress because its trust region has become too small, relative
+	// to the location, for a step to be resolved in floating-point arithmetic.
+	ErrTrustRegionCollapse = errors.New("optimize: trust region too small to make progress")
+
 	// ErrMissingGrad signifies that a Method requires a Gradient function that
 	// is not supplied by Problem.
 	ErrMissingGrad = errors.New("optimize: problem does not provide needed Grad function")
@@ -39,6 +44,19 @@ var (
 	// ErrMissingHess signifies that a Method requires a Hessian function that
 	// is not supplied by Problem.
 	ErrMissingHess = errors.New("optimize: problem does not provide needed Hess function")
+
+	// ErrMissingJacobian signifies that a Method requires a constraint
+	// Jacobian function that is not supplied by Problem.
+	ErrMissingJacobian = errors.New("optimize: problem does not provide needed constraint Jacobian function")
+
+	// ErrConstrained signifies that a Method for unconstrained optimization
+	// has been used with a Problem that has constraints.
+	ErrConstrained = errors.New("optimize: method does not support constrained problems")
+
+	// ErrNotSnapshotter signifies that a Method that does not implement
+	// Snapshotter has been used with Settings.Checkpoint or
+	// Settings.Resume.
+	ErrNotSnapshotter = errors.New("optimize: method does not support checkpoints")
 )
 
 // ErrFunc is returned when an initial function value is invalid. The error
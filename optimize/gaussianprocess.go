@@ -0,0 +1,327 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	// Bounds on the hyperparameters of the Gaussian process for inputs
+	// scaled to the unit hypercube and standardized outputs.
+	gpMinLength, gpMaxLength = 1e-2, 1e1
+	gpMinSignal, gpMaxSignal = 5e-2, 2e1
+	gpMinNoise, gpMaxNoise   = 1e-8, 1
+
+	// gpMaxJitter is the largest diagonal jitter added to the kernel
+	// matrix when its Cholesky factorization fails.
+	gpMaxJitter = 1e-2
+
+	gpFitIterations = 100
+)
+
+// gaussianProcess is a Gaussian process regression model with a constant
+// mean and an anisotropic Matérn 5/2 covariance
+//
+//	k(u, v) = σ_f² (1 + √5 r + 5r²/3) exp(-√5 r),   r² = Σ_j (u_j - v_j)²/ℓ_j²,
+//
+// observed with noise of variance σ_n². The observed values are
+// standardized to zero mean and unit variance before fitting.
+//
+// The hyperparameters θ = (log ℓ, log σ_f², log σ_n²) are estimated by
+// maximizing the marginal likelihood of the observations.
+//
+// Reference:
+//
+//	Rasmussen, C. E., Williams, C. K. I. (2006). Gaussian Processes for
+//	Machine Learning. MIT Press.
+type gaussianProcess struct {
+	dim   int
+	theta []float64
+
+	xs    [][]float64 // Observed inputs.
+	ys    []float64   // Standardized observed values.
+	mean  float64     // Mean of the observed values.
+	scale float64     // Standard deviation of the observed values.
+
+	chol  mat.Cholesky
+	kinv  mat.SymDense
+	alpha *mat.VecDense // K⁻¹ y.
+
+	kstar []float64
+	dk    *mat.Dense
+	v     *mat.VecDense
+}
+
+func newGaussianProcess(dim int) *gaussianProcess {
+	gp := &gaussianProcess{
+		dim:   dim,
+		theta: make([]float64, dim+2),
+	}
+	for j := 0; j < dim; j++ {
+		gp.theta[j] = math.Log(0.5)
+	}
+	gp.theta[dim] = 0
+	gp.theta[dim+1] = math.Log(1e-6)
+	return gp
+}
+
+// setData sets the observations of the model, standardizing fs. Values of fs
+// that are not finite are replaced by the largest finite value.
+func (gp *gaussianProcess) setData(xs [][]float64, fs []float64) {
+	gp.xs = append(gp.xs[:0], xs...)
+	gp.ys = resize(gp.ys, len(fs))
+	worst := math.Inf(-1)
+	for _, f := range fs {
+		if !math.IsInf(f, 0) && !math.IsNaN(f) {
+			worst = math.Max(worst, f)
+		}
+	}
+	if math.IsInf(worst, -1) {
+		worst = 0
+	}
+	for i, f := range fs {
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			f = worst
+		}
+		gp.ys[i] = f
+	}
+	gp.mean = floats.Sum(gp.ys) / float64(len(gp.ys))
+	var ss float64
+	for _, y := range gp.ys {
+		ss += (y - gp.mean) * (y - gp.mean)
+	}
+	gp.scale = math.Sqrt(ss / float64(len(gp.ys)))
+	if gp.scale == 0 {
+		gp.scale = 1
+	}
+	for i, y := range gp.ys {
+		gp.ys[i] = (y - gp.mean) / gp.scale
+	}
+}
+
+// add appends an observation with the standardized value y to the model and
+// updates the factorization, keeping the hyperparameters fixed.
+func (gp *gaussianProcess) add(x []float64, y float64) {
+	gp.xs = append(gp.xs, x)
+	gp.ys = append(gp.ys, y)
+	gp.factorize(gp.theta)
+}
+
+// fit estimates the hyperparameters by maximum marginal likelihood, starting
+// from the current estimate, and factorizes the kernel matrix.
+func (gp *gaussianProcess) fit() {
+	dim := gp.dim
+	lower := make([]float64, dim+2)
+	upper := make([]float64, dim+2)
+	for j := 0; j < dim; j++ {
+		lower[j], upper[j] = math.Log(gpMinLength), math.Log(gpMaxLength)
+	}
+	lower[dim], upper[dim] = math.Log(gpMinSignal), math.Log(gpMaxSignal)
+	lower[dim+1], upper[dim+1] = math.Log(gpMinNoise), math.Log(gpMaxNoise)
+	projectBounds(gp.theta, lower, upper)
+
+	p := Problem{
+		Func: func(theta []float64) float64 {
+			return gp.negLogLikelihood(nil, theta)
+		},
+		Grad: func(grad, theta []float64) {
+			gp.negLogLikelihood(grad, theta)
+		},
+	}
+	settings := &Settings{
+		MajorIterations: gpFitIterations,
+		Converger:       &FunctionConverge{Absolute: 1e-6, Iterations: 5},
+	}
+	f0 := gp.negLogLikelihood(nil, gp.theta)
+	res, err := Minimize(p, gp.theta, settings, &LBFGSB{Lower: lower, Upper: upper})
+	// A failed fit keeps the current hyperparameters unless it has found
+	// a better location.
+	if res != nil && (err == nil || res.F < f0) && !math.IsInf(res.F, 0) && !math.IsNaN(res.F) {
+		copy(gp.theta, res.X)
+	}
+	gp.factorize(gp.theta)
+}
+
+// kernel returns the covariance between u and v for the hyperparameters
+// theta. If dk is not nil, kernel stores into it the derivative of the
+// covariance with respect to u.
+func (gp *gaussianProcess) kernel(dk, u, v, theta []float64) float64 {
+	var r2 float64
+	for j := range u {
+		d := (u[j] - v[j]) / math.Exp(theta[j])
+		r2 += d * d
+	}
+	s2 := math.Exp(theta[gp.dim])
+	r := math.Sqrt(r2)
+	e := math.Exp(-math.Sqrt(5) * r)
+	if dk != nil {
+		// dk/du_j = -σ_f² 5/3 (1 + √5 r) exp(-√5 r) (u_j - v_j)/ℓ_j².
+		g := s2 * 5 / 3 * (1 + math.Sqrt(5)*r) * e
+		for j := range u {
+			l := math.Exp(theta[j])
+			dk[j] = -g * (u[j] - v[j]) / (l * l)
+		}
+	}
+	return s2 * (1 + math.Sqrt(5)*r + 5*r2/3) * e
+}
+
+// kernelMatrix stores the covariance matrix of the observations for the
+// hyperparameters theta into k, with the noise variance and jitter added
+// to the diagonal.
+func (gp *gaussianProcess) kernelMatrix(k *mat.SymDense, theta []float64, jitter float64) {
+	n := len(gp.xs)
+	noise := math.Exp(theta[gp.dim+1]) + jitter
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := gp.kernel(nil, gp.xs[i], gp.xs[j], theta)
+			if i == j {
+				v += noise
+			}
+			k.SetSym(i, j, v)
+		}
+	}
+}
+
+// factorize computes the Cholesky factorization of the kernel matrix for
+// the hyperparameters theta, adding jitter to the diagonal if necessary,
+// and the weights of the predictive mean. It returns false if the matrix
+// could not be factorized.
+func (gp *gaussianProcess) factorize(theta []float64) bool {
+	n := len(gp.xs)
+	k := mat.NewSymDense(n, nil)
+	for jitter := 0.0; jitter <= gpMaxJitter; jitter = math.Max(10*jitter, 1e-10) {
+		gp.kernelMatrix(k, theta, jitter)
+		if gp.chol.Factorize(k) {
+			gp.alpha = mat.NewVecDense(n, nil)
+			err := gp.chol.SolveVecTo(gp.alpha, mat.NewVecDense(n, gp.ys))
+			return err == nil
+		}
+	}
+	return false
+}
+
+// negLogLikelihood returns the negative log marginal likelihood of the
+// observations for the hyperparameters theta, up to a constant. If grad is
+// not nil, the gradient with respect to theta is stored into it.
+func (gp *gaussianProcess) negLogLikelihood(grad, theta []float64) float64 {
+	if !gp.factorize(theta) {
+		if grad != nil {
+			for i := range grad {
+				grad[i] = 0
+			}
+		}
+		return math.Inf(1)
+	}
+	n := len(gp.xs)
+	y := mat.NewVecDense(n, gp.ys)
+	nll := (mat.Dot(y, gp.alpha) + gp.chol.LogDet()) / 2
+	if grad == nil {
+		return nll
+	}
+
+	// ∂(-log L)/∂θ = -tr((ααᵀ - K⁻¹) ∂K/∂θ)/2.
+	gp.kinv.Reset()
+	err := gp.chol.InverseTo(&gp.kinv)
+	if err != nil {
+		for i := range grad {
+			grad[i] = 0
+		}
+		return nll
+	}
+	for i := range grad {
+		grad[i] = 0
+	}
+	dim := gp.dim
+	s2 := math.Exp(theta[dim])
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			w := gp.alpha.AtVec(i)*gp.alpha.AtVec(j) - gp.kinv.At(i, j)
+			if i != j {
+				w *= 2
+			}
+			if i == j {
+				grad[dim+1] -= w * math.Exp(theta[dim+1]) / 2
+			}
+			// ∂k/∂log ℓ_l = σ_f² 5/3 (1 + √5 r) exp(-√5 r) d_l²/ℓ_l² and
+			// ∂k/∂log σ_f² = k.
+			u, v := gp.xs[i], gp.xs[j]
+			var r2 float64
+			for l := 0; l < dim; l++ {
+				d := (u[l] - v[l]) / math.Exp(theta[l])
+				r2 += d * d
+			}
+			r := math.Sqrt(r2)
+			e := math.Exp(-math.Sqrt(5) * r)
+			g := s2 * 5 / 3 * (1 + math.Sqrt(5)*r) * e
+			for l := 0; l < dim; l++ {
+				d := (u[l] - v[l]) / math.Exp(theta[l])
+				grad[l] -= w * g * d * d / 2
+			}
+			grad[dim] -= w * s2 * (1 + math.Sqrt(5)*r + 5*r2/3) * e / 2
+		}
+	}
+	return nll
+}
+
+// predict returns the standardized predictive mean and standard deviation
+// of the latent function at u. If dMean and dStd are not nil, the
+// derivatives of the mean and the standard deviation with respect to u are
+// stored into them.
+func (gp *gaussianProcess) predict(dMean, dStd, u []float64) (mean, std float64) {
+	n := len(gp.xs)
+	gp.kstar = resize(gp.kstar, n)
+	withGrad := dMean != nil
+	if withGrad {
+		if gp.dk == nil || gp.dk.RawMatrix().Rows != n {
+			gp.dk = mat.NewDense(n, gp.dim, nil)
+		}
+	}
+	for i, x := range gp.xs {
+		var dk []float64
+		if withGrad {
+			dk = gp.dk.RawRowView(i)
+		}
+		gp.kstar[i] = gp.kernel(dk, u, x, gp.theta)
+	}
+	kstar := mat.NewVecDense(n, gp.kstar)
+	mean = mat.Dot(kstar, gp.alpha)
+	if gp.v == nil || gp.v.Len() != n {
+		gp.v = mat.NewVecDense(n, nil)
+	}
+	err := gp.chol.SolveVecTo(gp.v, kstar)
+	if err != nil {
+		gp.v.Zero()
+	}
+	variance := math.Exp(gp.theta[gp.dim]) - mat.Dot(kstar, gp.v)
+	std = math.Sqrt(math.Max(variance, 0))
+	if !withGrad {
+		return mean, std
+	}
+	for j := range dMean {
+		dMean[j] = 0
+		dStd[j] = 0
+	}
+	for i := 0; i < n; i++ {
+		a, v := gp.alpha.AtVec(i), gp.v.AtVec(i)
+		for j, d := range gp.dk.RawRowView(i) {
+			dMean[j] += a * d
+			dStd[j] -= v * d
+		}
+	}
+	// ∂σ/∂u = -(K⁻¹k*)ᵀ ∂k*/∂u / σ. The derivative is set to zero where
+	// the predictive variance vanishes.
+	if std > 0 {
+		floats.Scale(1/std, dStd)
+	} else {
+		for j := range dStd {
+			dStd[j] = 0
+		}
+	}
+	return mean, std
+}
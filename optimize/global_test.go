@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+// rastriginGrad is the gradient of functions.Rastrigin.
+func rastriginGrad(grad, x []float64) {
+	for i, v := range x {
+		grad[i] = 2*v + 20*math.Pi*math.Sin(2*math.Pi*v)
+	}
+}
+
+type globalTest struct {
+	name     string
+	problem  Problem
+	initX    []float64
+	method   func(src rand.Source) Method
+	optX     []float64
+	optF     float64
+	tol      float64
+	settings *Settings
+}
+
+func globalTests() []globalTest {
+	rastrigin := Problem{Func: functions.Rastrigin{}.Func}
+	rastriginWithGrad := Problem{
+		Func: functions.Rastrigin{}.Func,
+		Grad: rastriginGrad,
+	}
+	// shifted has its unconstrained minimum at (2, 2, 2) outside of the
+	// bounds used below.
+	shifted := Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for _, v := range x {
+				f += (v - 2) * (v - 2)
+			}
+			return f
+		},
+	}
+	lower := []float64{-5.12, -5.12}
+	upper := []float64{5.12, 5.12}
+	// bowl has a single minimum at (1, -0.5) within the bounds used below.
+	bowl := Problem{
+		Func: func(x []float64) float64 {
+			a, b := x[0]-1, x[1]+0.5
+			return a*a + b*b - math.Cos(3*a)
+		},
+	}
+	return []globalTest{
+		{
+			name:    "DifferentialEvolution",
+			problem: rastrigin,
+			initX:   []float64{3.2, -2.1},
+			method: func(src rand.Source) Method {
+				return &DifferentialEvolution{Lower: lower, Upper: upper, Src: src}
+			},
+			optX: []float64{0, 0},
+			tol:  1e-4,
+		},
+		{
+			name:    "DifferentialEvolutionBounds",
+			problem: shifted,
+			initX:   []float64{0, 0, 0},
+			method: func(src rand.Source) Method {
+				return &DifferentialEvolution{
+					Lower: []float64{-1, -1, math.Inf(-1)},
+					Upper: []float64{1, 1.5, 1},
+					Src:   src,
+				}
+			},
+			optX: []float64{1, 1.5, 1},
+			optF: 2.25,
+			tol:  1e-4,
+		},
+		{
+			name:    "ParticleSwarm",
+			problem: rastrigin,
+			initX:   []float64{3.2, -2.1},
+			method: func(src rand.Source) Method {
+				return &ParticleSwarm{Lower: lower, Upper: upper, Src: src}
+			},
+			optX: []float64{0, 0},
+			tol:  1e-4,
+		},
+		{
+			name:    "ParticleSwarmBounds",
+			problem: shifted,
+			initX:   []float64{0, 0, 0},
+			method: func(src rand.Source) Method {
+				return &ParticleSwarm{
+					Lower: []float64{-1, -1, math.Inf(-1)},
+					Upper: []float64{1, 1.5, 1},
+					Src:   src,
+				}
+			},
+			optX: []float64{1, 1.5, 1},
+			optF: 2.25,
+			tol:  1e-4,
+		},
+		{
+			name:    "SimulatedAnnealing",
+			problem: rastrigin,
+			initX:   []float64{3.2, -2.1},
+			method: func(src rand.Source) Method {
+				return &SimulatedAnnealing{Cooling: 0.97, Lower: lower, Upper: upper, Src: src}
+			},
+			// The best location is not refined once the random walk has
+			// left the global basin.
+			optX: []float64{0, 0},
+			tol:  5e-2,
+			settings: &Settings{
+				Converger:       NeverTerminate{},
+				MajorIterations: 200,
+			},
+		},
+		{
+			name:    "SimulatedAnnealingBatch",
+			problem: shifted,
+			initX:   []float64{0, 0, 0},
+			method: func(src rand.Source) Method {
+				return &SimulatedAnnealing{
+					Batch: 4,
+					Lower: []float64{-1, -1, math.Inf(-1)},
+					Upper: []float64{1, 1.5, 1},
+					Src:   src,
+				}
+			},
+			optX: []float64{1, 1.5, 1},
+			optF: 2.25,
+			tol:  1e-2,
+			settings: &Settings{
+				Converger:       NeverTerminate{},
+				MajorIterations: 200,
+			},
+		},
+		{
+			name:    "BayesianOptimization",
+			problem: bowl,
+			initX:   []float64{-1, 1},
+			method: func(src rand.Source) Method {
+				return &BayesianOptimization{
+					Lower: []float64{-2, -2},
+					Upper: []float64{3, 2},
+					Batch: 1,
+					Src:   src,
+				}
+			},
+			optX: []float64{1, -0.5},
+			optF: -1,
+			tol:  1e-2,
+			settings: &Settings{
+				FuncEvaluations: 30,
+			},
+		},
+		{
+			name:    "BayesianOptimizationBatch",
+			problem: bowl,
+			initX:   []float64{-1, 1},
+			method: func(src rand.Source) Method {
+				return &BayesianOptimization{
+					Lower:       []float64{-2, -2},
+					Upper:       []float64{3, 2},
+					Batch:       3,
+					Acquisition: UpperConfidenceBound{},
+					Src:         src,
+				}
+			},
+			optX: []float64{1, -0.5},
+			optF: -1,
+			tol:  1e-2,
+			settings: &Settings{
+				FuncEvaluations: 40,
+			},
+		},
+		{
+			name:    "BasinHopping",
+			problem: rastriginWithGrad,
+			initX:   []float64{3.2, -2.1},
+			method: func(src rand.Source) Method {
+				return &BasinHopping{StepSize: 2, Src: src}
+			},
+			optX: []float64{0, 0},
+			tol:  1e-6,
+			settings: &Settings{
+				Converger: &FunctionConverge{Absolute: 1e-10, Iterations: 20},
+			},
+		},
+		{
+			name:    "BasinHoppingHops",
+			problem: rastriginWithGrad,
+			initX:   []float64{3.2, -2.1},
+			method: func(src rand.Source) Method {
+				return &BasinHopping{Hops: 4, StepSize: 2, Lower: lower, Upper: upper, Src: src}
+			},
+			optX: []float64{0, 0},
+			tol:  1e-6,
+			settings: &Settings{
+				Converger: &FunctionConverge{Absolute: 1e-10, Iterations: 20},
+			},
+		},
+		{
+			name:    "BasinHoppingNelderMead",
+			problem: rastrigin,
+			initX:   []float64{3.2, -2.1},
+			method: func(src rand.Source) Method {
+				return &BasinHopping{Hops: 2, StepSize: 2, Src: src}
+			},
+			optX: []float64{0, 0},
+			tol:  1e-4,
+			settings: &Settings{
+				Converger: &FunctionConverge{Absolute: 1e-10, Iterations: 20},
+			},
+		},
+	}
+}
+
+func TestGlobalMethods(t *testing.T) {
+	t.Parallel()
+	for _, test := range globalTests() {
+		var first *Result
+		for _, concurrent := range []int{0, 0, 5} {
+			settings := &Settings{}
+			if test.settings != nil {
+				*settings = *test.settings
+			}
+			settings.Concurrent = concurrent
+			if settings.Converger == nil {
+				settings.Converger = &FunctionConverge{Absolute: 1e-10, Iterations: 100}
+			}
+			method := test.method(rand.NewPCG(1, 1))
+			result, err := Minimize(test.problem, test.initX, settings, method)
+			if err != nil {
+				t.Errorf("%s concurrent=%d: unexpected error: %v", test.name, concurrent, err)
+				continue
+			}
+			if !floats.EqualApprox(result.X, test.optX, test.tol) {
+				t.Errorf("%s concurrent=%d: unexpected minimizer: got %v, want %v", test.name, concurrent, result.X, test.optX)
+			}
+			if math.Abs(result.F-test.optF) > 10*test.tol {
+				t.Errorf("%s concurrent=%d: unexpected minimum: got %v, want %v", test.name, concurrent, result.F, test.optF)
+			}
+			// The evaluations are independent of the order in which they
+			// complete, so the same seed must give the same result.
+			if first == nil {
+				first = result
+				continue
+			}
+			if !floats.Equal(result.X, first.X) || result.F != first.F {
+				t.Errorf("%s concurrent=%d: result not reproducible: got %v, want %v", test.name, concurrent, result.X, first.X)
+			}
+		}
+	}
+}
+
+func TestBasinHoppingTermination(t *testing.T) {
+	t.Parallel()
+	// Terminate while the local minimizations are running.
+	for _, concurrent := range []int{0, 3, 8} {
+		method := &BasinHopping{
+			Hops:  4,
+			Local: func() Method { return &Newton{} },
+			Src:   rand.NewPCG(1, 1),
+		}
+		settings := &Settings{
+			Concurrent:      concurrent,
+			FuncEvaluations: 500,
+		}
+		problem := Problem{
+			Func: functions.Wood{}.Func,
+			Grad: functions.Wood{}.Grad,
+			Hess: functions.Wood{}.Hess,
+		}
+		result, err := Minimize(problem, []float64{-3, -1, -3, -1}, settings, method)
+		if err != nil {
+			t.Errorf("concurrent=%d: unexpected error: %v", concurrent, err)
+			continue
+		}
+		if result.Status != FunctionEvaluationLimit {
+			t.Errorf("concurrent=%d: unexpected status: got %v, want %v", concurrent, result.Status, FunctionEvaluationLimit)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vecfunc provides the evaluation of vector-valued functions and the
+// iteration control shared by the lsq and nonlin packages.
+package vecfunc // import "gonum.org/v1/gonum/optimize/internal/vecfunc"
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vecfunc
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// Evaluator evaluates a vector-valued function and its Jacobian, and counts
+// the evaluations.
+type Evaluator struct {
+	// Func evaluates the function at x and stores the result in y. Func
+	// must not modify x.
+	Func func(y, x []float64)
+	// Jacobian evaluates the Jacobian of the function at x and stores the
+	// result in-place in jac. If Jacobian is nil, it is approximated by
+	// finite differences using fd.Jacobian.
+	Jacobian func(jac *mat.Dense, x []float64)
+	// Status is called before every evaluation if it is not nil and can be
+	// used to terminate early.
+	Status func() (optimize.Status, error)
+
+	// MaxFuncEvaluations is the maximum number of evaluations of Func,
+	// including those used for finite difference Jacobians. If it is zero,
+	// the number of evaluations is not limited.
+	MaxFuncEvaluations int
+	// FiniteDifference holds the settings used to approximate the Jacobian
+	// if Jacobian is nil. The OriginValue field is ignored. If it is nil,
+	// the forward difference formula is used.
+	FiniteDifference *fd.JacobianSettings
+
+	FuncEvaluations     int // Number of evaluations of Func.
+	JacobianEvaluations int // Number of evaluations of the Jacobian.
+}
+
+// EvalFunc evaluates the function at x and stores the result into y. The
+// returned status is not NotTerminated and the function is not evaluated if
+// the run must be terminated.
+func (e *Evaluator) EvalFunc(y, x []float64) (optimize.Status, error) {
+	if status, err := e.CheckStatus(); status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	xc := make([]float64, len(x))
+	copy(xc, x)
+	e.Func(y, xc)
+	e.FuncEvaluations++
+	return optimize.NotTerminated, nil
+}
+
+// EvalJacobian evaluates the Jacobian at x, where the function value is y,
+// and stores it into jac, which must have the dimensions of the Jacobian.
+// The returned status is not NotTerminated if the run must be terminated.
+func (e *Evaluator) EvalJacobian(jac *mat.Dense, x, y []float64) (optimize.Status, error) {
+	if status, err := e.CheckStatus(); status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	xc := make([]float64, len(x))
+	copy(xc, x)
+	if e.Jacobian != nil {
+		e.Jacobian(jac, xc)
+	} else {
+		var settings fd.JacobianSettings
+		if e.FiniteDifference != nil {
+			settings = *e.FiniteDifference
+		}
+		settings.OriginValue = y
+		// The evaluations may be concurrent.
+		var evals atomic.Int64
+		fd.Jacobian(jac, func(y, x []float64) {
+			evals.Add(1)
+			e.Func(y, x)
+		}, xc, &settings)
+		e.FuncEvaluations += int(evals.Load())
+	}
+	e.JacobianEvaluations++
+	return e.checkEvaluations(), nil
+}
+
+// CheckStatus returns the status reported by Status and whether the limit
+// on the number of evaluations has been reached.
+func (e *Evaluator) CheckStatus() (optimize.Status, error) {
+	if e.Status != nil {
+		status, err := e.Status()
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+	}
+	return e.checkEvaluations(), nil
+}
+
+func (e *Evaluator) checkEvaluations() optimize.Status {
+	if e.MaxFuncEvaluations > 0 && e.FuncEvaluations >= e.MaxFuncEvaluations {
+		return optimize.FunctionEvaluationLimit
+	}
+	return optimize.NotTerminated
+}
+
+// Loop controls the iterations of a method and checks the termination
+// criteria common to the methods.
+type Loop struct {
+	// StepTolerance terminates the run with StepConvergence status if the
+	// norm of the step is less than StepTolerance*(|x| + StepTolerance).
+	StepTolerance float64
+	// MajorIterations and Runtime are the limits on the number of
+	// iterations and on the runtime. They have no effect if they are zero.
+	MajorIterations int
+	Runtime         time.Duration
+
+	Iterations int           // Number of completed iterations.
+	Elapsed    time.Duration // Runtime of the run.
+}
+
+// Run calls iterate until the run terminates, and returns the final status
+// and any error returned by iterate. The run terminates when iterate returns
+// a status other than NotTerminated or a non-nil error, or when one of the
+// termination criteria is met.
+//
+// Before each iteration, Run calls before and terminates with its status if
+// it is not NotTerminated. After each iteration it calls after in the same
+// way, and then checks the norm of step against the norm of x and the limits
+// on the iterations and the runtime. iterate must update x and step on a
+// successful iteration.
+func (l *Loop) Run(start time.Time, x, step []float64, before, after func() optimize.Status, iterate func() (optimize.Status, error)) (optimize.Status, error) {
+	for {
+		if status := before(); status != optimize.NotTerminated {
+			return status, nil
+		}
+		status, err := iterate()
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+		l.Iterations++
+		l.Elapsed = time.Since(start)
+		if status := after(); status != optimize.NotTerminated {
+			return status, nil
+		}
+		switch {
+		case floats.Norm(step, 2) <= l.StepTolerance*(floats.Norm(x, 2)+l.StepTolerance):
+			return optimize.StepConvergence, nil
+		case l.MajorIterations > 0 && l.Iterations >= l.MajorIterations:
+			return optimize.IterationLimit, nil
+		case l.Runtime > 0 && l.Elapsed >= l.Runtime:
+			return optimize.RuntimeLimit, nil
+		}
+	}
+}
+
+// IsFinite returns whether all elements of x are finite.
+func IsFinite(x []float64) bool {
+	for _, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
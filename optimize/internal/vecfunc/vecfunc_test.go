@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vecfunc
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+func TestEvaluator(t *testing.T) {
+	t.Parallel()
+	e := Evaluator{
+		Func: func(y, x []float64) {
+			y[0] = x[0] * x[1]
+			y[1] = x[0] + x[1]
+		},
+		MaxFuncEvaluations: 3,
+	}
+	x := []float64{2, 3}
+	y := make([]float64, 2)
+	status, err := e.EvalFunc(y, x)
+	if status != optimize.NotTerminated || err != nil {
+		t.Fatalf("unexpected status: %v, %v", status, err)
+	}
+	if !floats.Equal(y, []float64{6, 5}) {
+		t.Errorf("unexpected function value: got %v, want [6 5]", y)
+	}
+
+	// The forward difference Jacobian uses one evaluation per variable and
+	// reaches the limit on the number of evaluations.
+	jac := mat.NewDense(2, 2, nil)
+	status, err = e.EvalJacobian(jac, x, y)
+	if status != optimize.FunctionEvaluationLimit || err != nil {
+		t.Errorf("unexpected status after the Jacobian: %v, %v", status, err)
+	}
+	want := mat.NewDense(2, 2, []float64{3, 2, 1, 1})
+	if !mat.EqualApprox(jac, want, 1e-6) {
+		t.Errorf("unexpected Jacobian: got %v, want %v", mat.Formatted(jac), mat.Formatted(want))
+	}
+	if e.FuncEvaluations != 3 || e.JacobianEvaluations != 1 {
+		t.Errorf("unexpected evaluation counts: got %d and %d, want 3 and 1", e.FuncEvaluations, e.JacobianEvaluations)
+	}
+	status, _ = e.EvalFunc(y, x)
+	if status != optimize.FunctionEvaluationLimit || e.FuncEvaluations != 3 {
+		t.Errorf("evaluation limit not enforced: status %v after %d evaluations", status, e.FuncEvaluations)
+	}
+
+	errStop := errors.New("stop")
+	e = Evaluator{
+		Func:   func(y, x []float64) { t.Error("unexpected evaluation") },
+		Status: func() (optimize.Status, error) { return optimize.Failure, errStop },
+	}
+	status, err = e.EvalFunc(y, x)
+	if status != optimize.Failure || err != errStop {
+		t.Errorf("unexpected status from Status: %v, %v", status, err)
+	}
+}
+
+func TestLoop(t *testing.T) {
+	t.Parallel()
+	never := func() optimize.Status { return optimize.NotTerminated }
+	for _, test := range []struct {
+		name      string
+		loop      Loop
+		before    func() optimize.Status
+		after     func() optimize.Status
+		stepScale float64
+		want      optimize.Status
+		iters     int
+	}{
+		{
+			name:      "IterationLimit",
+			loop:      Loop{MajorIterations: 3},
+			before:    never,
+			after:     never,
+			stepScale: 1,
+			want:      optimize.IterationLimit,
+			iters:     3,
+		},
+		{
+			name:      "StepConvergence",
+			loop:      Loop{StepTolerance: 1e-3},
+			before:    never,
+			after:     never,
+			stepScale: 0.1,
+			want:      optimize.StepConvergence,
+			iters:     3,
+		},
+		{
+			name:      "Before",
+			loop:      Loop{MajorIterations: 10},
+			before:    func() optimize.Status { return optimize.GradientThreshold },
+			after:     never,
+			stepScale: 1,
+			want:      optimize.GradientThreshold,
+			iters:     0,
+		},
+		{
+			name:      "After",
+			loop:      Loop{StepTolerance: math.Inf(1)},
+			before:    never,
+			after:     func() optimize.Status { return optimize.FunctionConvergence },
+			stepScale: 1,
+			want:      optimize.FunctionConvergence,
+			iters:     1,
+		},
+	} {
+		x := []float64{1}
+		step := []float64{0}
+		size := 1.0
+		status, err := test.loop.Run(time.Now(), x, step, test.before, test.after, func() (optimize.Status, error) {
+			size *= test.stepScale
+			step[0] = size
+			x[0] += size
+			return optimize.NotTerminated, nil
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if status != test.want || test.loop.Iterations != test.iters {
+			t.Errorf("%s: unexpected termination: got %v after %d iterations, want %v after %d", test.name, status, test.loop.Iterations, test.want, test.iters)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package optimize
 
 import (
+	"errors"
+
 	"gonum.org/v1/gonum/floats"
 )
 
@@ -12,6 +14,7 @@ var (
 	_ Method          = (*LBFGS)(nil)
 	_ localMethod     = (*LBFGS)(nil)
 	_ NextDirectioner = (*LBFGS)(nil)
+	_ Snapshotter     = (*LBFGS)(nil)
 )
 
 // LBFGS implements the limited-memory BFGS method for gradient-based
@@ -52,6 +55,8 @@ type LBFGS struct {
 	s      [][]float64 // Last Store values of s
 	rho    []float64   // Last Store values of rho
 	a      []float64   // Cache of Hessian updates
+
+	resume bool // Indicator of a state restored from a snapshot
 }
 
 func (l *LBFGS) Status() (Status, error) {
@@ -65,11 +70,13 @@ func (*LBFGS) Uses(has Available) (uses Available, err error) {
 func (l *LBFGS) Init(dim, tasks int) int {
 	l.status = NotTerminated
 	l.err = nil
+	l.dim = 0
+	l.resume = false
 	return 1
 }
 
 func (l *LBFGS) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
-	l.status, l.err = localOptimizer{}.run(l, l.GradStopThreshold, operation, result, tasks)
+	l.status, l.err = localOptimizer{resume: l.resume}.run(l, l.GradStopThreshold, operation, result, tasks)
 	close(operation)
 }
 
@@ -95,6 +102,13 @@ func (l *LBFGS) iterateLocal(loc *Location) (Operation, error) {
 }
 
 func (l *LBFGS) InitDirection(loc *Location, dir []float64) (stepSize float64) {
+	if l.resume {
+		l.resume = false
+		if l.dim != 0 {
+			// Continue with the restored history.
+			return l.NextDirection(loc, dir)
+		}
+	}
 	dim := len(loc.X)
 	l.dim = dim
 	l.oldest = 0
@@ -197,3 +211,67 @@ func (*LBFGS) needs() struct {
 		Hessian  bool
 	}{true, false}
 }
+
+// lbfgsState is the encoded state of LBFGS.
+type lbfgsState struct {
+	Dim, Store int
+	Oldest     int
+	X, Grad    []float64
+	Y, S       [][]float64
+	Rho        []float64
+}
+
+// Snapshot returns an encoding of the state of the method at the last major
+// iteration.
+func (l *LBFGS) Snapshot() ([]byte, error) {
+	v := lbfgsState{Dim: l.dim}
+	if l.dim != 0 {
+		v.Store = l.Store
+		v.Oldest = l.oldest
+		v.X = l.x
+		v.Grad = l.grad
+		v.Y = l.y
+		v.S = l.s
+		v.Rho = l.rho
+	}
+	return encodeState(&v)
+}
+
+// Restore restores the state of the method from a snapshot. Restore must be
+// called after Init, and the snapshot must have been taken with the same
+// value of Store.
+func (l *LBFGS) Restore(data []byte) error {
+	var v lbfgsState
+	err := decodeState(data, &v)
+	if err != nil {
+		return err
+	}
+	if v.Dim < 0 {
+		return errBadSnapshot
+	}
+	if v.Dim != 0 {
+		store := l.Store
+		if store == 0 {
+			store = 15
+		}
+		if v.Store != store {
+			return errors.New("lbfgs: snapshot history size mismatch")
+		}
+		if v.Oldest < 0 || v.Oldest >= store || len(v.X) != v.Dim || len(v.Grad) != v.Dim ||
+			len(v.Y) != store || len(v.S) != store || len(v.Rho) != store {
+			return errBadSnapshot
+		}
+		for i := range v.Y {
+			if len(v.Y[i]) != v.Dim || len(v.S[i]) != v.Dim {
+				return errBadSnapshot
+			}
+		}
+		l.oldest = v.Oldest
+		l.x, l.grad = v.X, v.Grad
+		l.y, l.s, l.rho = v.Y, v.S, v.Rho
+		l.a = resize(l.a, store)
+	}
+	l.dim = v.Dim
+	l.resume = true
+	return nil
+}
@@ -0,0 +1,647 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// machEps is the machine epsilon for float64.
+const machEps = 0x1p-52
+
+var (
+	_ Method            = (*LBFGSB)(nil)
+	_ localMethod       = (*LBFGSB)(nil)
+	_ gradientProjector = (*LBFGSB)(nil)
+)
+
+// lbfgsbIterType is the kind of evaluation requested by LBFGSB.
+type lbfgsbIterType int
+
+const (
+	lbfgsbLinesearch lbfgsbIterType = iota
+	lbfgsbMajor
+)
+
+// lbfgsbMaxLinesearch is the largest number of evaluations in a line search,
+// as in the reference implementation.
+const lbfgsbMaxLinesearch = 20
+
+// LBFGSB implements the limited-memory BFGS method for gradient-based
+// minimization subject to simple bounds on the variables
+//
+//	Lower[i] <= x[i] <= Upper[i].
+//
+// At each iteration LBFGSB computes the generalized Cauchy point along the
+// projected steepest descent path of a limited-memory quadratic model of the
+// objective function, minimizes the model over the variables that are not at
+// a bound at the Cauchy point, and performs a line search along the resulting
+// feasible direction. The algorithm is described in
+//
+//	Byrd, R.H., Lu, P., Nocedal, J., Zhu, C.: A limited memory algorithm for
+//	bound constrained optimization. SIAM Journal on Scientific Computing 16(5)
+//	(1995), 1190-1208.
+//
+// Convergence is declared when the infinity norm of the projected gradient
+//
+//	x - P(x - ∇f(x)),
+//
+// where P is the projection onto the feasible box, is below the gradient
+// threshold. Settings.GradientThreshold is checked against the projected
+// gradient as well. If the initial location is not feasible, it is projected
+// onto the feasible box before the optimization starts.
+type LBFGSB struct {
+	// Lower and Upper are the bounds on the variables. If Lower is nil, the
+	// variables are not bounded from below, and if Upper is nil they are not
+	// bounded from above. Otherwise they must have the same length as the
+	// problem dimension, and individual entries may be infinite.
+	Lower, Upper []float64
+	// Store is the size of the limited-memory storage.
+	// If Store is 0, it will be defaulted to 10.
+	Store int
+	// GradStopThreshold sets the threshold for stopping if the projected
+	// gradient norm gets too small. If GradStopThreshold is 0 it is defaulted
+	// to 1e-12, and if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	store int // Size of the limited-memory storage.
+
+	status Status
+	err    error
+
+	ls   MoreThuente
+	iter lbfgsbIterType
+
+	dim   int
+	boxed bool      // All variables have finite lower and upper bounds.
+	first bool      // Indicator of the first iteration.
+	x     []float64 // Location at the last major iteration.
+	f     float64   // Function value at the last major iteration.
+	grad  []float64 // Gradient at the last major iteration.
+	dir   []float64 // Search direction for the current line search.
+	step  float64   // Step taken from x in the current line search.
+	evals int       // Number of evaluations in the current line search.
+
+	// Limited-memory representation of the Hessian approximation
+	//  B = theta*I - W*M*Wᵀ,
+	// where W = [Y theta*S] and M is the inverse of the 2k×2k matrix
+	//  [ -D    Lᵀ        ]
+	//  [  L    theta*SᵀS ],
+	// D is the diagonal of SᵀY and L is its strictly lower triangular part.
+	s, y  [][]float64 // History of location and gradient differences, oldest first.
+	theta float64
+	k     int // Number of stored pairs.
+	kmat  *mat.Dense
+	lu    mat.LU
+
+	// Storage for the generalized Cauchy point and subspace minimization.
+	xcp   []float64
+	d     []float64
+	t     []float64
+	order []int
+	r     []float64
+	p, c  []float64
+	w     []float64
+	mv    []float64
+}
+
+func (l *LBFGSB) Status() (Status, error) {
+	return l.status, l.err
+}
+
+func (*LBFGSB) Uses(has Available) (uses Available, err error) {
+	return has.gradient()
+}
+
+func (l *LBFGSB) Init(dim, tasks int) int {
+	checkBounds(l.Lower, l.Upper, dim)
+	l.store = l.Store
+	if l.store == 0 {
+		l.store = 10
+	}
+	if l.store < 0 {
+		panic("lbfgsb: negative store")
+	}
+	l.status = NotTerminated
+	l.err = nil
+	return 1
+}
+
+func (l *LBFGSB) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	if loc := tasks[0].Location; !isFeasible(loc.X, l.Lower, l.Upper) {
+		// Move the initial location into the feasible box before it is
+		// evaluated and sent as the first major iteration. Any values
+		// supplied for the infeasible location are discarded.
+		projectBounds(loc.X, l.Lower, l.Upper)
+		loc.Gradient = nil
+		loc.Hessian = nil
+		tasks[0].Op = NoOperation
+	}
+	l.status, l.err = localOptimizer{}.run(l, l.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (l *LBFGSB) projectGradient(dst []float64, loc *Location) {
+	boundedGradient(dst, loc.X, loc.Gradient, l.Lower, l.Upper)
+}
+
+func (l *LBFGSB) initLocal(loc *Location) (Operation, error) {
+	dim := len(loc.X)
+	l.dim = dim
+	l.boxed = l.Lower != nil && l.Upper != nil
+	for i := 0; i < dim && l.boxed; i++ {
+		if math.IsInf(l.Lower[i], 0) || math.IsInf(l.Upper[i], 0) {
+			l.boxed = false
+		}
+	}
+	l.first = true
+	l.x = resize(l.x, dim)
+	l.grad = resize(l.grad, dim)
+	l.dir = resize(l.dir, dim)
+	l.xcp = resize(l.xcp, dim)
+	l.d = resize(l.d, dim)
+	l.t = resize(l.t, dim)
+	l.r = resize(l.r, dim)
+	if cap(l.order) < dim {
+		l.order = make([]int, dim)
+	}
+	l.s = l.initHistory(l.s)
+	l.y = l.initHistory(l.y)
+	l.resetMemory()
+	l.storeLocation(loc)
+	return l.nextLinesearch(loc)
+}
+
+func (l *LBFGSB) initHistory(hist [][]float64) [][]float64 {
+	if cap(hist) < l.store {
+		hist = append(hist[:cap(hist)], make([][]float64, l.store-cap(hist))...)
+	}
+	hist = hist[:l.store]
+	for i := range hist {
+		hist[i] = resize(hist[i], l.dim)
+	}
+	return hist
+}
+
+// resetMemory discards the stored correction pairs.
+func (l *LBFGSB) resetMemory() {
+	l.k = 0
+	l.theta = 1
+}
+
+// storeLocation stores the location of the current major iteration.
+func (l *LBFGSB) storeLocation(loc *Location) {
+	copy(l.x, loc.X)
+	copy(l.grad, loc.Gradient)
+	l.f = loc.F
+}
+
+func (l *LBFGSB) iterateLocal(loc *Location) (Operation, error) {
+	switch l.iter {
+	case lbfgsbMajor:
+		return l.nextLinesearch(loc)
+	case lbfgsbLinesearch:
+		op, step, err := l.ls.Iterate(loc.F, floats.Dot(loc.Gradient, l.dir))
+		switch err {
+		case nil:
+		case ErrLinesearcherBound:
+			// The largest feasible step gives sufficient decrease. Accept it.
+			op = MajorIteration
+		default:
+			return l.restart(loc, err)
+		}
+		if op == MajorIteration {
+			l.updateMemory(loc)
+			l.storeLocation(loc)
+			l.iter = lbfgsbMajor
+			return MajorIteration, nil
+		}
+		l.evals++
+		if l.evals >= lbfgsbMaxLinesearch {
+			return l.restart(loc, ErrLinesearcherFailure)
+		}
+		l.step = step
+		op, err = l.evaluateStep(loc, op)
+		if err != nil {
+			return l.restart(loc, err)
+		}
+		return op, nil
+	default:
+		panic("unreachable")
+	}
+}
+
+// restart handles a failure of the line search. If there is curvature
+// information stored, it is discarded and a new line search is started from
+// the last major iteration along the projected steepest descent path.
+// Otherwise err is returned.
+func (l *LBFGSB) restart(loc *Location, err error) (Operation, error) {
+	if l.k == 0 {
+		return NoOperation, err
+	}
+	l.resetMemory()
+	copy(loc.X, l.x)
+	copy(loc.Gradient, l.grad)
+	loc.F = l.f
+	return l.nextLinesearch(loc)
+}
+
+// nextLinesearch computes a new search direction from the location of the
+// last major iteration and starts a line search along it.
+func (l *LBFGSB) nextLinesearch(loc *Location) (Operation, error) {
+	if !l.computeDirection() {
+		return l.restart(loc, ErrNoProgress)
+	}
+	projGrad := floats.Dot(l.grad, l.dir)
+	if projGrad >= 0 {
+		// The limited-memory model is inaccurate.
+		return l.restart(loc, ErrNonDescentDirection)
+	}
+
+	// Find the largest step that keeps the location feasible. The direction
+	// leads to a feasible point for a unit step so the maximum is at least one.
+	maxStep := math.Inf(1)
+	for i, v := range l.dir {
+		switch {
+		case v > 0:
+			maxStep = math.Min(maxStep, (upperBound(l.Upper, i)-l.x[i])/v)
+		case v < 0:
+			maxStep = math.Min(maxStep, (lowerBound(l.Lower, i)-l.x[i])/v)
+		}
+	}
+	maxStep = math.Min(math.Max(maxStep, 1), 1e10)
+	step := 1.0
+	if l.first && !l.boxed {
+		step = math.Min(1/floats.Norm(l.dir, 2), maxStep)
+	}
+	l.first = false
+
+	l.ls = MoreThuente{
+		DecreaseFactor:  1e-3,
+		CurvatureFactor: 0.9,
+		MaximumStep:     maxStep,
+	}
+	op := l.ls.Init(l.f, projGrad, step)
+	l.step = step
+	l.evals = 1
+	l.iter = lbfgsbLinesearch
+	op, err := l.evaluateStep(loc, op)
+	if err != nil {
+		return l.restart(loc, err)
+	}
+	return op, nil
+}
+
+// evaluateStep sets loc.X to the location at the current step along the
+// search direction and returns the evaluation op.
+func (l *LBFGSB) evaluateStep(loc *Location, op Operation) (Operation, error) {
+	floats.AddScaledTo(loc.X, l.x, l.step, l.dir)
+	// Guard against leaving the feasible region due to rounding errors.
+	projectBounds(loc.X, l.Lower, l.Upper)
+	if floats.Equal(loc.X, l.x) {
+		return NoOperation, ErrNoProgress
+	}
+	return op, nil
+}
+
+// updateMemory adds the correction pair from the last major iteration to loc
+// to the limited-memory storage if it satisfies the curvature condition.
+func (l *LBFGSB) updateMemory(loc *Location) {
+	var sy, yy, gs float64
+	for i := range loc.X {
+		s := loc.X[i] - l.x[i]
+		y := loc.Gradient[i] - l.grad[i]
+		sy += s * y
+		yy += y * y
+		gs += l.grad[i] * s
+	}
+	if sy <= machEps*-gs || yy == 0 {
+		// Skip the update to keep the Hessian approximation positive definite.
+		return
+	}
+	if l.k == l.store {
+		// Discard the oldest pair.
+		s0, y0 := l.s[0], l.y[0]
+		copy(l.s, l.s[1:])
+		copy(l.y, l.y[1:])
+		l.s[l.store-1], l.y[l.store-1] = s0, y0
+		l.k--
+	}
+	floats.SubTo(l.s[l.k], loc.X, l.x)
+	floats.SubTo(l.y[l.k], loc.Gradient, l.grad)
+	l.k++
+	l.theta = yy / sy
+}
+
+// factorizeMiddle forms and factorizes the middle matrix of the compact
+// limited-memory representation. It returns false if the matrix is singular.
+func (l *LBFGSB) factorizeMiddle() bool {
+	k := l.k
+	if k == 0 {
+		return true
+	}
+	if l.kmat == nil {
+		l.kmat = &mat.Dense{}
+	}
+	l.kmat.Reset()
+	l.kmat.ReuseAs(2*k, 2*k)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			var sy float64
+			if i >= j {
+				sy = floats.Dot(l.s[i], l.y[j])
+			}
+			switch {
+			case i == j:
+				l.kmat.Set(i, j, -sy)
+				l.kmat.Set(i, k+j, 0)
+			case i > j:
+				// L is the strictly lower triangle of SᵀY.
+				l.kmat.Set(k+i, j, sy)
+				l.kmat.Set(j, k+i, sy)
+				l.kmat.Set(i, j, 0)
+				l.kmat.Set(j, i, 0)
+			}
+			if i <= j {
+				ss := l.theta * floats.Dot(l.s[i], l.s[j])
+				l.kmat.Set(k+i, k+j, ss)
+				l.kmat.Set(k+j, k+i, ss)
+			}
+		}
+		l.kmat.Set(k+i, i, 0)
+	}
+	l.lu.Factorize(l.kmat)
+	return !math.IsInf(l.lu.Cond(), 1)
+}
+
+// wRow stores the i-th row of W = [Y theta*S] in dst.
+func (l *LBFGSB) wRow(dst []float64, i int) {
+	for j := 0; j < l.k; j++ {
+		dst[j] = l.y[j][i]
+		dst[l.k+j] = l.theta * l.s[j][i]
+	}
+}
+
+// mulMiddle stores M*v in dst.
+func (l *LBFGSB) mulMiddle(dst, v []float64) {
+	if len(v) == 0 {
+		return
+	}
+	// The factorization is known to be non-singular, so an error only
+	// reports ill-conditioning and the solution is still usable.
+	_ = l.lu.SolveVecTo(mat.NewVecDense(len(dst), dst), false, mat.NewVecDense(len(v), v))
+}
+
+// computeDirection computes the search direction at the last major iteration
+// by finding the generalized Cauchy point of the quadratic model and then
+// minimizing the model over the free variables. It returns false if no
+// direction of decrease exists.
+func (l *LBFGSB) computeDirection() bool {
+	if !l.factorizeMiddle() {
+		l.resetMemory()
+	}
+	k2 := 2 * l.k
+	l.p = resize(l.p, k2)
+	l.c = resize(l.c, k2)
+	l.w = resize(l.w, k2)
+	l.mv = resize(l.mv, k2)
+
+	l.cauchyPoint()
+	l.subspaceMinimization()
+
+	floats.SubTo(l.dir, l.xcp, l.x)
+	for _, v := range l.dir {
+		if v != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// cauchyPoint computes the generalized Cauchy point xcp, the first local
+// minimizer of the quadratic model along the projected steepest descent path
+// x(t) = P(x - t*g), using Algorithm CP of Byrd et al. It also computes
+// c = Wᵀ(xcp - x).
+func (l *LBFGSB) cauchyPoint() {
+	x, g := l.x, l.grad
+	theta := l.theta
+
+	// Compute the breakpoints.
+	order := l.order[:0]
+	var dd float64
+	for i, gi := range g {
+		ti := math.Inf(1)
+		switch {
+		case gi < 0:
+			if up := upperBound(l.Upper, i); !math.IsInf(up, 1) {
+				ti = (x[i] - up) / gi
+			}
+		case gi > 0:
+			if lo := lowerBound(l.Lower, i); !math.IsInf(lo, -1) {
+				ti = (x[i] - lo) / gi
+			}
+		default:
+			ti = math.Inf(1)
+		}
+		l.t[i] = ti
+		if ti == 0 {
+			l.d[i] = 0
+		} else {
+			l.d[i] = -gi
+			dd += gi * gi
+			if !math.IsInf(ti, 1) {
+				order = append(order, i)
+			}
+		}
+	}
+	sort.Slice(order, func(a, b int) bool { return l.t[order[a]] < l.t[order[b]] })
+
+	copy(l.xcp, x)
+	for i := range l.c {
+		l.c[i] = 0
+	}
+	// p = Wᵀd.
+	for i := range l.p {
+		l.p[i] = 0
+	}
+	for i, di := range l.d {
+		if di == 0 {
+			continue
+		}
+		l.wRow(l.w, i)
+		floats.AddScaled(l.p, di, l.w)
+	}
+
+	l.mulMiddle(l.mv, l.p)
+	fp := -dd
+	fpp := theta*dd - floats.Dot(l.p, l.mv)
+	fpp0 := fpp
+	dtMin := -fp / fpp
+	var tOld float64
+	free := len(order) // Number of variables with a finite breakpoint left.
+	for _, b := range order {
+		dt := l.t[b] - tOld
+		if dtMin < dt {
+			break
+		}
+		// Variable b becomes fixed at its bound.
+		if l.d[b] > 0 {
+			l.xcp[b] = upperBound(l.Upper, b)
+		} else {
+			l.xcp[b] = lowerBound(l.Lower, b)
+		}
+		zb := l.xcp[b] - x[b]
+		gb := g[b]
+		floats.AddScaled(l.c, dt, l.p)
+		l.wRow(l.w, b)
+		var wMc, wMp, wMw float64
+		if l.k > 0 {
+			l.mulMiddle(l.mv, l.c)
+			wMc = floats.Dot(l.w, l.mv)
+			l.mulMiddle(l.mv, l.p)
+			wMp = floats.Dot(l.w, l.mv)
+			l.mulMiddle(l.mv, l.w)
+			wMw = floats.Dot(l.w, l.mv)
+		}
+		fp += dt*fpp + gb*gb + theta*gb*zb - gb*wMc
+		fpp += -theta*gb*gb - 2*gb*wMp - gb*gb*wMw
+		fpp = math.Max(machEps*fpp0, fpp)
+		floats.AddScaled(l.p, gb, l.w)
+		l.d[b] = 0
+		dtMin = -fp / fpp
+		tOld = l.t[b]
+		free--
+	}
+	if free == 0 {
+		// All variables with a breakpoint are at their bounds, so the
+		// remaining path is a ray along which the model may be minimized.
+		remaining := false
+		for _, v := range l.d {
+			if v != 0 {
+				remaining = true
+				break
+			}
+		}
+		if !remaining {
+			dtMin = 0
+		}
+	}
+	dtMin = math.Max(dtMin, 0)
+	tOld += dtMin
+	for i, di := range l.d {
+		if di != 0 {
+			l.xcp[i] = x[i] + tOld*di
+		}
+	}
+	floats.AddScaled(l.c, dtMin, l.p)
+}
+
+// subspaceMinimization minimizes the quadratic model over the variables that
+// are free at the generalized Cauchy point using the direct primal method of
+// Byrd et al., and backtracks towards the Cauchy point so that the result is
+// feasible. The result is stored in xcp.
+func (l *LBFGSB) subspaceMinimization() {
+	theta := l.theta
+	k2 := 2 * l.k
+
+	// Reduced gradient r = Zᵀ(g + theta*(xcp - x) - W*M*c) on the free
+	// variables.
+	l.mulMiddle(l.mv, l.c)
+	var nFree int
+	for i := range l.x {
+		l.r[i] = 0
+		if l.atBound(i) {
+			continue
+		}
+		nFree++
+		l.wRow(l.w, i)
+		l.r[i] = l.grad[i] + theta*(l.xcp[i]-l.x[i]) - floats.Dot(l.w, l.mv)
+	}
+	if nFree == 0 {
+		return
+	}
+
+	// Solve B̂ d = -r with the Sherman-Morrison-Woodbury formula
+	//  B̂⁻¹ = 1/theta*I + 1/theta² * ZᵀW (I - 1/theta*M*WᵀZZᵀW)⁻¹ M WᵀZ.
+	var v []float64
+	if k2 > 0 {
+		wtzr := make([]float64, k2)
+		wtzzw := mat.NewDense(k2, k2, nil)
+		for i := range l.x {
+			if l.atBound(i) {
+				continue
+			}
+			l.wRow(l.w, i)
+			floats.AddScaled(wtzr, l.r[i], l.w)
+			for a := 0; a < k2; a++ {
+				row := wtzzw.RawRowView(a)
+				floats.AddScaled(row, l.w[a], l.w)
+			}
+		}
+		mwtzr := make([]float64, k2)
+		l.mulMiddle(mwtzr, wtzr)
+		// N = I - 1/theta*M*WᵀZZᵀW.
+		var n mat.Dense
+		_ = l.lu.SolveTo(&n, false, wtzzw)
+		n.Scale(-1/theta, &n)
+		for a := 0; a < k2; a++ {
+			n.Set(a, a, n.At(a, a)+1)
+		}
+		vec := mat.NewVecDense(k2, nil)
+		err := vec.SolveVec(&n, mat.NewVecDense(k2, mwtzr))
+		if _, ok := err.(mat.Condition); err == nil || ok {
+			v = vec.RawVector().Data
+		}
+	}
+
+	// Find the unconstrained subspace step and the largest fraction of it
+	// that keeps the location feasible.
+	alpha := 1.0
+	for i := range l.x {
+		if l.atBound(i) {
+			l.d[i] = 0
+			continue
+		}
+		di := -l.r[i] / theta
+		if v != nil {
+			l.wRow(l.w, i)
+			di -= floats.Dot(l.w, v) / (theta * theta)
+		}
+		l.d[i] = di
+		switch {
+		case di > 0:
+			alpha = math.Min(alpha, (upperBound(l.Upper, i)-l.xcp[i])/di)
+		case di < 0:
+			alpha = math.Min(alpha, (lowerBound(l.Lower, i)-l.xcp[i])/di)
+		}
+	}
+	for i, di := range l.d {
+		if di == 0 {
+			continue
+		}
+		xi := l.xcp[i] + alpha*di
+		l.xcp[i] = math.Min(math.Max(xi, lowerBound(l.Lower, i)), upperBound(l.Upper, i))
+	}
+}
+
+// atBound returns whether the i-th variable of the Cauchy point is at one of
+// its bounds.
+func (l *LBFGSB) atBound(i int) bool {
+	return l.xcp[i] == lowerBound(l.Lower, i) || l.xcp[i] == upperBound(l.Upper, i)
+}
+
+func (*LBFGSB) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
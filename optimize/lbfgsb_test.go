@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+// boundedTest is a bound-constrained test problem.
+type boundedTest struct {
+	name         string
+	p            Problem
+	x            []float64
+	lower, upper []float64
+	// want is the known minimizer. If it is nil only the projected gradient
+	// is checked.
+	want []float64
+}
+
+var boundedTests = []boundedTest{
+	{
+		name: "QuadraticActiveBounds",
+		p: Problem{
+			Func: func(x []float64) float64 {
+				var f float64
+				for i, v := range x {
+					d := v - float64(i) + 1
+					f += float64(i+1) * d * d
+				}
+				return f
+			},
+			Grad: func(grad, x []float64) {
+				for i, v := range x {
+					grad[i] = 2 * float64(i+1) * (v - float64(i) + 1)
+				}
+			},
+		},
+		x:     []float64{0.5, 0.5, 0.5, 0.5, 0.5},
+		lower: []float64{0, 0, 0, 0, 0},
+		upper: []float64{1.5, 1.5, 1.5, 1.5, 1.5},
+		want:  []float64{0, 0, 1, 1.5, 1.5},
+	},
+	{
+		name:  "RosenbrockUpperBound",
+		p:     Problem{Func: functions.ExtendedRosenbrock{}.Func, Grad: functions.ExtendedRosenbrock{}.Grad},
+		x:     []float64{-1.2, 1},
+		upper: []float64{0.5, math.Inf(1)},
+		want:  []float64{0.5, 0.25},
+	},
+	{
+		name:  "RosenbrockInfeasibleStart",
+		p:     Problem{Func: functions.ExtendedRosenbrock{}.Func, Grad: functions.ExtendedRosenbrock{}.Grad},
+		x:     []float64{-3, 4},
+		lower: []float64{-2, 1.5},
+		upper: []float64{2, 3},
+	},
+	{
+		// Problem from the driver program of the L-BFGS-B distribution.
+		name: "ExtendedRosenbrockDriver",
+		p:    Problem{Func: lbfgsbDriverFunc, Grad: lbfgsbDriverGrad},
+		x:    fill(25, 3),
+		lower: func() []float64 {
+			l := make([]float64, 25)
+			for i := range l {
+				if i%2 == 0 {
+					l[i] = 1
+				} else {
+					l[i] = -100
+				}
+			}
+			return l
+		}(),
+		upper: fill(25, 100),
+	},
+}
+
+func fill(n int, v float64) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = v
+	}
+	return x
+}
+
+func lbfgsbDriverFunc(x []float64) float64 {
+	f := 0.25 * (x[0] - 1) * (x[0] - 1)
+	for i := 1; i < len(x); i++ {
+		d := x[i] - x[i-1]*x[i-1]
+		f += d * d
+	}
+	return 4 * f
+}
+
+func lbfgsbDriverGrad(grad, x []float64) {
+	n := len(x)
+	t1 := x[1] - x[0]*x[0]
+	grad[0] = 2*(x[0]-1) - 16*x[0]*t1
+	for i := 1; i < n-1; i++ {
+		t2 := t1
+		t1 = x[i+1] - x[i]*x[i]
+		grad[i] = 8*t2 - 16*x[i]*t1
+	}
+	grad[n-1] = 8 * t1
+}
+
+func TestLBFGSBBounded(t *testing.T) {
+	t.Parallel()
+	for _, test := range boundedTests {
+		method := &LBFGSB{Lower: test.lower, Upper: test.upper}
+		settings := &Settings{
+			GradientThreshold: 1e-10,
+			Converger:         NeverTerminate{},
+		}
+		result, err := Minimize(test.p, test.x, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, GradientThreshold)
+		}
+		if !isFeasible(result.X, test.lower, test.upper) {
+			t.Errorf("%s: result is not feasible: %v", test.name, result.X)
+		}
+		pg := make([]float64, len(result.X))
+		boundedGradient(pg, result.X, result.Gradient, test.lower, test.upper)
+		if norm := floats.Norm(pg, math.Inf(1)); norm >= settings.GradientThreshold {
+			t.Errorf("%s: projected gradient norm %v not below threshold", test.name, norm)
+		}
+		if test.want != nil && !floats.EqualApprox(result.X, test.want, 1e-8) {
+			t.Errorf("%s: unexpected minimizer: got %v, want %v", test.name, result.X, test.want)
+		}
+	}
+}
+
+func TestLBFGSBRecorder(t *testing.T) {
+	t.Parallel()
+	test := boundedTests[0]
+	var rec countRecorder
+	method := &LBFGSB{Lower: test.lower, Upper: test.upper}
+	result, err := Minimize(test.p, test.x, &Settings{Recorder: &rec}, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.major != result.MajorIterations {
+		t.Errorf("unexpected number of recorded major iterations: got %d, want %d", rec.major, result.MajorIterations)
+	}
+}
+
+func TestLBFGSBInfeasibleStart(t *testing.T) {
+	t.Parallel()
+	test := boundedTests[0]
+	x := []float64{-1, 2, 0.5, -3, 4}
+	want := []float64{0, 1.5, 0.5, 0, 1.5}
+	for _, initValues := range []*Location{nil, {F: test.p.Func(x), Gradient: make([]float64, len(x))}} {
+		var rec majorRecorder
+		method := &LBFGSB{Lower: test.lower, Upper: test.upper}
+		result, err := Minimize(test.p, x, &Settings{InitValues: initValues, Recorder: &rec}, method)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rec.xs) == 0 {
+			t.Fatal("no major iterations recorded")
+		}
+		if !floats.Equal(rec.xs[0], want) {
+			t.Errorf("unexpected first major iteration: got %v, want %v", rec.xs[0], want)
+		}
+		if f := test.p.Func(want); rec.fs[0] != f {
+			t.Errorf("unexpected function value at the first major iteration: got %v, want %v", rec.fs[0], f)
+		}
+		for i, xi := range rec.xs {
+			if !isFeasible(xi, test.lower, test.upper) {
+				t.Errorf("major iteration %d is not feasible: %v", i, xi)
+			}
+		}
+		if !floats.EqualApprox(result.X, test.want, 1e-6) {
+			t.Errorf("unexpected minimizer: got %v, want %v", result.X, test.want)
+		}
+	}
+}
+
+// majorRecorder records the locations and function values of the major
+// iterations.
+type majorRecorder struct {
+	xs [][]float64
+	fs []float64
+}
+
+func (*majorRecorder) Init() error { return nil }
+
+func (r *majorRecorder) Record(loc *Location, op Operation, _ *Stats) error {
+	if op == MajorIteration {
+		r.xs = append(r.xs, append([]float64(nil), loc.X...))
+		r.fs = append(r.fs, loc.F)
+	}
+	return nil
+}
+
+// countRecorder counts the major iterations it records.
+type countRecorder struct {
+	major int
+}
+
+func (*countRecorder) Init() error { return nil }
+
+func (r *countRecorder) Record(_ *Location, op Operation, _ *Stats) error {
+	if op == MajorIteration {
+		r.major++
+	}
+	return nil
+}
@@ -11,7 +11,12 @@ import (
 )
 
 // localOptimizer is a helper type for running an optimization using a LocalMethod.
-type localOptimizer struct{}
+type localOptimizer struct {
+	// resume indicates that the method has restored its state from a
+	// Checkpoint, and that the initial location has already been announced
+	// as a major iteration by the interrupted run.
+	resume bool
+}
 
 // run controls the optimization run for a localMethod. The calling method
 // must close the operation channel at the conclusion of the optimization. This
@@ -25,19 +30,29 @@ func (l localOptimizer) run(method localMethod, gradThresh float64, operation ch
 		l.finish(operation, result)
 		return NotTerminated, nil
 	}
-	status, err := l.checkStartingLocation(task, gradThresh)
+	status, err := l.checkStartingLocation(task, gradThresh, method)
 	if err != nil {
 		l.finishMethodDone(operation, result, task)
 		return status, err
 	}
 
-	// Send a major iteration with the starting location.
-	task.Op = MajorIteration
-	operation <- task
-	task = <-result
-	if task.Op == PostIteration {
-		l.finish(operation, result)
-		return NotTerminated, nil
+	if l.resume {
+		// The starting location is the last major iteration of the
+		// interrupted run, which has not been checked for gradient
+		// convergence.
+		if status == GradientThreshold {
+			l.finishMethodDone(operation, result, task)
+			return status, nil
+		}
+	} else {
+		// Send a major iteration with the starting location.
+		task.Op = MajorIteration
+		operation <- task
+		task = <-result
+		if task.Op == PostIteration {
+			l.finish(operation, result)
+			return NotTerminated, nil
+		}
 	}
 	op, err := method.initLocal(task.Location)
 	if err != nil {
@@ -55,7 +70,7 @@ Loop:
 		case MajorIteration:
 			// The last operation was a MajorIteration. Check if the gradient
 			// is below the threshold.
-			if status := l.checkGradientConvergence(r.Gradient, gradThresh); status != NotTerminated {
+			if status := l.checkGradientConvergence(r.Location, gradThresh, method); status != NotTerminated {
 				l.finishMethodDone(operation, result, task)
 				return GradientThreshold, nil
 			}
@@ -100,7 +115,7 @@ func (l localOptimizer) initialLocation(operation chan<- Task, result <-chan Tas
 	return <-result
 }
 
-func (l localOptimizer) checkStartingLocation(task Task, gradThresh float64) (Status, error) {
+func (l localOptimizer) checkStartingLocation(task Task, gradThresh float64, method localMethod) (Status, error) {
 	if math.IsInf(task.F, 1) || math.IsNaN(task.F) {
 		return Failure, ErrFunc(task.F)
 	}
@@ -109,14 +124,22 @@ func (l localOptimizer) checkStartingLocation(task Task, gradThresh float64) (St
 			return Failure, ErrGrad{Grad: v, Index: i}
 		}
 	}
-	status := l.checkGradientConvergence(task.Gradient, gradThresh)
+	status := l.checkGradientConvergence(task.Location, gradThresh, method)
 	return status, nil
 }
 
-func (localOptimizer) checkGradientConvergence(gradient []float64, gradThresh float64) Status {
+// checkGradientConvergence checks whether the norm of the gradient at loc is
+// below gradThresh. If method is a gradientProjector, the projected gradient
+// is used instead.
+func (localOptimizer) checkGradientConvergence(loc *Location, gradThresh float64, method localMethod) Status {
+	gradient := loc.Gradient
 	if gradient == nil || math.IsNaN(gradThresh) {
 		return NotTerminated
 	}
+	if p, ok := method.(gradientProjector); ok {
+		gradient = make([]float64, len(loc.Gradient))
+		p.projectGradient(gradient, loc)
+	}
 	if gradThresh == 0 {
 		gradThresh = defaultGradientAbsTol
 	}
@@ -0,0 +1,6 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lsq implements routines to solve nonlinear least-squares problems.
+package lsq // import "gonum.org/v1/gonum/optimize/lsq"
@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsq_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/optimize/lsq"
+)
+
+func ExampleMinimize() {
+	// Fit the model y = a*exp(b*t) to data.
+	t := []float64{0, 1, 2, 3, 4, 5}
+	y := []float64{2.01, 2.98, 4.52, 6.69, 10.02, 14.91}
+
+	p := lsq.Problem{
+		M: len(t),
+		Func: func(r, x []float64) {
+			for i, ti := range t {
+				r[i] = x[0]*math.Exp(x[1]*ti) - y[i]
+			}
+		},
+	}
+	result, err := lsq.Minimize(p, []float64{1, 1}, nil, &lsq.LevenbergMarquardt{Geodesic: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+	stdErr := result.StdErr(nil)
+	fmt.Printf("a = %.3f ± %.3f\n", result.X[0], stdErr[0])
+	fmt.Printf("b = %.4f ± %.4f\n", result.X[1], stdErr[1])
+	fmt.Println("status:", result.Status)
+
+	// Output:
+	// a = 2.017 ± 0.010
+	// b = 0.4002 ± 0.0011
+	// status: FunctionConvergence
+}
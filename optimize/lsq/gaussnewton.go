@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsq
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*GaussNewton)(nil)
+
+// GaussNewton is the Gauss-Newton method for nonlinear least squares with a
+// line search. At each iteration it computes the search direction d as the
+// least-squares solution of
+//
+//	J d = -r,
+//
+// and performs a line search along d on the sum of squares. If J does not
+// have full column rank, the steepest descent direction -Jᵀr is used instead.
+//
+// Gauss-Newton converges quickly for problems with small residuals at the
+// minimum, but it may converge slowly or fail for problems with large
+// residuals or rank-deficient Jacobians, for which LevenbergMarquardt is more
+// robust.
+type GaussNewton struct {
+	// Linesearcher performs the line search along the Gauss-Newton
+	// direction. If Linesearcher is nil, optimize.Backtracking is used.
+	Linesearcher optimize.Linesearcher
+
+	dir   []float64
+	xt    []float64
+	rt    []float64
+	jac   mat.Dense
+	gradt []float64
+}
+
+func (gn *GaussNewton) init(m, n int) {
+	if gn.Linesearcher == nil {
+		gn.Linesearcher = &optimize.Backtracking{}
+	}
+	gn.dir = make([]float64, n)
+	gn.xt = make([]float64, n)
+	gn.rt = make([]float64, m)
+	gn.gradt = make([]float64, n)
+	gn.jac.Reset()
+	gn.jac.ReuseAs(m, n)
+}
+
+func (gn *GaussNewton) iterate(s *state) (optimize.Status, error) {
+	n := len(s.x)
+	m := len(s.r)
+	d := mat.NewVecDense(n, gn.dir)
+	negR := mat.NewVecDense(m, nil)
+	negR.ScaleVec(-1, mat.NewVecDense(m, s.r))
+	err := d.SolveVec(s.jac, negR)
+	if err != nil {
+		// J is numerically rank deficient. The condition number reported
+		// by mat.Condition is infinite or so large that the solution is
+		// dominated by rounding errors, so use the steepest descent
+		// direction instead.
+		floats.ScaleTo(gn.dir, -1, s.grad)
+	}
+	deriv := floats.Dot(s.grad, gn.dir)
+	if !(deriv < 0) {
+		floats.ScaleTo(gn.dir, -1, s.grad)
+		deriv = floats.Dot(s.grad, gn.dir)
+		if !(deriv < 0) {
+			return optimize.Failure, optimize.ErrNonDescentDirection
+		}
+	}
+
+	op := gn.Linesearcher.Init(s.cost, deriv, 1)
+	step := 1.0
+	for {
+		floats.AddScaledTo(gn.xt, s.x, step, gn.dir)
+		status, err := s.EvalFunc(gn.rt, gn.xt)
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+		cost := sumSquares(gn.rt) / 2
+		if math.IsNaN(cost) {
+			cost = math.Inf(1)
+		}
+		var deriv float64
+		if op&optimize.GradEvaluation != 0 {
+			status, err := s.EvalJacobian(&gn.jac, gn.xt, gn.rt)
+			if status != optimize.NotTerminated || err != nil {
+				return status, err
+			}
+			g := mat.NewVecDense(n, gn.gradt)
+			g.MulVec(gn.jac.T(), mat.NewVecDense(m, gn.rt))
+			deriv = floats.Dot(gn.gradt, gn.dir)
+		}
+		op, step, err = gn.Linesearcher.Iterate(cost, deriv)
+		if err != nil {
+			return optimize.Failure, err
+		}
+		if op == optimize.MajorIteration {
+			return s.accept(gn.xt, gn.rt)
+		}
+	}
+}
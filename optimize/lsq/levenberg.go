@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsq
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const (
+	defaultInitialDamping    = 1e-3
+	defaultAccelerationRatio = 0.75
+	defaultGeodesicStep      = 0.1
+	maxDamping               = 1e32
+)
+
+var _ Method = (*LevenbergMarquardt)(nil)
+
+// LevenbergMarquardt is the Levenberg-Marquardt method for nonlinear least
+// squares. At each iteration it computes the step v by solving
+//
+//	(JᵀJ + λD) v = -Jᵀr,
+//
+// where D is a diagonal scaling matrix holding the largest diagonal of JᵀJ
+// seen so far and λ is the damping parameter. The step is accepted if it
+// reduces the sum of squares, and λ is updated based on the agreement between
+// the actual reduction and the reduction predicted by the linear model of the
+// residuals.
+//
+// If Geodesic is true, the step is corrected by the geodesic acceleration
+//
+//	a = -(JᵀJ + λD)⁻¹ Jᵀ r_vv,
+//
+// where r_vv is the second directional derivative of the residuals along v
+// approximated by finite differences, and the step v + ½a is taken. Steps
+// with 2|a|/|v| larger than AccelerationRatio are rejected. Geodesic
+// acceleration costs one additional evaluation of the residuals per step, but
+// it can considerably reduce the number of iterations for problems with
+// narrow curved valleys.
+//
+// References:
+//   - Moré, J. J. (1978). The Levenberg-Marquardt algorithm: Implementation
+//     and theory. In Numerical Analysis (pp. 105-116). Springer.
+//   - Nielsen, H. B. (1999). Damping parameter in Marquardt's method.
+//     Technical Report IMM-REP-1999-05, Technical University of Denmark.
+//   - Transtrum, M. K., & Sethna, J. P. (2012). Improvements to the
+//     Levenberg-Marquardt algorithm for nonlinear least-squares minimization.
+//     arXiv:1201.5885.
+type LevenbergMarquardt struct {
+	// InitialDamping is the initial value of the damping parameter λ. If
+	// InitialDamping is zero, a default value of 1e-3 is used.
+	InitialDamping float64
+	// Geodesic specifies whether geodesic acceleration is used.
+	Geodesic bool
+	// AccelerationRatio is the largest allowed ratio 2|a|/|v| between the
+	// geodesic acceleration and the step. If AccelerationRatio is zero, a
+	// default value of 0.75 is used.
+	AccelerationRatio float64
+	// GeodesicStep is the relative step used in the finite difference
+	// approximation of the second directional derivative. If GeodesicStep
+	// is zero, a default value of 0.1 is used.
+	GeodesicStep float64
+
+	lambda float64 // Damping parameter.
+	nu     float64 // Growth factor of the damping parameter.
+
+	diag []float64
+	jtj  mat.SymDense
+	a    mat.SymDense
+	chol mat.Cholesky
+
+	v, acc, rhs []float64
+	xt, rt, jv  []float64
+}
+
+func (lm *LevenbergMarquardt) init(m, n int) {
+	if lm.InitialDamping == 0 {
+		lm.InitialDamping = defaultInitialDamping
+	}
+	if lm.InitialDamping < 0 {
+		panic("lsq: negative initial damping")
+	}
+	if lm.AccelerationRatio == 0 {
+		lm.AccelerationRatio = defaultAccelerationRatio
+	}
+	if lm.GeodesicStep == 0 {
+		lm.GeodesicStep = defaultGeodesicStep
+	}
+	lm.lambda = lm.InitialDamping
+	lm.nu = 2
+	lm.diag = make([]float64, n)
+	lm.v = make([]float64, n)
+	lm.acc = make([]float64, n)
+	lm.rhs = make([]float64, n)
+	lm.xt = make([]float64, n)
+	lm.rt = make([]float64, m)
+	lm.jv = make([]float64, m)
+	lm.jtj.Reset()
+	lm.jtj.ReuseAsSym(n)
+	lm.a.Reset()
+	lm.a.ReuseAsSym(n)
+}
+
+func (lm *LevenbergMarquardt) iterate(s *state) (optimize.Status, error) {
+	n := len(s.x)
+	lm.jtj.SymOuterK(1, s.jac.T())
+	for i := range lm.diag {
+		lm.diag[i] = math.Max(lm.diag[i], lm.jtj.At(i, i))
+		if lm.diag[i] == 0 {
+			lm.diag[i] = 1
+		}
+	}
+	for {
+		if lm.lambda > maxDamping {
+			return optimize.Failure, optimize.ErrNoProgress
+		}
+		lm.a.CopySym(&lm.jtj)
+		for i, d := range lm.diag {
+			lm.a.SetSym(i, i, lm.a.At(i, i)+lm.lambda*d)
+		}
+		if !lm.chol.Factorize(&lm.a) {
+			lm.increaseDamping()
+			continue
+		}
+		// Compute the Levenberg-Marquardt step.
+		err := lm.chol.SolveVecTo(mat.NewVecDense(n, lm.v), mat.NewVecDense(n, s.grad))
+		if err != nil {
+			lm.increaseDamping()
+			continue
+		}
+		floats.Scale(-1, lm.v)
+		vnorm := floats.Norm(lm.v, 2)
+		if vnorm <= s.stepTol*(floats.Norm(s.x, 2)+s.stepTol) {
+			// The damped step is too small to make further progress.
+			copy(s.step, lm.v)
+			return optimize.StepConvergence, nil
+		}
+
+		jv := mat.NewVecDense(len(lm.jv), lm.jv)
+		jv.MulVec(s.jac, mat.NewVecDense(n, lm.v))
+		if lm.Geodesic {
+			// Approximate the second directional derivative of the
+			// residuals along v and compute the geodesic acceleration.
+			h := lm.GeodesicStep
+			floats.AddScaledTo(lm.xt, s.x, h, lm.v)
+			status, err := s.EvalFunc(lm.rt, lm.xt)
+			if status != optimize.NotTerminated || err != nil {
+				return status, err
+			}
+			for i, r := range lm.rt {
+				lm.rt[i] = 2 / h * ((r-s.r[i])/h - lm.jv[i])
+			}
+			jtr := mat.NewVecDense(n, lm.rhs)
+			jtr.MulVec(s.jac.T(), mat.NewVecDense(len(lm.rt), lm.rt))
+			err = lm.chol.SolveVecTo(mat.NewVecDense(n, lm.acc), jtr)
+			if err != nil || math.IsNaN(floats.Norm(lm.acc, 2)) {
+				lm.increaseDamping()
+				continue
+			}
+			floats.Scale(-1, lm.acc)
+			if 2*floats.Norm(lm.acc, 2) > lm.AccelerationRatio*vnorm {
+				lm.increaseDamping()
+				continue
+			}
+			floats.AddScaled(lm.v, 0.5, lm.acc)
+			jv.MulVec(s.jac, mat.NewVecDense(n, lm.v))
+		}
+
+		floats.AddTo(lm.xt, s.x, lm.v)
+		status, err := s.EvalFunc(lm.rt, lm.xt)
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+		cost := sumSquares(lm.rt) / 2
+		// The reduction predicted by the linear model of the residuals is
+		//  ½|r|² - ½|r + Jv|² = -rᵀJv - ½|Jv|².
+		pred := -floats.Dot(s.r, lm.jv) - sumSquares(lm.jv)/2
+		rho := (s.cost - cost) / pred
+		if pred > 0 && rho > 0 && !math.IsInf(cost, 1) && !math.IsNaN(cost) {
+			lm.lambda *= math.Max(1.0/3, 1-math.Pow(2*rho-1, 3))
+			lm.nu = 2
+			return s.accept(lm.xt, lm.rt)
+		}
+		lm.increaseDamping()
+	}
+}
+
+func (lm *LevenbergMarquardt) increaseDamping() {
+	lm.lambda *= lm.nu
+	lm.nu *= 2
+}
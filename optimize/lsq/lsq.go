@@ -0,0 +1,348 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsq
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/internal/vecfunc"
+)
+
+const (
+	defaultGradientThreshold = 1e-12
+	defaultStepTolerance     = 1e-10
+	defaultFunctionTolerance = 1e-12
+)
+
+var errNonFinite = errors.New("lsq: residuals are not finite at the initial location")
+
+// Problem describes the nonlinear least-squares problem
+//
+//	minimize ½ Σ r_i(x)²,  i = 0, ..., M-1,
+//
+// where r is the vector of residuals.
+type Problem struct {
+	// M is the number of residuals.
+	M int
+
+	// Func evaluates the residuals at x and stores the result in r which
+	// will have length M. Func must not modify x.
+	Func func(r, x []float64)
+
+	// Jacobian evaluates the Jacobian of the residuals at x and stores the
+	// result in-place in jac which will have M rows and len(x) columns.
+	// Jacobian must not modify x. If Jacobian is nil, it is approximated
+	// by finite differences using fd.Jacobian.
+	Jacobian func(jac *mat.Dense, x []float64)
+
+	// Status reports the status of the problem being optimized and any
+	// error. It is called before every evaluation of Func and Jacobian and
+	// can be used to terminate early.
+	Status func() (optimize.Status, error)
+}
+
+// Settings represents settings of the least-squares optimization run. See
+// the field comments for default values.
+type Settings struct {
+	// GradientThreshold stops the optimization with GradientThreshold status
+	// if the infinity norm of the gradient Jᵀr of the objective function is
+	// less than this value. If it is zero, a default value of 1e-12 is used.
+	GradientThreshold float64
+
+	// StepTolerance stops the optimization with StepConvergence status if
+	// the norm of the step is less than StepTolerance*(|x| + StepTolerance).
+	// If it is zero, a default value of 1e-10 is used.
+	StepTolerance float64
+
+	// FunctionTolerance stops the optimization with FunctionConvergence
+	// status if the relative reduction of the sum of squares in an iteration
+	// is less than this value. If it is zero, a default value of 1e-12 is
+	// used.
+	FunctionTolerance float64
+
+	// MajorIterations is the maximum number of iterations allowed.
+	// IterationLimit status is returned if the number of iterations equals
+	// or exceeds this value. If it equals zero, this setting has no effect.
+	MajorIterations int
+
+	// FuncEvaluations is the maximum allowed number of evaluations of the
+	// residuals, including those used for finite difference Jacobians.
+	// FunctionEvaluationLimit status is returned if the total number of
+	// evaluations equals or exceeds this number. If it equals zero, this
+	// setting has no effect.
+	FuncEvaluations int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If it
+	// equals zero, this setting has no effect.
+	Runtime time.Duration
+
+	// FiniteDifference holds the settings used to approximate the Jacobian
+	// if Problem.Jacobian is nil. The OriginValue field is ignored. If
+	// FiniteDifference is nil, the forward difference formula is used.
+	FiniteDifference *fd.JacobianSettings
+}
+
+// Method is a method for solving nonlinear least-squares problems. Method
+// is implemented by LevenbergMarquardt and GaussNewton.
+//
+// The methods of Method are unexported, so the set of implementations is
+// closed and Method cannot be implemented outside of this package.
+type Method interface {
+	// init initializes the method for a problem with m residuals and
+	// n parameters.
+	init(m, n int)
+	// iterate performs a single iteration of the method. It must update
+	// the location, the residuals and the Jacobian of s on a successful
+	// step.
+	iterate(s *state) (optimize.Status, error)
+}
+
+// Stats contains the statistics of the run.
+type Stats struct {
+	MajorIterations     int           // Total number of major iterations
+	FuncEvaluations     int           // Number of evaluations of the residuals
+	JacobianEvaluations int           // Number of evaluations of the Jacobian
+	Runtime             time.Duration // Total runtime of the optimization
+}
+
+// ResidualStats holds statistics of the residuals at the solution.
+type ResidualStats struct {
+	// SumSquares is the sum of squares of the residuals.
+	SumSquares float64
+	// RMS is the root mean square of the residuals.
+	RMS float64
+	// MaxAbs is the largest absolute value of the residuals.
+	MaxAbs float64
+	// DOF is the number of degrees of freedom, the number of residuals
+	// minus the number of parameters.
+	DOF int
+	// Variance is the estimate of the variance of the residuals,
+	// SumSquares/DOF. Variance is NaN if DOF is not positive.
+	Variance float64
+}
+
+// Result represents the answer of a least-squares optimization run.
+type Result struct {
+	// X is the location of the minimum.
+	X []float64
+	// Residuals holds the residuals at X.
+	Residuals []float64
+	// Jacobian holds the Jacobian of the residuals at X.
+	Jacobian *mat.Dense
+
+	ResidualStats
+
+	// Covariance is the estimate of the covariance matrix of the parameters,
+	//  Variance * (JᵀJ)⁻¹.
+	// Covariance is nil if the variance is not defined or if JᵀJ is
+	// singular.
+	Covariance *mat.SymDense
+
+	Stats
+	Status optimize.Status
+}
+
+// StdErr stores the standard errors of the parameters, the square roots of
+// the diagonal of the covariance matrix, into dst and returns the result. If
+// dst is nil, a new slice is allocated. StdErr returns nil if the covariance
+// is not available.
+func (r *Result) StdErr(dst []float64) []float64 {
+	if r.Covariance == nil {
+		return nil
+	}
+	n := r.Covariance.SymmetricDim()
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("lsq: slice length mismatch")
+	}
+	for i := range dst {
+		dst[i] = math.Sqrt(r.Covariance.At(i, i))
+	}
+	return dst
+}
+
+// Minimize finds a minimum of the sum of squares of the residuals of p,
+// starting from initX, using the given method. If settings is nil, the zero
+// value is used, see the documentation of Settings for the default values.
+// If method is nil, LevenbergMarquardt is used.
+//
+// Minimize returns a Result and any error that occurred. The error is non-nil
+// if the residuals are not finite at initX, if the method fails or if p.Status
+// returns an error.
+func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Func == nil {
+		panic("lsq: residual function is undefined")
+	}
+	if p.M <= 0 {
+		panic("lsq: non-positive number of residuals")
+	}
+	n := len(initX)
+	if n == 0 {
+		panic("lsq: zero dimensional input")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &LevenbergMarquardt{}
+	}
+	gradThresh := settings.GradientThreshold
+	if gradThresh == 0 {
+		gradThresh = defaultGradientThreshold
+	}
+	stepTol := settings.StepTolerance
+	if stepTol == 0 {
+		stepTol = defaultStepTolerance
+	}
+	funcTol := settings.FunctionTolerance
+	if funcTol == 0 {
+		funcTol = defaultFunctionTolerance
+	}
+
+	s := newState(&p, settings, initX, stepTol)
+	status, err := s.EvalFunc(s.r, s.x)
+	if status == optimize.NotTerminated && err == nil {
+		if !vecfunc.IsFinite(s.r) {
+			status, err = optimize.Failure, errNonFinite
+		} else {
+			s.cost = sumSquares(s.r) / 2
+			status, err = s.evalJacobian()
+		}
+	}
+
+	method.init(p.M, n)
+	if status == optimize.NotTerminated && err == nil {
+		loop := vecfunc.Loop{
+			StepTolerance:   stepTol,
+			MajorIterations: settings.MajorIterations,
+			Runtime:         settings.Runtime,
+		}
+		var prevCost float64
+		status, err = loop.Run(startTime, s.x, s.step,
+			func() optimize.Status {
+				if floats.Norm(s.grad, math.Inf(1)) < gradThresh {
+					return optimize.GradientThreshold
+				}
+				return optimize.NotTerminated
+			},
+			func() optimize.Status {
+				if prevCost-s.cost <= funcTol*prevCost {
+					return optimize.FunctionConvergence
+				}
+				return optimize.NotTerminated
+			},
+			func() (optimize.Status, error) {
+				prevCost = s.cost
+				return method.iterate(s)
+			},
+		)
+		s.stats.MajorIterations = loop.Iterations
+	}
+	s.stats.Runtime = time.Since(startTime)
+	return s.result(status), err
+}
+
+// state holds the current location of a least-squares optimization run. The
+// embedded Evaluator evaluates the residuals and the Jacobian.
+type state struct {
+	vecfunc.Evaluator
+	stats   Stats
+	stepTol float64
+
+	x    []float64  // Current location.
+	r    []float64  // Residuals at x.
+	jac  *mat.Dense // Jacobian at x.
+	grad []float64  // Gradient Jᵀr at x.
+	cost float64    // Objective function ½|r|² at x.
+	step []float64  // Last accepted step.
+}
+
+func newState(p *Problem, settings *Settings, initX []float64, stepTol float64) *state {
+	n := len(initX)
+	s := &state{
+		Evaluator: vecfunc.Evaluator{
+			Func:               p.Func,
+			Jacobian:           p.Jacobian,
+			Status:             p.Status,
+			MaxFuncEvaluations: settings.FuncEvaluations,
+			FiniteDifference:   settings.FiniteDifference,
+		},
+		stepTol: stepTol,
+		x:       make([]float64, n),
+		r:       make([]float64, p.M),
+		jac:     mat.NewDense(p.M, n, nil),
+		grad:    make([]float64, n),
+		step:    make([]float64, n),
+	}
+	copy(s.x, initX)
+	return s
+}
+
+// evalJacobian evaluates the Jacobian and the gradient at the current
+// location.
+func (s *state) evalJacobian() (optimize.Status, error) {
+	status, err := s.EvalJacobian(s.jac, s.x, s.r)
+	g := mat.NewVecDense(len(s.grad), s.grad)
+	g.MulVec(s.jac.T(), mat.NewVecDense(len(s.r), s.r))
+	return status, err
+}
+
+// accept moves the state to x with residuals r and evaluates the Jacobian
+// there.
+func (s *state) accept(x, r []float64) (optimize.Status, error) {
+	floats.SubTo(s.step, x, s.x)
+	copy(s.x, x)
+	copy(s.r, r)
+	s.cost = sumSquares(r) / 2
+	return s.evalJacobian()
+}
+
+// result returns the Result of the run at the current state.
+func (s *state) result(status optimize.Status) *Result {
+	m, n := s.jac.Dims()
+	s.stats.FuncEvaluations = s.FuncEvaluations
+	s.stats.JacobianEvaluations = s.JacobianEvaluations
+	res := &Result{
+		X:         s.x,
+		Residuals: s.r,
+		Jacobian:  s.jac,
+		Stats:     s.stats,
+		Status:    status,
+	}
+	res.SumSquares = sumSquares(s.r)
+	res.RMS = math.Sqrt(res.SumSquares / float64(m))
+	res.MaxAbs = floats.Norm(s.r, math.Inf(1))
+	res.DOF = m - n
+	res.Variance = math.NaN()
+	if res.DOF > 0 {
+		res.Variance = res.SumSquares / float64(res.DOF)
+		var jtj mat.SymDense
+		jtj.SymOuterK(1, s.jac.T())
+		var chol mat.Cholesky
+		if chol.Factorize(&jtj) {
+			var cov mat.SymDense
+			err := chol.InverseTo(&cov)
+			if err == nil {
+				cov.ScaleSym(res.Variance, &cov)
+				res.Covariance = &cov
+			}
+		}
+	}
+	return res
+}
+
+func sumSquares(r []float64) float64 {
+	return floats.Dot(r, r)
+}
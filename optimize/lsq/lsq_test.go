@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsq
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// misra1a is the Misra1a problem from the NIST StRD nonlinear regression
+// data sets, with the model y = b₀(1 - exp(-b₁x)).
+var misra1a = struct {
+	x, y   []float64
+	want   []float64
+	stdErr []float64
+	ssr    float64
+}{
+	x:      []float64{77.6, 114.9, 141.1, 190.8, 239.9, 289.0, 332.8, 378.4, 434.8, 477.3, 536.8, 593.1, 689.1, 760.0},
+	y:      []float64{10.07, 14.73, 17.94, 23.93, 29.61, 35.18, 40.02, 44.82, 50.76, 55.05, 61.01, 66.40, 75.47, 81.78},
+	want:   []float64{2.3894212918e+02, 5.5015643181e-04},
+	stdErr: []float64{2.7070075241e+00, 7.2668688436e-06},
+	ssr:    1.2455138894e-01,
+}
+
+func misra1aProblem(withJacobian bool) Problem {
+	p := Problem{
+		M: len(misra1a.x),
+		Func: func(r, b []float64) {
+			for i, x := range misra1a.x {
+				r[i] = b[0]*(1-math.Exp(-b[1]*x)) - misra1a.y[i]
+			}
+		},
+	}
+	if withJacobian {
+		p.Jacobian = func(jac *mat.Dense, b []float64) {
+			for i, x := range misra1a.x {
+				e := math.Exp(-b[1] * x)
+				jac.Set(i, 0, 1-e)
+				jac.Set(i, 1, b[0]*x*e)
+			}
+		}
+	}
+	return p
+}
+
+// rosenbrock is the Rosenbrock function written as a least-squares problem.
+var rosenbrock = Problem{
+	M: 2,
+	Func: func(r, x []float64) {
+		r[0] = 10 * (x[1] - x[0]*x[0])
+		r[1] = 1 - x[0]
+	},
+	Jacobian: func(jac *mat.Dense, x []float64) {
+		jac.Set(0, 0, -20*x[0])
+		jac.Set(0, 1, 10)
+		jac.Set(1, 0, -1)
+		jac.Set(1, 1, 0)
+	},
+}
+
+func lsqMethods() []struct {
+	name   string
+	method func() Method
+} {
+	return []struct {
+		name   string
+		method func() Method
+	}{
+		{name: "LevenbergMarquardt", method: func() Method { return &LevenbergMarquardt{} }},
+		{name: "Geodesic", method: func() Method { return &LevenbergMarquardt{Geodesic: true} }},
+		{name: "GaussNewton", method: func() Method { return &GaussNewton{} }},
+	}
+}
+
+func TestMisra1a(t *testing.T) {
+	t.Parallel()
+	for _, m := range lsqMethods() {
+		for _, withJac := range []bool{true, false} {
+			// The second NIST starting point is closer to the solution,
+			// the first is used only with the Jacobian.
+			for _, x0 := range [][]float64{{500, 1e-4}, {250, 5e-4}} {
+				if !withJac && x0[0] == 500 {
+					continue
+				}
+				result, err := Minimize(misra1aProblem(withJac), x0, nil, m.method())
+				if err != nil {
+					t.Errorf("%s (Jacobian %t, x0 %v): unexpected error: %v", m.name, withJac, x0, err)
+					continue
+				}
+				if result.Status.Early() {
+					t.Errorf("%s (Jacobian %t, x0 %v): unexpected status %v", m.name, withJac, x0, result.Status)
+				}
+				tol := 1e-8
+				if !withJac {
+					tol = 1e-5
+				}
+				for i, v := range result.X {
+					if !scalar.EqualWithinRel(v, misra1a.want[i], tol) {
+						t.Errorf("%s (Jacobian %t, x0 %v): unexpected parameter %d: got %v, want %v",
+							m.name, withJac, x0, i, v, misra1a.want[i])
+					}
+				}
+				if !scalar.EqualWithinRel(result.SumSquares, misra1a.ssr, tol) {
+					t.Errorf("%s (Jacobian %t, x0 %v): unexpected sum of squares: got %v, want %v",
+						m.name, withJac, x0, result.SumSquares, misra1a.ssr)
+				}
+				if result.DOF != 12 {
+					t.Errorf("%s: unexpected degrees of freedom: got %d, want 12", m.name, result.DOF)
+				}
+				stdErr := result.StdErr(nil)
+				for i, v := range stdErr {
+					if !scalar.EqualWithinRel(v, misra1a.stdErr[i], 1e-4) {
+						t.Errorf("%s (Jacobian %t, x0 %v): unexpected standard error %d: got %v, want %v",
+							m.name, withJac, x0, i, v, misra1a.stdErr[i])
+					}
+				}
+				if !withJac && result.JacobianEvaluations == 0 {
+					t.Errorf("%s: finite difference Jacobian evaluations not counted", m.name)
+				}
+			}
+		}
+	}
+}
+
+func TestRosenbrock(t *testing.T) {
+	t.Parallel()
+	for _, m := range lsqMethods() {
+		result, err := Minimize(rosenbrock, []float64{-1.2, 1}, nil, m.method())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", m.name, err)
+			continue
+		}
+		if !floats.EqualApprox(result.X, []float64{1, 1}, 1e-8) {
+			t.Errorf("%s: unexpected minimizer: got %v, want [1 1]", m.name, result.X)
+		}
+		if result.MaxAbs > 1e-8 {
+			t.Errorf("%s: residuals not zero: %v", m.name, result.Residuals)
+		}
+		if result.Covariance != nil {
+			t.Errorf("%s: unexpected covariance for zero degrees of freedom", m.name)
+		}
+		if !math.IsNaN(result.Variance) {
+			t.Errorf("%s: unexpected variance for zero degrees of freedom: %v", m.name, result.Variance)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+	result, err := Minimize(misra1aProblem(true), []float64{500, 1e-4}, &Settings{MajorIterations: 2}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != optimize.IterationLimit || result.MajorIterations != 2 {
+		t.Errorf("unexpected status with iteration limit: got %v after %d iterations", result.Status, result.MajorIterations)
+	}
+
+	result, err = Minimize(misra1aProblem(false), []float64{250, 5e-4}, &Settings{FuncEvaluations: 10}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != optimize.FunctionEvaluationLimit {
+		t.Errorf("unexpected status with evaluation limit: got %v", result.Status)
+	}
+	if result.FuncEvaluations > 10 {
+		t.Errorf("evaluation limit exceeded: %d evaluations", result.FuncEvaluations)
+	}
+}
+
+func TestGaussNewtonRankDeficient(t *testing.T) {
+	t.Parallel()
+	// The residuals depend on x only through x₀ + 3x₁, so the Jacobian has
+	// rank one and the sum of squares is minimized on a line. The computed
+	// Gauss-Newton direction is dominated by rounding errors along the null
+	// space of the Jacobian.
+	c := []float64{0.1, 0.7, 1.3}
+	y := []float64{0.2, 1.5, 2.5}
+	p := Problem{
+		M: len(c),
+		Func: func(r, x []float64) {
+			for i, ci := range c {
+				r[i] = ci*(x[0]+3*x[1]) - y[i]
+			}
+		},
+		Jacobian: func(jac *mat.Dense, x []float64) {
+			for i, ci := range c {
+				jac.Set(i, 0, ci)
+				jac.Set(i, 1, 3*ci)
+			}
+		},
+	}
+	want := floats.Dot(c, y) / floats.Dot(c, c)
+	result, err := Minimize(p, []float64{0, 0}, nil, &GaussNewton{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status == optimize.Failure {
+		t.Errorf("unexpected status: %v", result.Status)
+	}
+	if s := result.X[0] + 3*result.X[1]; !scalar.EqualWithinAbsOrRel(s, want, 1e-6, 1e-6) {
+		t.Errorf("unexpected minimizer: got %v with x₀ + 3x₁ = %v, want %v", result.X, s, want)
+	}
+	if floats.Norm(result.X, math.Inf(1)) > 10 {
+		t.Errorf("minimizer moved along the null space of the Jacobian: %v", result.X)
+	}
+}
@@ -45,6 +45,22 @@ type Location struct {
 	// a new mat.SymDense will be allocated, if it is empty
 	// it will be resized to match the length of X.
 	Hessian *mat.SymDense
+	// Constraints holds the values of the constraint
+	// functions at X, equality constraints first.
+	// If the capacity of Constraints is less than the
+	// number of constraints, a new slice will be allocated.
+	Constraints []float64
+	// Jacobian holds the first-order partial derivatives
+	// of the constraint functions at X.
+	// Jacobian must be nil or empty, or its dimensions
+	// must match the number of constraints and the length
+	// of X. If Jacobian is nil a new mat.Dense will be
+	// allocated, if it is empty it will be resized.
+	Jacobian *mat.Dense
+	// Multipliers holds the estimates of the Lagrange
+	// multipliers of the constraints at X. Multipliers
+	// is set by constrained Methods at MajorIterations.
+	Multipliers []float64
 }
 
 // Method is a type which can search for an optimum of an objective function.
@@ -126,7 +142,9 @@ type Method interface {
 //
 // The second argument specifies the initial location for the optimization.
 // Some Methods do not require an initial location, but initX must still be
-// specified for the dimension of the optimization problem.
+// specified for the dimension of the optimization problem. If
+// settings.Resume is not nil, the initial location is taken from the
+// Checkpoint and initX is ignored.
 //
 // The third argument contains the settings for the minimization. If settings
 // is nil, the zero value will be used, see the documentation of the Settings
@@ -139,7 +157,8 @@ type Method interface {
 // an appropriate default is chosen based on the properties of the other arguments
 // (dimension, gradient-free or gradient-based, etc.). If method is not nil,
 // Minimize panics if the Problem is not consistent with the Method (Uses
-// returns an error).
+// returns an error). Problems with Constraints can only be solved by Methods
+// for constrained optimization, such as SQP, AugmentedLagrangian and COBYLA.
 //
 // Minimize returns a Result struct and any error that occurred. See the
 // documentation of Result for more information.
@@ -159,22 +178,55 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 		settings = &Settings{}
 	}
 	stats := &Stats{}
+	initValues := settings.InitValues
+	resume := settings.Resume
+	if resume != nil {
+		if initValues != nil {
+			panic("optimize: InitValues specified when resuming")
+		}
+		initX = resume.Location.X
+		initValues = &Location{
+			F:        resume.Location.F,
+			Gradient: copySlice(nil, resume.Location.Gradient),
+		}
+		*stats = resume.Stats
+		// Include the runtime of the previous runs.
+		startTime = startTime.Add(-resume.Stats.Runtime)
+	}
 	dim := len(initX)
 	err := checkOptimization(p, dim, settings.Recorder)
 	if err != nil {
 		return nil, err
 	}
+	if resume != nil || settings.Checkpoint != nil {
+		if _, ok := method.(Snapshotter); !ok {
+			return nil, ErrNotSnapshotter
+		}
+	}
 
 	optLoc := newLocation(dim) // This must have an allocated X field.
 	optLoc.F = math.Inf(1)
+	if resume != nil {
+		copy(optLoc.X, resume.Location.X)
+		optLoc.F = resume.Location.F
+		optLoc.Gradient = copySlice(nil, resume.Location.Gradient)
+	}
 
-	initOp, initLoc := getInitLocation(dim, initX, settings.InitValues)
+	initOp, initLoc := getInitLocation(dim, initX, initValues)
 
 	converger := settings.Converger
 	if converger == nil {
 		converger = defaultFunctionConverge()
 	}
 	converger.Init(dim)
+	if resume != nil && resume.Converger != nil {
+		if s, ok := converger.(Snapshotter); ok {
+			err = s.Restore(resume.Converger)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	stats.Runtime = time.Since(startTime)
 
@@ -195,14 +247,26 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 		err = settings.Recorder.Record(optLoc, PostIteration, stats)
 	}
 	stats.Runtime = time.Since(startTime)
+	var kkt *KKTResidual
+	if p.Constraints != nil && optLoc.Multipliers != nil && optLoc.Constraints != nil {
+		kkt = &KKTResidual{}
+		kkt.compute(optLoc, p.Constraints.Equality)
+	}
 	return &Result{
 		Location: *optLoc,
 		Stats:    *stats,
 		Status:   status,
+		KKT:      kkt,
 	}, err
 }
 
 func getDefaultMethod(p *Problem) Method {
+	if p.Constraints != nil {
+		if p.Grad != nil && p.Constraints.Jacobian != nil {
+			return &SQP{}
+		}
+		return &AugmentedLagrangian{}
+	}
 	if p.Grad != nil {
 		return &LBFGS{}
 	}
@@ -222,11 +286,24 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	if initErr != nil {
 		panic(fmt.Sprintf("optimize: specified method inconsistent with Problem: %v", initErr))
 	}
+	if c, ok := method.(constrainedMethod); ok {
+		var eq, ineq int
+		if prob.Constraints != nil {
+			eq, ineq = prob.Constraints.Equality, prob.Constraints.Inequality
+		}
+		c.initConstraints(eq, ineq)
+	}
 	newNTasks := method.Init(dim, nTasks)
 	if newNTasks > nTasks {
 		panic("optimize: too many tasks returned by Method")
 	}
 	nTasks = newNTasks
+	if settings.Resume != nil {
+		err := method.(Snapshotter).Restore(settings.Resume.Method)
+		if err != nil {
+			return Failure, err
+		}
+	}
 
 	// Launch the method. The method communicates tasks using the operations
 	// channel, and results is used to return the evaluated results.
@@ -349,7 +426,17 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 		case NoOperation:
 			// Just send the task back.
 		case MajorIteration:
-			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings)
+			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings, method)
+			if status == NotTerminated && settings.Checkpoint != nil {
+				var c *Checkpoint
+				c, err = newCheckpoint(optLoc, stats, method, converger)
+				if err == nil {
+					err = settings.Checkpoint(c)
+				}
+				if err != nil {
+					status = Failure
+				}
+			}
 		case MethodDone:
 			methodDone = true
 			status = MethodConverge
@@ -456,6 +543,14 @@ func checkOptimization(p Problem, dim int, recorder Recorder) error {
 	if dim <= 0 {
 		panic("optimize: impossible problem dimension")
 	}
+	if c := p.Constraints; c != nil {
+		if c.Func == nil {
+			panic("optimize: constraint function is undefined")
+		}
+		if c.Equality < 0 || c.Inequality < 0 {
+			panic("optimize: negative number of constraints")
+		}
+	}
 	if p.Status != nil {
 		_, err := p.Status()
 		if err != nil {
@@ -503,6 +598,27 @@ func evaluate(p *Problem, loc *Location, op Operation, x []float64) {
 		}
 		p.Hess(loc.Hessian, x)
 	}
+	if op&(ConstraintEvaluation|JacobianEvaluation) != 0 && p.Constraints == nil {
+		panic("optimize: constraint evaluation requested for an unconstrained problem")
+	}
+	if op&ConstraintEvaluation != 0 {
+		n := p.Constraints.Equality + p.Constraints.Inequality
+		if len(loc.Constraints) != n {
+			loc.Constraints = resize(loc.Constraints, n)
+		}
+		p.Constraints.Func(loc.Constraints, x)
+	}
+	if op&JacobianEvaluation != 0 {
+		// Make sure we have a destination in which to place the Jacobian.
+		n := p.Constraints.Equality + p.Constraints.Inequality
+		switch {
+		case loc.Jacobian == nil:
+			loc.Jacobian = mat.NewDense(n, len(x), nil)
+		case loc.Jacobian.IsEmpty():
+			loc.Jacobian.ReuseAs(n, len(x))
+		}
+		p.Constraints.Jacobian(loc.Jacobian, x)
+	}
 }
 
 // updateEvaluationStats updates the statistics based on the operation.
@@ -516,6 +632,12 @@ func updateEvaluationStats(stats *Stats, op Operation) {
 	if op&HessEvaluation != 0 {
 		stats.HessEvaluations++
 	}
+	if op&ConstraintEvaluation != 0 {
+		stats.ConstraintEvaluations++
+	}
+	if op&JacobianEvaluation != 0 {
+		stats.JacobianEvaluations++
+	}
 }
 
 // checkLocationConvergence checks if the current optimal location satisfies
@@ -525,12 +647,26 @@ func updateEvaluationStats(stats *Stats, op Operation) {
 // the convergence criteria given by settings. Otherwise a corresponding status is
 // returned.
 // Unlike checkLimits, checkConvergence is called only at MajorIterations.
-func checkLocationConvergence(loc *Location, settings *Settings, converger Converger) Status {
+// If method is a gradientProjector, the projected gradient is used in place
+// of the gradient. If method is a feasibilityChecker, the convergence criteria
+// are not satisfied at locations that violate the constraints.
+func checkLocationConvergence(loc *Location, settings *Settings, converger Converger, method Method) Status {
 	if math.IsInf(loc.F, -1) {
 		return FunctionNegativeInfinity
 	}
+	if c, ok := method.(feasibilityChecker); ok && !c.feasible(loc) {
+		// Keep the state of the converger up to date, but an infeasible
+		// location is not a solution however stationary it is.
+		converger.Converged(loc)
+		return NotTerminated
+	}
 	if loc.Gradient != nil && settings.GradientThreshold > 0 {
-		norm := floats.Norm(loc.Gradient, math.Inf(1))
+		grad := loc.Gradient
+		if p, ok := method.(gradientProjector); ok {
+			grad = make([]float64, len(loc.Gradient))
+			p.projectGradient(grad, loc)
+		}
+		norm := floats.Norm(grad, math.Inf(1))
 		if norm < settings.GradientThreshold {
 			return GradientThreshold
 		}
@@ -574,7 +710,7 @@ func checkIterationLimits(loc *Location, stats *Stats, settings *Settings) Statu
 // performMajorIteration does all of the steps needed to perform a MajorIteration.
 // It increments the iteration count, updates the optimal location, and checks
 // the necessary convergence criteria.
-func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings) Status {
+func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings, method Method) Status {
 	optLoc.F = loc.F
 	copy(optLoc.X, loc.X)
 	if loc.Gradient == nil {
@@ -585,11 +721,32 @@ func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Conver
 		}
 		copy(optLoc.Gradient, loc.Gradient)
 	}
+	optLoc.Constraints = copySlice(optLoc.Constraints, loc.Constraints)
+	optLoc.Multipliers = copySlice(optLoc.Multipliers, loc.Multipliers)
+	if loc.Jacobian == nil || loc.Jacobian.IsEmpty() {
+		optLoc.Jacobian = nil
+	} else {
+		if optLoc.Jacobian == nil {
+			optLoc.Jacobian = &mat.Dense{}
+		}
+		optLoc.Jacobian.CloneFrom(loc.Jacobian)
+	}
 	stats.MajorIterations++
 	stats.Runtime = time.Since(startTime)
-	status := checkLocationConvergence(optLoc, settings, converger)
+	status := checkLocationConvergence(optLoc, settings, converger, method)
 	if status != NotTerminated {
 		return status
 	}
 	return checkIterationLimits(optLoc, stats, settings)
 }
+
+// copySlice copies src into dst, reallocating dst if necessary, and returns
+// the result. copySlice returns nil if src is nil.
+func copySlice(dst, src []float64) []float64 {
+	if src == nil {
+		return nil
+	}
+	dst = resize(dst, len(src))
+	copy(dst, src)
+	return dst
+}
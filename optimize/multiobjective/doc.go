@@ -0,0 +1,9 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multiobjective implements evolutionary methods for multi-objective
+// optimization, which approximate the set of Pareto optimal trade-offs
+// between several conflicting objectives, and metrics for assessing the
+// quality of the approximations.
+package multiobjective // import "gonum.org/v1/gonum/optimize/multiobjective"
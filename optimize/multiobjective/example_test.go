@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective_test
+
+import (
+	"fmt"
+	"log"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/optimize/multiobjective"
+)
+
+func ExampleMinimize() {
+	// Trade off the cost x² against the error (x-2)² of a design
+	// parameter x in [-5, 5]. Every x in [0, 2] is Pareto optimal.
+	p := multiobjective.Problem{
+		M: 2,
+		Func: func(f, x []float64) {
+			f[0] = x[0] * x[0]
+			f[1] = (x[0] - 2) * (x[0] - 2)
+		},
+		Lower: []float64{-5},
+		Upper: []float64{5},
+	}
+	method := &multiobjective.NSGA2{Population: 40, Src: rand.NewPCG(1, 1)}
+	result, err := multiobjective.Minimize(p, &multiobjective.Settings{Concurrent: 4}, method)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	n, _ := result.X.Dims()
+	lo, hi := result.X.At(0, 0), result.X.At(0, 0)
+	for i := 1; i < n; i++ {
+		lo = min(lo, result.X.At(i, 0))
+		hi = max(hi, result.X.At(i, 0))
+	}
+	fmt.Printf("Pareto set size: %d\n", n)
+	fmt.Printf("Pareto set within [0, 2]: %t\n", lo > -0.01 && hi < 2.01)
+	fmt.Printf("Hypervolume: %.3f\n", multiobjective.Hypervolume(result.F, []float64{4, 4}))
+
+	// Output:
+	// Pareto set size: 40
+	// Pareto set within [0, 2]: true
+	// Hypervolume: 13.142
+}
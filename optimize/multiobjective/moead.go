@@ -0,0 +1,286 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*MOEAD)(nil)
+
+// MOEAD implements the multi-objective evolutionary algorithm based on
+// decomposition, MOEA/D, of Zhang and Li with the Tchebycheff approach.
+//
+// MOEAD decomposes the problem into scalar subproblems
+//
+//	minimize max_k λ_k |f_k(x) - z_k|,
+//
+// where z is the best value of each objective found so far and the weight
+// vectors λ are spread uniformly over the unit simplex. Each subproblem has
+// one member of the population, and neighboring subproblems, those with the
+// closest weight vectors, cooperate by sharing the children generated from
+// their members. In each generation a child is generated for every
+// subproblem from two parents chosen among its neighbors, as described by
+// Variation, and it replaces the members of a limited number of neighbors
+// whose subproblem it improves.
+//
+// The children of a generation are generated from the population at the
+// start of the generation, so that they can be evaluated concurrently if
+// Settings.Concurrent is larger than one, and the population is then
+// updated with the children in random order.
+//
+// References:
+//   - Zhang, Q., & Li, H. (2007). MOEA/D: A multiobjective evolutionary
+//     algorithm based on decomposition. IEEE Transactions on Evolutionary
+//     Computation, 11(6), 712-731.
+//   - Li, H., & Zhang, Q. (2009). Multiobjective optimization problems with
+//     complicated Pareto sets, MOEA/D and NSGA-II. IEEE Transactions on
+//     Evolutionary Computation, 13(2), 284-302.
+type MOEAD struct {
+	// Population is the requested number of subproblems. The weight vectors
+	// form the largest simplex lattice with at most Population points and
+	// at least one division, so the number of subproblems is Population for
+	// two objectives and may be smaller otherwise. If Population is zero, it
+	// is defaulted to 100.
+	Population int
+	// Neighbors is the number of neighbors of each subproblem, including
+	// the subproblem itself. If Neighbors is zero, it is defaulted to 20.
+	// It is limited to the number of subproblems.
+	Neighbors int
+	// NeighborProbability is the probability that the parents and the
+	// replaced members of a child are chosen among the neighbors rather
+	// than the whole population. If it is zero, it is defaulted to 0.9. It
+	// must be in (0, 1].
+	NeighborProbability float64
+	// Replacements is the maximum number of members replaced by a child.
+	// If Replacements is zero, it is defaulted to 2.
+	Replacements int
+
+	// Variation holds the parameters of the crossover and the mutation.
+	Variation
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	pop       int
+	delta     float64
+	nr        int
+	rnd       *rand.Rand
+	op        operator
+	weights   [][]float64
+	neighbors [][]int
+	all       []int
+	local     []bool
+	z         []float64
+	xs, fs    *mat.Dense
+	children  *mat.Dense
+	childF    *mat.Dense
+	evaluated bool
+}
+
+func (m *MOEAD) init(s *state) (optimize.Status, error) {
+	pop := m.Population
+	if pop == 0 {
+		pop = 100
+	}
+	if pop <= 0 {
+		panic("multiobjective: MOEAD population not positive")
+	}
+	m.delta = m.NeighborProbability
+	if m.delta == 0 {
+		m.delta = 0.9
+	}
+	if !(0 < m.delta && m.delta <= 1) {
+		panic("multiobjective: MOEAD neighbor probability out of range")
+	}
+	m.nr = m.Replacements
+	if m.nr == 0 {
+		m.nr = 2
+	}
+	if m.nr < 0 {
+		panic("multiobjective: MOEAD negative replacements")
+	}
+	m.weights = simplexLattice(s.p.M, pop)
+	m.pop = len(m.weights)
+	t := m.Neighbors
+	if t == 0 {
+		t = 20
+	}
+	if t < 0 {
+		panic("multiobjective: MOEAD negative neighbors")
+	}
+	t = min(t, m.pop)
+	m.neighbors = make([][]int, m.pop)
+	m.all = make([]int, m.pop)
+	dist := make([]float64, m.pop)
+	for i, w := range m.weights {
+		m.all[i] = i
+		for j, v := range m.weights {
+			dist[j] = floats.Distance(w, v, 2)
+		}
+		idx := make([]int, m.pop)
+		for j := range idx {
+			idx[j] = j
+		}
+		slices.SortStableFunc(idx, func(a, b int) int { return cmpFloat(dist[a], dist[b]) })
+		m.neighbors[i] = idx[:t]
+	}
+	m.local = make([]bool, m.pop)
+
+	m.rnd = newRand(m.Src)
+	m.op = newOperator(m.Variation, s.p.Lower, s.p.Upper, m.rnd)
+	m.xs = randomPopulation(s.p, m.pop, m.rnd)
+	m.fs = mat.NewDense(m.pop, s.p.M, nil)
+	m.children = mat.NewDense(m.pop, s.dim, nil)
+	m.childF = mat.NewDense(m.pop, s.p.M, nil)
+	m.evaluated = false
+	status, err := s.evaluate(m.xs, m.fs)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	m.evaluated = true
+	m.z = make([]float64, s.p.M)
+	for k := range m.z {
+		m.z[k] = math.Inf(1)
+	}
+	for i := 0; i < m.pop; i++ {
+		m.updateIdeal(m.fs.RawRowView(i))
+	}
+	return optimize.NotTerminated, nil
+}
+
+func (m *MOEAD) iterate(s *state) (optimize.Status, error) {
+	c2 := make([]float64, s.dim)
+	for i := 0; i < m.pop; i++ {
+		m.local[i] = m.rnd.Float64() < m.delta
+		pool := m.pool(i)
+		k := pool[m.rnd.IntN(len(pool))]
+		l := k
+		for len(pool) > 1 && l == k {
+			l = pool[m.rnd.IntN(len(pool))]
+		}
+		c := m.children.RawRowView(i)
+		m.op.crossover(c, c2, m.xs.RawRowView(k), m.xs.RawRowView(l))
+		m.op.mutate(c)
+	}
+	status, err := s.evaluate(m.children, m.childF)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	for _, i := range m.rnd.Perm(m.pop) {
+		y := m.children.RawRowView(i)
+		fy := m.childF.RawRowView(i)
+		m.updateIdeal(fy)
+		pool := m.pool(i)
+		var replaced int
+		for _, p := range m.rnd.Perm(len(pool)) {
+			if replaced >= m.nr {
+				break
+			}
+			j := pool[p]
+			w := m.weights[j]
+			if tchebycheff(fy, w, m.z) <= tchebycheff(m.fs.RawRowView(j), w, m.z) {
+				m.xs.SetRow(j, y)
+				m.fs.SetRow(j, fy)
+				replaced++
+			}
+		}
+	}
+	return optimize.NotTerminated, nil
+}
+
+// pool returns the subproblems from which the parents of the i-th child are
+// chosen and whose members it may replace.
+func (m *MOEAD) pool(i int) []int {
+	if m.local[i] {
+		return m.neighbors[i]
+	}
+	return m.all
+}
+
+// updateIdeal updates the ideal point with the objectives f.
+func (m *MOEAD) updateIdeal(f []float64) {
+	for k, v := range f {
+		m.z[k] = math.Min(m.z[k], v)
+	}
+}
+
+func (m *MOEAD) population() (x, f *mat.Dense) {
+	if !m.evaluated {
+		return nil, nil
+	}
+	return m.xs, m.fs
+}
+
+// tchebycheff returns the Tchebycheff scalarization of the objectives f with
+// the weights w and the ideal point z. Zero weights are replaced by a small
+// value so that all objectives contribute.
+func tchebycheff(f, w, z []float64) float64 {
+	const minWeight = 1e-6
+	var g float64
+	for k, v := range f {
+		g = math.Max(g, math.Max(w[k], minWeight)*math.Abs(v-z[k]))
+	}
+	return g
+}
+
+// simplexLattice returns the points of the simplex lattice design for m
+// objectives with the largest number of divisions h ≥ 1 for which the number
+// of points, (h+m-1 choose m-1), does not exceed n. For a single objective
+// it returns n copies of the only weight.
+func simplexLattice(m, n int) [][]float64 {
+	if m == 1 {
+		pts := make([][]float64, n)
+		for i := range pts {
+			pts[i] = []float64{1}
+		}
+		return pts
+	}
+	h := 1
+	for latticeSize(h+1, m) <= n {
+		h++
+	}
+	var pts [][]float64
+	w := make([]int, m)
+	var gen func(k, left int)
+	gen = func(k, left int) {
+		if k == m-1 {
+			w[k] = left
+			p := make([]float64, m)
+			for i, v := range w {
+				p[i] = float64(v) / float64(h)
+			}
+			pts = append(pts, p)
+			return
+		}
+		for v := 0; v <= left; v++ {
+			w[k] = v
+			gen(k+1, left-v)
+		}
+	}
+	gen(0, h)
+	return pts
+}
+
+// latticeSize returns the number of points of the simplex lattice design with
+// h divisions for m objectives, (h+m-1 choose m-1), saturating at the
+// maximum int value.
+func latticeSize(h, m int) int {
+	size := 1.0
+	for i := 1; i < m; i++ {
+		size = size * float64(h+i) / float64(i)
+	}
+	if size > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(math.Round(size))
+}
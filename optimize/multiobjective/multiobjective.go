@@ -0,0 +1,299 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const defaultGenerations = 100
+
+// Problem describes the multi-objective optimization problem
+//
+//	minimize (f_0(x), ..., f_{M-1}(x))
+//	subject to Lower ≤ x ≤ Upper,
+//
+// whose solution is the set of Pareto optimal locations, at which no
+// objective can be improved without worsening another.
+type Problem struct {
+	// M is the number of objectives.
+	M int
+
+	// Func evaluates the objectives at x and stores the result in f which
+	// will have length M. Func must not modify x. If Settings.Concurrent is
+	// larger than one, Func is called concurrently and must be safe for
+	// concurrent use. NaN values of the objectives are treated as +Inf.
+	Func func(f, x []float64)
+
+	// Lower and Upper are the bounds on the variables. They must have the
+	// same length, which is the dimension of the problem, and hold finite
+	// values with Lower[i] ≤ Upper[i].
+	Lower, Upper []float64
+
+	// Status reports the status of the problem being optimized and any
+	// error. It is called before the evaluation of every generation and can
+	// be used to terminate early.
+	Status func() (optimize.Status, error)
+}
+
+// Settings represents settings of the multi-objective optimization run. See
+// the field comments for default values.
+type Settings struct {
+	// Generations is the number of generations of the population after the
+	// initial one. IterationLimit status is returned when the number of
+	// generations is reached. If Generations is zero, a default value of
+	// 100 is used.
+	Generations int
+
+	// FuncEvaluations is the maximum allowed number of evaluations of the
+	// objectives. FunctionEvaluationLimit status is returned if a generation
+	// would exceed this number, which is checked before the generation is
+	// evaluated. If it equals zero, this setting has no effect.
+	FuncEvaluations int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If it
+	// equals zero, this setting has no effect.
+	Runtime time.Duration
+
+	// Concurrent is the number of concurrent evaluations of the objectives
+	// when evaluating a generation. If Concurrent is zero, it is defaulted
+	// to one. The result of a run does not depend on Concurrent.
+	Concurrent int
+}
+
+// Method is an evolutionary method for multi-objective optimization. Method
+// is implemented by NSGA2 and MOEAD.
+type Method interface {
+	// init initializes the method for the problem and evaluates the initial
+	// population using s.
+	init(s *state) (optimize.Status, error)
+	// iterate evolves the population by one generation using s to evaluate
+	// the new locations.
+	iterate(s *state) (optimize.Status, error)
+	// population returns the current population and its objectives, or
+	// nil if the initial population has not been evaluated.
+	population() (x, f *mat.Dense)
+}
+
+// Stats contains the statistics of the run.
+type Stats struct {
+	MajorIterations int           // Number of generations after the initial one
+	FuncEvaluations int           // Number of evaluations of the objectives
+	Runtime         time.Duration // Total runtime of the optimization
+}
+
+// Result represents the answer of a multi-objective optimization run.
+type Result struct {
+	// X holds the distinct non-dominated locations of the final population
+	// in its rows, which approximate the Pareto set.
+	X *mat.Dense
+	// F holds the objectives at the rows of X in its rows, which
+	// approximate the Pareto front.
+	F *mat.Dense
+
+	Stats
+	Status optimize.Status
+}
+
+// Minimize approximates the Pareto set of p using the given method. If
+// settings is nil, the zero value is used, see the documentation of Settings
+// for the default values. If method is nil, NSGA2 is used.
+//
+// Minimize returns a Result holding the non-dominated locations of the final
+// population and any error that occurred. The error is non-nil if p.Status
+// returns an error.
+func Minimize(p Problem, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Func == nil {
+		panic("multiobjective: objective function is undefined")
+	}
+	if p.M <= 0 {
+		panic("multiobjective: non-positive number of objectives")
+	}
+	dim := len(p.Lower)
+	if dim == 0 {
+		panic("multiobjective: zero dimensional input")
+	}
+	if len(p.Upper) != dim {
+		panic("multiobjective: bound length mismatch")
+	}
+	for i, lo := range p.Lower {
+		up := p.Upper[i]
+		if math.IsInf(lo, 0) || math.IsInf(up, 0) || !(lo <= up) {
+			panic("multiobjective: invalid bounds")
+		}
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &NSGA2{}
+	}
+	generations := settings.Generations
+	if generations == 0 {
+		generations = defaultGenerations
+	}
+
+	s := &state{
+		p:          &p,
+		settings:   settings,
+		dim:        dim,
+		startTime:  startTime,
+		concurrent: max(1, settings.Concurrent),
+	}
+	status, err := method.init(s)
+	for status == optimize.NotTerminated && err == nil {
+		if s.stats.MajorIterations >= generations {
+			status = optimize.IterationLimit
+			break
+		}
+		status, err = method.iterate(s)
+		if status != optimize.NotTerminated || err != nil {
+			break
+		}
+		s.stats.MajorIterations++
+	}
+	s.stats.Runtime = time.Since(startTime)
+
+	x, f := method.population()
+	res := paretoSet(x, f)
+	res.Stats = s.stats
+	res.Status = status
+	return res, err
+}
+
+// state holds the problem of a multi-objective optimization run and
+// performs the evaluations of the objectives.
+type state struct {
+	p          *Problem
+	settings   *Settings
+	stats      Stats
+	dim        int
+	startTime  time.Time
+	concurrent int
+}
+
+// evaluate evaluates the objectives at the rows of xs and stores them in the
+// rows of fs. The evaluations are performed concurrently if
+// Settings.Concurrent is larger than one. evaluate returns a status other
+// than NotTerminated without evaluating the objectives if the run must be
+// terminated.
+func (s *state) evaluate(xs, fs *mat.Dense) (optimize.Status, error) {
+	n, _ := xs.Dims()
+	if s.p.Status != nil {
+		status, err := s.p.Status()
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+	}
+	if s.settings.FuncEvaluations > 0 && s.stats.FuncEvaluations+n > s.settings.FuncEvaluations {
+		return optimize.FunctionEvaluationLimit, nil
+	}
+	if s.settings.Runtime > 0 && time.Since(s.startTime) >= s.settings.Runtime {
+		return optimize.RuntimeLimit, nil
+	}
+
+	eval := func(i int, x []float64) {
+		copy(x, xs.RawRowView(i))
+		f := fs.RawRowView(i)
+		s.p.Func(f, x)
+		for k, v := range f {
+			if math.IsNaN(v) {
+				f[k] = math.Inf(1)
+			}
+		}
+	}
+	workers := min(s.concurrent, n)
+	if workers <= 1 {
+		x := make([]float64, s.dim)
+		for i := 0; i < n; i++ {
+			eval(i, x)
+		}
+	} else {
+		jobs := make(chan int)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				x := make([]float64, s.dim)
+				for i := range jobs {
+					eval(i, x)
+				}
+			}()
+		}
+		for i := 0; i < n; i++ {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
+	}
+	s.stats.FuncEvaluations += n
+	return optimize.NotTerminated, nil
+}
+
+// randomPopulation returns a population of n locations sampled uniformly
+// within the bounds of p.
+func randomPopulation(p *Problem, n int, rnd *rand.Rand) *mat.Dense {
+	xs := mat.NewDense(n, len(p.Lower), nil)
+	for i := 0; i < n; i++ {
+		row := xs.RawRowView(i)
+		for j, lo := range p.Lower {
+			row[j] = lo + (p.Upper[j]-lo)*rnd.Float64()
+		}
+	}
+	return xs
+}
+
+// paretoSet returns a Result holding the distinct non-dominated rows of x
+// and f.
+func paretoSet(x, f *mat.Dense) *Result {
+	if x == nil {
+		return &Result{X: &mat.Dense{}, F: &mat.Dense{}}
+	}
+	n, dim := x.Dims()
+	_, m := f.Dims()
+	var idx []int
+	fronts := nonDominatedSort(rows(f))
+	if len(fronts) != 0 {
+	Front:
+		for _, i := range fronts[0] {
+			for _, j := range idx {
+				if mat.Equal(x.RowView(i), x.RowView(j)) {
+					continue Front
+				}
+			}
+			idx = append(idx, i)
+		}
+	}
+	if n == 0 || len(idx) == 0 {
+		return &Result{X: &mat.Dense{}, F: &mat.Dense{}}
+	}
+	res := &Result{
+		X: mat.NewDense(len(idx), dim, nil),
+		F: mat.NewDense(len(idx), m, nil),
+	}
+	for k, i := range idx {
+		res.X.SetRow(k, x.RawRowView(i))
+		res.F.SetRow(k, f.RawRowView(i))
+	}
+	return res
+}
+
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(src)
+}
+
+const badLength = "multiobjective: slice length mismatch"
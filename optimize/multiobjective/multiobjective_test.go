@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync/atomic"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// zdt1 returns the ZDT1 test problem with n variables, whose Pareto front is
+// f_1 = 1 - √f_0 for f_0 in [0, 1].
+func zdt1(n int) Problem {
+	lower := make([]float64, n)
+	upper := make([]float64, n)
+	for i := range upper {
+		upper[i] = 1
+	}
+	return Problem{
+		M: 2,
+		Func: func(f, x []float64) {
+			g := 1 + 9*floats.Sum(x[1:])/float64(len(x)-1)
+			f[0] = x[0]
+			f[1] = g * (1 - math.Sqrt(x[0]/g))
+		},
+		Lower: lower,
+		Upper: upper,
+	}
+}
+
+// dtlz2 returns the DTLZ2 test problem with three objectives and n variables,
+// whose Pareto front is the part of the unit sphere in the positive orthant.
+func dtlz2(n int) Problem {
+	lower := make([]float64, n)
+	upper := make([]float64, n)
+	for i := range upper {
+		upper[i] = 1
+	}
+	return Problem{
+		M: 3,
+		Func: func(f, x []float64) {
+			var g float64
+			for _, v := range x[2:] {
+				g += (v - 0.5) * (v - 0.5)
+			}
+			a, b := x[0]*math.Pi/2, x[1]*math.Pi/2
+			f[0] = (1 + g) * math.Cos(a) * math.Cos(b)
+			f[1] = (1 + g) * math.Cos(a) * math.Sin(b)
+			f[2] = (1 + g) * math.Sin(a)
+		},
+		Lower: lower,
+		Upper: upper,
+	}
+}
+
+func TestMinimizeZDT1(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		method Method
+	}{
+		{name: "NSGA2", method: &NSGA2{Src: rand.NewPCG(1, 1)}},
+		{name: "MOEAD", method: &MOEAD{Src: rand.NewPCG(1, 1)}},
+	} {
+		p := zdt1(10)
+		res, err := Minimize(p, &Settings{Generations: 200}, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if res.Status != optimize.IterationLimit {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, res.Status, optimize.IterationLimit)
+		}
+		if res.MajorIterations != 200 {
+			t.Errorf("%s: unexpected number of generations: got %d, want 200", test.name, res.MajorIterations)
+		}
+		n, _ := res.F.Dims()
+		if n < 50 {
+			t.Errorf("%s: too few points in the Pareto set: %d", test.name, n)
+		}
+		f := make([]float64, 2)
+		for i := 0; i < n; i++ {
+			p.Func(f, res.X.RawRowView(i))
+			if !floats.Equal(f, res.F.RawRowView(i)) {
+				t.Errorf("%s: objectives mismatch for row %d", test.name, i)
+			}
+			if d := f[1] - (1 - math.Sqrt(f[0])); d > 0.05 {
+				t.Errorf("%s: point %v not close to the Pareto front: distance %v", test.name, f, d)
+			}
+		}
+		// The hypervolume of the Pareto front is 2/3.
+		if hv := Hypervolume(res.F, []float64{1, 1}); hv < 0.65 {
+			t.Errorf("%s: hypervolume too small: got %v, want close to 2/3", test.name, hv)
+		}
+		fronts := NonDominatedSort(res.F)
+		if len(fronts) != 1 {
+			t.Errorf("%s: result is not mutually non-dominated", test.name)
+		}
+	}
+}
+
+func TestMinimizeDTLZ2(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		method Method
+	}{
+		{name: "NSGA2", method: &NSGA2{Population: 92, Src: rand.NewPCG(1, 2)}},
+		{name: "MOEAD", method: &MOEAD{Population: 91, Src: rand.NewPCG(1, 2)}},
+	} {
+		res, err := Minimize(dtlz2(7), &Settings{Generations: 150}, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		n, _ := res.F.Dims()
+		if n < 40 {
+			t.Errorf("%s: too few points in the Pareto set: %d", test.name, n)
+		}
+		for i := 0; i < n; i++ {
+			if r := floats.Norm(res.F.RawRowView(i), 2); r > 1.1 {
+				t.Errorf("%s: point %v not close to the Pareto front: radius %v", test.name, res.F.RawRowView(i), r)
+			}
+		}
+		// The hypervolume of the Pareto front with respect to (1, 1, 1) is
+		// 1 - π/6, which is only approached by a large number of points.
+		want := 1 - math.Pi/6
+		if hv := Hypervolume(res.F, []float64{1, 1, 1}); hv < 0.7*want {
+			t.Errorf("%s: hypervolume too small: got %v, want close to %v", test.name, hv, want)
+		}
+	}
+}
+
+func TestMinimizeConcurrent(t *testing.T) {
+	t.Parallel()
+	for _, newMethod := range []func() Method{
+		func() Method { return &NSGA2{Population: 20, Src: rand.NewPCG(1, 3)} },
+		func() Method { return &MOEAD{Population: 20, Src: rand.NewPCG(1, 3)} },
+	} {
+		var want *Result
+		for _, concurrent := range []int{0, 1, 3, 8} {
+			p := zdt1(5)
+			var evals atomic.Int64
+			f := p.Func
+			p.Func = func(y, x []float64) {
+				evals.Add(1)
+				f(y, x)
+			}
+			res, err := Minimize(p, &Settings{Generations: 20, Concurrent: concurrent}, newMethod())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if int(evals.Load()) != res.FuncEvaluations {
+				t.Errorf("unexpected number of evaluations: got %d, want %d", res.FuncEvaluations, evals.Load())
+			}
+			if want == nil {
+				want = res
+				continue
+			}
+			if !mat.Equal(res.X, want.X) || !mat.Equal(res.F, want.F) {
+				t.Errorf("result with %d concurrent evaluations differs from serial result", concurrent)
+			}
+		}
+	}
+}
+
+func TestMinimizeTermination(t *testing.T) {
+	t.Parallel()
+	p := zdt1(5)
+	res, err := Minimize(p, &Settings{FuncEvaluations: 250}, &NSGA2{Population: 20, Src: rand.NewPCG(1, 4)})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if res.Status != optimize.FunctionEvaluationLimit {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, optimize.FunctionEvaluationLimit)
+	}
+	if res.FuncEvaluations > 250 {
+		t.Errorf("evaluation limit exceeded: %d", res.FuncEvaluations)
+	}
+
+	errStop := errors.New("stop")
+	var calls int
+	p.Status = func() (optimize.Status, error) {
+		calls++
+		if calls > 3 {
+			return optimize.Failure, errStop
+		}
+		return optimize.NotTerminated, nil
+	}
+	res, err = Minimize(p, nil, &MOEAD{Population: 20, Src: rand.NewPCG(1, 4)})
+	if err != errStop {
+		t.Errorf("unexpected error: got %v, want %v", err, errStop)
+	}
+	if res.Status != optimize.Failure || res.MajorIterations != 2 {
+		t.Errorf("unexpected termination: status %v after %d generations", res.Status, res.MajorIterations)
+	}
+	if n, _ := res.X.Dims(); n == 0 {
+		t.Errorf("missing Pareto set after termination")
+	}
+
+	// Termination before the initial population is evaluated gives an
+	// empty result.
+	p.Status = func() (optimize.Status, error) { return optimize.Failure, errStop }
+	res, err = Minimize(p, nil, nil)
+	if err != errStop {
+		t.Errorf("unexpected error: got %v, want %v", err, errStop)
+	}
+	if !res.X.IsEmpty() || !res.F.IsEmpty() {
+		t.Errorf("unexpected non-empty result")
+	}
+}
+
+func TestSimplexLattice(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		m, n, want int
+	}{
+		{m: 2, n: 100, want: 100},
+		{m: 3, n: 91, want: 91},
+		{m: 3, n: 100, want: 91},
+		{m: 4, n: 2, want: 4},
+		{m: 1, n: 5, want: 5},
+	} {
+		w := simplexLattice(test.m, test.n)
+		if len(w) != test.want {
+			t.Errorf("unexpected number of weights for m=%d, n=%d: got %d, want %d", test.m, test.n, len(w), test.want)
+		}
+		for _, v := range w {
+			if len(v) != test.m || math.Abs(floats.Sum(v)-1) > 1e-14 {
+				t.Errorf("invalid weight vector %v", v)
+			}
+		}
+	}
+}
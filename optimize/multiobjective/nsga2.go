@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*NSGA2)(nil)
+
+// NSGA2 implements the elitist non-dominated sorting genetic algorithm
+// NSGA-II of Deb et al.
+//
+// In each generation, NSGA2 selects parents from the population by binary
+// tournaments, preferring members in better fronts of the non-dominated
+// sorting and, within a front, members with a larger crowding distance. The
+// parents are recombined and mutated as described by Variation, and the
+// next population is chosen from the union of the population and the
+// children by the same order, which keeps the best members found and
+// spreads the population along the Pareto front. The children of a
+// generation are evaluated concurrently if Settings.Concurrent is larger
+// than one.
+//
+// References:
+//   - Deb, K., Pratap, A., Agarwal, S., & Meyarivan, T. (2002). A fast and
+//     elitist multiobjective genetic algorithm: NSGA-II. IEEE Transactions
+//     on Evolutionary Computation, 6(2), 182-197.
+type NSGA2 struct {
+	// Population is the size of the population. If Population is zero, it
+	// is defaulted to 100. Population must be at least 4.
+	Population int
+
+	// Variation holds the parameters of the crossover and the mutation.
+	Variation
+
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	pop       int
+	rnd       *rand.Rand
+	op        operator
+	xs, fs    *mat.Dense
+	children  *mat.Dense
+	childF    *mat.Dense
+	rank      []int
+	crowd     []float64
+	evaluated bool
+}
+
+func (g *NSGA2) init(s *state) (optimize.Status, error) {
+	g.pop = g.Population
+	if g.pop == 0 {
+		g.pop = 100
+	}
+	if g.pop < 4 {
+		panic("multiobjective: NSGA2 population smaller than 4")
+	}
+	g.rnd = newRand(g.Src)
+	g.op = newOperator(g.Variation, s.p.Lower, s.p.Upper, g.rnd)
+	g.xs = randomPopulation(s.p, g.pop, g.rnd)
+	g.fs = mat.NewDense(g.pop, s.p.M, nil)
+	g.children = mat.NewDense(g.pop, s.dim, nil)
+	g.childF = mat.NewDense(g.pop, s.p.M, nil)
+	g.rank = make([]int, g.pop)
+	g.crowd = make([]float64, g.pop)
+	g.evaluated = false
+	status, err := s.evaluate(g.xs, g.fs)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	g.evaluated = true
+	g.survive(g.xs, g.fs)
+	return optimize.NotTerminated, nil
+}
+
+func (g *NSGA2) iterate(s *state) (optimize.Status, error) {
+	c2 := make([]float64, s.dim)
+	for k := 0; k < g.pop; k += 2 {
+		p1 := g.xs.RawRowView(g.tournament())
+		p2 := g.xs.RawRowView(g.tournament())
+		c1 := g.children.RawRowView(k)
+		dst := c2
+		if k+1 < g.pop {
+			dst = g.children.RawRowView(k + 1)
+		}
+		g.op.crossover(c1, dst, p1, p2)
+		g.op.mutate(c1)
+		g.op.mutate(dst)
+	}
+	status, err := s.evaluate(g.children, g.childF)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	var xs, fs mat.Dense
+	xs.Stack(g.xs, g.children)
+	fs.Stack(g.fs, g.childF)
+	g.survive(&xs, &fs)
+	return optimize.NotTerminated, nil
+}
+
+// survive chooses the next population from the rows of xs and fs by their
+// front and crowding distance, and stores their ranks and crowding
+// distances.
+func (g *NSGA2) survive(xs, fs *mat.Dense) {
+	var (
+		selected []int
+		rank     []int
+		crowd    []float64
+	)
+	for r, front := range nonDominatedSort(rows(fs)) {
+		f := make([][]float64, len(front))
+		for k, i := range front {
+			f[k] = fs.RawRowView(i)
+		}
+		d := make([]float64, len(front))
+		crowdingDistance(d, f)
+		order := make([]int, len(front))
+		for k := range order {
+			order[k] = k
+		}
+		if len(selected)+len(front) > g.pop {
+			// Truncate the last front keeping the least crowded members.
+			slices.SortStableFunc(order, func(a, b int) int { return cmpFloat(d[b], d[a]) })
+			order = order[:g.pop-len(selected)]
+		}
+		for _, k := range order {
+			selected = append(selected, front[k])
+			rank = append(rank, r)
+			crowd = append(crowd, d[k])
+		}
+		if len(selected) == g.pop {
+			break
+		}
+	}
+	x := mat.NewDense(g.pop, xs.RawMatrix().Cols, nil)
+	f := mat.NewDense(g.pop, fs.RawMatrix().Cols, nil)
+	for k, i := range selected {
+		x.SetRow(k, xs.RawRowView(i))
+		f.SetRow(k, fs.RawRowView(i))
+	}
+	g.xs, g.fs = x, f
+	copy(g.rank, rank)
+	copy(g.crowd, crowd)
+}
+
+// tournament returns the index of the winner of a binary tournament between
+// two random members of the population.
+func (g *NSGA2) tournament() int {
+	i, j := g.rnd.IntN(g.pop), g.rnd.IntN(g.pop)
+	switch {
+	case g.rank[i] < g.rank[j]:
+		return i
+	case g.rank[j] < g.rank[i]:
+		return j
+	case g.crowd[i] > g.crowd[j]:
+		return i
+	case g.crowd[j] > g.crowd[i]:
+		return j
+	}
+	if g.rnd.IntN(2) == 0 {
+		return i
+	}
+	return j
+}
+
+func (g *NSGA2) population() (x, f *mat.Dense) {
+	if !g.evaluated {
+		return nil, nil
+	}
+	return g.xs, g.fs
+}
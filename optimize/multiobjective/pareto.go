@@ -0,0 +1,289 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Dominates returns whether the objective vector a Pareto dominates b, that
+// is, whether a_i ≤ b_i for all i and a_i < b_i for at least one i.
+//
+// Dominates panics if the lengths of a and b are not equal.
+func Dominates(a, b []float64) bool {
+	if len(a) != len(b) {
+		panic(badLength)
+	}
+	var strict bool
+	for i, v := range a {
+		if v > b[i] {
+			return false
+		}
+		if v < b[i] {
+			strict = true
+		}
+	}
+	return strict
+}
+
+// NonDominatedSort sorts the objective vectors held in the rows of f into
+// fronts. The first front holds the indices of the rows that are not
+// dominated by any other row, and each following front holds the indices of
+// the rows that are only dominated by rows in the preceding fronts. The
+// indices within a front are in increasing order.
+//
+// NonDominatedSort uses the algorithm of Deb et al., which requires O(M N²)
+// operations for N vectors of M objectives.
+//
+// References:
+//   - Deb, K., Pratap, A., Agarwal, S., & Meyarivan, T. (2002). A fast and
+//     elitist multiobjective genetic algorithm: NSGA-II. IEEE Transactions
+//     on Evolutionary Computation, 6(2), 182-197.
+func NonDominatedSort(f mat.Matrix) [][]int {
+	return nonDominatedSort(rows(f))
+}
+
+func nonDominatedSort(f [][]float64) [][]int {
+	n := len(f)
+	dominated := make([][]int, n)
+	count := make([]int, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			switch {
+			case Dominates(f[i], f[j]):
+				dominated[i] = append(dominated[i], j)
+				count[j]++
+			case Dominates(f[j], f[i]):
+				dominated[j] = append(dominated[j], i)
+				count[i]++
+			}
+		}
+	}
+	var front []int
+	for i, c := range count {
+		if c == 0 {
+			front = append(front, i)
+		}
+	}
+	var fronts [][]int
+	for len(front) > 0 {
+		fronts = append(fronts, front)
+		var next []int
+		for _, i := range front {
+			for _, j := range dominated[i] {
+				count[j]--
+				if count[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		slices.Sort(next)
+		front = next
+	}
+	return fronts
+}
+
+// CrowdingDistance computes the crowding distances of the objective vectors
+// held in the rows of f, which measure the density of the vectors around
+// each other. The crowding distance of a vector is the sum over the
+// objectives of the distance between its neighbors along the objective,
+// normalized by the range of the objective. Vectors with the smallest or
+// largest value of an objective have an infinite crowding distance.
+//
+// If dst is not nil, the result will be stored in-place into dst and
+// returned, otherwise a new slice will be allocated first. CrowdingDistance
+// panics if the length of dst is not equal to the number of rows of f.
+//
+// References:
+//   - Deb, K., Pratap, A., Agarwal, S., & Meyarivan, T. (2002). A fast and
+//     elitist multiobjective genetic algorithm: NSGA-II. IEEE Transactions
+//     on Evolutionary Computation, 6(2), 182-197.
+func CrowdingDistance(dst []float64, f mat.Matrix) []float64 {
+	n, _ := f.Dims()
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic(badLength)
+	}
+	crowdingDistance(dst, rows(f))
+	return dst
+}
+
+func crowdingDistance(dst []float64, f [][]float64) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	if len(f) == 0 {
+		return
+	}
+	idx := make([]int, len(f))
+	for k := range f[0] {
+		for i := range idx {
+			idx[i] = i
+		}
+		slices.SortStableFunc(idx, func(a, b int) int {
+			return cmpFloat(f[a][k], f[b][k])
+		})
+		first, last := idx[0], idx[len(idx)-1]
+		dst[first] = math.Inf(1)
+		dst[last] = math.Inf(1)
+		r := f[last][k] - f[first][k]
+		if r == 0 || math.IsInf(r, 0) || math.IsNaN(r) {
+			continue
+		}
+		for i := 1; i < len(idx)-1; i++ {
+			dst[idx[i]] += (f[idx[i+1]][k] - f[idx[i-1]][k]) / r
+		}
+	}
+}
+
+// Hypervolume returns the hypervolume indicator of the objective vectors held
+// in the rows of f with respect to the reference point ref, the volume of the
+// region dominated by the vectors and bounded above by ref. Larger values
+// indicate a better approximation of the Pareto front. Vectors that do not
+// strictly dominate ref in every objective do not contribute.
+//
+// Hypervolume computes the exact volume by slicing the region along the
+// objectives, which is efficient for two and three objectives but grows
+// exponentially with the number of objectives.
+//
+// Hypervolume panics if the length of ref is not equal to the number of
+// columns of f.
+//
+// References:
+//   - While, L., Hingston, P., Barone, L., & Huband, S. (2006). A faster
+//     algorithm for calculating hypervolume. IEEE Transactions on
+//     Evolutionary Computation, 10(1), 29-38.
+func Hypervolume(f mat.Matrix, ref []float64) float64 {
+	_, m := f.Dims()
+	if len(ref) != m {
+		panic(badLength)
+	}
+	var pts [][]float64
+	for _, p := range rows(f) {
+		if dominatesStrictly(p, ref) {
+			pts = append(pts, p)
+		}
+	}
+	return hypervolume(pts, ref, m)
+}
+
+// dominatesStrictly returns whether a_i < b_i for all i.
+func dominatesStrictly(a, b []float64) bool {
+	for i, v := range a {
+		if !(v < b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// hypervolume returns the hypervolume of the first d objectives of pts with
+// respect to ref. All points must strictly dominate ref.
+func hypervolume(pts [][]float64, ref []float64, d int) float64 {
+	switch {
+	case len(pts) == 0:
+		return 0
+	case d == 1:
+		lo := pts[0][0]
+		for _, p := range pts[1:] {
+			lo = math.Min(lo, p[0])
+		}
+		return ref[0] - lo
+	case d == 2:
+		pts = slices.Clone(pts)
+		slices.SortFunc(pts, func(a, b []float64) int { return cmpFloat(a[0], b[0]) })
+		var vol float64
+		top := ref[1]
+		for _, p := range pts {
+			if p[1] < top {
+				vol += (ref[0] - p[0]) * (top - p[1])
+				top = p[1]
+			}
+		}
+		return vol
+	}
+	// Slice the region along the last objective. The cross section between
+	// consecutive values of the objective is the region dominated by the
+	// points below the slice.
+	pts = slices.Clone(pts)
+	slices.SortFunc(pts, func(a, b []float64) int { return cmpFloat(a[d-1], b[d-1]) })
+	var vol float64
+	var section [][]float64
+	for i, p := range pts {
+		section = appendNonDominated(section, p, d-1)
+		next := ref[d-1]
+		if i+1 < len(pts) {
+			next = pts[i+1][d-1]
+		}
+		if h := next - p[d-1]; h > 0 {
+			vol += h * hypervolume(section, ref, d-1)
+		}
+	}
+	return vol
+}
+
+// appendNonDominated adds p to the set of mutually non-dominated points in
+// the first d objectives, removing the points dominated by p.
+func appendNonDominated(set [][]float64, p []float64, d int) [][]float64 {
+	for _, q := range set {
+		if weaklyDominates(q[:d], p[:d]) {
+			return set
+		}
+	}
+	kept := set[:0]
+	for _, q := range set {
+		if !weaklyDominates(p[:d], q[:d]) {
+			kept = append(kept, q)
+		}
+	}
+	return append(kept, p)
+}
+
+// weaklyDominates returns whether a_i ≤ b_i for all i.
+func weaklyDominates(a, b []float64) bool {
+	for i, v := range a {
+		if v > b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// rows returns the rows of f as slices.
+func rows(f mat.Matrix) [][]float64 {
+	n, m := f.Dims()
+	r := make([][]float64, n)
+	if d, ok := f.(mat.RawMatrixer); ok {
+		raw := d.RawMatrix()
+		for i := range r {
+			r[i] = raw.Data[i*raw.Stride : i*raw.Stride+m]
+		}
+		return r
+	}
+	for i := range r {
+		r[i] = mat.Row(nil, i, f)
+	}
+	return r
+}
+
+// cmpFloat compares a and b, ordering NaN after all other values.
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case math.IsNaN(a) && !math.IsNaN(b):
+		return 1
+	case !math.IsNaN(a) && math.IsNaN(b):
+		return -1
+	}
+	return 0
+}
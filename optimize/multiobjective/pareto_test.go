@@ -0,0 +1,153 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestDominates(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b []float64
+		want bool
+	}{
+		{a: []float64{1, 2}, b: []float64{2, 3}, want: true},
+		{a: []float64{1, 2}, b: []float64{1, 3}, want: true},
+		{a: []float64{1, 2}, b: []float64{1, 2}, want: false},
+		{a: []float64{1, 3}, b: []float64{2, 2}, want: false},
+		{a: []float64{2, 3}, b: []float64{1, 2}, want: false},
+		{a: []float64{1, 2, 3}, b: []float64{1, 2, math.Inf(1)}, want: true},
+	} {
+		if got := Dominates(test.a, test.b); got != test.want {
+			t.Errorf("unexpected result for Dominates(%v, %v): got %t, want %t", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestNonDominatedSort(t *testing.T) {
+	t.Parallel()
+	f := mat.NewDense(7, 2, []float64{
+		1, 5,
+		2, 2,
+		3, 3,
+		5, 1,
+		4, 4,
+		2, 2,
+		6, 6,
+	})
+	want := [][]int{{0, 1, 3, 5}, {2}, {4}, {6}}
+	if got := NonDominatedSort(f); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected fronts: got %v, want %v", got, want)
+	}
+}
+
+func TestCrowdingDistance(t *testing.T) {
+	t.Parallel()
+	f := mat.NewDense(4, 2, []float64{
+		0, 4,
+		1, 2,
+		3, 1,
+		4, 0,
+	})
+	inf := math.Inf(1)
+	want := []float64{inf, 3.0/4 + 3.0/4, 3.0/4 + 2.0/4, inf}
+	got := CrowdingDistance(nil, f)
+	if !floats.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected crowding distances: got %v, want %v", got, want)
+	}
+}
+
+func TestHypervolume(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f    *mat.Dense
+		ref  []float64
+		want float64
+	}{
+		{
+			f:    mat.NewDense(1, 3, []float64{1, 2, 3}),
+			ref:  []float64{4, 4, 4},
+			want: 3 * 2 * 1,
+		},
+		{
+			f:    mat.NewDense(3, 2, []float64{1, 3, 2, 2, 3, 1}),
+			ref:  []float64{4, 4},
+			want: 6,
+		},
+		{
+			// Points not dominating the reference point do not contribute.
+			f:    mat.NewDense(2, 2, []float64{1, 1, 0, 5}),
+			ref:  []float64{2, 2},
+			want: 1,
+		},
+	} {
+		if got := Hypervolume(test.f, test.ref); !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected hypervolume: got %v, want %v", got, test.want)
+		}
+	}
+
+	// Compare with the inclusion-exclusion formula for random sets.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, m := range []int{1, 2, 3, 4} {
+		for trial := 0; trial < 20; trial++ {
+			n := rnd.IntN(8) + 1
+			f := mat.NewDense(n, m, nil)
+			for i := 0; i < n; i++ {
+				for k := 0; k < m; k++ {
+					f.Set(i, k, rnd.Float64())
+				}
+			}
+			ref := make([]float64, m)
+			for k := range ref {
+				ref[k] = 1
+			}
+			want := inclusionExclusion(f, ref)
+			if got := Hypervolume(f, ref); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected hypervolume for m=%d, n=%d: got %v, want %v", m, n, got, want)
+			}
+		}
+	}
+}
+
+// inclusionExclusion returns the hypervolume of the rows of f with respect to
+// ref computed by the inclusion-exclusion principle.
+func inclusionExclusion(f *mat.Dense, ref []float64) float64 {
+	n, m := f.Dims()
+	var vol float64
+	corner := make([]float64, m)
+	for set := 1; set < 1<<n; set++ {
+		for k := range corner {
+			corner[k] = math.Inf(-1)
+		}
+		var size int
+		for i := 0; i < n; i++ {
+			if set&(1<<i) == 0 {
+				continue
+			}
+			size++
+			for k := range corner {
+				corner[k] = math.Max(corner[k], f.At(i, k))
+			}
+		}
+		v := 1.0
+		for k, c := range corner {
+			v *= math.Max(0, ref[k]-c)
+		}
+		if size%2 == 1 {
+			vol += v
+		} else {
+			vol -= v
+		}
+	}
+	return vol
+}
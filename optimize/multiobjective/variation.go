@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multiobjective
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Variation holds the parameters of the simulated binary crossover and the
+// polynomial mutation operators, which generate new locations from two
+// parent locations within the bounds of the problem.
+//
+// References:
+//   - Deb, K., & Agrawal, R. B. (1995). Simulated binary crossover for
+//     continuous search space. Complex Systems, 9(2), 115-148.
+//   - Deb, K., & Goyal, M. (1996). A combined genetic adaptive search
+//     (GeneAS) for engineering design. Computer Science and Informatics,
+//     26(4), 30-45.
+type Variation struct {
+	// CrossoverProbability is the probability that two parents are
+	// recombined by crossover rather than copied. If it is zero, a default
+	// value of 0.9 is used. It must be in (0, 1].
+	CrossoverProbability float64
+	// CrossoverIndex is the distribution index of the crossover. Larger
+	// values generate children closer to the parents. If it is zero, a
+	// default value of 20 is used. It must not be negative.
+	CrossoverIndex float64
+	// MutationProbability is the probability that a variable of a child is
+	// mutated. If it is zero, a default value of 1/dim is used. It must be
+	// in (0, 1].
+	MutationProbability float64
+	// MutationIndex is the distribution index of the mutation. Larger values
+	// generate mutations closer to the child. If it is zero, a default value
+	// of 20 is used. It must not be negative.
+	MutationIndex float64
+}
+
+// operator performs the variation of locations within bounds.
+type operator struct {
+	pc, etac     float64
+	pm, etam     float64
+	lower, upper []float64
+	rnd          *rand.Rand
+}
+
+func newOperator(v Variation, lower, upper []float64, rnd *rand.Rand) operator {
+	op := operator{
+		pc:    v.CrossoverProbability,
+		etac:  v.CrossoverIndex,
+		pm:    v.MutationProbability,
+		etam:  v.MutationIndex,
+		lower: lower,
+		upper: upper,
+		rnd:   rnd,
+	}
+	if op.pc == 0 {
+		op.pc = 0.9
+	}
+	if op.etac == 0 {
+		op.etac = 20
+	}
+	if op.pm == 0 {
+		op.pm = 1 / float64(len(lower))
+	}
+	if op.etam == 0 {
+		op.etam = 20
+	}
+	if !(0 < op.pc && op.pc <= 1) || !(0 < op.pm && op.pm <= 1) || op.etac < 0 || op.etam < 0 {
+		panic("multiobjective: invalid variation parameter")
+	}
+	return op
+}
+
+// crossover stores into c1 and c2 the children of the parents p1 and p2
+// generated by the simulated binary crossover.
+func (op *operator) crossover(c1, c2, p1, p2 []float64) {
+	copy(c1, p1)
+	copy(c2, p2)
+	if op.rnd.Float64() >= op.pc {
+		return
+	}
+	for j := range c1 {
+		if op.rnd.Float64() >= 0.5 || math.Abs(p1[j]-p2[j]) <= 1e-14 {
+			continue
+		}
+		y1, y2 := math.Min(p1[j], p2[j]), math.Max(p1[j], p2[j])
+		lo, up := op.lower[j], op.upper[j]
+		u := op.rnd.Float64()
+		beta := 1 + 2*(y1-lo)/(y2-y1)
+		b1 := op.spread(beta, u)
+		beta = 1 + 2*(up-y2)/(y2-y1)
+		b2 := op.spread(beta, u)
+		v1 := math.Min(math.Max(0.5*(y1+y2-b1*(y2-y1)), lo), up)
+		v2 := math.Min(math.Max(0.5*(y1+y2+b2*(y2-y1)), lo), up)
+		if op.rnd.Float64() < 0.5 {
+			v1, v2 = v2, v1
+		}
+		c1[j], c2[j] = v1, v2
+	}
+}
+
+// spread returns the spread factor of the bounded simulated binary
+// crossover for the bound factor beta and the uniform random number u.
+func (op *operator) spread(beta, u float64) float64 {
+	e := op.etac + 1
+	alpha := 2 - math.Pow(beta, -e)
+	if u <= 1/alpha {
+		return math.Pow(u*alpha, 1/e)
+	}
+	return math.Pow(1/(2-u*alpha), 1/e)
+}
+
+// mutate applies the polynomial mutation to x in place.
+func (op *operator) mutate(x []float64) {
+	e := op.etam + 1
+	for j, v := range x {
+		if op.rnd.Float64() >= op.pm {
+			continue
+		}
+		lo, up := op.lower[j], op.upper[j]
+		if up == lo {
+			continue
+		}
+		d1 := (v - lo) / (up - lo)
+		d2 := (up - v) / (up - lo)
+		u := op.rnd.Float64()
+		var dq float64
+		if u < 0.5 {
+			val := 2*u + (1-2*u)*math.Pow(1-d1, e)
+			dq = math.Pow(val, 1/e) - 1
+		} else {
+			val := 2*(1-u) + 2*(u-0.5)*math.Pow(1-d2, e)
+			dq = 1 - math.Pow(val, 1/e)
+		}
+		x[j] = math.Min(math.Max(v+dq*(up-lo), lo), up)
+	}
+}
@@ -5,6 +5,7 @@
 package optimize
 
 import (
+	"errors"
 	"math"
 	"sort"
 
@@ -42,7 +43,10 @@ func (n nmVertexSorter) Swap(i, j int) {
 	n.vertices[i], n.vertices[j] = n.vertices[j], n.vertices[i]
 }
 
-var _ Method = (*NelderMead)(nil)
+var (
+	_ Method      = (*NelderMead)(nil)
+	_ Snapshotter = (*NelderMead)(nil)
+)
 
 // NelderMead is an implementation of the Nelder-Mead simplex algorithm for
 // gradient-free nonlinear optimization (not to be confused with Danzig's
@@ -86,6 +90,9 @@ type NelderMead struct {
 	lastIter       nmIterType // Last iteration
 	reflectedPoint []float64  // Storage of the reflected point location
 	reflectedValue float64    // Value at the last reflection point
+
+	dim      int              // Dimension of the problem
+	restored *nelderMeadState // State restored from a snapshot
 }
 
 func (n *NelderMead) Status() (Status, error) {
@@ -99,11 +106,14 @@ func (*NelderMead) Uses(has Available) (uses Available, err error) {
 func (n *NelderMead) Init(dim, tasks int) int {
 	n.status = NotTerminated
 	n.err = nil
+	n.vertices = n.vertices[:0]
+	n.dim = dim
+	n.restored = nil
 	return 1
 }
 
 func (n *NelderMead) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
-	n.status, n.err = localOptimizer{}.run(n, math.NaN(), operation, result, tasks)
+	n.status, n.err = localOptimizer{resume: n.restored != nil}.run(n, math.NaN(), operation, result, tasks)
 	close(operation)
 }
 
@@ -152,6 +162,20 @@ func (n *NelderMead) initLocal(loc *Location) (Operation, error) {
 		}
 	}
 
+	if r := n.restored; r != nil {
+		n.restored = nil
+		if r.Vertices != nil {
+			// Continue from the restored simplex, whose dimensions
+			// have been checked by Restore.
+			for i, v := range r.Vertices {
+				copy(n.vertices[i], v)
+			}
+			copy(n.values, r.Values)
+			copy(n.centroid, r.Centroid)
+			return n.returnNext(nmReflected, loc)
+		}
+	}
+
 	if n.InitialVertices != nil {
 		// Initial simplex provided. Copy the locations and values, and sort them.
 		if len(n.InitialVertices) != dim+1 {
@@ -346,3 +370,51 @@ func (*NelderMead) needs() struct {
 		Hessian  bool
 	}{false, false}
 }
+
+// nelderMeadState is the encoded state of NelderMead.
+type nelderMeadState struct {
+	Vertices [][]float64
+	Values   []float64
+	Centroid []float64
+}
+
+// Snapshot returns an encoding of the state of the method at the last major
+// iteration.
+func (n *NelderMead) Snapshot() ([]byte, error) {
+	var v nelderMeadState
+	if len(n.vertices) != 0 {
+		if n.lastIter != nmMajor {
+			return nil, errors.New("neldermead: snapshot not at a major iteration")
+		}
+		v.Vertices = n.vertices
+		v.Values = n.values
+		v.Centroid = n.centroid
+	}
+	return encodeState(&v)
+}
+
+// Restore restores the state of the method from a snapshot. Restore must be
+// called after Init.
+func (n *NelderMead) Restore(data []byte) error {
+	var v nelderMeadState
+	err := decodeState(data, &v)
+	if err != nil {
+		return err
+	}
+	if v.Vertices == nil {
+		if v.Values != nil || v.Centroid != nil {
+			return errBadSnapshot
+		}
+	} else {
+		if len(v.Vertices) != n.dim+1 || len(v.Values) != n.dim+1 || len(v.Centroid) != n.dim {
+			return errBadSnapshot
+		}
+		for _, vertex := range v.Vertices {
+			if len(vertex) != n.dim {
+				return errBadSnapshot
+			}
+		}
+	}
+	n.restored = &v
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*Broyden)(nil)
+
+// Broyden is Broyden's quasi-Newton method for systems of nonlinear
+// equations. It maintains an approximation H of the inverse of the Jacobian,
+// computes the step p = -H F(x) and performs a backtracking line search on
+// |F| along p. After a step s with the change y in F, H is updated by
+// Broyden's good formula
+//
+//	H += (s - Hy) sᵀH / sᵀHy,
+//
+// which is a rank-one update of the Jacobian approximation that satisfies the
+// secant equation, or if Bad is true, by Broyden's bad formula
+//
+//	H += (s - Hy) yᵀ / yᵀy.
+//
+// H is initialized with the inverse of the Jacobian at the initial location.
+// The Jacobian is evaluated again when the line search fails along the
+// quasi-Newton direction.
+//
+// References:
+//   - Broyden, C. G. (1965). A class of methods for solving nonlinear
+//     simultaneous equations. Mathematics of Computation 19(92), 577-593.
+type Broyden struct {
+	// Bad specifies whether Broyden's bad update is used instead of the
+	// good update.
+	Bad bool
+
+	needJac bool // Whether the Jacobian must be evaluated.
+	fresh   bool // Whether H is the inverse of an evaluated Jacobian.
+
+	jac mat.Dense
+	inv mat.Dense
+
+	p, xt, ft, y, hy, sh []float64
+}
+
+func (b *Broyden) init(n int) {
+	b.needJac = true
+	b.fresh = false
+	b.p = make([]float64, n)
+	b.xt = make([]float64, n)
+	b.ft = make([]float64, n)
+	b.y = make([]float64, n)
+	b.hy = make([]float64, n)
+	b.sh = make([]float64, n)
+}
+
+func (b *Broyden) iterate(s *state) (optimize.Status, error) {
+	n := len(s.x)
+	for {
+		if b.needJac {
+			status, err := s.evalJacobian(&b.jac, s.x, s.f)
+			if status != optimize.NotTerminated || err != nil {
+				return status, err
+			}
+			b.inv.Reset()
+			if !usable(b.inv.Inverse(&b.jac)) {
+				return optimize.Failure, ErrSingularJacobian
+			}
+			b.needJac = false
+			b.fresh = true
+		}
+
+		p := mat.NewVecDense(n, b.p)
+		p.MulVec(&b.inv, mat.NewVecDense(n, s.f))
+		floats.Scale(-1, b.p)
+		lambda, status, err := s.backtrack(b.xt, b.ft, b.p, 0)
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+		if lambda == 0 {
+			if b.fresh {
+				return optimize.Failure, optimize.ErrNoProgress
+			}
+			// The approximation of the Jacobian is poor, start afresh.
+			b.needJac = true
+			continue
+		}
+
+		// Update the approximation of the inverse Jacobian with the step
+		// s = λp stored in p and the change y = F(x+s) - F(x).
+		floats.Scale(lambda, b.p)
+		floats.SubTo(b.y, b.ft, s.f)
+		hy := mat.NewVecDense(n, b.hy)
+		hy.MulVec(&b.inv, mat.NewVecDense(n, b.y))
+		var denom float64
+		if b.Bad {
+			copy(b.sh, b.y)
+			denom = floats.Dot(b.y, b.y)
+		} else {
+			sh := mat.NewVecDense(n, b.sh)
+			sh.MulVec(b.inv.T(), p)
+			denom = floats.Dot(b.sh, b.y)
+		}
+		floats.SubTo(b.hy, b.p, b.hy)
+		s.accept(b.xt, b.ft)
+		if denom == 0 {
+			b.needJac = true
+		} else {
+			b.inv.RankOne(&b.inv, 1/denom, mat.NewVecDense(n, b.hy), mat.NewVecDense(n, b.sh))
+			b.fresh = false
+		}
+		return optimize.NotTerminated, nil
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/optimize/nonlin"
+)
+
+func ExampleSolve() {
+	// Find the intersection of the circle x² + y² = 4 and the hyperbola
+	// xy = 1 in the first quadrant.
+	p := nonlin.Problem{
+		Func: func(f, x []float64) {
+			f[0] = x[0]*x[0] + x[1]*x[1] - 4
+			f[1] = x[0]*x[1] - 1
+		},
+	}
+	result, err := nonlin.Solve(p, []float64{2, 0.5}, nil, &nonlin.Hybrid{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("x = %.6f, y = %.6f\n", result.X[0], result.X[1])
+	fmt.Println("status:", result.Status)
+
+	// Output:
+	// x = 1.931852, y = 0.517638
+	// status: FunctionThreshold
+}
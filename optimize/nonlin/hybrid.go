@@ -0,0 +1,287 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/internal/vecfunc"
+)
+
+const defaultHybridRadius = 100
+
+var _ Method = (*Hybrid)(nil)
+
+// Hybrid is Powell's hybrid method for systems of nonlinear equations, as
+// implemented in the HYBRJ routine of MINPACK. At each iteration it computes
+// the dogleg step in a trust region of the scaled variables Dx, where the
+// diagonal scaling matrix D holds the largest norms of the columns of the
+// Jacobian seen so far. The dogleg step is a combination of the Newton step
+// and the steepest descent step for ½|F|².
+//
+// The Jacobian is evaluated at the initial location, and it is updated by
+// Broyden's rank-one formula after each step. It is evaluated again only when
+// two consecutive steps fail to reduce |F| sufficiently, so Hybrid typically
+// needs few evaluations of the Jacobian.
+//
+// References:
+//   - Powell, M. J. D. (1970). A hybrid method for nonlinear equations. In
+//     Numerical Methods for Nonlinear Algebraic Equations (pp. 87-114).
+//     Gordon and Breach.
+//   - Moré, J. J., Garbow, B. S., & Hillstrom, K. E. (1980). User guide for
+//     MINPACK-1. Technical Report ANL-80-74, Argonne National Laboratory.
+type Hybrid struct {
+	// InitialRadius determines the initial trust region radius, which is
+	// InitialRadius times the scaled norm |Dx₀| of the initial location, or
+	// InitialRadius if that is zero. If InitialRadius is zero, a default
+	// value of 100 is used.
+	InitialRadius float64
+
+	radius    float64 // Initial trust region radius factor.
+	delta     float64 // Trust region radius.
+	first     bool    // Whether the first step has not yet been taken.
+	needJac   bool    // Whether the Jacobian must be evaluated.
+	atX       bool    // Whether the Jacobian was evaluated at the current location.
+	fails     int     // Number of consecutive unsuccessful steps.
+	successes int     // Number of consecutive successful steps.
+	slowSteps int     // Number of consecutive steps with little reduction of |F|.
+	slowJacs  int     // Number of consecutive Jacobian evaluations with little reduction of |F|.
+
+	diag []float64
+	jac  mat.Dense
+	lu   mat.LU
+
+	p, gn, g, d, jp []float64
+	xt, ft          []float64
+}
+
+func (h *Hybrid) init(n int) {
+	h.radius = h.InitialRadius
+	if h.radius == 0 {
+		h.radius = defaultHybridRadius
+	}
+	if h.radius < 0 {
+		panic("nonlin: negative initial radius")
+	}
+	h.delta = 0
+	h.first = true
+	h.needJac = true
+	h.atX = false
+	h.fails = 0
+	h.successes = 0
+	h.slowSteps = 0
+	h.slowJacs = 0
+	h.diag = make([]float64, n)
+	h.p = make([]float64, n)
+	h.gn = make([]float64, n)
+	h.g = make([]float64, n)
+	h.d = make([]float64, n)
+	h.jp = make([]float64, n)
+	h.xt = make([]float64, n)
+	h.ft = make([]float64, n)
+}
+
+func (h *Hybrid) iterate(s *state) (optimize.Status, error) {
+	n := len(s.x)
+	for {
+		if h.needJac {
+			status, err := s.evalJacobian(&h.jac, s.x, s.f)
+			if status != optimize.NotTerminated || err != nil {
+				return status, err
+			}
+			h.needJac = false
+			h.atX = true
+			h.fails = 0
+			for j := range h.diag {
+				norm := floats.Norm(mat.Col(h.d, j, &h.jac), 2)
+				h.diag[j] = math.Max(h.diag[j], norm)
+				if h.diag[j] == 0 {
+					h.diag[j] = 1
+				}
+			}
+			if h.delta == 0 {
+				h.delta = h.radius * scaledNorm(h.diag, s.x)
+				if h.delta == 0 {
+					h.delta = h.radius
+				}
+			}
+			h.lu.Factorize(&h.jac)
+		}
+
+		h.dogleg(s.f)
+		pnorm := scaledNorm(h.diag, h.p)
+		if h.first {
+			h.delta = math.Min(h.delta, pnorm)
+			h.first = false
+		}
+		floats.AddTo(h.xt, s.x, h.p)
+		status, err := s.EvalFunc(h.ft, h.xt)
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+
+		// Compare the actual reduction of |F| with the reduction predicted
+		// by the linear model.
+		fnorm := floats.Norm(s.f, 2)
+		fnorm1 := floats.Norm(h.ft, 2)
+		jp := mat.NewVecDense(n, h.jp)
+		jp.MulVec(&h.jac, mat.NewVecDense(n, h.p))
+		var fpred float64
+		for i, v := range s.f {
+			fpred = math.Hypot(fpred, v+h.jp[i])
+		}
+		actred := -1.0
+		if fnorm1 < fnorm {
+			actred = 1 - (fnorm1/fnorm)*(fnorm1/fnorm)
+		}
+		var prered float64
+		if fpred < fnorm {
+			prered = 1 - (fpred/fnorm)*(fpred/fnorm)
+		}
+		var ratio float64
+		if prered > 0 {
+			ratio = actred / prered
+		}
+
+		// Update the trust region radius.
+		if ratio < 0.1 {
+			h.successes = 0
+			h.fails++
+			h.delta /= 2
+		} else {
+			h.fails = 0
+			h.successes++
+			if ratio >= 0.5 || h.successes > 1 {
+				h.delta = math.Max(h.delta, 2*pnorm)
+			}
+			if math.Abs(ratio-1) <= 0.1 {
+				h.delta = 2 * pnorm
+			}
+		}
+
+		// Detect slow progress of the iteration.
+		h.slowSteps++
+		if actred >= 0.001 {
+			h.slowSteps = 0
+		}
+		if h.atX {
+			h.slowJacs++
+		}
+		if actred >= 0.1 {
+			h.slowJacs = 0
+		}
+
+		// Evaluate the Jacobian again after two consecutive failures,
+		// otherwise update it by Broyden's formula
+		//  J += (F(x+p) - F(x) - Jp) (D²p)ᵀ / |Dp|².
+		if h.fails == 2 {
+			h.needJac = true
+		} else if vecfunc.IsFinite(h.ft) && pnorm > 0 {
+			for i := range h.jp {
+				h.jp[i] = (h.ft[i] - s.f[i] - h.jp[i]) / pnorm
+			}
+			for j, v := range h.p {
+				h.d[j] = h.diag[j] * (h.diag[j] * v / pnorm)
+			}
+			h.jac.RankOne(&h.jac, 1, jp, mat.NewVecDense(n, h.d))
+			h.lu.Factorize(&h.jac)
+		}
+
+		if ratio >= 1e-4 {
+			s.accept(h.xt, h.ft)
+			h.atX = false
+		}
+		if h.slowSteps == 10 || h.slowJacs == 5 {
+			return optimize.Failure, optimize.ErrNoProgress
+		}
+		if ratio >= 1e-4 {
+			return optimize.NotTerminated, nil
+		}
+		if h.delta <= s.stepTol*(scaledNorm(h.diag, s.x)+s.stepTol) {
+			if h.atX {
+				// The trust region is too small to make further
+				// progress even with the exact Jacobian.
+				copy(s.step, h.p)
+				return optimize.StepConvergence, nil
+			}
+			h.needJac = true
+		}
+	}
+}
+
+// dogleg computes the dogleg step for the function value f and stores it
+// into h.p.
+func (h *Hybrid) dogleg(f []float64) {
+	n := len(f)
+	// Compute the Newton step.
+	gn := mat.NewVecDense(n, h.gn)
+	newton := usable(h.lu.SolveVecTo(gn, false, mat.NewVecDense(n, f))) && vecfunc.IsFinite(h.gn)
+	if newton {
+		floats.Scale(-1, h.gn)
+		if scaledNorm(h.diag, h.gn) <= h.delta {
+			copy(h.p, h.gn)
+			return
+		}
+	}
+
+	// Compute the scaled gradient D⁻¹Jᵀf of ½|F|² and the minimizer of the
+	// linear model along the scaled steepest descent direction d.
+	g := mat.NewVecDense(n, h.g)
+	g.MulVec(h.jac.T(), mat.NewVecDense(n, f))
+	for j := range h.g {
+		h.g[j] /= h.diag[j]
+	}
+	gnorm := floats.Norm(h.g, 2)
+	if gnorm == 0 {
+		// f is at a stationary point of |F|².
+		for i := range h.p {
+			h.p[i] = 0
+		}
+		if newton {
+			floats.ScaleTo(h.p, h.delta/scaledNorm(h.diag, h.gn), h.gn)
+		}
+		return
+	}
+	for j, v := range h.g {
+		h.d[j] = v / h.diag[j] / gnorm
+	}
+	jd := mat.NewVecDense(n, h.jp)
+	jd.MulVec(&h.jac, mat.NewVecDense(n, h.d))
+	t := gnorm / floats.Dot(h.jp, h.jp)
+	if !newton || t >= h.delta || math.IsInf(t, 1) {
+		floats.ScaleTo(h.p, -math.Min(t, h.delta), h.d)
+		return
+	}
+
+	// Find the point on the segment between the steepest descent step
+	// -t*d and the Newton step whose scaled norm equals the trust region
+	// radius.
+	var a, b, c float64
+	for j, v := range h.d {
+		sd := -t * v * h.diag[j]
+		diff := h.gn[j]*h.diag[j] - sd
+		a += diff * diff
+		b += 2 * sd * diff
+		c += sd * sd
+	}
+	c -= h.delta * h.delta
+	tau := (-b + math.Sqrt(b*b-4*a*c)) / (2 * a)
+	for j, v := range h.d {
+		sd := -t * v
+		h.p[j] = sd + tau*(h.gn[j]-sd)
+	}
+}
+
+// scaledNorm returns the Euclidean norm of diag*x.
+func scaledNorm(diag, x []float64) float64 {
+	var norm float64
+	for i, v := range x {
+		norm = math.Hypot(norm, diag[i]*v)
+	}
+	return norm
+}
@@ -0,0 +1,282 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize"
+)
+
+const (
+	defaultKrylovDim  = 30
+	defaultRestarts   = 10
+	defaultMaxForcing = 0.9
+	forcingGamma      = 0.9
+)
+
+var _ Method = (*NewtonKrylov)(nil)
+
+// NewtonKrylov is the Jacobian-free Newton–Krylov method for systems of
+// nonlinear equations. At each iteration it solves the Newton equation
+//
+//	J p = -F(x)
+//
+// inexactly by the restarted GMRES method, to the relative residual given by
+// the forcing term η, and performs a backtracking line search on |F| along p.
+// The Jacobian is never formed. Instead, the products of the Jacobian with
+// vectors are approximated by the finite differences
+//
+//	J v ≈ (F(x + hv) - F(x)) / h,
+//
+// so NewtonKrylov is suitable for large systems where the Jacobian is not
+// available or is expensive to store. Problem.Jacobian is not used. The
+// forcing term is chosen by the second method of Eisenstat and Walker, which
+// gives superlinear convergence close to the solution.
+//
+// References:
+//   - Knoll, D. A., & Keyes, D. E. (2004). Jacobian-free Newton–Krylov
+//     methods: a survey of approaches and applications. Journal of
+//     Computational Physics 193(2), 357-397.
+//   - Eisenstat, S. C., & Walker, H. F. (1996). Choosing the forcing terms
+//     in an inexact Newton method. SIAM Journal on Scientific Computing
+//     17(1), 16-32.
+//   - Saad, Y., & Schultz, M. H. (1986). GMRES: A generalized minimal
+//     residual algorithm for solving nonsymmetric linear systems. SIAM
+//     Journal on Scientific and Statistical Computing 7(3), 856-869.
+type NewtonKrylov struct {
+	// KrylovDim is the dimension of the Krylov subspace after which GMRES
+	// is restarted. If KrylovDim is zero, the smaller of 30 and the order
+	// of the system is used.
+	KrylovDim int
+	// MaxLinearIterations is the maximum number of GMRES iterations in a
+	// single Newton step. If MaxLinearIterations is zero, 10*KrylovDim is
+	// used.
+	MaxLinearIterations int
+	// MaxForcing is the upper bound of the forcing term η in (0, 1). If
+	// MaxForcing is zero, a default value of 0.9 is used.
+	MaxForcing float64
+	// DiffStep is the relative step of the finite difference approximation
+	// of the Jacobian-vector products. If DiffStep is zero, the square root
+	// of the machine epsilon is used.
+	DiffStep float64
+
+	krylovDim  int     // Dimension of the Krylov subspace.
+	maxLinear  int     // Maximum number of GMRES iterations in a Newton step.
+	maxForcing float64 // Upper bound of the forcing term.
+	diffStep   float64 // Relative step of the finite differences.
+
+	eta   float64 // Forcing term.
+	fnorm float64 // Norm of F at the previous location.
+
+	p, b, xt, ft, w []float64
+	v               [][]float64 // Orthonormal basis of the Krylov subspace.
+	h               [][]float64 // Upper Hessenberg matrix of the Arnoldi process.
+	cs, sn, g, y    []float64   // Givens rotations and the least-squares problem.
+}
+
+func (nk *NewtonKrylov) init(n int) {
+	nk.krylovDim = nk.KrylovDim
+	if nk.krylovDim == 0 {
+		nk.krylovDim = min(defaultKrylovDim, n)
+	}
+	if nk.krylovDim < 0 {
+		panic("nonlin: negative Krylov subspace dimension")
+	}
+	nk.maxLinear = nk.MaxLinearIterations
+	if nk.maxLinear == 0 {
+		nk.maxLinear = defaultRestarts * nk.krylovDim
+	}
+	if nk.maxLinear < 0 {
+		panic("nonlin: negative maximum number of linear iterations")
+	}
+	nk.maxForcing = nk.MaxForcing
+	if nk.maxForcing == 0 {
+		nk.maxForcing = defaultMaxForcing
+	}
+	if nk.maxForcing < 0 || 1 <= nk.maxForcing {
+		panic("nonlin: maximum forcing term out of range")
+	}
+	nk.diffStep = nk.DiffStep
+	if nk.diffStep == 0 {
+		nk.diffStep = math.Sqrt(0x1p-52)
+	}
+	if nk.diffStep < 0 {
+		panic("nonlin: negative difference step")
+	}
+	nk.eta = nk.maxForcing
+	nk.fnorm = 0
+	m := nk.krylovDim
+	nk.p = make([]float64, n)
+	nk.b = make([]float64, n)
+	nk.xt = make([]float64, n)
+	nk.ft = make([]float64, n)
+	nk.w = make([]float64, n)
+	nk.v = make([][]float64, m+1)
+	for i := range nk.v {
+		nk.v[i] = make([]float64, n)
+	}
+	nk.h = make([][]float64, m+1)
+	for i := range nk.h {
+		nk.h[i] = make([]float64, m)
+	}
+	nk.cs = make([]float64, m)
+	nk.sn = make([]float64, m)
+	nk.g = make([]float64, m+1)
+	nk.y = make([]float64, m)
+}
+
+func (nk *NewtonKrylov) iterate(s *state) (optimize.Status, error) {
+	fnorm := floats.Norm(s.f, 2)
+	if nk.fnorm != 0 {
+		// Choose the forcing term by the second method of Eisenstat and
+		// Walker with safeguards against oversolving.
+		eta := forcingGamma * (fnorm / nk.fnorm) * (fnorm / nk.fnorm)
+		if prev := forcingGamma * nk.eta * nk.eta; prev > 0.1 {
+			eta = math.Max(eta, prev)
+		}
+		eta = math.Max(eta, 0.5*s.fThresh/fnorm)
+		nk.eta = math.Min(eta, nk.maxForcing)
+	}
+	nk.fnorm = fnorm
+
+	floats.ScaleTo(nk.b, -1, s.f)
+	status, err := nk.gmres(s, nk.p, nk.b, nk.eta*fnorm)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	lambda, status, err := s.backtrack(nk.xt, nk.ft, nk.p, nk.eta)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	if lambda == 0 {
+		return optimize.Failure, optimize.ErrNoProgress
+	}
+	s.accept(nk.xt, nk.ft)
+	return optimize.NotTerminated, nil
+}
+
+// jacVec approximates the product of the Jacobian at the current location
+// with the unit vector v by finite differences and stores it into dst.
+func (nk *NewtonKrylov) jacVec(s *state, dst, v []float64) (optimize.Status, error) {
+	h := nk.diffStep * math.Max(1, floats.Norm(s.x, 2))
+	floats.AddScaledTo(nk.xt, s.x, h, v)
+	status, err := s.EvalFunc(dst, nk.xt)
+	if status != optimize.NotTerminated || err != nil {
+		return status, err
+	}
+	for i, f := range s.f {
+		dst[i] = (dst[i] - f) / h
+	}
+	return optimize.NotTerminated, nil
+}
+
+// gmres solves the linear system J x = b approximately by the restarted GMRES
+// method starting from x = 0 until the norm of the residual is at most tol.
+func (nk *NewtonKrylov) gmres(s *state, x, b []float64, tol float64) (optimize.Status, error) {
+	for i := range x {
+		x[i] = 0
+	}
+	m := nk.krylovDim
+	r := nk.ft
+	copy(r, b)
+	var iter int
+	for {
+		beta := floats.Norm(r, 2)
+		if beta <= tol || beta == 0 {
+			return optimize.NotTerminated, nil
+		}
+		floats.ScaleTo(nk.v[0], 1/beta, r)
+		for i := range nk.g {
+			nk.g[i] = 0
+		}
+		nk.g[0] = beta
+
+		var k int
+		for k < m && iter < nk.maxLinear {
+			// Extend the orthonormal basis by the modified Gram–Schmidt
+			// process.
+			w := nk.w
+			status, err := nk.jacVec(s, w, nk.v[k])
+			if status != optimize.NotTerminated || err != nil {
+				return status, err
+			}
+			iter++
+			s.stats.LinearIterations++
+			for i := 0; i <= k; i++ {
+				nk.h[i][k] = floats.Dot(w, nk.v[i])
+				floats.AddScaled(w, -nk.h[i][k], nk.v[i])
+			}
+			hk := floats.Norm(w, 2)
+			nk.h[k+1][k] = hk
+			if hk != 0 {
+				floats.ScaleTo(nk.v[k+1], 1/hk, w)
+			}
+
+			// Apply the previous Givens rotations to the new column and
+			// eliminate its subdiagonal element.
+			for i := 0; i < k; i++ {
+				a, c := nk.h[i][k], nk.h[i+1][k]
+				nk.h[i][k] = nk.cs[i]*a + nk.sn[i]*c
+				nk.h[i+1][k] = -nk.sn[i]*a + nk.cs[i]*c
+			}
+			rho := math.Hypot(nk.h[k][k], nk.h[k+1][k])
+			if rho == 0 {
+				// The Krylov subspace is invariant but J is singular
+				// on it.
+				break
+			}
+			nk.cs[k] = nk.h[k][k] / rho
+			nk.sn[k] = nk.h[k+1][k] / rho
+			nk.h[k][k] = rho
+			nk.h[k+1][k] = 0
+			nk.g[k+1] = -nk.sn[k] * nk.g[k]
+			nk.g[k] *= nk.cs[k]
+			k++
+			if math.Abs(nk.g[k]) <= tol || hk == 0 {
+				break
+			}
+		}
+
+		// Solve the triangular least-squares problem and update x.
+		for i := k - 1; i >= 0; i-- {
+			sum := nk.g[i]
+			for j := i + 1; j < k; j++ {
+				sum -= nk.h[i][j] * nk.y[j]
+			}
+			nk.y[i] = sum / nk.h[i][i]
+		}
+		for i := 0; i < k; i++ {
+			floats.AddScaled(x, nk.y[i], nk.v[i])
+		}
+		if k == 0 || math.Abs(nk.g[k]) <= tol || iter >= nk.maxLinear {
+			return optimize.NotTerminated, nil
+		}
+
+		// Compute the true residual for the restart.
+		status, err := nk.jacVecAt(s, r, x)
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+		floats.SubTo(r, b, r)
+	}
+}
+
+// jacVecAt approximates the product of the Jacobian at the current location
+// with the vector v by finite differences and stores it into dst.
+func (nk *NewtonKrylov) jacVecAt(s *state, dst, v []float64) (optimize.Status, error) {
+	norm := floats.Norm(v, 2)
+	if norm == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return optimize.NotTerminated, nil
+	}
+	floats.ScaleTo(nk.w, 1/norm, v)
+	status, err := nk.jacVec(s, dst, nk.w)
+	floats.Scale(norm, dst)
+	return status, err
+}
@@ -0,0 +1,277 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+	"gonum.org/v1/gonum/optimize/internal/vecfunc"
+)
+
+const (
+	defaultFunctionThreshold = 1e-10
+	defaultStepTolerance     = 1e-12
+
+	// Parameters of the backtracking line search.
+	decreaseFactor = 1e-4
+	maxBacktracks  = 20
+)
+
+var errNonFinite = errors.New("nonlin: function is not finite at the initial location")
+
+// ErrSingularJacobian signifies that the Jacobian is singular at the current
+// location and that the method cannot proceed.
+var ErrSingularJacobian = errors.New("nonlin: singular Jacobian")
+
+// Problem describes the system of nonlinear equations
+//
+//	F(x) = 0,
+//
+// where F maps ℝⁿ to ℝⁿ.
+type Problem struct {
+	// Func evaluates F at x and stores the result in f which will have the
+	// same length as x. Func must not modify x.
+	Func func(f, x []float64)
+
+	// Jacobian evaluates the Jacobian of F at x and stores the result
+	// in-place in jac which will be a square matrix of order len(x).
+	// Jacobian must not modify x. If Jacobian is nil, it is approximated
+	// by finite differences using fd.Jacobian.
+	Jacobian func(jac *mat.Dense, x []float64)
+
+	// Status reports the status of the problem being solved and any error.
+	// It is called before every evaluation of Func and Jacobian and can be
+	// used to terminate early.
+	Status func() (optimize.Status, error)
+}
+
+// Settings represents settings of the solution run. See the field comments
+// for default values.
+type Settings struct {
+	// FunctionThreshold stops the run with FunctionThreshold status if the
+	// infinity norm of F is less than or equal to this value. If it is
+	// zero, a default value of 1e-10 is used.
+	FunctionThreshold float64
+
+	// StepTolerance stops the run with StepConvergence status if the norm
+	// of the step is less than StepTolerance*(|x| + StepTolerance). If it
+	// is zero, a default value of 1e-12 is used.
+	StepTolerance float64
+
+	// MajorIterations is the maximum number of iterations allowed.
+	// IterationLimit status is returned if the number of iterations equals
+	// or exceeds this value. If it equals zero, this setting has no effect.
+	MajorIterations int
+
+	// FuncEvaluations is the maximum allowed number of evaluations of F,
+	// including those used for finite difference Jacobians and
+	// Jacobian-vector products. FunctionEvaluationLimit status is returned
+	// if the total number of evaluations equals or exceeds this number. If
+	// it equals zero, this setting has no effect.
+	FuncEvaluations int
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If it
+	// equals zero, this setting has no effect.
+	Runtime time.Duration
+
+	// FiniteDifference holds the settings used to approximate the Jacobian
+	// if Problem.Jacobian is nil. The OriginValue field is ignored. If
+	// FiniteDifference is nil, the forward difference formula is used.
+	FiniteDifference *fd.JacobianSettings
+}
+
+// Method is a method for solving systems of nonlinear equations. Method is
+// implemented by Hybrid, Broyden and NewtonKrylov.
+//
+// The methods of Method are unexported, so the set of implementations is
+// closed and Method cannot be implemented outside of this package.
+type Method interface {
+	// init initializes the method for a system of order n.
+	init(n int)
+	// iterate performs a single iteration of the method. It must update
+	// the location and the function value of s on a successful step.
+	iterate(s *state) (optimize.Status, error)
+}
+
+// Stats contains the statistics of the run.
+type Stats struct {
+	MajorIterations     int           // Total number of major iterations
+	FuncEvaluations     int           // Number of evaluations of F
+	JacobianEvaluations int           // Number of evaluations of the Jacobian
+	LinearIterations    int           // Number of iterations of the iterative linear solver
+	Runtime             time.Duration // Total runtime of the run
+}
+
+// Result represents the answer of a solution run.
+type Result struct {
+	// X is the approximate solution.
+	X []float64
+	// F holds the value of F at X.
+	F []float64
+	// Norm is the Euclidean norm of F.
+	Norm float64
+
+	Stats
+	Status optimize.Status
+}
+
+// Solve finds a solution of the system of nonlinear equations p, starting
+// from initX, using the given method. If settings is nil, the zero value is
+// used, see the documentation of Settings for the default values. If method
+// is nil, Hybrid is used.
+//
+// Solve returns a Result and any error that occurred. The error is non-nil if
+// F is not finite at initX, if the method fails or if p.Status returns an
+// error. A method may fail at a local minimum of |F| that is not a solution.
+func Solve(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Func == nil {
+		panic("nonlin: function is undefined")
+	}
+	n := len(initX)
+	if n == 0 {
+		panic("nonlin: zero dimensional input")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &Hybrid{}
+	}
+	fThresh := settings.FunctionThreshold
+	if fThresh == 0 {
+		fThresh = defaultFunctionThreshold
+	}
+	stepTol := settings.StepTolerance
+	if stepTol == 0 {
+		stepTol = defaultStepTolerance
+	}
+
+	s := newState(&p, settings, initX, fThresh, stepTol)
+	status, err := s.EvalFunc(s.f, s.x)
+	if status == optimize.NotTerminated && err == nil && !vecfunc.IsFinite(s.f) {
+		status, err = optimize.Failure, errNonFinite
+	}
+
+	method.init(n)
+	if status == optimize.NotTerminated && err == nil {
+		loop := vecfunc.Loop{
+			StepTolerance:   stepTol,
+			MajorIterations: settings.MajorIterations,
+			Runtime:         settings.Runtime,
+		}
+		solved := func() optimize.Status {
+			if floats.Norm(s.f, math.Inf(1)) <= fThresh {
+				return optimize.FunctionThreshold
+			}
+			return optimize.NotTerminated
+		}
+		status, err = loop.Run(startTime, s.x, s.step, solved, solved, func() (optimize.Status, error) {
+			return method.iterate(s)
+		})
+		s.stats.MajorIterations = loop.Iterations
+	}
+	s.stats.Runtime = time.Since(startTime)
+	s.stats.FuncEvaluations = s.FuncEvaluations
+	s.stats.JacobianEvaluations = s.JacobianEvaluations
+	return &Result{
+		X:      s.x,
+		F:      s.f,
+		Norm:   floats.Norm(s.f, 2),
+		Stats:  s.stats,
+		Status: status,
+	}, err
+}
+
+// state holds the current location of a solution run. The embedded
+// Evaluator evaluates F and its Jacobian.
+type state struct {
+	vecfunc.Evaluator
+	stats   Stats
+	fThresh float64
+	stepTol float64
+
+	x    []float64 // Current location.
+	f    []float64 // Function value at x.
+	step []float64 // Last accepted step.
+}
+
+func newState(p *Problem, settings *Settings, initX []float64, fThresh, stepTol float64) *state {
+	n := len(initX)
+	s := &state{
+		Evaluator: vecfunc.Evaluator{
+			Func:               p.Func,
+			Jacobian:           p.Jacobian,
+			Status:             p.Status,
+			MaxFuncEvaluations: settings.FuncEvaluations,
+			FiniteDifference:   settings.FiniteDifference,
+		},
+		fThresh: fThresh,
+		stepTol: stepTol,
+		x:       make([]float64, n),
+		f:       make([]float64, n),
+		step:    make([]float64, n),
+	}
+	copy(s.x, initX)
+	return s
+}
+
+// evalJacobian evaluates the Jacobian at x, where the function value is f,
+// and stores it into jac.
+func (s *state) evalJacobian(jac *mat.Dense, x, f []float64) (optimize.Status, error) {
+	n := len(x)
+	jac.Reset()
+	jac.ReuseAs(n, n)
+	return s.EvalJacobian(jac, x, f)
+}
+
+// accept moves the state to x with the function value f.
+func (s *state) accept(x, f []float64) {
+	floats.SubTo(s.step, x, s.x)
+	copy(s.x, x)
+	copy(s.f, f)
+}
+
+// backtrack performs a backtracking line search along the direction p from
+// the current location. It halves the step length λ until
+//
+//	|F(x + λp)| ≤ (1 - 10⁻⁴ λ (1-eta)) |F(x)|,
+//
+// and stores the location and the function value into xt and ft. backtrack
+// returns the accepted step length, or zero if no step length was accepted
+// within the maximum number of backtracking steps.
+func (s *state) backtrack(xt, ft, p []float64, eta float64) (float64, optimize.Status, error) {
+	fnorm := floats.Norm(s.f, 2)
+	lambda := 1.0
+	for i := 0; i < maxBacktracks; i++ {
+		floats.AddScaledTo(xt, s.x, lambda, p)
+		status, err := s.EvalFunc(ft, xt)
+		if status != optimize.NotTerminated || err != nil {
+			return 0, status, err
+		}
+		if floats.Norm(ft, 2) <= (1-decreaseFactor*lambda*(1-eta))*fnorm {
+			return lambda, optimize.NotTerminated, nil
+		}
+		lambda /= 2
+	}
+	return 0, optimize.NotTerminated, nil
+}
+
+// usable returns whether a solution of a linear system computed despite the
+// error err can be used.
+func usable(err error) bool {
+	if err == nil {
+		return true
+	}
+	cond, ok := err.(mat.Condition)
+	return ok && !math.IsInf(float64(cond), 1)
+}
@@ -0,0 +1,295 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+type nonlinTest struct {
+	name string
+	p    Problem
+	x    []float64
+	want []float64 // Solution, or nil if only the residual is checked.
+}
+
+func nonlinTests() []nonlinTest {
+	return []nonlinTest{
+		{
+			// The Rosenbrock function written as a system of equations.
+			name: "Rosenbrock",
+			p: Problem{
+				Func: func(f, x []float64) {
+					f[0] = 10 * (x[1] - x[0]*x[0])
+					f[1] = 1 - x[0]
+				},
+				Jacobian: func(jac *mat.Dense, x []float64) {
+					jac.Set(0, 0, -20*x[0])
+					jac.Set(0, 1, 10)
+					jac.Set(1, 0, -1)
+					jac.Set(1, 1, 0)
+				},
+			},
+			x:    []float64{-1.2, 1},
+			want: []float64{1, 1},
+		},
+		{
+			name: "HelicalValley",
+			p: Problem{
+				Func: func(f, x []float64) {
+					theta := math.Atan(x[1]/x[0]) / (2 * math.Pi)
+					if x[0] < 0 {
+						theta += 0.5
+					}
+					f[0] = 10 * (x[2] - 10*theta)
+					f[1] = 10 * (math.Hypot(x[0], x[1]) - 1)
+					f[2] = x[2]
+				},
+				Jacobian: func(jac *mat.Dense, x []float64) {
+					r2 := x[0]*x[0] + x[1]*x[1]
+					r := math.Sqrt(r2)
+					jac.Set(0, 0, 100*x[1]/(2*math.Pi*r2))
+					jac.Set(0, 1, -100*x[0]/(2*math.Pi*r2))
+					jac.Set(0, 2, 10)
+					jac.Set(1, 0, 10*x[0]/r)
+					jac.Set(1, 1, 10*x[1]/r)
+					jac.Set(1, 2, 0)
+					jac.Set(2, 0, 0)
+					jac.Set(2, 1, 0)
+					jac.Set(2, 2, 1)
+				},
+			},
+			x:    []float64{-1, 0, 0},
+			want: []float64{1, 0, 0},
+		},
+		{
+			// The Broyden tridiagonal function of Moré, Garbow and
+			// Hillstrom.
+			name: "BroydenTridiagonal",
+			p: Problem{
+				Func: func(f, x []float64) {
+					n := len(x)
+					for i, v := range x {
+						f[i] = (3-2*v)*v + 1
+						if i > 0 {
+							f[i] -= x[i-1]
+						}
+						if i < n-1 {
+							f[i] -= 2 * x[i+1]
+						}
+					}
+				},
+				Jacobian: func(jac *mat.Dense, x []float64) {
+					n := len(x)
+					jac.Zero()
+					for i, v := range x {
+						jac.Set(i, i, 3-4*v)
+						if i > 0 {
+							jac.Set(i, i-1, -1)
+						}
+						if i < n-1 {
+							jac.Set(i, i+1, -2)
+						}
+					}
+				},
+			},
+			x: []float64{-1, -1, -1, -1, -1, -1, -1, -1, -1, -1},
+		},
+		{
+			// Chemical equilibrium of the combustion of propane in air,
+			// from Meintjes and Morgan.
+			name: "Combustion",
+			p: Problem{
+				Func: func(f, x []float64) {
+					const (
+						r   = 10
+						r5  = 0.193
+						r8  = 0.00001799 / 40
+						r10 = 0.00003846 / 40
+					)
+					sqrt40 := math.Sqrt(40)
+					r6 := 0.002597 / sqrt40
+					r7 := 0.003448 / sqrt40
+					r9 := 0.0002155 / sqrt40
+					f[0] = x[0]*x[1] + x[0] - 3*x[4]
+					f[1] = 2*x[0]*x[1] + x[0] + x[1]*x[2]*x[2] + r8*x[1] - r*x[4] + 2*r10*x[1]*x[1] + r7*x[1]*x[2] + r9*x[1]*x[3]
+					f[2] = 2*x[1]*x[2]*x[2] + 2*r5*x[2]*x[2] - 8*x[4] + r6*x[2] + r7*x[1]*x[2]
+					f[3] = r9*x[1]*x[3] + 2*x[3]*x[3] - 4*r*x[4]
+					f[4] = x[0]*x[1] + x[0] + r10*x[1]*x[1] + x[1]*x[2]*x[2] + r8*x[1] + r5*x[2]*x[2] + x[3]*x[3] - 1 + r6*x[2] + r7*x[1]*x[2] + r9*x[1]*x[3]
+				},
+			},
+			x: []float64{10, 10, 10, 10, 10},
+		},
+	}
+}
+
+func nonlinMethods() []struct {
+	name   string
+	method func() Method
+} {
+	return []struct {
+		name   string
+		method func() Method
+	}{
+		{name: "Hybrid", method: func() Method { return &Hybrid{} }},
+		{name: "Broyden", method: func() Method { return &Broyden{} }},
+		{name: "BroydenBad", method: func() Method { return &Broyden{Bad: true} }},
+		{name: "NewtonKrylov", method: func() Method { return &NewtonKrylov{} }},
+	}
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	for _, test := range nonlinTests() {
+		for _, m := range nonlinMethods() {
+			for _, withJac := range []bool{true, false} {
+				p := test.p
+				if !withJac {
+					if p.Jacobian == nil {
+						continue
+					}
+					p.Jacobian = nil
+				}
+				result, err := Solve(p, test.x, nil, m.method())
+				if err != nil {
+					t.Errorf("%s %s (Jacobian %t): unexpected error: %v", m.name, test.name, withJac, err)
+					continue
+				}
+				if result.Status != optimize.FunctionThreshold {
+					t.Errorf("%s %s (Jacobian %t): unexpected status: got %v, want %v", m.name, test.name, withJac, result.Status, optimize.FunctionThreshold)
+				}
+				if test.want != nil && !floats.EqualApprox(result.X, test.want, 1e-8) {
+					t.Errorf("%s %s (Jacobian %t): unexpected solution: got %v, want %v", m.name, test.name, withJac, result.X, test.want)
+				}
+				f := make([]float64, len(test.x))
+				p.Func(f, result.X)
+				if !floats.Equal(f, result.F) {
+					t.Errorf("%s %s (Jacobian %t): function value at the solution %v not equal to the returned value %v", m.name, test.name, withJac, f, result.F)
+				}
+				if result.Norm != floats.Norm(f, 2) {
+					t.Errorf("%s %s (Jacobian %t): unexpected norm: got %v, want %v", m.name, test.name, withJac, result.Norm, floats.Norm(f, 2))
+				}
+				switch {
+				case m.name == "NewtonKrylov":
+					if result.JacobianEvaluations != 0 || result.LinearIterations == 0 {
+						t.Errorf("%s %s: unexpected statistics: %+v", m.name, test.name, result.Stats)
+					}
+				case result.JacobianEvaluations == 0:
+					t.Errorf("%s %s (Jacobian %t): Jacobian evaluations not counted", m.name, test.name, withJac)
+				}
+			}
+		}
+	}
+}
+
+func TestReuse(t *testing.T) {
+	t.Parallel()
+	// Methods reused for a larger system must behave as fresh values, so
+	// defaults that depend on the order of the system are resolved anew.
+	p := nonlinTests()[2].p
+	small := []float64{-1}
+	large := make([]float64, 40)
+	for i := range large {
+		large[i] = -1
+	}
+	for _, m := range nonlinMethods() {
+		want, err := Solve(p, large, nil, m.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		method := m.method()
+		_, err = Solve(p, small, nil, method)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		switch method := method.(type) {
+		case *NewtonKrylov:
+			if method.KrylovDim != 0 || method.MaxLinearIterations != 0 || method.MaxForcing != 0 || method.DiffStep != 0 {
+				t.Errorf("%s: exported fields modified by Solve", m.name)
+			}
+		case *Hybrid:
+			if method.InitialRadius != 0 {
+				t.Errorf("%s: exported fields modified by Solve", m.name)
+			}
+		}
+		got, err := Solve(p, large, nil, method)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		if got.MajorIterations != want.MajorIterations || got.FuncEvaluations != want.FuncEvaluations {
+			t.Errorf("%s: reused method differs from a fresh one: got %d iterations and %d evaluations, want %d and %d",
+				m.name, got.MajorIterations, got.FuncEvaluations, want.MajorIterations, want.FuncEvaluations)
+		}
+	}
+}
+
+func TestPowellBadlyScaled(t *testing.T) {
+	t.Parallel()
+	// The solution of Powell's badly scaled function has components that
+	// differ by six orders of magnitude. Hybrid handles it by scaling the
+	// variables with the norms of the columns of the Jacobian.
+	p := Problem{
+		Func: func(f, x []float64) {
+			f[0] = 1e4*x[0]*x[1] - 1
+			f[1] = math.Exp(-x[0]) + math.Exp(-x[1]) - 1.0001
+		},
+	}
+	result, err := Solve(p, []float64{0, 1}, nil, &Hybrid{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{1.098159329699759e-05, 9.106146739867318}
+	if !floats.EqualApprox(result.X, want, 1e-8) {
+		t.Errorf("unexpected solution: got %v, want %v", result.X, want)
+	}
+}
+
+func TestNoSolution(t *testing.T) {
+	t.Parallel()
+	// F has a local minimum of |F| at x = 0 which is not a solution.
+	p := Problem{
+		Func: func(f, x []float64) {
+			f[0] = x[0]*x[0] + 1
+		},
+	}
+	for _, m := range nonlinMethods() {
+		result, err := Solve(p, []float64{0.5}, &Settings{MajorIterations: 1000}, m.method())
+		if err == nil && result.Status == optimize.FunctionThreshold {
+			t.Errorf("%s: unexpected solution found at %v", m.name, result.X)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+	test := nonlinTests()[0]
+	for _, m := range nonlinMethods() {
+		result, err := Solve(test.p, test.x, &Settings{MajorIterations: 1}, m.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		if result.Status != optimize.IterationLimit || result.MajorIterations != 1 {
+			t.Errorf("%s: unexpected status with iteration limit: got %v after %d iterations", m.name, result.Status, result.MajorIterations)
+		}
+
+		p := test.p
+		p.Jacobian = nil
+		result, err = Solve(p, test.x, &Settings{FuncEvaluations: 5}, m.method())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", m.name, err)
+		}
+		if result.Status != optimize.FunctionEvaluationLimit {
+			t.Errorf("%s: unexpected status with evaluation limit: got %v", m.name, result.Status)
+		}
+		if result.FuncEvaluations > 5 {
+			t.Errorf("%s: evaluation limit exceeded: %d evaluations", m.name, result.FuncEvaluations)
+		}
+	}
+}
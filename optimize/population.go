@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// populationEvaluator evaluates batches of locations for population-based
+// global Methods. The evaluations of a batch are distributed over all of the
+// tasks passed to Method.Run, so that they run concurrently when
+// Settings.Concurrent is larger than one.
+type populationEvaluator struct {
+	operation chan<- Task
+	result    <-chan Task
+	tasks     []Task
+
+	// terminated is set when a PostIteration has been received.
+	terminated bool
+}
+
+func newPopulationEvaluator(operation chan<- Task, result <-chan Task, tasks []Task) *populationEvaluator {
+	return &populationEvaluator{
+		operation: operation,
+		result:    result,
+		tasks:     tasks,
+	}
+}
+
+// evaluate evaluates the function at the rows of xs and stores the values
+// into fs. It returns false if the optimization has been terminated, in which
+// case fs may be incomplete.
+func (p *populationEvaluator) evaluate(xs *mat.Dense, fs []float64) bool {
+	n, _ := xs.Dims()
+	free := p.tasks
+	var sent, received int
+	for received < n {
+		for ; sent < n && len(free) > 0; sent++ {
+			task := free[len(free)-1]
+			free = free[:len(free)-1]
+			task.ID = sent
+			task.Op = FuncEvaluation
+			copy(task.X, xs.RawRowView(sent))
+			p.operation <- task
+		}
+		task := <-p.result
+		switch task.Op {
+		case PostIteration:
+			p.terminated = true
+			return false
+		case FuncEvaluation:
+			fs[task.ID] = task.F
+			received++
+			free = append(free, task)
+		default:
+			panic("optimize: unexpected operation")
+		}
+	}
+	return true
+}
+
+// majorIteration sends a MajorIteration with the location x and the function
+// value f. It returns false if the optimization has been terminated.
+func (p *populationEvaluator) majorIteration(x []float64, f float64) bool {
+	task := p.tasks[0]
+	task.ID = -1
+	task.Op = MajorIteration
+	task.F = f
+	copy(task.X, x)
+	p.operation <- task
+	task = <-p.result
+	switch task.Op {
+	case PostIteration:
+		p.terminated = true
+		return false
+	case MajorIteration:
+		return true
+	default:
+		panic("optimize: unexpected operation")
+	}
+}
+
+// methodDone signals that the method has converged.
+func (p *populationEvaluator) methodDone() {
+	task := p.tasks[0]
+	task.ID = -1
+	task.Op = MethodDone
+	p.operation <- task
+	task = <-p.result
+	if task.Op != PostIteration {
+		panic("optimize: task should have returned post iteration")
+	}
+	p.terminated = true
+}
+
+// finish waits for the outstanding evaluations to complete and closes the
+// operation channel.
+func (p *populationEvaluator) finish() {
+	for range p.result {
+	}
+	close(p.operation)
+}
+
+// newRand returns a random number generator using src, or a randomly seeded
+// generator if src is nil.
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(src)
+}
+
+// initPopulation initializes the rows of xs with random locations. The first
+// row is set to x0 projected onto the bounds. Variables with both bounds
+// finite are sampled uniformly between the bounds, the others are sampled
+// from a normal distribution around x0 with standard deviation scale and
+// projected onto the bounds.
+func initPopulation(xs *mat.Dense, x0, lower, upper []float64, scale float64, rnd *rand.Rand) {
+	n, _ := xs.Dims()
+	for i := 0; i < n; i++ {
+		row := xs.RawRowView(i)
+		for j, v := range x0 {
+			lo, up := lowerBound(lower, j), upperBound(upper, j)
+			switch {
+			case i == 0:
+				row[j] = v
+			case !math.IsInf(lo, 0) && !math.IsInf(up, 0):
+				row[j] = lo + (up-lo)*rnd.Float64()
+			default:
+				row[j] = v + scale*rnd.NormFloat64()
+			}
+		}
+		projectBounds(row, lower, upper)
+	}
+}
+
+// populationConverged returns whether the function values of a population
+// have a standard deviation smaller than tol relative to their mean. If tol
+// is NaN, populationConverged returns false.
+func populationConverged(fs []float64, tol float64) bool {
+	if math.IsNaN(tol) {
+		return false
+	}
+	mean, std := stat.MeanStdDev(fs, nil)
+	return std <= tol*(1+math.Abs(mean))
+}
+
+// bestIndex returns the index of the smallest value in fs that is not NaN,
+// or -1 if all values are NaN.
+func bestIndex(fs []float64) int {
+	best := -1
+	for i, f := range fs {
+		if math.IsNaN(f) {
+			continue
+		}
+		if best == -1 || f < fs[best] {
+			best = i
+		}
+	}
+	return best
+}
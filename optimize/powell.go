@@ -0,0 +1,367 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	goldenRatio     = 1.618033988749895
+	goldenSection   = 0.3819660112501051 // 2 - goldenRatio
+	maxBracketSteps = 50
+	maxBrentIters   = 100
+)
+
+var (
+	_ Method   = (*Powell)(nil)
+	_ Statuser = (*Powell)(nil)
+)
+
+// Powell implements Powell's conjugate direction method for gradient-free
+// minimization.
+//
+// Powell keeps a set of dim search directions, initially the coordinate
+// directions, and in each iteration minimizes the function along each of them
+// in turn with Brent's method. The overall displacement of the iteration then
+// replaces the direction along which the function decreased the most, unless
+// this would make the directions nearly linearly dependent. For a convex
+// quadratic function the directions become mutually conjugate. Each iteration
+// is a major iteration.
+//
+// The method converges when the decrease of the function value over an
+// iteration is smaller than Tolerance relative to the function value.
+//
+// References:
+//   - Powell, M. J. D. (1964). An efficient method for finding the minimum of
+//     a function of several variables without calculating derivatives. The
+//     Computer Journal 7(2), 155-162.
+//   - Brent, R. P. (1973). Algorithms for Minimization without Derivatives.
+//     Prentice-Hall. Chapter 7.
+type Powell struct {
+	// InitStepSize is the length of the initial search directions. If
+	// InitStepSize is zero, it is defaulted to 1.
+	InitStepSize float64
+	// LineTolerance is the relative tolerance on the step of the line
+	// minimizations. If LineTolerance is zero, it is defaulted to 1e-8.
+	LineTolerance float64
+	// Tolerance is the relative tolerance on the decrease of the function
+	// value in an iteration. If Tolerance is zero, it is defaulted to 1e-12,
+	// and if it is NaN the criterion is not used.
+	Tolerance float64
+
+	status Status
+	err    error
+
+	step, lineTol, tol float64
+
+	dirs   *mat.Dense // Search directions stored in rows.
+	x      []float64  // Current location.
+	f      float64    // Function value at x.
+	xStart []float64  // Location at the beginning of the iteration.
+	dir    []float64  // Direction of the current line minimization.
+}
+
+func (p *Powell) Status() (Status, error) {
+	return p.status, p.err
+}
+
+func (*Powell) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (p *Powell) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	p.step = p.InitStepSize
+	if p.step == 0 {
+		p.step = 1
+	}
+	p.lineTol = p.LineTolerance
+	if p.lineTol == 0 {
+		p.lineTol = 1e-8
+	}
+	p.tol = p.Tolerance
+	if p.tol == 0 {
+		p.tol = 1e-12
+	}
+	if p.step < 0 || p.lineTol < 0 || p.tol < 0 {
+		panic("optimize: negative Powell parameter")
+	}
+	p.status = NotTerminated
+	p.err = nil
+	p.dirs = mat.NewDense(dim, dim, nil)
+	p.x = resize(p.x, dim)
+	p.xStart = resize(p.xStart, dim)
+	p.dir = resize(p.dir, dim)
+	return 1
+}
+
+func (p *Powell) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	p.status, p.err = p.run(&syncTasker{operation: operation, result: result, task: tasks[0]})
+	close(operation)
+}
+
+func (p *Powell) run(t *syncTasker) (Status, error) {
+	loc := t.task.Location
+	if !t.do(FuncEvaluation &^ t.task.Op) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if math.IsInf(loc.F, 1) || math.IsNaN(loc.F) {
+		t.finishMethodDone()
+		return Failure, ErrFunc(loc.F)
+	}
+	copy(p.x, loc.X)
+	p.f = loc.F
+	if !t.do(MajorIteration) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	dim := len(p.x)
+	for i := 0; i < dim; i++ {
+		p.dirs.Set(i, i, p.step)
+	}
+
+	// eval evaluates the function at p.x + alpha*p.dir.
+	eval := func(alpha float64) (float64, bool) {
+		floats.AddScaledTo(loc.X, p.x, alpha, p.dir)
+		if !t.do(FuncEvaluation) {
+			return 0, false
+		}
+		return loc.F, true
+	}
+	// lineMinimize minimizes the function along p.dir and updates p.x and
+	// p.f. It returns false if the optimization has been terminated.
+	lineMinimize := func() bool {
+		alpha, f, ok := lineMinimum(eval, p.f, p.lineTol)
+		if !ok {
+			return false
+		}
+		if f < p.f {
+			floats.AddScaled(p.x, alpha, p.dir)
+			p.f = f
+		}
+		return true
+	}
+
+	for {
+		copy(p.xStart, p.x)
+		fStart := p.f
+		var (
+			big      int
+			decrease float64
+		)
+		for i := 0; i < dim; i++ {
+			copy(p.dir, p.dirs.RawRowView(i))
+			f := p.f
+			if !lineMinimize() {
+				t.finish()
+				return NotTerminated, nil
+			}
+			if f-p.f > decrease {
+				big = i
+				decrease = f - p.f
+			}
+		}
+
+		if !math.IsNaN(p.tol) && 2*(fStart-p.f) <= p.tol*(math.Abs(fStart)+math.Abs(p.f))+tiny {
+			copy(loc.X, p.x)
+			loc.F = p.f
+			if !t.do(MajorIteration) {
+				t.finish()
+				return NotTerminated, nil
+			}
+			t.finishMethodDone()
+			return MethodConverge, nil
+		}
+
+		// Consider replacing the direction of the largest decrease by the
+		// displacement of the iteration, following Powell's criterion.
+		floats.SubTo(p.dir, p.x, p.xStart)
+		fe, ok := eval(1)
+		if !ok {
+			t.finish()
+			return NotTerminated, nil
+		}
+		if fe < fStart {
+			d1 := fStart - p.f - decrease
+			d2 := fStart - fe
+			if 2*(fStart-2*p.f+fe)*d1*d1 < decrease*d2*d2 {
+				if !lineMinimize() {
+					t.finish()
+					return NotTerminated, nil
+				}
+				last := dim - 1
+				p.dirs.SetRow(big, p.dirs.RawRowView(last))
+				p.dirs.SetRow(last, p.dir)
+			}
+		}
+
+		copy(loc.X, p.x)
+		loc.F = p.f
+		if !t.do(MajorIteration) {
+			t.finish()
+			return NotTerminated, nil
+		}
+	}
+}
+
+// tiny is added to relative tolerances to handle function values close to
+// zero.
+const tiny = 1e-300
+
+// lineMinimum finds a local minimum of the function f of one variable by
+// bracketing a minimum starting from the points 0 and 1 and then applying
+// Brent's method. f0 is the value of f at 0 and tol is the relative tolerance
+// on the step. lineMinimum returns the step and the function value at the
+// minimum, which is 0 if no decrease was found. The returned bool is false if
+// f returned false.
+func lineMinimum(f func(float64) (float64, bool), f0, tol float64) (x, fx float64, ok bool) {
+	a, b, c, fa, fb, fc, ok := bracketMinimum(f, 0, 1, f0)
+	if !ok {
+		return 0, f0, false
+	}
+	if !(fb < fa && fb <= fc) {
+		// No interior minimum has been found, return the best endpoint.
+		if fc < fa {
+			return c, fc, true
+		}
+		return a, fa, true
+	}
+	return brentMinimum(f, a, b, c, fb, tol)
+}
+
+// bracketMinimum searches for points a, b and c with b between a and c such
+// that f(b) is smaller than f(a) and not larger than f(c), starting from the
+// points a and b where fa = f(a). The search expands the interval downhill by
+// the golden ratio. If no bracket is found after a limited number of steps,
+// the last points are returned. The returned bool is false if f returned
+// false.
+func bracketMinimum(f func(float64) (float64, bool), a, b, fa float64) (x0, x1, x2, f0, f1, f2 float64, ok bool) {
+	fb, ok := f(b)
+	if !ok {
+		return
+	}
+	fb = nanToInf(fb)
+	if fb > fa {
+		a, b = b, a
+		fa, fb = fb, fa
+	}
+	c := b + goldenRatio*(b-a)
+	fc, ok := f(c)
+	if !ok {
+		return
+	}
+	fc = nanToInf(fc)
+	for i := 0; fb > fc && i < maxBracketSteps; i++ {
+		a, b = b, c
+		fa, fb = fb, fc
+		c = b + goldenRatio*(b-a)
+		fc, ok = f(c)
+		if !ok {
+			return
+		}
+		fc = nanToInf(fc)
+	}
+	return a, b, c, fa, fb, fc, true
+}
+
+// brentMinimum finds a local minimum of f in the interval between a and c by
+// Brent's method of combined parabolic interpolation and golden section
+// search. The point b must lie between a and c, and fb = f(b) must be smaller
+// than the function values at a and c. The returned bool is false if f
+// returned false.
+func brentMinimum(f func(float64) (float64, bool), a, b, c, fb, tol float64) (x, fx float64, ok bool) {
+	if a > c {
+		a, c = c, a
+	}
+	x, w, v := b, b, b
+	fx, fw, fv := fb, fb, fb
+	var d, e float64
+	for i := 0; i < maxBrentIters; i++ {
+		m := (a + c) / 2
+		tol1 := tol*math.Abs(x) + 1e-12
+		tol2 := 2 * tol1
+		if math.Abs(x-m) <= tol2-(c-a)/2 {
+			break
+		}
+		useGolden := true
+		if math.Abs(e) > tol1 {
+			// Try a parabolic step through x, w and v.
+			r := (x - w) * (fx - fv)
+			q := (x - v) * (fx - fw)
+			p := (x-v)*q - (x-w)*r
+			q = 2 * (q - r)
+			if q > 0 {
+				p = -p
+			}
+			q = math.Abs(q)
+			if math.Abs(p) < math.Abs(q*e/2) && p > q*(a-x) && p < q*(c-x) {
+				e = d
+				d = p / q
+				if u := x + d; u-a < tol2 || c-u < tol2 {
+					d = math.Copysign(tol1, m-x)
+				}
+				useGolden = false
+			}
+		}
+		if useGolden {
+			if x >= m {
+				e = a - x
+			} else {
+				e = c - x
+			}
+			d = goldenSection * e
+		}
+		u := x + d
+		if math.Abs(d) < tol1 {
+			u = x + math.Copysign(tol1, d)
+		}
+		fu, ok := f(u)
+		if !ok {
+			return x, fx, false
+		}
+		fu = nanToInf(fu)
+		if fu <= fx {
+			if u >= x {
+				a = x
+			} else {
+				c = x
+			}
+			v, w, x = w, x, u
+			fv, fw, fx = fw, fx, fu
+			continue
+		}
+		if u < x {
+			a = u
+		} else {
+			c = u
+		}
+		if fu <= fw || w == x {
+			v, w = w, u
+			fv, fw = fw, fu
+		} else if fu <= fv || v == x || v == w {
+			v = u
+			fv = fu
+		}
+	}
+	return x, fx, true
+}
+
+// nanToInf returns +Inf if f is NaN and f otherwise.
+func nanToInf(f float64) float64 {
+	if math.IsNaN(f) {
+		return math.Inf(1)
+	}
+	return f
+}
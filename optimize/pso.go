@@ -0,0 +1,214 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method   = (*ParticleSwarm)(nil)
+	_ Statuser = (*ParticleSwarm)(nil)
+)
+
+// ParticleSwarm implements the particle swarm global optimization method with
+// a global best topology and inertia weight.
+//
+// ParticleSwarm maintains a swarm of particles with locations x_i and
+// velocities v_i. Each particle remembers the best location p_i it has
+// visited, and the swarm remembers the best location g visited by any
+// particle. In each iteration the velocities and locations are updated by
+//
+//	v_i = w v_i + c₁ r₁ ∘ (p_i - x_i) + c₂ r₂ ∘ (g - x_i),
+//	x_i = x_i + v_i,
+//
+// where r₁ and r₂ are vectors of uniform random numbers in [0, 1) and ∘ is the
+// element-wise product. The locations of all particles of an iteration are
+// evaluated concurrently if Settings.Concurrent is larger than one. Each
+// iteration is a major iteration, reporting the best location found so far.
+//
+// If bounds are given, particles that leave the feasible box are moved onto
+// its boundary and the corresponding velocity components are set to zero.
+//
+// The default parameters are the constriction coefficients of Clerc and
+// Kennedy. Reference:
+//
+//	Clerc, M., Kennedy, J. (2002). The particle swarm - explosion, stability,
+//	and convergence in a multidimensional complex space. IEEE Transactions on
+//	Evolutionary Computation 6(1), 58-73.
+type ParticleSwarm struct {
+	// Particles is the size of the swarm. If Particles is zero, it is
+	// defaulted to 10 + 2*dim.
+	Particles int
+	// Inertia is the inertia weight w. If Inertia is zero, it is defaulted
+	// to 0.7298.
+	Inertia float64
+	// Cognitive and Social are the acceleration coefficients c₁ and c₂
+	// towards the best location of the particle and of the swarm. If they are
+	// zero, they are defaulted to 1.49618.
+	Cognitive, Social float64
+	// InitStepSize is the standard deviation of the initial locations for
+	// variables without finite bounds. If InitStepSize is zero, it is
+	// defaulted to 1.
+	InitStepSize float64
+	// Lower and Upper are optional bounds on the variables. If Lower is nil,
+	// the variables are not bounded from below, and if Upper is nil they are
+	// not bounded from above. Otherwise they must have the same length as the
+	// problem dimension, and individual entries may be infinite.
+	Lower, Upper []float64
+	// Tolerance sets the threshold for stopping when the swarm has converged.
+	// The method converges when the standard deviation of the function values
+	// at the best locations of the particles is less than
+	// Tolerance·(1 + |mean|). If Tolerance is zero, it is defaulted to 1e-12,
+	// and if it is NaN the criterion is not used.
+	Tolerance float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil, a randomly seeded generator is used.
+	Src rand.Source
+
+	n                 int
+	w, c1, c2         float64
+	tol, initStepSize float64
+	lower, upper      []float64
+	status            Status
+	rnd               *rand.Rand
+
+	xs, vs, ps *mat.Dense // Locations, velocities and best locations.
+	fs, pf     []float64  // Function values at xs and ps.
+	bestX      []float64
+	bestF      float64
+}
+
+func (ps *ParticleSwarm) Status() (Status, error) {
+	return ps.status, nil
+}
+
+func (*ParticleSwarm) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+func (ps *ParticleSwarm) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(ps.Lower, ps.Upper, dim)
+	ps.n = ps.Particles
+	if ps.n == 0 {
+		ps.n = 10 + 2*dim
+	}
+	if ps.n < 0 {
+		panic("optimize: negative number of particles")
+	}
+	ps.w = ps.Inertia
+	if ps.w == 0 {
+		ps.w = 0.7298
+	}
+	ps.c1 = ps.Cognitive
+	if ps.c1 == 0 {
+		ps.c1 = 1.49618
+	}
+	ps.c2 = ps.Social
+	if ps.c2 == 0 {
+		ps.c2 = 1.49618
+	}
+	ps.initStepSize = ps.InitStepSize
+	if ps.initStepSize == 0 {
+		ps.initStepSize = 1
+	}
+	ps.tol = ps.Tolerance
+	if ps.tol == 0 {
+		ps.tol = 1e-12
+	}
+	ps.lower, ps.upper = ps.Lower, ps.Upper
+	ps.status = NotTerminated
+	ps.xs = mat.NewDense(ps.n, dim, nil)
+	ps.vs = mat.NewDense(ps.n, dim, nil)
+	ps.ps = mat.NewDense(ps.n, dim, nil)
+	ps.fs = resize(ps.fs, ps.n)
+	ps.pf = resize(ps.pf, ps.n)
+	ps.bestX = resize(ps.bestX, dim)
+	ps.bestF = math.Inf(1)
+	ps.rnd = newRand(ps.Src)
+	return min(tasks, ps.n)
+}
+
+func (ps *ParticleSwarm) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	p := newPopulationEvaluator(operation, result, tasks)
+	initPopulation(ps.xs, tasks[0].X, ps.lower, ps.upper, ps.initStepSize, ps.rnd)
+	// Initialize the velocities to the difference between two random
+	// initial locations.
+	ps.initVelocities()
+	ps.ps.Copy(ps.xs)
+	for i := range ps.pf {
+		ps.pf[i] = math.Inf(1)
+	}
+	for p.evaluate(ps.xs, ps.fs) {
+		ps.update()
+		if !p.majorIteration(ps.bestX, ps.bestF) {
+			break
+		}
+		if populationConverged(ps.pf, ps.tol) {
+			ps.status = MethodConverge
+			p.methodDone()
+			break
+		}
+		ps.move()
+	}
+	p.finish()
+}
+
+func (ps *ParticleSwarm) initVelocities() {
+	for i := 0; i < ps.n; i++ {
+		v := ps.vs.RawRowView(i)
+		a := ps.xs.RawRowView(ps.rnd.IntN(ps.n))
+		b := ps.xs.RawRowView(ps.rnd.IntN(ps.n))
+		for j := range v {
+			v[j] = (a[j] - b[j]) / 2
+		}
+	}
+}
+
+// update updates the best locations of the particles and of the swarm.
+func (ps *ParticleSwarm) update() {
+	for i, f := range ps.fs {
+		if f < ps.pf[i] {
+			ps.pf[i] = f
+			copy(ps.ps.RawRowView(i), ps.xs.RawRowView(i))
+		}
+	}
+	if i := bestIndex(ps.pf); i != -1 && (ps.pf[i] < ps.bestF || math.IsInf(ps.bestF, 1)) {
+		ps.bestF = ps.pf[i]
+		copy(ps.bestX, ps.ps.RawRowView(i))
+	}
+}
+
+// move updates the velocities and the locations of the particles.
+func (ps *ParticleSwarm) move() {
+	for i := 0; i < ps.n; i++ {
+		x := ps.xs.RawRowView(i)
+		v := ps.vs.RawRowView(i)
+		p := ps.ps.RawRowView(i)
+		for j := range x {
+			r1 := ps.rnd.Float64()
+			r2 := ps.rnd.Float64()
+			v[j] = ps.w*v[j] + ps.c1*r1*(p[j]-x[j]) + ps.c2*r2*(ps.bestX[j]-x[j])
+			x[j] += v[j]
+			if lo := lowerBound(ps.lower, j); x[j] < lo {
+				x[j] = lo
+				v[j] = 0
+			}
+			if up := upperBound(ps.upper, j); x[j] > up {
+				x[j] = up
+				v[j] = 0
+			}
+		}
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import "math"
+
+// Brent finds a root of f in the interval [a, b] by the Brent–Dekker method,
+// which combines inverse quadratic interpolation and the secant method with
+// bisection. f(a) and f(b) must have opposite signs, otherwise Brent returns
+// ErrNotBracketed. The number of function evaluations is at most about the
+// square of that of bisection, and typically much smaller for smooth
+// functions.
+//
+// Brent panics if a > b or if the interval is not finite. If settings is nil,
+// the default settings are used.
+//
+// References:
+//   - Brent, R. P. (1973). Algorithms for Minimization without Derivatives.
+//     Prentice-Hall. Chapter 4.
+func Brent(f func(x float64) float64, a, b float64, settings *Settings) (Result, error) {
+	checkInterval(a, b)
+	tol := newTolerance(settings, 4*eps)
+	c := counter{f: f}
+	fa := c.eval(a)
+	fb := c.eval(b)
+	if r, done, err := checkBracket(a, b, fa, fb, c.evals); done {
+		return r, err
+	}
+
+	x, fx := b, fb
+	// The root is between x and the contrapoint y.
+	y, fy := a, fa
+	d := b - a
+	e := d
+	for iter := 1; ; iter++ {
+		if math.Signbit(fx) == math.Signbit(fy) {
+			y, fy = a, fa
+			d = x - a
+			e = d
+		}
+		if math.Abs(fy) < math.Abs(fx) {
+			a, x, y = x, y, x
+			fa, fx, fy = fx, fy, fx
+		}
+		tol1 := tol.at(x) / 2
+		m := (y - x) / 2
+		if math.Abs(m) <= tol1 || fx == 0 {
+			return Result{X: x, F: fx, BracketWidth: math.Abs(y - x), Iterations: iter - 1, FuncEvaluations: c.evals}, nil
+		}
+		if iter > tol.maxIter {
+			return Result{X: x, F: fx, BracketWidth: math.Abs(y - x), Iterations: iter - 1, FuncEvaluations: c.evals}, ErrIterationLimit
+		}
+
+		if math.Abs(e) >= tol1 && math.Abs(fa) > math.Abs(fx) {
+			// Try interpolation.
+			var p, q float64
+			s := fx / fa
+			if a == y {
+				// Secant method.
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// Inverse quadratic interpolation.
+				q = fa / fy
+				r := fx / fy
+				p = s * (2*m*q*(q-r) - (x-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol1*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = m
+				e = m
+			}
+		} else {
+			d = m
+			e = m
+		}
+		a, fa = x, fx
+		if math.Abs(d) > tol1 {
+			x += d
+		} else {
+			x += math.Copysign(tol1, m)
+		}
+		fx = c.eval(x)
+		if !isFinite(fx) {
+			return Result{X: x, F: fx, Iterations: iter, FuncEvaluations: c.evals}, ErrNotFinite
+		}
+	}
+}
+
+// Illinois finds a root of f in the interval [a, b] by the Illinois variant
+// of the method of false position. When the same endpoint of the bracket is
+// retained in two consecutive iterations, its function value is halved, which
+// gives superlinear convergence. f(a) and f(b) must have opposite signs,
+// otherwise Illinois returns ErrNotBracketed. A bisection step is taken when
+// the bracket has not been halved in two iterations, so the convergence is
+// never much slower than that of bisection.
+//
+// Illinois panics if a > b or if the interval is not finite. If settings is
+// nil, the default settings are used.
+//
+// References:
+//   - Dowell, M., & Jarratt, P. (1971). A modified regula falsi method for
+//     computing the root of an equation. BIT 11(2), 168-174.
+func Illinois(f func(x float64) float64, a, b float64, settings *Settings) (Result, error) {
+	return falsePosition(f, a, b, settings, func(fx, fnew float64) float64 {
+		return 0.5
+	})
+}
+
+// AndersonBjorck finds a root of f in the interval [a, b] by the
+// Anderson–Björck variant of the method of false position. When the same
+// endpoint of the bracket is retained in two consecutive iterations, its
+// function value is scaled by a factor computed from the last two iterates,
+// which typically converges faster than Illinois. f(a) and f(b) must have
+// opposite signs, otherwise AndersonBjorck returns ErrNotBracketed. As in
+// Illinois, a bisection step is taken when the bracket has not been halved in
+// two iterations.
+//
+// AndersonBjorck panics if a > b or if the interval is not finite. If
+// settings is nil, the default settings are used.
+//
+// References:
+//   - Anderson, N., & Björck, Å. (1973). A new high order method of regula
+//     falsi type for computing a root of an equation. BIT 13(3), 253-264.
+func AndersonBjorck(f func(x float64) float64, a, b float64, settings *Settings) (Result, error) {
+	return falsePosition(f, a, b, settings, func(fx, fnew float64) float64 {
+		m := 1 - fnew/fx
+		if m <= 0 {
+			return 0.5
+		}
+		return m
+	})
+}
+
+// falsePosition implements the modified methods of false position. scale
+// returns the factor applied to the function value at the retained endpoint
+// given the function values at the previous and the new iterate.
+func falsePosition(f func(float64) float64, a, b float64, settings *Settings, scale func(fx, fnew float64) float64) (Result, error) {
+	checkInterval(a, b)
+	tol := newTolerance(settings, 4*eps)
+	c := counter{f: f}
+	fa := c.eval(a)
+	fb := c.eval(b)
+	if r, done, err := checkBracket(a, b, fa, fb, c.evals); done {
+		return r, err
+	}
+
+	// x is the last iterate and y is the other endpoint of the bracket. fy
+	// is the scaled function value at y and fyOrig is the unscaled one.
+	x, fx := b, fb
+	y, fy := a, fa
+	fyOrig := fa
+	// ref is the width of the bracket at the last check for slow
+	// convergence.
+	ref := b - a
+	for iter := 1; ; iter++ {
+		lo, hi := math.Min(x, y), math.Max(x, y)
+		xnew := x - fx*(x-y)/(fx-fy)
+		bisect := iter%2 == 0 && hi-lo > ref/2
+		if iter%2 == 0 {
+			ref = hi - lo
+		}
+		if bisect || !(lo < xnew && xnew < hi) {
+			// Bisect the bracket if the convergence is slow, and guard
+			// against rounding errors.
+			xnew = lo + (hi-lo)/2
+		} else if tol1 := tol.at(x) / 2; math.Abs(xnew-x) < tol1 {
+			// Step at least half the tolerance towards y so that the
+			// bracket collapses close to the root.
+			xnew = x + math.Copysign(tol1, y-x)
+		}
+		fnew := c.eval(xnew)
+		if !isFinite(fnew) {
+			return Result{X: xnew, F: fnew, Iterations: iter, FuncEvaluations: c.evals}, ErrNotFinite
+		}
+		switch {
+		case math.Signbit(fnew) != math.Signbit(fx):
+			// The root is between x and xnew.
+			y, fy, fyOrig = x, fx, fx
+		case bisect:
+			// Discard the scaling after a bisection step.
+			fy = fyOrig
+		default:
+			// The endpoint y is retained.
+			fy *= scale(fx, fnew)
+		}
+		x, fx = xnew, fnew
+		width := math.Abs(y - x)
+		if fx == 0 || width <= 2*tol.at(x) {
+			return Result{X: x, F: fx, BracketWidth: width, Iterations: iter, FuncEvaluations: c.evals}, nil
+		}
+		if iter >= tol.maxIter {
+			return Result{X: x, F: fx, BracketWidth: width, Iterations: iter, FuncEvaluations: c.evals}, ErrIterationLimit
+		}
+	}
+}
+
+// ITP finds a root of f in the interval [a, b] by the
+// Interpolate-Truncate-Project method of Oliveira and Takahashi, with the
+// hyperparameters κ₁ = 0.2/(b-a), κ₂ = 2 and n₀ = 1. ITP combines the regula
+// falsi estimate with bisection so that it never requires more than one
+// iteration more than bisection, while it converges superlinearly for smooth
+// functions. f(a) and f(b) must have opposite signs, otherwise ITP returns
+// ErrNotBracketed.
+//
+// ITP uses the absolute tolerance Absolute + Relative*max(|a|, |b|) for the
+// whole interval. ITP panics if a > b or if the interval is not finite. If
+// settings is nil, the default settings are used.
+//
+// References:
+//   - Oliveira, I. F. D., & Takahashi, R. H. C. (2020). An enhancement of
+//     the bisection method average performance preserving minmax optimality.
+//     ACM Transactions on Mathematical Software 47(1), 5:1-5:24.
+func ITP(f func(x float64) float64, a, b float64, settings *Settings) (Result, error) {
+	checkInterval(a, b)
+	tol := newTolerance(settings, 4*eps)
+	c := counter{f: f}
+	fa := c.eval(a)
+	fb := c.eval(b)
+	if r, done, err := checkBracket(a, b, fa, fb, c.evals); done {
+		return r, err
+	}
+
+	epsilon := tol.at(math.Max(math.Abs(a), math.Abs(b)))
+	const (
+		kappa2 = 2
+		n0     = 1
+	)
+	kappa1 := 0.2 / (b - a)
+	nHalf := math.Max(0, math.Ceil(math.Log2((b-a)/(2*epsilon))))
+	nMax := int(nHalf) + n0
+	// Work with the orientation of f such that f(a) < 0 < f(b).
+	sign := 1.0
+	if fa > 0 {
+		sign = -1
+	}
+	ya, yb := sign*fa, sign*fb
+	var x, fx float64
+	for iter := 1; ; iter++ {
+		xHalf := a + (b-a)/2
+		r := epsilon*math.Exp2(float64(nMax-iter+1)) - (b-a)/2
+		delta := kappa1 * math.Pow(b-a, kappa2)
+
+		// Interpolate.
+		xf := (yb*a - ya*b) / (yb - ya)
+		// Truncate.
+		s := math.Copysign(1, xHalf-xf)
+		xt := xHalf
+		if delta <= math.Abs(xHalf-xf) {
+			xt = xf + s*delta
+		}
+		// Project.
+		x = xt
+		if math.Abs(xt-xHalf) > r {
+			x = xHalf - s*r
+		}
+		if !(a < x && x < b) {
+			x = xHalf
+		}
+
+		fx = c.eval(x)
+		if !isFinite(fx) {
+			return Result{X: x, F: fx, Iterations: iter, FuncEvaluations: c.evals}, ErrNotFinite
+		}
+		switch y := sign * fx; {
+		case y > 0:
+			b, yb = x, y
+		case y < 0:
+			a, ya = x, y
+		default:
+			a, b = x, x
+		}
+		// In exact arithmetic the bracket is within the tolerance after
+		// nMax iterations, so stop there in spite of rounding errors.
+		if b-a <= 2*epsilon || iter >= nMax {
+			// Return the endpoint with the smaller function value.
+			if fx != 0 {
+				if math.Abs(ya) < math.Abs(yb) {
+					x, fx = a, sign*ya
+				} else {
+					x, fx = b, sign*yb
+				}
+			}
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, nil
+		}
+		if iter >= tol.maxIter {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, ErrIterationLimit
+		}
+	}
+}
+
+// checkBracket checks the function values fa and fb at the ends of the
+// interval [a, b]. If the root finding is complete, checkBracket returns the
+// result and the error, and true. evals is the number of function evaluations.
+func checkBracket(a, b, fa, fb float64, evals int) (r Result, done bool, err error) {
+	switch {
+	case !isFinite(fa):
+		return Result{X: a, F: fa, BracketWidth: b - a, FuncEvaluations: evals}, true, ErrNotFinite
+	case !isFinite(fb):
+		return Result{X: b, F: fb, BracketWidth: b - a, FuncEvaluations: evals}, true, ErrNotFinite
+	case fa == 0:
+		return Result{X: a, F: fa, FuncEvaluations: evals}, true, nil
+	case fb == 0:
+		return Result{X: b, F: fb, FuncEvaluations: evals}, true, nil
+	case math.Signbit(fa) == math.Signbit(fb):
+		return Result{X: a, F: fa, BracketWidth: b - a, FuncEvaluations: evals}, true, ErrNotBracketed
+	}
+	return Result{}, false, nil
+}
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package scalar implements routines to find roots and minima of functions of
+// one variable.
+//
+// The bracketing root finders Brent, Illinois, AndersonBjorck and ITP require
+// an interval over which the function changes sign, and always return a root
+// within it. Newton requires the derivative and starting point instead of a
+// bracket, and Halley combines second-order steps with bisection of a bracket.
+// PolynomialRoots finds all of the real roots of a polynomial in an interval.
+//
+// MinimizeBrent and MinimizeGoldenSection find a local minimum of a function
+// in an interval.
+package scalar // import "gonum.org/v1/gonum/optimize/scalar"
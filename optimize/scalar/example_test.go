@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/optimize/scalar"
+)
+
+func ExampleBrent() {
+	// Find the root of cos(x) = x.
+	f := func(x float64) float64 { return math.Cos(x) - x }
+	r, err := scalar.Brent(f, 0, 1, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("x = %.12f\n", r.X)
+	fmt.Printf("bracket width < 1e-12: %t\n", r.BracketWidth < 1e-12)
+	// Output:
+	// x = 0.739085133215
+	// bracket width < 1e-12: true
+}
+
+func ExamplePolynomialRoots() {
+	// Find the real roots of x³ - 7x + 6.
+	roots := scalar.PolynomialRoots(nil, []float64{6, -7, 0, 1}, math.Inf(-1), math.Inf(1), nil)
+	for _, x := range roots {
+		fmt.Printf("%.6f\n", x)
+	}
+	// Output:
+	// -3.000000
+	// 1.000000
+	// 2.000000
+}
+
+func ExampleMinimizeBrent() {
+	// Find the minimum of eˣ - 2x.
+	f := func(x float64) float64 { return math.Exp(x) - 2*x }
+	r, err := scalar.MinimizeBrent(f, -2, 3, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("x = %.6f, f(x) = %.6f\n", r.X, r.F)
+	// Output:
+	// x = 0.693147, f(x) = 0.613706
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import "math"
+
+const (
+	invPhi  = 0.6180339887498949 // 1/φ, where φ is the golden ratio.
+	invPhi2 = 0.3819660112501051 // 1/φ² = 1 - 1/φ.
+)
+
+// MinimizeGoldenSection finds a local minimum of f in the interval [a, b] by
+// golden-section search. Each iteration reduces the bracket of the minimum by
+// the factor 1/φ ≈ 0.618 with one function evaluation. If f is unimodal over
+// the interval, the minimum is the global minimum over the interval.
+// MinimizeGoldenSection returns ErrNotFinite if f returns NaN.
+//
+// MinimizeGoldenSection panics if a > b or if the interval is not finite. If
+// settings is nil, the default settings are used.
+func MinimizeGoldenSection(f func(x float64) float64, a, b float64, settings *Settings) (Result, error) {
+	checkInterval(a, b)
+	tol := newTolerance(settings, math.Sqrt(eps))
+	c := counter{f: f}
+	x1 := a + invPhi2*(b-a)
+	x2 := a + invPhi*(b-a)
+	f1 := c.eval(x1)
+	f2 := c.eval(x2)
+	for iter := 0; ; iter++ {
+		if math.IsNaN(f1) || math.IsNaN(f2) {
+			x, fx := x1, f1
+			if !math.IsNaN(f1) {
+				x, fx = x2, f2
+			}
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, ErrNotFinite
+		}
+		x, fx := x1, f1
+		if f2 < f1 {
+			x, fx = x2, f2
+		}
+		if b-a <= 2*tol.at(x) {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, nil
+		}
+		if iter >= tol.maxIter {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, ErrIterationLimit
+		}
+		if f1 <= f2 {
+			b = x2
+			x2, f2 = x1, f1
+			x1 = a + invPhi2*(b-a)
+			f1 = c.eval(x1)
+		} else {
+			a = x1
+			x1, f1 = x2, f2
+			x2 = a + invPhi*(b-a)
+			f2 = c.eval(x2)
+		}
+	}
+}
+
+// MinimizeBrent finds a local minimum of f in the interval [a, b] by Brent's
+// method, which combines parabolic interpolation with golden-section search.
+// MinimizeBrent converges superlinearly for smooth functions, and it never
+// requires many more function evaluations than golden-section search. If f is
+// unimodal over the interval, the minimum is the global minimum over the
+// interval. MinimizeBrent returns ErrNotFinite if f returns NaN.
+//
+// MinimizeBrent panics if a > b or if the interval is not finite. If settings
+// is nil, the default settings are used.
+//
+// References:
+//   - Brent, R. P. (1973). Algorithms for Minimization without Derivatives.
+//     Prentice-Hall. Chapter 5.
+func MinimizeBrent(f func(x float64) float64, a, b float64, settings *Settings) (Result, error) {
+	checkInterval(a, b)
+	tol := newTolerance(settings, math.Sqrt(eps))
+	c := counter{f: f}
+	x := a + invPhi2*(b-a)
+	fx := c.eval(x)
+	w, v := x, x
+	fw, fv := fx, fx
+	var d, e float64
+	if math.IsNaN(fx) {
+		return Result{X: x, F: fx, BracketWidth: b - a, FuncEvaluations: c.evals}, ErrNotFinite
+	}
+	for iter := 0; ; iter++ {
+		m := a + (b-a)/2
+		tol1 := tol.at(x) / 2
+		tol2 := 2 * tol1
+		if math.Abs(x-m) <= tol2-(b-a)/2 {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, nil
+		}
+		if iter >= tol.maxIter {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: c.evals}, ErrIterationLimit
+		}
+
+		golden := true
+		if math.Abs(e) > tol1 {
+			// Try a parabolic step through x, w and v.
+			r := (x - w) * (fx - fv)
+			q := (x - v) * (fx - fw)
+			p := (x-v)*q - (x-w)*r
+			q = 2 * (q - r)
+			if q > 0 {
+				p = -p
+			}
+			q = math.Abs(q)
+			if math.Abs(p) < math.Abs(q*e/2) && p > q*(a-x) && p < q*(b-x) {
+				e = d
+				d = p / q
+				if u := x + d; u-a < tol2 || b-u < tol2 {
+					d = math.Copysign(tol1, m-x)
+				}
+				golden = false
+			}
+		}
+		if golden {
+			if x >= m {
+				e = a - x
+			} else {
+				e = b - x
+			}
+			d = invPhi2 * e
+		}
+		u := x + d
+		if math.Abs(d) < tol1 {
+			u = x + math.Copysign(tol1, d)
+		}
+		fu := c.eval(u)
+		if math.IsNaN(fu) {
+			return Result{X: u, F: fu, BracketWidth: b - a, Iterations: iter + 1, FuncEvaluations: c.evals}, ErrNotFinite
+		}
+		if fu <= fx {
+			if u >= x {
+				a = x
+			} else {
+				b = x
+			}
+			v, w, x = w, x, u
+			fv, fw, fx = fw, fx, fu
+			continue
+		}
+		if u < x {
+			a = u
+		} else {
+			b = u
+		}
+		if fu <= fw || w == x {
+			v, w = w, u
+			fv, fw = fw, fu
+		} else if fu <= fv || v == x || v == w {
+			v = u
+			fv = fu
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMinimize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func(x float64) float64
+		a, b float64
+		want float64
+	}{
+		{
+			name: "Quadratic",
+			f:    func(x float64) float64 { return (x - 0.7) * (x - 0.7) },
+			a:    -3, b: 5,
+			want: 0.7,
+		},
+		{
+			name: "Cosine",
+			f:    math.Cos,
+			a:    2, b: 4,
+			want: math.Pi,
+		},
+		{
+			name: "Quartic",
+			f:    func(x float64) float64 { return x*x*x*x - 3*x*x*x + 2 },
+			a:    0, b: 10,
+			want: 2.25,
+		},
+		{
+			name: "Abs",
+			f:    func(x float64) float64 { return math.Abs(x - 1e-3) },
+			a:    -1, b: 1,
+			want: 1e-3,
+		},
+		{
+			name: "Boundary",
+			f:    math.Exp,
+			a:    -1, b: 1,
+			want: -1,
+		},
+	} {
+		for _, method := range []struct {
+			name     string
+			minimize func(f func(float64) float64, a, b float64, settings *Settings) (Result, error)
+		}{
+			{"Brent", MinimizeBrent},
+			{"GoldenSection", MinimizeGoldenSection},
+		} {
+			for _, settings := range []*Settings{nil, {Absolute: 1e-6, Relative: 1e-6}} {
+				tol := newTolerance(settings, math.Sqrt(eps))
+				r, err := method.minimize(test.f, test.a, test.b, settings)
+				if err != nil {
+					t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+					continue
+				}
+				if math.Abs(r.X-test.want) > 2*tol.at(test.want) {
+					t.Errorf("%s %s: unexpected minimizer: got %v, want %v", method.name, test.name, r.X, test.want)
+				}
+				if r.F != test.f(r.X) {
+					t.Errorf("%s %s: function value at the minimizer %v not equal to the returned value %v", method.name, test.name, test.f(r.X), r.F)
+				}
+				if r.BracketWidth > 4*tol.at(r.X) {
+					t.Errorf("%s %s: bracket too wide: %v", method.name, test.name, r.BracketWidth)
+				}
+			}
+		}
+	}
+}
+
+func TestMinimizeBrentEvaluations(t *testing.T) {
+	t.Parallel()
+	// Parabolic interpolation finds the minimum of a smooth function with
+	// far fewer evaluations than golden-section search.
+	f := func(x float64) float64 { return math.Exp(x) - 2*x }
+	brent, err := MinimizeBrent(f, -2, 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	golden, err := MinimizeGoldenSection(f, -2, 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if brent.FuncEvaluations >= golden.FuncEvaluations/2 {
+		t.Errorf("too many evaluations: got %d, golden-section search used %d", brent.FuncEvaluations, golden.FuncEvaluations)
+	}
+	if math.Abs(brent.X-math.Ln2) > 1e-7 {
+		t.Errorf("unexpected minimizer: got %v, want %v", brent.X, math.Ln2)
+	}
+}
+
+func TestMinimizeIterationLimit(t *testing.T) {
+	t.Parallel()
+	for _, minimize := range []func(f func(float64) float64, a, b float64, settings *Settings) (Result, error){MinimizeBrent, MinimizeGoldenSection} {
+		r, err := minimize(math.Cos, 2, 4, &Settings{MaxIterations: 3})
+		if err != ErrIterationLimit {
+			t.Errorf("unexpected error: got %v, want %v", err, ErrIterationLimit)
+		}
+		if r.Iterations != 3 {
+			t.Errorf("unexpected number of iterations: got %d, want 3", r.Iterations)
+		}
+	}
+}
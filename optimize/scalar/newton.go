@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import "math"
+
+// Newton finds a root of a function by Newton's method starting from x0. f
+// returns the function value and the derivative at x. Newton converges
+// quadratically close to a simple root, but it may diverge from a poor
+// starting point. The iteration stops when the length of the step is within
+// the tolerance.
+//
+// Newton returns ErrZeroDerivative if the derivative vanishes at an iterate.
+// If settings is nil, the default settings are used.
+func Newton(f func(x float64) (fx, dfx float64), x0 float64, settings *Settings) (Result, error) {
+	tol := newTolerance(settings, 4*eps)
+	x := x0
+	step := math.Inf(1)
+	for iter := 0; ; iter++ {
+		fx, dfx := f(x)
+		r := Result{X: x, F: fx, BracketWidth: math.Abs(step), Iterations: iter, FuncEvaluations: iter + 1}
+		switch {
+		case !isFinite(fx):
+			return r, ErrNotFinite
+		case fx == 0 || math.Abs(step) <= tol.at(x):
+			return r, nil
+		case iter >= tol.maxIter:
+			return r, ErrIterationLimit
+		case dfx == 0:
+			return r, ErrZeroDerivative
+		}
+		step = fx / dfx
+		x -= step
+	}
+}
+
+// Halley finds a root of a function in the interval [a, b] by Halley's
+// method safeguarded by bisection. f returns the function value and its first
+// and second derivatives at x. The iteration starts from the midpoint of the
+// interval and maintains a bracket of the root. A bisection step is taken
+// instead of the Halley step when the latter leaves the bracket or does not
+// reduce it quickly enough, so Halley converges for any continuous function
+// and cubically close to a simple root. The iteration stops when the bracket
+// or the length of the last step is within the tolerance. In the latter case
+// the reported bracket may be wide if the iterates approach the root from one
+// side. f(a) and f(b) must have opposite signs, otherwise Halley returns
+// ErrNotBracketed.
+//
+// Halley panics if a > b or if the interval is not finite. If settings is
+// nil, the default settings are used.
+func Halley(f func(x float64) (fx, dfx, d2fx float64), a, b float64, settings *Settings) (Result, error) {
+	checkInterval(a, b)
+	tol := newTolerance(settings, 4*eps)
+	var evals int
+	eval := func(x float64) (float64, float64, float64) {
+		evals++
+		return f(x)
+	}
+	fa, _, _ := eval(a)
+	fb, _, _ := eval(b)
+	if r, done, err := checkBracket(a, b, fa, fb, evals); done {
+		return r, err
+	}
+
+	x := a + (b-a)/2
+	// The previous two step lengths are used to detect slow convergence.
+	step, prev := b-a, b-a
+	for iter := 1; ; iter++ {
+		fx, dfx, d2fx := eval(x)
+		if !isFinite(fx) {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: evals}, ErrNotFinite
+		}
+		if math.Signbit(fx) == math.Signbit(fa) {
+			a, fa = x, fx
+		} else {
+			b = x
+		}
+		if fx == 0 || b-a <= 2*tol.at(x) || math.Abs(step) <= tol.at(x) {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: evals}, nil
+		}
+		if iter >= tol.maxIter {
+			return Result{X: x, F: fx, BracketWidth: b - a, Iterations: iter, FuncEvaluations: evals}, ErrIterationLimit
+		}
+
+		// Compute the Halley step, falling back to Newton's step if the
+		// denominator vanishes.
+		var next float64
+		denom := 2*dfx*dfx - fx*d2fx
+		switch {
+		case denom != 0 && isFinite(d2fx):
+			next = 2 * fx * dfx / denom
+		case dfx != 0:
+			next = fx / dfx
+		default:
+			next = math.NaN()
+		}
+		xnew := x - next
+		if !(a < xnew && xnew < b) || math.Abs(next) > math.Abs(prev)/2 {
+			// Bisect the bracket.
+			xnew = a + (b-a)/2
+			next = x - xnew
+		}
+		prev, step = step, next
+		x = xnew
+	}
+}
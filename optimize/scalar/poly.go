@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import "math"
+
+// PolynomialRoots appends the real roots of the polynomial
+//
+//	c[0] + c[1]*x + c[2]*x² + ... + c[n]*xⁿ
+//
+// in the interval [a, b] to dst in increasing order and returns the result.
+// The roots of the derivative, which are found recursively, split the
+// interval into pieces over which the polynomial is monotone, and the root in
+// each piece where the polynomial changes sign is found by Brent. A multiple
+// root is reported once. A root of even multiplicity is found when the
+// polynomial vanishes at a root of the derivative to within rounding error.
+//
+// a and b may be infinite, in which case they are replaced by a bound on the
+// magnitude of the roots. PolynomialRoots panics if a > b or if all of the
+// coefficients are zero. If settings is nil, the default settings are used.
+func PolynomialRoots(dst, c []float64, a, b float64, settings *Settings) []float64 {
+	if !(a <= b) {
+		panic("scalar: invalid interval")
+	}
+	n := len(c) - 1
+	for n >= 0 && c[n] == 0 {
+		n--
+	}
+	if n < 0 {
+		panic("scalar: zero polynomial")
+	}
+	c = c[:n+1]
+	// All roots lie within the Cauchy bound.
+	bound := 0.0
+	for _, v := range c[:n] {
+		bound = math.Max(bound, math.Abs(v/c[n]))
+	}
+	bound++
+	a = math.Max(a, -bound)
+	b = math.Min(b, bound)
+	if a > b {
+		return dst
+	}
+	return polynomialRoots(dst, c, a, b, settings)
+}
+
+// polynomialRoots appends the roots of the polynomial with coefficients c,
+// whose leading coefficient is not zero, in the finite interval [a, b] to dst.
+func polynomialRoots(dst, c []float64, a, b float64, settings *Settings) []float64 {
+	n := len(c) - 1
+	switch n {
+	case 0:
+		return dst
+	case 1:
+		if x := -c[0] / c[1]; a <= x && x <= b {
+			dst = append(dst, x)
+		}
+		return dst
+	}
+
+	deriv := make([]float64, n)
+	for i := range deriv {
+		deriv[i] = float64(i+1) * c[i+1]
+	}
+	pts := []float64{a}
+	pts = polynomialRoots(pts, deriv, a, b, settings)
+	pts = append(pts, b)
+
+	tol := newTolerance(settings, 4*eps)
+	add := func(x float64) {
+		if k := len(dst); k > 0 && math.Abs(x-dst[k-1]) <= tol.at(x) {
+			return
+		}
+		dst = append(dst, x)
+	}
+	f := func(x float64) float64 {
+		return horner(c, x)
+	}
+	vals := make([]float64, len(pts))
+	for i, x := range pts {
+		vals[i] = f(x)
+	}
+	for i, x := range pts {
+		v := vals[i]
+		critical := 0 < i && i < len(pts)-1
+		if v == 0 || (critical && math.Abs(v) <= 8*eps*hornerAbs(c, x)) {
+			add(x)
+		}
+		if i == len(pts)-1 || v == 0 || vals[i+1] == 0 || math.Signbit(v) == math.Signbit(vals[i+1]) {
+			continue
+		}
+		r, _ := Brent(f, x, pts[i+1], settings)
+		add(r.X)
+	}
+	return dst
+}
+
+// horner returns the value of the polynomial with coefficients c at x.
+func horner(c []float64, x float64) float64 {
+	var v float64
+	for i := len(c) - 1; i >= 0; i-- {
+		v = v*x + c[i]
+	}
+	return v
+}
+
+// hornerAbs returns the value of the polynomial with coefficients |c| at |x|,
+// which bounds the rounding error of horner.
+func hornerAbs(c []float64, x float64) float64 {
+	var v float64
+	x = math.Abs(x)
+	for i := len(c) - 1; i >= 0; i-- {
+		v = v*x + math.Abs(c[i])
+	}
+	return v
+}
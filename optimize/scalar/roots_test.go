@@ -0,0 +1,315 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+type rootTest struct {
+	name string
+	f    func(x float64) float64
+	df   func(x float64) float64
+	d2f  func(x float64) float64
+	a, b float64
+	want float64
+}
+
+var rootTests = []rootTest{
+	{
+		name: "Sqrt2",
+		f:    func(x float64) float64 { return x*x - 2 },
+		df:   func(x float64) float64 { return 2 * x },
+		d2f:  func(x float64) float64 { return 2 },
+		a:    0, b: 2,
+		want: math.Sqrt2,
+	},
+	{
+		name: "Cosine",
+		f:    func(x float64) float64 { return math.Cos(x) - x },
+		df:   func(x float64) float64 { return -math.Sin(x) - 1 },
+		d2f:  func(x float64) float64 { return -math.Cos(x) },
+		a:    0, b: 1,
+		want: 0.7390851332151607,
+	},
+	{
+		// The classical example of Wallis.
+		name: "Cubic",
+		f:    func(x float64) float64 { return x*x*x - 2*x - 5 },
+		df:   func(x float64) float64 { return 3*x*x - 2 },
+		d2f:  func(x float64) float64 { return 6 * x },
+		a:    2, b: 3,
+		want: 2.0945514815423265,
+	},
+	{
+		name: "TripleRoot",
+		f:    func(x float64) float64 { return (x - 1) * (x - 1) * (x - 1) },
+		df:   func(x float64) float64 { return 3 * (x - 1) * (x - 1) },
+		d2f:  func(x float64) float64 { return 6 * (x - 1) },
+		a:    -1, b: 4,
+		want: 1,
+	},
+	{
+		name: "Exponential",
+		f:    func(x float64) float64 { return math.Exp(x) - 1e4 },
+		df:   math.Exp,
+		d2f:  math.Exp,
+		a:    -10, b: 20,
+		want: math.Log(1e4),
+	},
+	{
+		name: "Steep",
+		f:    func(x float64) float64 { return math.Atan(1e3 * (x - 0.3)) },
+		df:   func(x float64) float64 { return 1e3 / (1 + 1e6*(x-0.3)*(x-0.3)) },
+		d2f: func(x float64) float64 {
+			s := 1 + 1e6*(x-0.3)*(x-0.3)
+			return -2e9 * (x - 0.3) / (s * s)
+		},
+		a: -1, b: 1,
+		want: 0.3,
+	},
+	{
+		name: "RootAtEnd",
+		f:    func(x float64) float64 { return x * (x + 1) },
+		df:   func(x float64) float64 { return 2*x + 1 },
+		d2f:  func(x float64) float64 { return 2 },
+		a:    -0.5, b: 0,
+		want: 0,
+	},
+}
+
+func TestBracketingRoots(t *testing.T) {
+	t.Parallel()
+	halley := func(f, df, d2f func(float64) float64) func(f func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+		return func(_ func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+			return Halley(func(x float64) (float64, float64, float64) {
+				return f(x), df(x), d2f(x)
+			}, a, b, settings)
+		}
+	}
+	for _, test := range rootTests {
+		for _, method := range []struct {
+			name string
+			root func(f func(float64) float64, a, b float64, settings *Settings) (Result, error)
+		}{
+			{"Brent", Brent},
+			{"Illinois", Illinois},
+			{"AndersonBjorck", AndersonBjorck},
+			{"ITP", ITP},
+			{"Halley", halley(test.f, test.df, test.d2f)},
+		} {
+			for _, settings := range []*Settings{nil, {Absolute: 1e-6}} {
+				tol := newTolerance(settings, 4*eps)
+				r, err := method.root(test.f, test.a, test.b, settings)
+				if err != nil {
+					t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+					continue
+				}
+				// The accuracy of the multiple root is limited by the
+				// rounding errors of the function.
+				want := 2 * tol.at(test.want)
+				if test.name == "TripleRoot" {
+					want = math.Max(want, 1e-5)
+				}
+				if math.Abs(r.X-test.want) > want {
+					t.Errorf("%s %s: unexpected root: got %v, want %v", method.name, test.name, r.X, test.want)
+				}
+				if r.F != test.f(r.X) {
+					t.Errorf("%s %s: function value at the root %v not equal to the returned value %v", method.name, test.name, test.f(r.X), r.F)
+				}
+				// ITP uses the tolerance at the end of the initial interval
+				// and may stop with a slightly wider bracket due to rounding.
+				// Halley stops when the step is small, and the bracket may
+				// remain wide when the iterates approach the root from one
+				// side.
+				width := 2 * tol.at(r.X)
+				if method.name == "ITP" {
+					width = 2.01 * tol.at(math.Max(math.Abs(test.a), math.Abs(test.b)))
+				}
+				if r.F != 0 && method.name != "Halley" && r.BracketWidth > width {
+					t.Errorf("%s %s: bracket too wide: %v", method.name, test.name, r.BracketWidth)
+				}
+				if r.FuncEvaluations < r.Iterations {
+					t.Errorf("%s %s: fewer evaluations %d than iterations %d", method.name, test.name, r.FuncEvaluations, r.Iterations)
+				}
+			}
+		}
+	}
+}
+
+func TestBracketingRootErrors(t *testing.T) {
+	t.Parallel()
+	f := func(x float64) float64 { return x*x + 1 }
+	fh := func(x float64) (float64, float64, float64) { return f(x), 2 * x, 2 }
+	cbrt := func(x float64) (float64, float64, float64) {
+		c := math.Cbrt(x)
+		return c, 1 / (3 * c * c), -2 / (9 * c * c * c * c * c)
+	}
+	for _, method := range []struct {
+		name string
+		root func(f func(float64) float64, a, b float64, settings *Settings) (Result, error)
+	}{
+		{"Brent", Brent},
+		{"Illinois", Illinois},
+		{"AndersonBjorck", AndersonBjorck},
+		{"ITP", ITP},
+		{"Halley", func(g func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+			if g(0) == 0 {
+				return Halley(cbrt, a, b, settings)
+			}
+			return Halley(fh, a, b, settings)
+		}},
+	} {
+		_, err := method.root(f, -1, 2, nil)
+		if err != ErrNotBracketed {
+			t.Errorf("%s: unexpected error: got %v, want %v", method.name, err, ErrNotBracketed)
+		}
+		r, err := method.root(math.Cbrt, -1, 2, &Settings{MaxIterations: 2})
+		if err != ErrIterationLimit {
+			t.Errorf("%s: unexpected error with iteration limit: got %v, want %v", method.name, err, ErrIterationLimit)
+		}
+		if r.Iterations != 2 {
+			t.Errorf("%s: unexpected number of iterations: got %d, want 2", method.name, r.Iterations)
+		}
+	}
+}
+
+func TestITPEvaluations(t *testing.T) {
+	t.Parallel()
+	// ITP requires at most one evaluation more than bisection, even for a
+	// function that defeats interpolation.
+	f := func(x float64) float64 {
+		if x < 1.0/3 {
+			return -1
+		}
+		return 1e-12 + x*x*x*x*x*x*x*x*x*x
+	}
+	settings := &Settings{Absolute: 1e-10}
+	r, err := ITP(f, 0, 1, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bisection := int(math.Ceil(math.Log2(1 / (2 * newTolerance(settings, 4*eps).at(1)))))
+	if r.Iterations > bisection+1 {
+		t.Errorf("too many iterations: got %d, want at most %d", r.Iterations, bisection+1)
+	}
+	if math.Abs(r.X-1.0/3) > 2e-10 {
+		t.Errorf("unexpected root: got %v, want %v", r.X, 1.0/3)
+	}
+}
+
+func TestNewton(t *testing.T) {
+	t.Parallel()
+	for _, test := range rootTests {
+		if test.name == "Steep" {
+			// Newton diverges from the midpoint of the interval.
+			continue
+		}
+		f := func(x float64) (float64, float64) { return test.f(x), test.df(x) }
+		r, err := Newton(f, test.b, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		tol := 1e-12 * math.Max(1, math.Abs(test.want))
+		if test.name == "TripleRoot" {
+			tol = 1e-5
+		}
+		if math.Abs(r.X-test.want) > tol {
+			t.Errorf("%s: unexpected root: got %v, want %v", test.name, r.X, test.want)
+		}
+		if r.FuncEvaluations != r.Iterations+1 {
+			t.Errorf("%s: unexpected number of evaluations: got %d, want %d", test.name, r.FuncEvaluations, r.Iterations+1)
+		}
+	}
+
+	_, err := Newton(func(x float64) (float64, float64) { return x*x + 1, 2 * x }, 0, nil)
+	if err != ErrZeroDerivative {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrZeroDerivative)
+	}
+}
+
+func TestHalleySafeguard(t *testing.T) {
+	t.Parallel()
+	// Halley's method alone cycles for this function from x = 0.
+	f := func(x float64) (float64, float64, float64) {
+		return math.Atan(x - 1), 1 / (1 + (x-1)*(x-1)), -2 * (x - 1) / ((1 + (x-1)*(x-1)) * (1 + (x-1)*(x-1)))
+	}
+	r, err := Halley(f, -20, 20, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(r.X-1) > 1e-12 {
+		t.Errorf("unexpected root: got %v, want 1", r.X)
+	}
+}
+
+func TestPolynomialRoots(t *testing.T) {
+	t.Parallel()
+	chebyshev5 := make([]float64, 0, 5)
+	for k := 5; k >= 1; k-- {
+		chebyshev5 = append(chebyshev5, math.Cos(float64(2*k-1)*math.Pi/10))
+	}
+	for _, test := range []struct {
+		name string
+		c    []float64
+		a, b float64
+		want []float64
+	}{
+		{
+			name: "Cubic",
+			// (x-1)(x-2)(x+3)
+			c: []float64{6, -7, 0, 1},
+			a: math.Inf(-1), b: math.Inf(1),
+			want: []float64{-3, 1, 2},
+		},
+		{
+			name: "CubicInterval",
+			c:    []float64{6, -7, 0, 1},
+			a:    0, b: 1.5,
+			want: []float64{1},
+		},
+		{
+			name: "DoubleRoot",
+			// (x-1)²(x+2)
+			c: []float64{2, -3, 0, 1},
+			a: -10, b: 10,
+			want: []float64{-2, 1},
+		},
+		{
+			name: "NoRealRoots",
+			c:    []float64{1, 0, 1},
+			a:    math.Inf(-1), b: math.Inf(1),
+			want: nil,
+		},
+		{
+			name: "Chebyshev",
+			// T₅(x) = 16x⁵ - 20x³ + 5x
+			c: []float64{0, 5, 0, -20, 0, 16},
+			a: -1, b: 1,
+			want: chebyshev5,
+		},
+		{
+			name: "Linear",
+			c:    []float64{-3, 2, 0, 0},
+			a:    math.Inf(-1), b: math.Inf(1),
+			want: []float64{1.5},
+		},
+		{
+			name: "Constant",
+			c:    []float64{2},
+			a:    -1, b: 1,
+			want: nil,
+		},
+	} {
+		got := PolynomialRoots(nil, test.c, test.a, test.b, nil)
+		if len(got) != len(test.want) || !floats.EqualApprox(got, test.want, 1e-7) {
+			t.Errorf("%s: unexpected roots: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scalar
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	defaultAbsolute      = 1e-12
+	defaultMaxIterations = 200
+
+	// eps is the machine epsilon for float64.
+	eps = 0x1p-52
+)
+
+var (
+	// ErrNotBracketed is returned by the bracketing root finders when the
+	// function does not change sign over the interval.
+	ErrNotBracketed = errors.New("scalar: root not bracketed")
+	// ErrIterationLimit is returned when the tolerance has not been reached
+	// within the maximum number of iterations. The returned Result then
+	// holds the best estimate found.
+	ErrIterationLimit = errors.New("scalar: iteration limit reached")
+	// ErrZeroDerivative is returned by Newton when the derivative vanishes.
+	ErrZeroDerivative = errors.New("scalar: zero derivative")
+	// ErrNotFinite is returned when the function value is infinite or NaN.
+	ErrNotFinite = errors.New("scalar: function value is not finite")
+)
+
+// Settings holds the termination criteria of the root finders and
+// minimizers. A nil *Settings is equivalent to the zero value, see the field
+// comments for the default values.
+type Settings struct {
+	// Absolute and Relative are the absolute and relative tolerances on the
+	// location of the root or the minimum, which is found within
+	//  Absolute + Relative*|x|.
+	// If Absolute is zero, it is defaulted to 1e-12. If Relative is zero,
+	// it is defaulted to 4ε for root finding and to √ε for minimization,
+	// where ε is the machine epsilon, as the attainable accuracy is limited
+	// by these values.
+	Absolute, Relative float64
+
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, it is defaulted to 200.
+	MaxIterations int
+}
+
+// Result holds the result of a root finder or a minimizer.
+type Result struct {
+	// X is the location of the root or the minimum.
+	X float64
+	// F is the function value at X.
+	F float64
+	// BracketWidth is the width of the final interval known to contain the
+	// root or the minimum. For Newton, which does not maintain a bracket,
+	// BracketWidth is the length of the last step.
+	BracketWidth float64
+	// Iterations is the number of iterations performed.
+	Iterations int
+	// FuncEvaluations is the number of function evaluations.
+	FuncEvaluations int
+}
+
+// tolerance holds the termination criteria with the defaults applied.
+type tolerance struct {
+	abs, rel float64
+	maxIter  int
+}
+
+// newTolerance returns the termination criteria of settings using rel as the
+// default relative tolerance.
+func newTolerance(settings *Settings, rel float64) tolerance {
+	t := tolerance{abs: defaultAbsolute, rel: rel, maxIter: defaultMaxIterations}
+	if settings == nil {
+		return t
+	}
+	if settings.Absolute != 0 {
+		t.abs = settings.Absolute
+	}
+	if settings.Relative != 0 {
+		t.rel = settings.Relative
+	}
+	if settings.MaxIterations != 0 {
+		t.maxIter = settings.MaxIterations
+	}
+	if t.abs < 0 || t.rel < 0 || t.maxIter < 0 {
+		panic("scalar: negative setting")
+	}
+	return t
+}
+
+// at returns the tolerance on the location x.
+func (t tolerance) at(x float64) float64 {
+	return t.abs + t.rel*math.Abs(x)
+}
+
+// counter counts the evaluations of a function.
+type counter struct {
+	f     func(float64) float64
+	evals int
+}
+
+func (c *counter) eval(x float64) float64 {
+	c.evals++
+	return c.f(x)
+}
+
+// isFinite returns whether v is neither infinite nor NaN.
+func isFinite(v float64) bool {
+	return !math.IsInf(v, 0) && !math.IsNaN(v)
+}
+
+// checkInterval panics if the interval [a, b] is not valid.
+func checkInterval(a, b float64) {
+	if !(a <= b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		panic("scalar: invalid interval")
+	}
+}
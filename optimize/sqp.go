@@ -0,0 +1,376 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const defaultSQPTolerance = 1e-8
+
+var (
+	_ Method             = (*SQP)(nil)
+	_ Statuser           = (*SQP)(nil)
+	_ constrainedMethod  = (*SQP)(nil)
+	_ feasibilityChecker = (*SQP)(nil)
+)
+
+// SQP is a sequential quadratic programming method for smooth constrained
+// optimization problems. At each iteration it minimizes a quadratic model of
+// the Lagrangian subject to the linearized constraints,
+//
+//	minimize    ½ dᵀ B d + ∇f(x)ᵀ d
+//	subject to  c_i(x) + ∇c_i(x)ᵀ d = 0,  for equality constraints,
+//	            c_i(x) + ∇c_i(x)ᵀ d ≥ 0,  for inequality constraints,
+//
+// where B is a damped BFGS approximation to the Hessian of the Lagrangian.
+// The quadratic subproblem is solved by a dual active-set method, and its
+// multipliers are the new estimates of the Lagrange multipliers. The step
+// along d is determined by a line search on the ℓ₁ merit function
+//
+//	f(x) + ρ (Σ |c_i(x)| + Σ max(0, -c_i(x))),
+//
+// where the penalty parameter ρ is increased as necessary for d to be a
+// descent direction. If the linearized constraints are inconsistent, SQP
+// takes a least-squares step towards feasibility instead.
+//
+// SQP requires the gradient of the objective function and the Jacobian of the
+// constraints.
+//
+// References:
+//   - Nocedal, J., & Wright, S. J. (2006). Numerical Optimization (2nd ed).
+//     Springer. Chapter 18.
+type SQP struct {
+	// Linesearcher performs the line search on the merit function. If
+	// Linesearcher is nil, a reasonable default will be chosen.
+	Linesearcher Linesearcher
+	// Tolerance is the tolerance on the KKT residuals for the convergence of
+	// SQP. If Tolerance is zero, a default value of 1e-8 is used.
+	Tolerance float64
+
+	constrainedProjector
+
+	neq     int          // Number of equality constraints.
+	consOps Operation    // Evaluation operations for the constraints.
+	ls      Linesearcher // Line search on the merit function.
+	tol     float64      // Tolerance on the KKT residuals.
+
+	status Status
+	err    error
+
+	x      []float64 // Current iterate.
+	f      float64
+	grad   []float64
+	cons   []float64
+	jac    mat.Dense
+	b      *mat.SymDense // Approximation to the Hessian of the Lagrangian.
+	d      []float64     // Search direction.
+	lambda []float64     // Lagrange multiplier estimates.
+	rho    float64       // Penalty parameter of the merit function.
+	qp     dualQP
+}
+
+func (s *SQP) Status() (Status, error) {
+	return s.status, s.err
+}
+
+func (*SQP) Uses(has Available) (uses Available, err error) {
+	if !has.Grad {
+		return Available{}, ErrMissingGrad
+	}
+	if has.Constraints && !has.Jacobian {
+		return Available{}, ErrMissingJacobian
+	}
+	return Available{Grad: true, Constraints: has.Constraints, Jacobian: has.Constraints}, nil
+}
+
+func (s *SQP) initConstraints(eq, ineq int) {
+	s.neq = eq
+	s.consOps = NoOperation
+	if eq+ineq > 0 {
+		s.consOps = ConstraintEvaluation | JacobianEvaluation
+	}
+}
+
+func (s *SQP) feasible(loc *Location) bool {
+	return maxViolation(loc.Constraints, s.neq) <= s.tol
+}
+
+func (s *SQP) Init(dim, tasks int) int {
+	s.status = NotTerminated
+	s.err = nil
+	s.ls = s.Linesearcher
+	if s.ls == nil {
+		s.ls = &Backtracking{}
+	}
+	s.tol = s.Tolerance
+	if s.tol == 0 {
+		s.tol = defaultSQPTolerance
+	}
+	s.x = resize(s.x, dim)
+	s.grad = resize(s.grad, dim)
+	s.d = resize(s.d, dim)
+	s.b = resizeSymDense(s.b, dim)
+	s.rho = 0
+	return 1
+}
+
+func (s *SQP) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	s.status, s.err = s.run(&syncTasker{operation: operation, result: result, task: tasks[0]})
+	close(operation)
+}
+
+func (s *SQP) run(t *syncTasker) (Status, error) {
+	loc := t.task.Location
+	if !t.do((FuncEvaluation | GradEvaluation | s.consOps) &^ t.task.Op) {
+		t.finish()
+		return NotTerminated, nil
+	}
+	if math.IsInf(loc.F, 1) || math.IsNaN(loc.F) {
+		t.finishMethodDone()
+		return Failure, ErrFunc(loc.F)
+	}
+	for i, v := range loc.Gradient {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			t.finishMethodDone()
+			return Failure, ErrGrad{Grad: v, Index: i}
+		}
+	}
+	s.store(loc)
+	s.lambda = resize(s.lambda, len(s.cons))
+	s.resetHessian()
+
+	dim := len(s.x)
+	lambdaQP := make([]float64, len(s.cons))
+	negCons := make([]float64, len(s.cons))
+	gradL := make([]float64, dim)
+	sk := make([]float64, dim)
+	yk := make([]float64, dim)
+	first := true
+	var kkt KKTResidual
+	for {
+		// Solve the quadratic subproblem for the search direction and the new
+		// estimates of the Lagrange multipliers.
+		floats.ScaleTo(negCons, -1, s.cons)
+		err := s.qp.solve(s.d, lambdaQP, s.b, s.grad, &s.jac, negCons, s.neq)
+		if err != nil {
+			err = s.restorationStep()
+			if err != nil {
+				t.finishMethodDone()
+				return Failure, err
+			}
+		} else {
+			copy(s.lambda, lambdaQP)
+		}
+
+		loc.Multipliers = s.lambda
+		if !t.do(MajorIteration) {
+			t.finish()
+			return NotTerminated, nil
+		}
+		kkt.compute(loc, s.neq)
+		if kkt.max() <= s.tol {
+			t.finishMethodDone()
+			return MethodConverge, nil
+		}
+
+		// Update the penalty parameter so that the search direction is a
+		// descent direction for the merit function.
+		viol := sumViolation(s.cons, s.neq)
+		rho := floats.Norm(s.lambda, math.Inf(1))
+		if viol > 0 {
+			var bd mat.VecDense
+			bd.MulVec(s.b, mat.NewVecDense(dim, s.d))
+			rho = math.Max(rho, (floats.Dot(s.grad, s.d)+0.5*floats.Dot(s.d, bd.RawVector().Data))/(0.5*viol))
+		}
+		if s.rho < rho {
+			s.rho = 1.1 * rho
+		}
+		merit := s.f + s.rho*viol
+		deriv := meritDerivative(s.grad, s.cons, &s.jac, s.d, s.rho, s.neq)
+		if deriv >= 0 || floats.Norm(s.d, math.Inf(1)) <= machEps*(1+floats.Norm(s.x, math.Inf(1))) {
+			if first {
+				t.finishMethodDone()
+				if deriv >= 0 {
+					return Failure, ErrNonDescentDirection
+				}
+				return Failure, ErrNoProgress
+			}
+			// Restart from the identity Hessian approximation.
+			s.resetHessian()
+			first = true
+			continue
+		}
+
+		// Perform the line search on the merit function.
+		op := s.ls.Init(merit, deriv, 1)
+		step := 1.0
+		for {
+			floats.AddScaledTo(loc.X, s.x, step, s.d)
+			eval := FuncEvaluation | s.consOps&ConstraintEvaluation
+			if op&GradEvaluation != 0 {
+				eval |= GradEvaluation | s.consOps
+			}
+			if !t.do(eval) {
+				t.finish()
+				return NotTerminated, nil
+			}
+			merit := loc.F + s.rho*sumViolation(loc.Constraints, s.neq)
+			var deriv float64
+			if op&GradEvaluation != 0 {
+				deriv = meritDerivative(loc.Gradient, loc.Constraints, loc.Jacobian, s.d, s.rho, s.neq)
+			}
+			op, step, err = s.ls.Iterate(merit, deriv)
+			if err != nil || op == MajorIteration {
+				if op == MajorIteration && eval&GradEvaluation == 0 {
+					if !t.do(GradEvaluation | s.consOps&JacobianEvaluation) {
+						t.finish()
+						return NotTerminated, nil
+					}
+				}
+				break
+			}
+		}
+		if err != nil {
+			s.restore(loc)
+			if first {
+				t.finishMethodDone()
+				return Failure, err
+			}
+			s.resetHessian()
+			first = true
+			continue
+		}
+
+		// Update the approximation to the Hessian of the Lagrangian using
+		// the change in its gradient with fixed multipliers.
+		floats.SubTo(sk, loc.X, s.x)
+		lagrangianGradient(yk, loc.Gradient, loc.Jacobian, s.lambda)
+		lagrangianGradient(gradL, s.grad, &s.jac, s.lambda)
+		floats.Sub(yk, gradL)
+		if first {
+			if sy := floats.Dot(sk, yk); sy > 0 {
+				s.b.ScaleSym(floats.Dot(yk, yk)/sy, s.b)
+			}
+			first = false
+		}
+		dampedBFGSUpdate(s.b, sk, yk)
+		s.store(loc)
+	}
+}
+
+// store stores the values at loc as the current iterate.
+func (s *SQP) store(loc *Location) {
+	copy(s.x, loc.X)
+	s.f = loc.F
+	copy(s.grad, loc.Gradient)
+	s.cons = resize(s.cons, len(loc.Constraints))
+	copy(s.cons, loc.Constraints)
+	if loc.Jacobian != nil {
+		s.jac.CloneFrom(loc.Jacobian)
+	}
+}
+
+// restore restores the values of the current iterate into loc.
+func (s *SQP) restore(loc *Location) {
+	copy(loc.X, s.x)
+	loc.F = s.f
+	copy(loc.Gradient, s.grad)
+	copy(loc.Constraints, s.cons)
+	if loc.Jacobian != nil {
+		loc.Jacobian.Copy(&s.jac)
+	}
+}
+
+// resetHessian sets the approximation to the Hessian of the Lagrangian to
+// the identity matrix.
+func (s *SQP) resetHessian() {
+	dim := len(s.x)
+	for i := 0; i < dim; i++ {
+		for j := i; j < dim; j++ {
+			s.b.SetSym(i, j, 0)
+		}
+		s.b.SetSym(i, i, 1)
+	}
+}
+
+// restorationStep computes a search direction that reduces the violation of
+// the linearized constraints in the least-squares sense. It is used when the
+// quadratic subproblem is infeasible.
+func (s *SQP) restorationStep() error {
+	var rows []int
+	for i, c := range s.cons {
+		if i < s.neq || c < 0 {
+			rows = append(rows, i)
+		}
+	}
+	if len(rows) == 0 {
+		return errQPInfeasible
+	}
+	dim := len(s.x)
+	a := mat.NewDense(len(rows), dim, nil)
+	rhs := mat.NewVecDense(len(rows), nil)
+	for k, i := range rows {
+		a.SetRow(k, s.jac.RawRowView(i))
+		rhs.SetVec(k, -s.cons[i])
+	}
+	d := mat.NewVecDense(dim, s.d)
+	err := d.SolveVec(a, rhs)
+	if err != nil {
+		return errQPInfeasible
+	}
+	return nil
+}
+
+// meritDerivative returns the directional derivative along d of the ℓ₁
+// merit function with penalty parameter rho at a location with the given
+// gradient, constraint values and Jacobian.
+func meritDerivative(grad, cons []float64, jac *mat.Dense, d []float64, rho float64, neq int) float64 {
+	deriv := floats.Dot(grad, d)
+	for i, c := range cons {
+		jd := floats.Dot(jac.RawRowView(i), d)
+		switch {
+		case i < neq && c > 0:
+			deriv += rho * jd
+		case i < neq && c < 0:
+			deriv -= rho * jd
+		case i < neq:
+			deriv += rho * math.Abs(jd)
+		case c < 0:
+			deriv -= rho * jd
+		case c == 0:
+			deriv += rho * math.Max(0, -jd)
+		}
+	}
+	return deriv
+}
+
+// dampedBFGSUpdate performs Powell's damped BFGS update of b with the step s
+// and the change in gradient y. The damping keeps b positive definite when
+// sᵀy is small or negative.
+func dampedBFGSUpdate(b *mat.SymDense, s, y []float64) {
+	dim := len(s)
+	sv := mat.NewVecDense(dim, s)
+	var bs mat.VecDense
+	bs.MulVec(b, sv)
+	sbs := mat.Dot(sv, &bs)
+	if sbs <= 0 {
+		return
+	}
+	sy := floats.Dot(s, y)
+	theta := 1.0
+	if sy < 0.2*sbs {
+		theta = 0.8 * sbs / (sbs - sy)
+	}
+	r := make([]float64, dim)
+	floats.ScaleTo(r, theta, y)
+	floats.AddScaled(r, 1-theta, bs.RawVector().Data)
+	sr := floats.Dot(s, r)
+	b.SymRankOne(b, -1/sbs, &bs)
+	b.SymRankOne(b, 1/sr, mat.NewVecDense(dim, r))
+}
@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method      = (*SteihaugCG)(nil)
+	_ localMethod = (*SteihaugCG)(nil)
+)
+
+// SteihaugCG implements the Steihaug-Toint truncated conjugate gradient
+// trust-region method for unconstrained minimization.
+//
+// At each iteration SteihaugCG approximately minimizes the quadratic model
+//
+//	m(p) = f_k + ∇f_kᵀp + ½pᵀH_kp
+//
+// within the trust region |p| ≤ Δ_k by the conjugate gradient method started
+// from p = 0. The iteration is terminated when the residual is sufficiently
+// small, when the iterate leaves the trust region, or when a direction of
+// negative curvature is found; in the last two cases the step is moved to the
+// boundary of the trust region. Steps are accepted and the radius Δ_k is
+// updated as in Dogleg. If the radius shrinks until it is negligible relative
+// to the current location, SteihaugCG returns ErrTrustRegionCollapse.
+//
+// The conjugate gradient method only needs products of the Hessian with
+// vectors. If the Hessian is available it is used to form the products,
+// otherwise they are approximated by finite differences of the gradient at
+// the cost of one gradient evaluation per product, and the Hessian is never
+// formed. SteihaugCG is therefore suitable for large problems.
+//
+// See Algorithm 7.2 in Nocedal, Wright (2006), 2nd edition.
+type SteihaugCG struct {
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is zero, it is defaulted to 1.
+	InitialRadius float64
+	// MaxRadius is the largest allowed radius of the trust region. If
+	// MaxRadius is zero, the radius is not bounded.
+	MaxRadius float64
+	// AcceptRatio is the smallest ratio between the actual and the predicted
+	// reduction for which a step is accepted. AcceptRatio must be in
+	// [0, 1/4). If AcceptRatio is zero, it is defaulted to 0.1.
+	AcceptRatio float64
+	// MaxIterations is the largest number of conjugate gradient iterations
+	// per step. If MaxIterations is zero, it is defaulted to three times the
+	// dimension of the problem to allow for the loss of conjugacy in
+	// floating-point arithmetic.
+	MaxIterations int
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	useHess bool
+	tr      trustRegion
+
+	// State of the conjugate gradient iteration.
+	z, r, d []float64
+	radius  float64
+	tol     float64
+	rr      float64 // Squared norm of the residual r.
+	m       float64 // Value of the model at z.
+	iter    int
+	maxIter int
+}
+
+func (s *SteihaugCG) Status() (Status, error) {
+	return s.status, s.err
+}
+
+func (s *SteihaugCG) Uses(has Available) (uses Available, err error) {
+	if has.Hess {
+		uses, err = has.hessian()
+	} else {
+		uses, err = has.gradient()
+	}
+	s.useHess = uses.Hess
+	return uses, err
+}
+
+func (s *SteihaugCG) Init(dim, tasks int) int {
+	s.status = NotTerminated
+	s.err = nil
+	return 1
+}
+
+func (s *SteihaugCG) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	s.status, s.err = localOptimizer{}.run(s, s.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+// TrustRegionStats returns the statistics of the current optimization run.
+func (s *SteihaugCG) TrustRegionStats() TrustRegionStats {
+	return s.tr.stats
+}
+
+func (s *SteihaugCG) initLocal(loc *Location) (Operation, error) {
+	if s.MaxIterations < 0 {
+		panic("optimize: negative SteihaugCG.MaxIterations")
+	}
+	dim := len(loc.X)
+	s.maxIter = s.MaxIterations
+	if s.maxIter == 0 {
+		s.maxIter = 3 * dim
+	}
+	s.r = resize(s.r, dim)
+	s.d = resize(s.d, dim)
+	s.tr.solver = s
+	s.tr.useHess = s.useHess && loc.Hessian != nil
+	return s.tr.init(loc, s.InitialRadius, s.MaxRadius, s.AcceptRatio)
+}
+
+func (s *SteihaugCG) iterateLocal(loc *Location) (Operation, error) {
+	return s.tr.iterate(loc)
+}
+
+func (s *SteihaugCG) start(p, g []float64, _ *mat.SymDense, radius float64) ([]float64, float64) {
+	s.z = p
+	for i := range s.z {
+		s.z[i] = 0
+	}
+	copy(s.r, g)
+	floats.ScaleTo(s.d, -1, g)
+	s.radius = radius
+	s.rr = floats.Dot(g, g)
+	gnorm := math.Sqrt(s.rr)
+	// Use a forcing sequence that gives superlinear convergence.
+	s.tol = math.Min(0.5, math.Sqrt(gnorm)) * gnorm
+	s.m = 0
+	s.iter = 0
+	if gnorm == 0 {
+		return nil, 0
+	}
+	return s.d, 0
+}
+
+func (s *SteihaugCG) next(bd []float64) ([]float64, float64) {
+	s.iter++
+	dBd := floats.Dot(s.d, bd)
+	rd := floats.Dot(s.r, s.d)
+	if dBd <= 0 {
+		// Negative curvature. Move to the boundary point along d with the
+		// lower value of the model.
+		tau1, tau2 := boundaryStep(s.z, s.d, s.radius)
+		m1 := s.m + tau1*rd + 0.5*tau1*tau1*dBd
+		m2 := s.m + tau2*rd + 0.5*tau2*tau2*dBd
+		if m1 < m2 {
+			return s.boundary(tau1, m1)
+		}
+		return s.boundary(tau2, m2)
+	}
+	alpha := s.rr / dBd
+	floats.AddScaled(s.z, alpha, s.d)
+	if floats.Norm(s.z, 2) >= s.radius {
+		floats.AddScaled(s.z, -alpha, s.d)
+		_, tau := boundaryStep(s.z, s.d, s.radius)
+		return s.boundary(tau, s.m+tau*rd+0.5*tau*tau*dBd)
+	}
+	s.m += alpha*rd + 0.5*alpha*alpha*dBd
+	floats.AddScaled(s.r, alpha, bd)
+	rr := floats.Dot(s.r, s.r)
+	if math.Sqrt(rr) < s.tol || s.iter >= s.maxIter {
+		return nil, s.m
+	}
+	beta := rr / s.rr
+	s.rr = rr
+	for i, v := range s.r {
+		s.d[i] = -v + beta*s.d[i]
+	}
+	return s.d, 0
+}
+
+// boundary moves z to z + τd and returns the model value m.
+func (s *SteihaugCG) boundary(tau, m float64) ([]float64, float64) {
+	floats.AddScaled(s.z, tau, s.d)
+	s.m = m
+	return nil, m
+}
+
+func (s *SteihaugCG) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, s.useHess}
+}
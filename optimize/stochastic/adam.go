@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*Adam)(nil)
+
+// Adam implements the adaptive moment estimation method of Kingma and Ba.
+// Adam keeps exponential moving averages of the mini-batch gradients g_k and
+// their squares,
+//
+//	m_{k+1} = β_1 m_k + (1-β_1) g_k,
+//	v_{k+1} = β_2 v_k + (1-β_2) g_k²,
+//
+// and updates the location by
+//
+//	x_{k+1} = x_k - η_k (m̂_{k+1} / (√v̂_{k+1} + ε) + λ x_k),
+//
+// where m̂ and v̂ are the bias-corrected averages, η_k is the step size and
+// λ is the weight decay. A non-zero weight decay gives the AdamW method of
+// Loshchilov and Hutter, where the decay is decoupled from the gradient.
+//
+// References:
+//   - Kingma, D.P., Ba, J. (2015). Adam: a method for stochastic
+//     optimization. 3rd International Conference on Learning
+//     Representations.
+//   - Loshchilov, I., Hutter, F. (2019). Decoupled weight decay
+//     regularization. 7th International Conference on Learning
+//     Representations.
+type Adam struct {
+	// StepSizer determines the step size η_k of every update. It is called
+	// with the mini-batch gradient and the update direction. If StepSizer is
+	// nil, a constant step size of 0.001 is used.
+	StepSizer optimize.StepSizer
+	// Beta1 is the decay rate β_1 of the average of the gradients. If Beta1
+	// is zero, it is defaulted to 0.9. Beta1 must be in (0, 1).
+	Beta1 float64
+	// Beta2 is the decay rate β_2 of the average of the squared gradients.
+	// If Beta2 is zero, it is defaulted to 0.999. Beta2 must be in (0, 1).
+	Beta2 float64
+	// Epsilon is the constant ε that prevents division by zero. If Epsilon
+	// is zero, it is defaulted to 1e-8.
+	Epsilon float64
+	// WeightDecay is the decoupled weight decay λ. WeightDecay must not be
+	// negative. If WeightDecay is zero, no weight decay is used.
+	WeightDecay float64
+
+	beta1, beta2 float64
+	eps          float64
+	pow1, pow2   float64 // β_1^k and β_2^k.
+
+	step stepper
+	grad []float64
+	m, v []float64
+	dir  []float64
+}
+
+func (a *Adam) init(dim int) {
+	a.beta1 = a.Beta1
+	if a.beta1 == 0 {
+		a.beta1 = 0.9
+	}
+	a.beta2 = a.Beta2
+	if a.beta2 == 0 {
+		a.beta2 = 0.999
+	}
+	if a.beta1 < 0 || a.beta1 >= 1 || a.beta2 < 0 || a.beta2 >= 1 {
+		panic("stochastic: Adam decay rate out of range")
+	}
+	a.eps = a.Epsilon
+	if a.eps == 0 {
+		a.eps = 1e-8
+	}
+	if a.WeightDecay < 0 {
+		panic("stochastic: negative weight decay")
+	}
+	a.pow1, a.pow2 = 1, 1
+	a.step.init(a.StepSizer, 0.001)
+	a.grad = resize(a.grad, dim)
+	a.m = resize(a.m, dim)
+	a.v = resize(a.v, dim)
+	a.dir = resize(a.dir, dim)
+	for i := range a.m {
+		a.m[i] = 0
+		a.v[i] = 0
+	}
+}
+
+func (*Adam) epoch(*state) (optimize.Status, error) {
+	return optimize.NotTerminated, nil
+}
+
+func (a *Adam) iterate(s *state, batch []int) (optimize.Status, error) {
+	s.grad(a.grad, s.x, batch)
+	a.pow1 *= a.beta1
+	a.pow2 *= a.beta2
+	c1 := 1 - a.pow1
+	c2 := 1 - a.pow2
+	for i, g := range a.grad {
+		a.m[i] = a.beta1*a.m[i] + (1-a.beta1)*g
+		a.v[i] = a.beta2*a.v[i] + (1-a.beta2)*g*g
+		a.dir[i] = -(a.m[i] / c1) / (math.Sqrt(a.v[i]/c2) + a.eps)
+	}
+	eta := a.step.size(s.x, a.grad, a.dir)
+	for i, d := range a.dir {
+		s.x[i] += eta * (d - a.WeightDecay*s.x[i])
+	}
+	return optimize.NotTerminated, nil
+}
@@ -0,0 +1,12 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stochastic implements stochastic first-order methods for
+// minimizing finite-sum objectives
+//
+//	f(x) = 1/N Σ_i f_i(x),
+//
+// such as the empirical risk of a model fitted to N observations, using
+// gradients estimated on randomly sampled mini-batches of the terms.
+package stochastic // import "gonum.org/v1/gonum/optimize/stochastic"
@@ -0,0 +1,78 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/optimize/stochastic"
+)
+
+func ExampleMinimize() {
+	// Generate observations of a logistic regression model
+	//  P(y = 1 | a) = 1 / (1 + exp(-aᵀw))
+	// with an intercept and two features.
+	rnd := rand.New(rand.NewPCG(1, 1))
+	w := []float64{-0.5, 2, -1}
+	const n = 10000
+	a := make([][]float64, n)
+	y := make([]float64, n)
+	for i := range a {
+		a[i] = []float64{1, rnd.NormFloat64(), rnd.NormFloat64()}
+		if rnd.Float64() < 1/(1+math.Exp(-floats.Dot(a[i], w))) {
+			y[i] = 1
+		}
+	}
+
+	// Fit the coefficients by minimizing the mean negative log-likelihood
+	// of the observations.
+	p := stochastic.Problem{
+		N: n,
+		Func: func(x []float64, batch []int) float64 {
+			var f float64
+			for _, i := range batch {
+				z := floats.Dot(a[i], x)
+				f += math.Log1p(math.Exp(z)) - y[i]*z
+			}
+			return f / float64(len(batch))
+		},
+		Grad: func(grad, x []float64, batch []int) {
+			for j := range grad {
+				grad[j] = 0
+			}
+			for _, i := range batch {
+				r := 1/(1+math.Exp(-floats.Dot(a[i], x))) - y[i]
+				floats.AddScaled(grad, r, a[i])
+			}
+			floats.Scale(1/float64(len(batch)), grad)
+		},
+	}
+	settings := &stochastic.Settings{
+		Epochs:    20,
+		BatchSize: 64,
+		Src:       rand.NewPCG(1, 2),
+	}
+	method := &stochastic.Adam{
+		StepSizer: &stochastic.CosineDecay{Initial: 0.05, Minimum: 0.001, Period: 20 * n / 64},
+	}
+	res, err := stochastic.Minimize(p, make([]float64, 3), settings, method)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("status: %v\n", res.Status)
+	fmt.Printf("updates: %d\n", res.Iterations)
+	fmt.Printf("coefficients: %.1f\n", res.X)
+	fmt.Printf("negative log-likelihood: %.3f\n", res.F)
+
+	// Output:
+	// status: IterationLimit
+	// updates: 3140
+	// coefficients: [-0.5 1.9 -1.0]
+	// negative log-likelihood: 0.433
+}
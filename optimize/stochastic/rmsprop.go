@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*RMSProp)(nil)
+
+// RMSProp implements the RMSProp method of Tieleman and Hinton, which scales
+// the mini-batch gradient g_k by a moving average of its root mean square,
+//
+//	v_{k+1} = ρ v_k + (1-ρ) g_k²,
+//	b_{k+1} = μ b_k + g_k / (√v_{k+1} + ε),
+//	x_{k+1} = x_k - η_k b_{k+1},
+//
+// where ρ is the decay rate, μ is the momentum and η_k is the step size.
+//
+// Reference:
+//
+//	Tieleman, T., Hinton, G. (2012). Lecture 6.5 - RMSProp: Divide the
+//	gradient by a running average of its recent magnitude. COURSERA: Neural
+//	Networks for Machine Learning.
+type RMSProp struct {
+	// StepSizer determines the step size η_k of every update. It is called
+	// with the mini-batch gradient and the update direction. If StepSizer is
+	// nil, a constant step size of 0.001 is used.
+	StepSizer optimize.StepSizer
+	// Decay is the decay rate ρ of the average of the squared gradients. If
+	// Decay is zero, it is defaulted to 0.9. Decay must be in (0, 1).
+	Decay float64
+	// Momentum is the momentum coefficient μ. Momentum must be in [0, 1).
+	// If Momentum is zero, no momentum is used.
+	Momentum float64
+	// Epsilon is the constant ε that prevents division by zero. If Epsilon
+	// is zero, it is defaulted to 1e-8.
+	Epsilon float64
+
+	decay float64
+	eps   float64
+
+	step stepper
+	grad []float64
+	v    []float64
+	buf  []float64
+	dir  []float64
+}
+
+func (r *RMSProp) init(dim int) {
+	r.decay = r.Decay
+	if r.decay == 0 {
+		r.decay = 0.9
+	}
+	if r.decay < 0 || r.decay >= 1 {
+		panic("stochastic: RMSProp decay rate out of range")
+	}
+	if r.Momentum < 0 || r.Momentum >= 1 {
+		panic("stochastic: momentum out of range")
+	}
+	r.eps = r.Epsilon
+	if r.eps == 0 {
+		r.eps = 1e-8
+	}
+	r.step.init(r.StepSizer, 0.001)
+	r.grad = resize(r.grad, dim)
+	r.v = resize(r.v, dim)
+	r.buf = resize(r.buf, dim)
+	r.dir = resize(r.dir, dim)
+	for i := range r.v {
+		r.v[i] = 0
+		r.buf[i] = 0
+	}
+}
+
+func (*RMSProp) epoch(*state) (optimize.Status, error) {
+	return optimize.NotTerminated, nil
+}
+
+func (r *RMSProp) iterate(s *state, batch []int) (optimize.Status, error) {
+	s.grad(r.grad, s.x, batch)
+	for i, g := range r.grad {
+		r.v[i] = r.decay*r.v[i] + (1-r.decay)*g*g
+		r.buf[i] = r.Momentum*r.buf[i] + g/(math.Sqrt(r.v[i])+r.eps)
+		r.dir[i] = -r.buf[i]
+	}
+	eta := r.step.size(s.x, r.grad, r.dir)
+	for i, d := range r.dir {
+		s.x[i] += eta * d
+	}
+	return optimize.NotTerminated, nil
+}
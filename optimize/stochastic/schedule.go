@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+// The schedules below are StepSizers that return a step size depending only
+// on the number k of updates since Init, which returns the step size for
+// k = 0. They ignore the location and the direction, which are noisy
+// estimates in stochastic methods, and can also be used with the methods of
+// the optimize package that accept a StepSizer.
+var (
+	_ optimize.StepSizer = (*ExponentialDecay)(nil)
+	_ optimize.StepSizer = (*InverseTimeDecay)(nil)
+	_ optimize.StepSizer = (*StepDecay)(nil)
+	_ optimize.StepSizer = (*CosineDecay)(nil)
+)
+
+// ExponentialDecay is a StepSizer that decays the step size exponentially,
+//
+//	η_k = Initial · Rate^k.
+type ExponentialDecay struct {
+	// Initial is the initial step size. It must be positive.
+	Initial float64
+	// Rate is the decay rate per update. It must be in (0, 1].
+	Rate float64
+
+	k int
+}
+
+func (e *ExponentialDecay) Init(_ *optimize.Location, _ []float64) float64 {
+	if !(e.Initial > 0) {
+		panic("stochastic: non-positive initial step size")
+	}
+	if !(e.Rate > 0 && e.Rate <= 1) {
+		panic("stochastic: decay rate out of range")
+	}
+	e.k = 0
+	return e.Initial
+}
+
+func (e *ExponentialDecay) StepSize(_ *optimize.Location, _ []float64) float64 {
+	e.k++
+	return e.Initial * math.Pow(e.Rate, float64(e.k))
+}
+
+// InverseTimeDecay is a StepSizer that decays the step size inversely
+// proportional to the number of updates,
+//
+//	η_k = Initial / (1 + Decay · k),
+//
+// which satisfies the Robbins-Monro conditions for the convergence of
+// stochastic gradient descent.
+type InverseTimeDecay struct {
+	// Initial is the initial step size. It must be positive.
+	Initial float64
+	// Decay is the decay factor. It must not be negative.
+	Decay float64
+
+	k int
+}
+
+func (d *InverseTimeDecay) Init(_ *optimize.Location, _ []float64) float64 {
+	if !(d.Initial > 0) {
+		panic("stochastic: non-positive initial step size")
+	}
+	if !(d.Decay >= 0) {
+		panic("stochastic: negative decay factor")
+	}
+	d.k = 0
+	return d.Initial
+}
+
+func (d *InverseTimeDecay) StepSize(_ *optimize.Location, _ []float64) float64 {
+	d.k++
+	return d.Initial / (1 + d.Decay*float64(d.k))
+}
+
+// StepDecay is a StepSizer that multiplies the step size by a constant
+// factor after every Interval updates,
+//
+//	η_k = Initial · Factor^⌊k/Interval⌋.
+type StepDecay struct {
+	// Initial is the initial step size. It must be positive.
+	Initial float64
+	// Factor is the decay factor. It must be in (0, 1].
+	Factor float64
+	// Interval is the number of updates between decays. It must be
+	// positive.
+	Interval int
+
+	k int
+}
+
+func (d *StepDecay) Init(_ *optimize.Location, _ []float64) float64 {
+	if !(d.Initial > 0) {
+		panic("stochastic: non-positive initial step size")
+	}
+	if !(d.Factor > 0 && d.Factor <= 1) {
+		panic("stochastic: decay factor out of range")
+	}
+	if d.Interval <= 0 {
+		panic("stochastic: non-positive decay interval")
+	}
+	d.k = 0
+	return d.Initial
+}
+
+func (d *StepDecay) StepSize(_ *optimize.Location, _ []float64) float64 {
+	d.k++
+	return d.Initial * math.Pow(d.Factor, float64(d.k/d.Interval))
+}
+
+// CosineDecay is a StepSizer that anneals the step size from Initial to
+// Minimum over Period updates following half a cosine period,
+//
+//	η_k = Minimum + (Initial - Minimum) (1 + cos(π min(k, Period)/Period)) / 2,
+//
+// and keeps it at Minimum afterwards.
+//
+// Reference:
+//
+//	Loshchilov, I., Hutter, F. (2017). SGDR: stochastic gradient descent
+//	with warm restarts. 5th International Conference on Learning
+//	Representations.
+type CosineDecay struct {
+	// Initial is the initial step size. It must be positive.
+	Initial float64
+	// Minimum is the final step size. It must be in [0, Initial].
+	Minimum float64
+	// Period is the number of updates over which the step size is
+	// annealed. It must be positive.
+	Period int
+
+	k int
+}
+
+func (c *CosineDecay) Init(_ *optimize.Location, _ []float64) float64 {
+	if !(c.Initial > 0) {
+		panic("stochastic: non-positive initial step size")
+	}
+	if !(c.Minimum >= 0 && c.Minimum <= c.Initial) {
+		panic("stochastic: minimum step size out of range")
+	}
+	if c.Period <= 0 {
+		panic("stochastic: non-positive annealing period")
+	}
+	c.k = 0
+	return c.Initial
+}
+
+func (c *CosineDecay) StepSize(_ *optimize.Location, _ []float64) float64 {
+	c.k++
+	t := float64(min(c.k, c.Period)) / float64(c.Period)
+	return c.Minimum + (c.Initial-c.Minimum)*(1+math.Cos(math.Pi*t))/2
+}
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic
+
+import (
+	"gonum.org/v1/gonum/optimize"
+)
+
+var _ Method = (*SGD)(nil)
+
+// SGD implements stochastic gradient descent with optional momentum. With
+// momentum μ, the update for the mini-batch gradient g_k is
+//
+//	v_{k+1} = μ v_k + g_k,
+//	x_{k+1} = x_k - η_k v_{k+1},
+//
+// and with Nesterov momentum the location is instead updated by
+//
+//	x_{k+1} = x_k - η_k (g_k + μ v_{k+1}),
+//
+// where η_k is the step size.
+//
+// References:
+//   - Robbins, H., Monro, S. (1951). A stochastic approximation method. The
+//     Annals of Mathematical Statistics 22(3), 400-407.
+//   - Sutskever, I., Martens, J., Dahl, G., Hinton, G. (2013). On the
+//     importance of initialization and momentum in deep learning. Proceedings
+//     of the 30th International Conference on Machine Learning, 1139-1147.
+type SGD struct {
+	// StepSizer determines the step size η_k of every update. It is called
+	// with the mini-batch gradient and the update direction. If StepSizer is
+	// nil, a constant step size of 0.01 is used.
+	StepSizer optimize.StepSizer
+	// Momentum is the momentum coefficient μ. Momentum must be in [0, 1).
+	// If Momentum is zero, no momentum is used.
+	Momentum float64
+	// Nesterov specifies whether Nesterov momentum is used.
+	Nesterov bool
+
+	step stepper
+	grad []float64
+	vel  []float64
+	dir  []float64
+}
+
+func (sgd *SGD) init(dim int) {
+	if sgd.Momentum < 0 || sgd.Momentum >= 1 {
+		panic("stochastic: momentum out of range")
+	}
+	sgd.step.init(sgd.StepSizer, 0.01)
+	sgd.grad = resize(sgd.grad, dim)
+	sgd.vel = resize(sgd.vel, dim)
+	sgd.dir = resize(sgd.dir, dim)
+	for i := range sgd.vel {
+		sgd.vel[i] = 0
+	}
+}
+
+func (*SGD) epoch(*state) (optimize.Status, error) {
+	return optimize.NotTerminated, nil
+}
+
+func (sgd *SGD) iterate(s *state, batch []int) (optimize.Status, error) {
+	s.grad(sgd.grad, s.x, batch)
+	mu := sgd.Momentum
+	for i, g := range sgd.grad {
+		sgd.vel[i] = mu*sgd.vel[i] + g
+		if sgd.Nesterov {
+			sgd.dir[i] = -(g + mu*sgd.vel[i])
+		} else {
+			sgd.dir[i] = -sgd.vel[i]
+		}
+	}
+	eta := sgd.step.size(s.x, sgd.grad, sgd.dir)
+	for i, d := range sgd.dir {
+		s.x[i] += eta * d
+	}
+	return optimize.NotTerminated, nil
+}
+
+// resize takes x and returns a slice of length dim. It returns a resliced x
+// if cap(x) >= dim, and a new slice otherwise.
+func resize(x []float64, dim int) []float64 {
+	if dim > cap(x) {
+		return make([]float64, dim)
+	}
+	return x[:dim]
+}
@@ -0,0 +1,358 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"gonum.org/v1/gonum/optimize"
+)
+
+const (
+	defaultEpochs    = 10
+	defaultBatchSize = 32
+)
+
+var errNonFinite = errors.New("stochastic: location is not finite")
+
+// Problem describes the finite-sum minimization problem
+//
+//	minimize f(x) = 1/N Σ_i f_i(x),
+//
+// whose terms are accessed through mini-batches. A mini-batch is a slice of
+// distinct term indices in [0, N). The callbacks must not modify or retain
+// the batch slice.
+type Problem struct {
+	// N is the number of terms of the objective.
+	N int
+
+	// Func returns the mean of the terms f_i at x over the indices in
+	// batch. Func must not modify x. Func is optional. If it is not nil,
+	// it is used to report the objective value over all terms at the end
+	// of every epoch and at the returned location.
+	Func func(x []float64, batch []int) float64
+
+	// Grad stores in grad the mean of the gradients ∇f_i at x over the
+	// indices in batch. Grad must not modify x.
+	Grad func(grad, x []float64, batch []int)
+
+	// Status reports the status of the problem being optimized and any
+	// error. It is called before every mini-batch update and can be used to
+	// terminate early.
+	Status func() (optimize.Status, error)
+}
+
+// Settings represents settings of the stochastic optimization run. See the
+// field comments for default values.
+type Settings struct {
+	// Epochs is the number of passes over all terms of the objective.
+	// IterationLimit status is returned when the number of epochs is
+	// reached. If Epochs is zero, a default value of 10 is used.
+	Epochs int
+
+	// BatchSize is the number of terms in a mini-batch. Each epoch visits
+	// the terms in a random order, split into consecutive mini-batches,
+	// the last of which may be smaller than BatchSize. If BatchSize is
+	// zero, a default value of min(32, N) is used, and if it is larger than
+	// N, N is used.
+	BatchSize int
+
+	// Src is the source of randomness used for sampling the mini-batches.
+	// Runs with the same Src state are reproducible. If Src is nil, a
+	// randomly seeded generator is used.
+	Src rand.Source
+
+	// Converger checks the objective value over all terms at the end of
+	// every epoch for convergence. Converger is only used if Problem.Func
+	// is not nil. If Converger is nil, convergence is not checked.
+	Converger optimize.Converger
+
+	// Runtime is the maximum runtime allowed. RuntimeLimit status is
+	// returned if the duration of the run is longer than this value. If it
+	// equals zero, this setting has no effect.
+	Runtime time.Duration
+}
+
+// Method is a stochastic first-order method. Method is implemented by SGD,
+// Adam, RMSProp and SVRG.
+type Method interface {
+	// init initializes the method for a problem of dimension dim.
+	init(dim int)
+	// epoch is called at the start of every epoch with the current
+	// location of s.
+	epoch(s *state) (optimize.Status, error)
+	// iterate updates the location of s using the terms in batch.
+	iterate(s *state, batch []int) (optimize.Status, error)
+}
+
+// Stats contains the statistics of the run.
+type Stats struct {
+	MajorIterations int           // Number of completed epochs
+	Iterations      int           // Number of mini-batch updates
+	FuncEvaluations int           // Number of evaluations of individual terms f_i
+	GradEvaluations int           // Number of evaluations of individual gradients ∇f_i
+	Runtime         time.Duration // Total runtime of the optimization
+}
+
+// Result represents the answer of a stochastic optimization run.
+type Result struct {
+	// X is the final location of the run.
+	X []float64
+	// F is the objective value over all terms at X if Problem.Func is not
+	// nil, and NaN otherwise.
+	F float64
+
+	Stats
+	Status optimize.Status
+}
+
+// Minimize minimizes the finite-sum objective of p starting at initX using
+// the given method. If settings is nil, the zero value is used, see the
+// documentation of Settings for the default values. If method is nil, Adam
+// is used.
+//
+// Minimize returns a Result and any error that occurred. The error is non-nil
+// if the location becomes non-finite or if p.Status returns an error.
+func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*Result, error) {
+	startTime := time.Now()
+	if p.Grad == nil {
+		panic("stochastic: gradient function is undefined")
+	}
+	if p.N <= 0 {
+		panic("stochastic: non-positive number of terms")
+	}
+	dim := len(initX)
+	if dim == 0 {
+		panic("stochastic: zero dimensional input")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if method == nil {
+		method = &Adam{}
+	}
+	epochs := settings.Epochs
+	if epochs == 0 {
+		epochs = defaultEpochs
+	}
+	if epochs < 0 {
+		panic("stochastic: negative number of epochs")
+	}
+	batchSize := settings.BatchSize
+	if batchSize == 0 {
+		batchSize = defaultBatchSize
+	}
+	if batchSize < 0 {
+		panic("stochastic: negative batch size")
+	}
+	batchSize = min(batchSize, p.N)
+
+	s := newState(&p, settings, initX, batchSize)
+	converger := settings.Converger
+	if p.Func == nil {
+		converger = nil
+	}
+	if converger != nil {
+		converger.Init(dim)
+	}
+
+	method.init(dim)
+	status := optimize.NotTerminated
+	var err error
+Epochs:
+	for {
+		if s.stats.MajorIterations >= epochs {
+			status = optimize.IterationLimit
+			break
+		}
+		status, err = method.epoch(s)
+		if status != optimize.NotTerminated || err != nil {
+			break
+		}
+		s.shuffle()
+		for start := 0; start < p.N; start += batchSize {
+			status, err = s.checkStatus(startTime)
+			if status != optimize.NotTerminated || err != nil {
+				break Epochs
+			}
+			status, err = method.iterate(s, s.perm[start:min(start+batchSize, p.N)])
+			if status != optimize.NotTerminated || err != nil {
+				break Epochs
+			}
+			s.stats.Iterations++
+			if !isFinite(s.x) {
+				status, err = optimize.Failure, errNonFinite
+				break Epochs
+			}
+		}
+		s.stats.MajorIterations++
+		if converger != nil {
+			s.evaluate()
+			status = converger.Converged(&optimize.Location{X: s.x, F: s.f})
+			if status != optimize.NotTerminated {
+				break
+			}
+		}
+	}
+	if p.Func != nil {
+		s.evaluate()
+	}
+	s.stats.Runtime = time.Since(startTime)
+	return &Result{
+		X:      s.x,
+		F:      s.f,
+		Stats:  s.stats,
+		Status: status,
+	}, err
+}
+
+// state holds the current location of a stochastic optimization run and
+// performs the evaluations of the problem.
+type state struct {
+	p         *Problem
+	settings  *Settings
+	stats     Stats
+	batchSize int
+	rnd       *rand.Rand
+
+	x    []float64 // Current location.
+	f    float64   // Objective value at x, NaN if not evaluated.
+	fIt  int       // Value of stats.Iterations when f was evaluated.
+	perm []int     // Order of the terms in the current epoch.
+	all  []int     // Indices of all terms in increasing order.
+	work []float64
+}
+
+func newState(p *Problem, settings *Settings, initX []float64, batchSize int) *state {
+	s := &state{
+		p:         p,
+		settings:  settings,
+		batchSize: batchSize,
+		rnd:       newRand(settings.Src),
+		x:         make([]float64, len(initX)),
+		f:         math.NaN(),
+		fIt:       -1,
+		perm:      make([]int, p.N),
+		all:       make([]int, p.N),
+	}
+	copy(s.x, initX)
+	for i := range s.all {
+		s.all[i] = i
+		s.perm[i] = i
+	}
+	return s
+}
+
+// shuffle randomly permutes the order of the terms for the next epoch.
+func (s *state) shuffle() {
+	s.rnd.Shuffle(len(s.perm), func(i, j int) {
+		s.perm[i], s.perm[j] = s.perm[j], s.perm[i]
+	})
+}
+
+// checkStatus returns the status of the problem and of the runtime limit.
+func (s *state) checkStatus(startTime time.Time) (optimize.Status, error) {
+	if s.p.Status != nil {
+		status, err := s.p.Status()
+		if status != optimize.NotTerminated || err != nil {
+			return status, err
+		}
+	}
+	if s.settings.Runtime > 0 && time.Since(startTime) >= s.settings.Runtime {
+		return optimize.RuntimeLimit, nil
+	}
+	return optimize.NotTerminated, nil
+}
+
+// grad stores in dst the mean gradient at x over the terms in batch.
+func (s *state) grad(dst, x []float64, batch []int) {
+	s.p.Grad(dst, x, batch)
+	s.stats.GradEvaluations += len(batch)
+}
+
+// fullGrad stores in dst the gradient of the objective at x over all terms.
+// The terms are evaluated in mini-batches of the size of the run.
+func (s *state) fullGrad(dst, x []float64) {
+	if len(s.work) != len(x) {
+		s.work = make([]float64, len(x))
+	}
+	for i := range dst {
+		dst[i] = 0
+	}
+	n := float64(s.p.N)
+	for start := 0; start < s.p.N; start += s.batchSize {
+		batch := s.all[start:min(start+s.batchSize, s.p.N)]
+		s.grad(s.work, x, batch)
+		w := float64(len(batch)) / n
+		for i, v := range s.work {
+			dst[i] += w * v
+		}
+	}
+}
+
+// evaluate updates the objective value over all terms at the current
+// location unless it is up to date. The terms are evaluated in mini-batches
+// of the size of the run.
+func (s *state) evaluate() {
+	if s.fIt == s.stats.Iterations {
+		return
+	}
+	var f float64
+	for start := 0; start < s.p.N; start += s.batchSize {
+		batch := s.all[start:min(start+s.batchSize, s.p.N)]
+		f += float64(len(batch)) * s.p.Func(s.x, batch)
+	}
+	s.stats.FuncEvaluations += s.p.N
+	s.f = f / float64(s.p.N)
+	s.fIt = s.stats.Iterations
+}
+
+// stepper calls the StepSizer of a method with the mini-batch gradient.
+type stepper struct {
+	sizer   optimize.StepSizer
+	started bool
+	loc     optimize.Location
+}
+
+// init sets the StepSizer, using a constant step size of def if sizer
+// is nil.
+func (st *stepper) init(sizer optimize.StepSizer, def float64) {
+	if sizer == nil {
+		sizer = optimize.ConstantStepSize{Size: def}
+	}
+	st.sizer = sizer
+	st.started = false
+}
+
+// size returns the step size along dir at x with the mini-batch gradient
+// grad. The Location passed to the StepSizer holds x and grad, and its
+// function value is NaN since it is not evaluated.
+func (st *stepper) size(x, grad, dir []float64) float64 {
+	st.loc = optimize.Location{X: x, F: math.NaN(), Gradient: grad}
+	if !st.started {
+		st.started = true
+		return st.sizer.Init(&st.loc, dir)
+	}
+	return st.sizer.StepSize(&st.loc, dir)
+}
+
+func isFinite(x []float64) bool {
+	for _, v := range x {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewPCG(rand.Uint64(), rand.Uint64())
+	}
+	return rand.New(src)
+}
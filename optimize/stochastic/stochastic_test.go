@@ -0,0 +1,323 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stochastic
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// leastSquares is a linear least-squares problem with the terms
+// f_i(x) = (a_iᵀx - b_i)²/2.
+type leastSquares struct {
+	a *mat.Dense
+	b []float64
+}
+
+func newLeastSquares(n, dim int, noise float64, rnd *rand.Rand) leastSquares {
+	a := mat.NewDense(n, dim, nil)
+	b := make([]float64, n)
+	want := make([]float64, dim)
+	for j := range want {
+		want[j] = rnd.NormFloat64()
+	}
+	for i := 0; i < n; i++ {
+		row := a.RawRowView(i)
+		for j := range row {
+			row[j] = rnd.NormFloat64()
+		}
+		b[i] = floats.Dot(row, want) + noise*rnd.NormFloat64()
+	}
+	return leastSquares{a: a, b: b}
+}
+
+func (ls leastSquares) problem() Problem {
+	_, dim := ls.a.Dims()
+	n := len(ls.b)
+	return Problem{
+		N: n,
+		Func: func(x []float64, batch []int) float64 {
+			var f float64
+			for _, i := range batch {
+				r := floats.Dot(ls.a.RawRowView(i), x) - ls.b[i]
+				f += r * r / 2
+			}
+			return f / float64(len(batch))
+		},
+		Grad: func(grad, x []float64, batch []int) {
+			if len(grad) != dim {
+				panic("bad gradient length")
+			}
+			for j := range grad {
+				grad[j] = 0
+			}
+			for _, i := range batch {
+				row := ls.a.RawRowView(i)
+				r := floats.Dot(row, x) - ls.b[i]
+				floats.AddScaled(grad, r, row)
+			}
+			floats.Scale(1/float64(len(batch)), grad)
+		},
+	}
+}
+
+// solution returns the minimizer of the least-squares problem.
+func (ls leastSquares) solution() []float64 {
+	_, dim := ls.a.Dims()
+	var x mat.VecDense
+	err := x.SolveVec(ls.a, mat.NewVecDense(len(ls.b), ls.b))
+	if err != nil {
+		panic(err)
+	}
+	return mat.Col(nil, 0, &x)[:dim]
+}
+
+func TestLeastSquares(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 1))
+	ls := newLeastSquares(500, 4, 0.1, rnd)
+	want := ls.solution()
+	x0 := make([]float64, 4)
+
+	for _, test := range []struct {
+		name   string
+		method Method
+		epochs int
+		tol    float64
+	}{
+		{
+			name:   "SGD",
+			method: &SGD{StepSizer: &InverseTimeDecay{Initial: 0.05, Decay: 0.01}},
+			epochs: 100,
+			tol:    2e-3,
+		},
+		{
+			name:   "SGDMomentum",
+			method: &SGD{StepSizer: &InverseTimeDecay{Initial: 0.01, Decay: 0.01}, Momentum: 0.9},
+			epochs: 100,
+			tol:    2e-3,
+		},
+		{
+			name:   "Nesterov",
+			method: &SGD{StepSizer: &InverseTimeDecay{Initial: 0.01, Decay: 0.01}, Momentum: 0.9, Nesterov: true},
+			epochs: 100,
+			tol:    2e-3,
+		},
+		{
+			name:   "Adam",
+			method: &Adam{StepSizer: &CosineDecay{Initial: 0.05, Period: 1500}},
+			epochs: 100,
+			tol:    2e-3,
+		},
+		{
+			name:   "RMSProp",
+			method: &RMSProp{StepSizer: &CosineDecay{Initial: 0.01, Period: 1500}},
+			epochs: 100,
+			tol:    2e-3,
+		},
+		{
+			name:   "RMSPropMomentum",
+			method: &RMSProp{StepSizer: &CosineDecay{Initial: 0.001, Period: 1500}, Momentum: 0.9},
+			epochs: 100,
+			tol:    2e-3,
+		},
+		{
+			name:   "SVRG",
+			method: &SVRG{StepSizer: optimize.ConstantStepSize{Size: 0.05}},
+			epochs: 30,
+			tol:    1e-8,
+		},
+	} {
+		settings := &Settings{
+			Epochs:    test.epochs,
+			BatchSize: 32,
+			Src:       rand.NewPCG(2, 2),
+		}
+		res, err := Minimize(ls.problem(), x0, settings, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if res.Status != optimize.IterationLimit {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, res.Status, optimize.IterationLimit)
+		}
+		if res.MajorIterations != test.epochs {
+			t.Errorf("%s: unexpected number of epochs: got %d, want %d", test.name, res.MajorIterations, test.epochs)
+		}
+		if want := test.epochs * 16; res.Iterations != want {
+			t.Errorf("%s: unexpected number of iterations: got %d, want %d", test.name, res.Iterations, want)
+		}
+		if !floats.EqualApprox(res.X, want, test.tol) {
+			t.Errorf("%s: unexpected solution: got %v, want %v", test.name, res.X, want)
+		}
+		if f := ls.problem().Func(res.X, allTerms(len(ls.b))); !scalar.EqualWithinRel(res.F, f, 1e-12) {
+			t.Errorf("%s: unexpected function value: got %v, want %v", test.name, res.F, f)
+		}
+	}
+}
+
+// allTerms returns the indices of n terms.
+func allTerms(n int) []int {
+	batch := make([]int, n)
+	for i := range batch {
+		batch[i] = i
+	}
+	return batch
+}
+
+func TestReproducible(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(1, 2))
+	ls := newLeastSquares(100, 3, 0.5, rnd)
+	x0 := []float64{1, -1, 0.5}
+
+	for _, newMethod := range []func() Method{
+		func() Method { return &SGD{Momentum: 0.5} },
+		func() Method { return &Adam{WeightDecay: 0.01} },
+		func() Method { return &RMSProp{} },
+		func() Method { return &SVRG{} },
+	} {
+		run := func(seed uint64) []float64 {
+			settings := &Settings{Epochs: 3, BatchSize: 7, Src: rand.NewPCG(seed, seed)}
+			res, err := Minimize(ls.problem(), x0, settings, newMethod())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			return res.X
+		}
+		a := run(1)
+		b := run(1)
+		c := run(2)
+		if !floats.Same(a, b) {
+			t.Errorf("%T: runs with the same seed differ: %v != %v", newMethod(), a, b)
+		}
+		if floats.Same(a, c) {
+			t.Errorf("%T: runs with different seeds are identical", newMethod())
+		}
+	}
+}
+
+func TestTermination(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewPCG(3, 1))
+	ls := newLeastSquares(50, 2, 0, rnd)
+	x0 := []float64{0, 0}
+
+	// Problem.Status.
+	p := ls.problem()
+	var calls int
+	errStop := errors.New("stop")
+	p.Status = func() (optimize.Status, error) {
+		calls++
+		if calls > 5 {
+			return optimize.Failure, errStop
+		}
+		return optimize.NotTerminated, nil
+	}
+	res, err := Minimize(p, x0, &Settings{BatchSize: 10, Src: rand.NewPCG(1, 1)}, &SGD{})
+	if err != errStop {
+		t.Errorf("unexpected error: got %v, want %v", err, errStop)
+	}
+	if res.Status != optimize.Failure {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, optimize.Failure)
+	}
+	if res.Iterations != 5 || res.MajorIterations != 1 {
+		t.Errorf("unexpected iterations: got %d and %d epochs, want 5 and 1", res.Iterations, res.MajorIterations)
+	}
+	if res.GradEvaluations != 50 {
+		t.Errorf("unexpected gradient evaluations: got %d, want 50", res.GradEvaluations)
+	}
+
+	// Convergence of the objective value.
+	settings := &Settings{
+		Epochs:    1000,
+		BatchSize: 10,
+		Src:       rand.NewPCG(1, 1),
+		Converger: &optimize.FunctionConverge{Absolute: 1e-10, Iterations: 5},
+	}
+	res, err = Minimize(ls.problem(), x0, settings, &SVRG{StepSizer: optimize.ConstantStepSize{Size: 0.1}})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if res.Status != optimize.FunctionConvergence {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, optimize.FunctionConvergence)
+	}
+	if res.MajorIterations >= 1000 {
+		t.Errorf("convergence not detected")
+	}
+	if res.F > 1e-8 {
+		t.Errorf("unexpected function value: got %v, want 0", res.F)
+	}
+	// The objective value at the final location is not evaluated again.
+	if want := res.MajorIterations * 50; res.FuncEvaluations != want {
+		t.Errorf("unexpected function evaluations: got %d, want %d", res.FuncEvaluations, want)
+	}
+
+	// Divergence.
+	res, err = Minimize(ls.problem(), x0, &Settings{Epochs: 1000, Src: rand.NewPCG(1, 1)}, &SGD{StepSizer: optimize.ConstantStepSize{Size: 100}})
+	if err != errNonFinite {
+		t.Errorf("unexpected error: got %v, want %v", err, errNonFinite)
+	}
+	if res.Status != optimize.Failure {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, optimize.Failure)
+	}
+
+	// Gradient only.
+	p = ls.problem()
+	p.Func = nil
+	res, err = Minimize(p, x0, &Settings{Epochs: 2, Src: rand.NewPCG(1, 1)}, nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !math.IsNaN(res.F) || res.FuncEvaluations != 0 {
+		t.Errorf("unexpected function value without Func: got %v after %d evaluations", res.F, res.FuncEvaluations)
+	}
+	if res.Iterations != 4 {
+		t.Errorf("unexpected number of iterations with default batch size: got %d, want 4", res.Iterations)
+	}
+}
+
+func TestSchedules(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		sizer optimize.StepSizer
+		want  []float64
+	}{
+		{
+			sizer: &ExponentialDecay{Initial: 1, Rate: 0.5},
+			want:  []float64{1, 0.5, 0.25, 0.125},
+		},
+		{
+			sizer: &InverseTimeDecay{Initial: 1, Decay: 1},
+			want:  []float64{1, 1.0 / 2, 1.0 / 3, 1.0 / 4},
+		},
+		{
+			sizer: &StepDecay{Initial: 1, Factor: 0.1, Interval: 2},
+			want:  []float64{1, 1, 0.1, 0.1, 0.01},
+		},
+		{
+			sizer: &CosineDecay{Initial: 1, Minimum: 0.2, Period: 2},
+			want:  []float64{1, 0.6, 0.2, 0.2},
+		},
+	} {
+		// Reinitialization restarts the schedule.
+		for range 2 {
+			got := []float64{test.sizer.Init(nil, nil)}
+			for len(got) < len(test.want) {
+				got = append(got, test.sizer.StepSize(nil, nil))
+			}
+			if !floats.EqualApprox(got, test.want, 1e-14) {
+				t.Errorf("%T: unexpected step sizes: got %v, want %v", test.sizer, got, test.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Method      = (*Subspace2D)(nil)
+	_ localMethod = (*Subspace2D)(nil)
+)
+
+// Subspace2D implements the two-dimensional subspace trust-region method for
+// Hessian-based unconstrained minimization.
+//
+// At each iteration Subspace2D minimizes the quadratic model
+//
+//	m(p) = f_k + ∇f_kᵀp + ½pᵀH_kp
+//
+// within the trust region |p| ≤ Δ_k over the subspace spanned by the gradient
+// ∇f_k and the Newton step -H_k⁻¹∇f_k. If H_k is not positive definite, the
+// Newton step is replaced by -(H_k + αI)⁻¹∇f_k, where α = -1.5λ₁ and λ₁ is the
+// smallest eigenvalue of H_k, so that directions of negative curvature are
+// exploited. The two-dimensional subproblem is solved exactly. Steps are
+// accepted and the radius Δ_k is updated as in Dogleg, and Subspace2D also
+// fails with ErrTrustRegionCollapse when the radius becomes negligible.
+//
+// See Section 4.1 of Nocedal, Wright (2006), 2nd edition, and
+// Byrd, Schnabel, Shultz (1988). Approximate solution of the trust region
+// problem by minimization over two-dimensional subspaces. Mathematical
+// Programming 40, 247-263.
+type Subspace2D struct {
+	// InitialRadius is the initial radius of the trust region. If
+	// InitialRadius is zero, it is defaulted to 1.
+	InitialRadius float64
+	// MaxRadius is the largest allowed radius of the trust region. If
+	// MaxRadius is zero, the radius is not bounded.
+	MaxRadius float64
+	// AcceptRatio is the smallest ratio between the actual and the predicted
+	// reduction for which a step is accepted. AcceptRatio must be in
+	// [0, 1/4). If AcceptRatio is zero, it is defaulted to 0.1.
+	AcceptRatio float64
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	tr      trustRegion
+	chol    mat.Cholesky
+	eig     mat.EigenSym
+	shifted *mat.SymDense
+	q       *mat.Dense // Orthonormal basis of the subspace.
+	v       []float64
+}
+
+func (s *Subspace2D) Status() (Status, error) {
+	return s.status, s.err
+}
+
+func (*Subspace2D) Uses(has Available) (uses Available, err error) {
+	return has.hessian()
+}
+
+func (s *Subspace2D) Init(dim, tasks int) int {
+	s.status = NotTerminated
+	s.err = nil
+	return 1
+}
+
+func (s *Subspace2D) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	s.status, s.err = localOptimizer{}.run(s, s.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+// TrustRegionStats returns the statistics of the current optimization run.
+func (s *Subspace2D) TrustRegionStats() TrustRegionStats {
+	return s.tr.stats
+}
+
+func (s *Subspace2D) initLocal(loc *Location) (Operation, error) {
+	dim := len(loc.X)
+	s.v = resize(s.v, dim)
+	s.shifted = resizeSymDense(s.shifted, dim)
+	s.tr.solver = s
+	s.tr.useHess = true
+	return s.tr.init(loc, s.InitialRadius, s.MaxRadius, s.AcceptRatio)
+}
+
+func (s *Subspace2D) iterateLocal(loc *Location) (Operation, error) {
+	return s.tr.iterate(loc)
+}
+
+func (s *Subspace2D) start(p, g []float64, hess *mat.SymDense, radius float64) ([]float64, float64) {
+	n := len(g)
+	gv := mat.NewVecDense(n, g)
+	v := mat.NewVecDense(n, s.v)
+
+	// Compute the second direction spanning the subspace.
+	ok := s.chol.Factorize(hess) && s.chol.SolveVecTo(v, gv) == nil
+	if ok {
+		v.ScaleVec(-1, v)
+		if floats.Norm(s.v, 2) <= radius {
+			copy(p, s.v)
+			return nil, quadModel(g, hess, p)
+		}
+	} else {
+		ok = s.eig.Factorize(hess, false)
+		if ok {
+			lambda := s.eig.Values(nil)[0]
+			alpha := -1.5 * lambda
+			if alpha <= 0 {
+				alpha = math.Sqrt(machEps) * math.Max(1, mat.Norm(hess, math.Inf(1)))
+			}
+			s.shifted.CopySym(hess)
+			for i := 0; i < n; i++ {
+				s.shifted.SetSym(i, i, hess.At(i, i)+alpha)
+			}
+			ok = s.chol.Factorize(s.shifted) && s.chol.SolveVecTo(v, gv) == nil
+			v.ScaleVec(-1, v)
+		}
+	}
+
+	// Form an orthonormal basis of span{g, v}.
+	gnorm := floats.Norm(g, 2)
+	k := 1
+	if ok {
+		floats.AddScaled(s.v, -floats.Dot(g, s.v)/(gnorm*gnorm), g)
+		if vnorm := floats.Norm(s.v, 2); vnorm > math.Sqrt(machEps)*gnorm && !math.IsInf(vnorm, 0) {
+			floats.Scale(1/vnorm, s.v)
+			k = 2
+		}
+	}
+	if s.q == nil {
+		s.q = &mat.Dense{}
+	}
+	s.q.Reset()
+	s.q.ReuseAs(n, k)
+	for i := 0; i < n; i++ {
+		s.q.Set(i, 0, g[i]/gnorm)
+		if k == 2 {
+			s.q.Set(i, 1, s.v[i])
+		}
+	}
+
+	// Project the model onto the subspace and solve the reduced problem.
+	var hq mat.Dense
+	hq.Mul(hess, s.q)
+	hr := mat.NewSymDense(k, nil)
+	var tmp mat.Dense
+	tmp.Mul(s.q.T(), &hq)
+	for i := 0; i < k; i++ {
+		for j := i; j < k; j++ {
+			hr.SetSym(i, j, (tmp.At(i, j)+tmp.At(j, i))/2)
+		}
+	}
+	gr := make([]float64, k)
+	mat.NewVecDense(k, gr).MulVec(s.q.T(), gv)
+	y := make([]float64, k)
+	solveSmallTrustRegion(y, gr, hr, radius)
+	mat.NewVecDense(n, p).MulVec(s.q, mat.NewVecDense(k, y))
+	return nil, quadModel(g, hess, p)
+}
+
+func (*Subspace2D) next([]float64) ([]float64, float64) {
+	panic("optimize: unexpected Hessian-vector product")
+}
+
+func (*Subspace2D) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, true}
+}
@@ -0,0 +1,363 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	defaultInitialRadius = 1
+	defaultAcceptRatio   = 0.1
+
+	// Thresholds on the ratio between the actual and the predicted reduction
+	// for shrinking and expanding the trust region.
+	shrinkRatio = 0.25
+	expandRatio = 0.75
+
+	// roundoffReduction is the multiple of machEps·|f| below which the
+	// predicted reduction cannot be reliably compared with the actual one.
+	roundoffReduction = 100
+)
+
+// TrustRegionStats contains statistics of a trust-region optimization run.
+type TrustRegionStats struct {
+	// Radius is the current radius of the trust region.
+	Radius float64
+	// Accepted and Rejected are the numbers of accepted and rejected trial
+	// steps.
+	Accepted, Rejected int
+	// Expansions and Contractions are the numbers of times the radius was
+	// increased and decreased.
+	Expansions, Contractions int
+	// HessVecProducts is the number of Hessian-vector products formed by the
+	// subproblem solver.
+	HessVecProducts int
+}
+
+// trustRegionSolver approximately solves the trust-region subproblem
+//
+//	minimize m(p) = gᵀp + ½pᵀBp subject to |p| ≤ radius,
+//
+// where B is the Hessian or its approximation. Solvers that only need
+// products with B request them through reverse communication.
+type trustRegionSolver interface {
+	// start begins solving the subproblem. hess is nil if B is only
+	// available through products. If a product Bd is needed, start returns
+	// d, which must not be modified by the caller. Otherwise the solution is
+	// stored in p and start returns nil and m(p).
+	start(p, g []float64, hess *mat.SymDense, radius float64) (d []float64, m float64)
+	// next continues solving the subproblem with bd holding the product with
+	// the vector returned by the previous call to start or next. Its return
+	// values are as for start.
+	next(bd []float64) (d []float64, m float64)
+}
+
+type trustRegionState int
+
+const (
+	trustRegionMajor trustRegionState = iota
+	trustRegionHessVec
+	trustRegionTrial
+	trustRegionHess
+)
+
+// trustRegion implements the outer iteration shared by the trust-region
+// methods. It implements Algorithm 4.1 from Nocedal, Wright (2006), 2nd
+// edition: the trial step p computed by the subproblem solver is accepted if
+// the ratio ρ between the actual and the predicted reduction of f is larger
+// than the accept ratio. The radius is decreased if ρ < 1/4 and doubled if
+// ρ > 3/4 and p lies on the boundary of the trust region.
+//
+// If the Hessian is not used, the products with the Hessian requested by the
+// solver are approximated by finite differences of the gradient.
+type trustRegion struct {
+	solver      trustRegionSolver
+	maxRadius   float64
+	acceptRatio float64
+	useHess     bool
+
+	state trustRegionState
+	stats TrustRegionStats
+
+	x    []float64 // Location of the current iterate.
+	f    float64   // Function value at x.
+	grad []float64 // Gradient at x.
+	hess *mat.SymDense
+
+	p    []float64 // Trial step.
+	bd   []float64 // Hessian-vector product.
+	h    float64   // Finite difference step.
+	pred float64   // Reduction predicted by the model.
+}
+
+func (tr *trustRegion) init(loc *Location, radius, maxRadius, acceptRatio float64) (Operation, error) {
+	if radius == 0 {
+		radius = defaultInitialRadius
+	}
+	if maxRadius == 0 {
+		maxRadius = math.Inf(1)
+	}
+	if acceptRatio == 0 {
+		acceptRatio = defaultAcceptRatio
+	}
+	if radius < 0 || maxRadius < radius {
+		panic("optimize: invalid trust-region radius")
+	}
+	if acceptRatio < 0 || acceptRatio >= shrinkRatio {
+		panic("optimize: trust-region accept ratio out of range")
+	}
+	tr.maxRadius = maxRadius
+	tr.acceptRatio = acceptRatio
+	tr.stats = TrustRegionStats{Radius: radius}
+
+	dim := len(loc.X)
+	tr.x = resize(tr.x, dim)
+	tr.grad = resize(tr.grad, dim)
+	tr.p = resize(tr.p, dim)
+	tr.bd = resize(tr.bd, dim)
+	tr.accept(loc)
+	if tr.useHess {
+		tr.hess = resizeSymDense(tr.hess, dim)
+		tr.hess.CopySym(loc.Hessian)
+	} else {
+		tr.hess = nil
+	}
+	return tr.nextStep(loc)
+}
+
+func (tr *trustRegion) iterate(loc *Location) (Operation, error) {
+	switch tr.state {
+	case trustRegionHessVec:
+		floats.SubTo(tr.bd, loc.Gradient, tr.grad)
+		floats.Scale(1/tr.h, tr.bd)
+		d, m := tr.solver.next(tr.bd)
+		return tr.continueStep(loc, d, m)
+	case trustRegionTrial:
+		return tr.checkTrial(loc)
+	case trustRegionHess:
+		tr.hess.CopySym(loc.Hessian)
+		tr.state = trustRegionMajor
+		return MajorIteration, nil
+	default:
+		return tr.nextStep(loc)
+	}
+}
+
+// nextStep starts computing a new trial step for the current radius.
+func (tr *trustRegion) nextStep(loc *Location) (Operation, error) {
+	d, m := tr.solver.start(tr.p, tr.grad, tr.hess, tr.stats.Radius)
+	return tr.continueStep(loc, d, m)
+}
+
+// continueStep forms the Hessian-vector products requested by the solver
+// and evaluates the function at the trial location once the step is known.
+func (tr *trustRegion) continueStep(loc *Location, d []float64, m float64) (Operation, error) {
+	for d != nil {
+		tr.stats.HessVecProducts++
+		if tr.hess == nil {
+			// Approximate the product by a forward difference of the
+			// gradient.
+			tr.h = math.Sqrt(machEps) * (1 + floats.Norm(tr.x, 2)) / floats.Norm(d, 2)
+			floats.AddScaledTo(loc.X, tr.x, tr.h, d)
+			tr.state = trustRegionHessVec
+			return GradEvaluation, nil
+		}
+		bd := mat.NewVecDense(len(tr.bd), tr.bd)
+		bd.MulVec(tr.hess, mat.NewVecDense(len(d), d))
+		d, m = tr.solver.next(tr.bd)
+	}
+	tr.pred = -m
+	floats.AddTo(loc.X, tr.x, tr.p)
+	tr.state = trustRegionTrial
+	return FuncEvaluation | GradEvaluation, nil
+}
+
+// checkTrial accepts or rejects the trial step and updates the radius.
+func (tr *trustRegion) checkTrial(loc *Location) (Operation, error) {
+	pnorm := floats.Norm(tr.p, 2)
+	rho := (tr.f - loc.F) / tr.pred
+	switch {
+	case !(tr.pred > 0):
+		rho = math.Inf(-1)
+	case tr.pred <= roundoffReduction*machEps*math.Abs(tr.f):
+		// The actual reduction is dominated by rounding errors, so accept
+		// the step if it reduces the norm of the gradient instead.
+		rho = 0
+		if floats.Norm(loc.Gradient, 2) < floats.Norm(tr.grad, 2) {
+			rho = 1
+		}
+	}
+	switch {
+	case !(rho >= shrinkRatio):
+		tr.stats.Radius = shrinkRatio * math.Min(tr.stats.Radius, pnorm)
+		tr.stats.Contractions++
+	case rho > expandRatio && pnorm >= 0.99*tr.stats.Radius:
+		radius := math.Min(2*tr.stats.Radius, tr.maxRadius)
+		if radius > tr.stats.Radius {
+			tr.stats.Radius = radius
+			tr.stats.Expansions++
+		}
+	}
+	if rho > tr.acceptRatio && !math.IsInf(loc.F, 0) && !math.IsNaN(loc.F) {
+		tr.stats.Accepted++
+		tr.accept(loc)
+		if tr.useHess {
+			tr.state = trustRegionHess
+			return HessEvaluation, nil
+		}
+		tr.state = trustRegionMajor
+		return MajorIteration, nil
+	}
+	tr.stats.Rejected++
+	if tr.stats.Radius <= machEps*(1+floats.Norm(tr.x, 2)) {
+		return NoOperation, ErrTrustRegionCollapse
+	}
+	return tr.nextStep(loc)
+}
+
+// accept stores the location and the gradient from loc as the current
+// iterate.
+func (tr *trustRegion) accept(loc *Location) {
+	copy(tr.x, loc.X)
+	copy(tr.grad, loc.Gradient)
+	tr.f = loc.F
+}
+
+// quadModel returns the value of the quadratic model gᵀp + ½pᵀHp.
+func quadModel(g []float64, hess *mat.SymDense, p []float64) float64 {
+	pv := mat.NewVecDense(len(p), p)
+	return floats.Dot(g, p) + 0.5*mat.Inner(pv, hess, pv)
+}
+
+// boundaryStep returns the values τ₁ ≤ 0 ≤ τ₂ for which |z + τd| = radius.
+// It must hold that |z| ≤ radius.
+func boundaryStep(z, d []float64, radius float64) (tau1, tau2 float64) {
+	dd := floats.Dot(d, d)
+	zd := floats.Dot(z, d)
+	zz := floats.Dot(z, z)
+	c := math.Min(zz-radius*radius, 0)
+	disc := math.Sqrt(zd*zd - dd*c)
+	// Avoid cancellation by computing the root of larger magnitude first.
+	if zd >= 0 {
+		q := -(zd + disc)
+		if q == 0 {
+			return 0, 0
+		}
+		return q / dd, c / q
+	}
+	q := -zd + disc
+	return c / q, q / dd
+}
+
+// solveSmallTrustRegion stores into p the global minimizer of the model
+// gᵀp + ½pᵀHp subject to |p| ≤ radius. It uses the eigendecomposition of H
+// and is only intended for subproblems of small dimension.
+//
+// The minimizer satisfies (H + λI) p = -g with H + λI positive semidefinite,
+// λ ≥ 0 and λ(|p| - radius) = 0. The multiplier λ is found by a safeguarded
+// Newton iteration on 1/|p(λ)| - 1/radius as described in Section 4.3 of
+// Nocedal, Wright (2006), 2nd edition.
+func solveSmallTrustRegion(p, g []float64, hess *mat.SymDense, radius float64) {
+	n := len(g)
+	var eig mat.EigenSym
+	if !eig.Factorize(hess, true) {
+		// Fall back to the steepest descent direction.
+		gnorm := floats.Norm(g, 2)
+		floats.ScaleTo(p, -math.Min(radius/gnorm, 1), g)
+		return
+	}
+	e := eig.Values(nil)
+	var v mat.Dense
+	eig.VectorsTo(&v)
+	gh := make([]float64, n)
+	mat.NewVecDense(n, gh).MulVec(v.T(), mat.NewVecDense(n, g))
+
+	// norm returns |p(λ)| and its derivative with respect to λ.
+	norm := func(lambda float64) (float64, float64) {
+		var s, ds float64
+		for i, gi := range gh {
+			d := e[i] + lambda
+			s += gi * gi / (d * d)
+			ds -= gi * gi / (d * d * d)
+		}
+		s = math.Sqrt(s)
+		return s, ds / s
+	}
+	// assemble stores p(λ) + τv₀ into p.
+	assemble := func(lambda, tau float64, skip func(int) bool) {
+		y := make([]float64, n)
+		for i, gi := range gh {
+			if !skip(i) {
+				y[i] = -gi / (e[i] + lambda)
+			}
+		}
+		y[0] += tau
+		mat.NewVecDense(n, p).MulVec(&v, mat.NewVecDense(n, y))
+	}
+	none := func(int) bool { return false }
+
+	if e[0] > 0 {
+		if s, _ := norm(0); s <= radius {
+			assemble(0, 0, none)
+			return
+		}
+	}
+	gnorm := floats.Norm(g, 2)
+	lo := math.Max(0, -e[0])
+
+	// Check for the hard case, where g is orthogonal to the eigenspace of
+	// the smallest eigenvalue and the step for λ = -λ₁ lies inside the trust
+	// region.
+	tol := math.Sqrt(machEps) * gnorm
+	lowest := func(i int) bool { return e[i]-e[0] <= machEps*math.Abs(e[0]) }
+	if e[0] <= 0 {
+		hard := true
+		var s float64
+		for i, gi := range gh {
+			if lowest(i) {
+				if math.Abs(gi) > tol {
+					hard = false
+					break
+				}
+				continue
+			}
+			d := e[i] - e[0]
+			s += gi * gi / (d * d)
+		}
+		if hard && math.Sqrt(s) <= radius {
+			tau := math.Sqrt(radius*radius - s)
+			assemble(-e[0], tau, lowest)
+			return
+		}
+	}
+
+	hi := lo + gnorm/radius
+	lambda := hi
+	for i := 0; i < 100; i++ {
+		s, ds := norm(lambda)
+		if math.Abs(s-radius) <= 1e-12*radius {
+			break
+		}
+		if s > radius {
+			lo = lambda
+		} else {
+			hi = lambda
+		}
+		// Newton step on φ(λ) = 1/|p(λ)| - 1/radius.
+		next := lambda - (1/s-1/radius)*s*s/-ds
+		if !(next > lo && next < hi) {
+			next = (lo + hi) / 2
+		}
+		if next == lambda {
+			break
+		}
+		lambda = next
+	}
+	assemble(lambda, 0, none)
+}
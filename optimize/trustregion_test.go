@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestDogleg(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &Dogleg{})
+}
+
+func TestSubspace2D(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &Subspace2D{})
+}
+
+func TestSteihaugCG(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &SteihaugCG{})
+}
+
+func TestSteihaugCGFiniteDifference(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	for _, test := range newtonTests {
+		test.p.Hess = nil
+		if test.gradTol == 0 {
+			// The finite difference products limit the attainable accuracy.
+			test.gradTol = 1e-10
+		}
+		tests = append(tests, test)
+	}
+	testLocal(t, tests, &SteihaugCG{})
+}
+
+func TestTrustRegionStats(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: functions.Wood{}.Func,
+		Grad: functions.Wood{}.Grad,
+		Hess: functions.Wood{}.Hess,
+	}
+	for _, method := range []interface {
+		Method
+		TrustRegionStats() TrustRegionStats
+	}{
+		&Dogleg{InitialRadius: 0.1},
+		&Subspace2D{InitialRadius: 0.1},
+		&SteihaugCG{InitialRadius: 0.1},
+	} {
+		result, err := Minimize(p, []float64{-3, -1, -3, -1}, nil, method)
+		if err != nil {
+			t.Errorf("%T: unexpected error: %v", method, err)
+			continue
+		}
+		if !floats.EqualApprox(result.X, []float64{1, 1, 1, 1}, 1e-8) {
+			t.Errorf("%T: unexpected minimizer: got %v, want [1 1 1 1]", method, result.X)
+		}
+		stats := method.TrustRegionStats()
+		if stats.Accepted+1 != result.MajorIterations {
+			t.Errorf("%T: accepted steps %d not equal to major iterations %d", method, stats.Accepted, result.MajorIterations)
+		}
+		if stats.Accepted+stats.Rejected+1 != result.FuncEvaluations {
+			t.Errorf("%T: trial steps %d do not match function evaluations %d",
+				method, stats.Accepted+stats.Rejected, result.FuncEvaluations)
+		}
+		if stats.Expansions == 0 {
+			t.Errorf("%T: radius not expanded from a small initial radius", method)
+		}
+		if !(stats.Radius > 0) {
+			t.Errorf("%T: invalid final radius %v", method, stats.Radius)
+		}
+	}
+}
+
+func TestSolveSmallTrustRegion(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		g      []float64
+		h      []float64
+		radius float64
+	}{
+		// Positive definite, interior solution.
+		{g: []float64{1, 1}, h: []float64{2, 0, 0, 4}, radius: 10},
+		// Positive definite, boundary solution.
+		{g: []float64{1, 1}, h: []float64{2, 0, 0, 4}, radius: 0.1},
+		// Indefinite.
+		{g: []float64{1, -2}, h: []float64{1, 2, 2, -3}, radius: 1},
+		// Hard case.
+		{g: []float64{0, 1}, h: []float64{-1, 0, 0, 2}, radius: 2},
+	} {
+		h := mat.NewSymDense(2, test.h)
+		p := make([]float64, 2)
+		solveSmallTrustRegion(p, test.g, h, test.radius)
+		if floats.Norm(p, 2) > test.radius*(1+1e-10) {
+			t.Errorf("step %v outside trust region of radius %v", p, test.radius)
+		}
+		// Compare with the minimum over a fine sampling of the region.
+		m := quadModel(test.g, h, p)
+		q := make([]float64, 2)
+		for i := 0; i <= 200; i++ {
+			r := test.radius * float64(i) / 200
+			for j := 0; j < 360; j++ {
+				theta := 2 * math.Pi * float64(j) / 360
+				q[0] = r * math.Cos(theta)
+				q[1] = r * math.Sin(theta)
+				if mq := quadModel(test.g, h, q); mq < m-1e-10 {
+					t.Errorf("model value %v at %v larger than %v at %v", m, p, mq, q)
+					i = 200
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestTrustRegionCollapse(t *testing.T) {
+	t.Parallel()
+	// The minimum of BrownAndDennis is not resolved to the default gradient
+	// threshold, and the trust region collapses before it is reached.
+	f := functions.BrownAndDennis{}
+	p := Problem{Func: f.Func, Grad: f.Grad, Hess: f.Hess}
+	for _, method := range []Method{&Dogleg{}, &Subspace2D{}, &SteihaugCG{}} {
+		result, err := Minimize(p, []float64{25, 5, -5, -1}, nil, method)
+		if err != ErrTrustRegionCollapse {
+			t.Errorf("%T: unexpected error: got %v, want %v", method, err, ErrTrustRegionCollapse)
+		}
+		if result == nil || result.Status != Failure {
+			t.Errorf("%T: unexpected result: %+v", method, result)
+			continue
+		}
+		if math.Abs(result.F-85822.2) > 1e-6*85822.2 {
+			t.Errorf("%T: collapse far from the minimum: f = %v", method, result.F)
+		}
+	}
+}
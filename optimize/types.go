@@ -49,11 +49,17 @@ const (
 	// HessEvaluation specifies that the Hessian
 	// of the objective function should be evaluated.
 	HessEvaluation
+	// ConstraintEvaluation specifies that the constraint
+	// functions should be evaluated.
+	ConstraintEvaluation
+	// JacobianEvaluation specifies that the Jacobian
+	// of the constraint functions should be evaluated.
+	JacobianEvaluation
 	// signalDone is used internally to signal completion.
 	signalDone
 
 	// Mask for the evaluating operations.
-	evalMask = FuncEvaluation | GradEvaluation | HessEvaluation
+	evalMask = FuncEvaluation | GradEvaluation | HessEvaluation | ConstraintEvaluation | JacobianEvaluation
 )
 
 func (op Operation) isEvaluation() bool {
@@ -62,10 +68,12 @@ func (op Operation) isEvaluation() bool {
 
 func (op Operation) String() string {
 	if op&evalMask != 0 {
-		return fmt.Sprintf("Evaluation(Func: %t, Grad: %t, Hess: %t, Extra: 0b%b)",
+		return fmt.Sprintf("Evaluation(Func: %t, Grad: %t, Hess: %t, Cons: %t, Jac: %t, Extra: 0b%b)",
 			op&FuncEvaluation != 0,
 			op&GradEvaluation != 0,
 			op&HessEvaluation != 0,
+			op&ConstraintEvaluation != 0,
+			op&JacobianEvaluation != 0,
 			op&^(evalMask))
 	}
 	s, ok := operationNames[op]
@@ -87,19 +95,27 @@ var operationNames = map[Operation]string{
 // Result represents the answer of an optimization run. It contains the optimum
 // function value, X location, and gradient as well as the Status at convergence
 // and Statistics taken during the run.
+//
+// For constrained problems, the Location also holds the constraint values and
+// the Lagrange multipliers estimated by the Method, and KKT holds the residuals
+// of the first-order optimality conditions at the optimum. KKT is nil if the
+// Method does not estimate Lagrange multipliers.
 type Result struct {
 	Location
 	Stats
 	Status Status
+	KKT    *KKTResidual
 }
 
 // Stats contains the statistics of the run.
 type Stats struct {
-	MajorIterations int           // Total number of major iterations
-	FuncEvaluations int           // Number of evaluations of Func
-	GradEvaluations int           // Number of evaluations of Grad
-	HessEvaluations int           // Number of evaluations of Hess
-	Runtime         time.Duration // Total runtime of the optimization
+	MajorIterations       int           // Total number of major iterations
+	FuncEvaluations       int           // Number of evaluations of Func
+	GradEvaluations       int           // Number of evaluations of Grad
+	HessEvaluations       int           // Number of evaluations of Hess
+	ConstraintEvaluations int           // Number of evaluations of Constraints.Func
+	JacobianEvaluations   int           // Number of evaluations of Constraints.Jacobian
+	Runtime               time.Duration // Total runtime of the optimization
 }
 
 // complementEval returns an evaluating operation that evaluates fields of loc
@@ -131,6 +147,12 @@ type Problem struct {
 	// will have dimensions matching the length of x. Hess must not modify x.
 	Hess func(hess *mat.SymDense, x []float64)
 
+	// Constraints specifies nonlinear equality and inequality constraints
+	// on x. If Constraints is nil, the problem is unconstrained. Only Methods
+	// that support constrained optimization, such as AugmentedLagrangian and
+	// SQP, may be used with a constrained problem.
+	Constraints *Constraints
+
 	// Status reports the status of the objective function being optimized and any
 	// error. This can be used to terminate early, for example when the function is
 	// not able to evaluate itself. The user can use one of the pre-provided Status
@@ -142,25 +164,37 @@ type Problem struct {
 type Available struct {
 	Grad bool
 	Hess bool
+
+	// Constraints and Jacobian describe the availability of the constraint
+	// functions and of their Jacobian.
+	Constraints bool
+	Jacobian    bool
 }
 
 func availFromProblem(prob Problem) Available {
-	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil}
+	has := Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil}
+	if prob.Constraints != nil {
+		has.Constraints = true
+		has.Jacobian = prob.Constraints.Jacobian != nil
+	}
+	return has
 }
 
 // function tests if the Problem described by the receiver is suitable for an
 // unconstrained Method that only calls the function, and returns the result.
 func (has Available) function() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Constraints {
+		return Available{}, ErrConstrained
+	}
 	return Available{}, nil
 }
 
 // gradient tests if the Problem described by the receiver is suitable for an
 // unconstrained gradient-based Method, and returns the result.
 func (has Available) gradient() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Constraints {
+		return Available{}, ErrConstrained
+	}
 	if !has.Grad {
 		return Available{}, ErrMissingGrad
 	}
@@ -170,8 +204,9 @@ func (has Available) gradient() (uses Available, err error) {
 // hessian tests if the Problem described by the receiver is suitable for an
 // unconstrained Hessian-based Method, and returns the result.
 func (has Available) hessian() (uses Available, err error) {
-	// TODO(btracey): This needs to be modified when optimize supports
-	// constrained optimization.
+	if has.Constraints {
+		return Available{}, ErrConstrained
+	}
 	if !has.Grad {
 		return Available{}, ErrMissingGrad
 	}
@@ -254,6 +289,24 @@ type Settings struct {
 
 	// Concurrent represents how many concurrent evaluations are possible.
 	Concurrent int
+
+	// Checkpoint, if not nil, is called with the state of the run after
+	// every major iteration that does not terminate the optimization. The
+	// Checkpoint can be saved, for example using its MarshalBinary method,
+	// and passed in Resume to continue the run later. The Method must
+	// implement Snapshotter. If Checkpoint returns an error, the
+	// optimization is terminated with Failure status and the error.
+	Checkpoint func(*Checkpoint) error
+
+	// Resume, if not nil, continues an optimization run from a Checkpoint
+	// of a previous run of the same Problem with a Method of the same
+	// configuration, which must implement Snapshotter. The initial location
+	// and statistics of the run are taken from Resume, so the initX passed
+	// to Minimize is ignored and may be nil, and InitValues must be nil.
+	// The Converger state is restored if the Converger implements
+	// Snapshotter. The limits in Settings apply to the total statistics of
+	// the resumed runs.
+	Resume *Checkpoint
 }
 
 // resize takes x and returns a slice of length dim. It returns a resliced x
@@ -1171,6 +1171,14 @@ func TestLBFGS(t *testing.T) {
 	testLocal(t, tests, &LBFGS{})
 }
 
+func TestLBFGSB(t *testing.T) {
+	t.Parallel()
+	var tests []unconstrainedTest
+	tests = append(tests, gradientDescentTests...)
+	tests = append(tests, lbfgsTests...)
+	testLocal(t, tests, &LBFGSB{})
+}
+
 func TestNewton(t *testing.T) {
 	t.Parallel()
 	testLocal(t, newtonTests, &Newton{})